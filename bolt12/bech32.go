@@ -0,0 +1,103 @@
+package bolt12
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/brsuite/bronutil/bech32"
+)
+
+const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// encodeBech32 encodes the data as a bech32 string with the given
+// human-readable part. Unlike BOLT11 invoices, BOLT12 strings don't carry a
+// checksum, as they are meant to be read by scanners rather than typed in.
+func encodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var bldr strings.Builder
+	bldr.Grow(len(hrp) + 1 + len(converted))
+	bldr.WriteString(hrp)
+	bldr.WriteString("1")
+	for _, b := range converted {
+		bldr.WriteByte(charset[b])
+	}
+
+	return bldr.String(), nil
+}
+
+// decodeBech32 decodes a bech32 string without checksum, asserting that its
+// human-readable part matches the expected one. Strings may be split into
+// several parts that are joined by a '+' followed by optional whitespace.
+func decodeBech32(expectedHrp, str string) ([]byte, error) {
+	str, err := joinParts(str)
+	if err != nil {
+		return nil, err
+	}
+
+	// The characters must be either all lowercase or all uppercase.
+	lower := strings.ToLower(str)
+	if str != lower && str != strings.ToUpper(str) {
+		return nil, errors.New("string not all lowercase or all " +
+			"uppercase")
+	}
+	str = lower
+
+	one := strings.LastIndexByte(str, '1')
+	if one < 1 {
+		return nil, errors.New("invalid index of 1")
+	}
+
+	hrp := str[:one]
+	if hrp != expectedHrp {
+		return nil, fmt.Errorf("invalid human-readable part %q, "+
+			"expected %q", hrp, expectedHrp)
+	}
+
+	data := str[one+1:]
+	if len(data) == 0 {
+		return nil, errors.New("empty data part")
+	}
+
+	converted := make([]byte, len(data))
+	for i := 0; i < len(data); i++ {
+		index := strings.IndexByte(charset, data[i])
+		if index < 0 {
+			return nil, fmt.Errorf("invalid character not part of "+
+				"charset: %v", data[i])
+		}
+		converted[i] = byte(index)
+	}
+
+	return bech32.ConvertBits(converted, 5, 8, false)
+}
+
+// joinParts removes the '+' separators, along with any whitespace following
+// them, from the string.
+func joinParts(str string) (string, error) {
+	parts := strings.Split(str, "+")
+	for i, part := range parts {
+		if i > 0 {
+			part = strings.TrimLeftFunc(part, unicode.IsSpace)
+		}
+
+		if part == "" {
+			return "", errors.New("empty part in string")
+		}
+		for _, c := range part {
+			if c < 33 || c > 126 {
+				return "", fmt.Errorf("invalid character in "+
+					"string: '%c'", c)
+			}
+		}
+
+		parts[i] = part
+	}
+
+	return strings.Join(parts, ""), nil
+}
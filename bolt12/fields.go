@@ -0,0 +1,298 @@
+package bolt12
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	"unicode/utf8"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+)
+
+var (
+	// ErrUnknownRequiredField is returned when a message contains an
+	// unknown even field.
+	ErrUnknownRequiredField = errors.New("unknown required field")
+
+	// ErrFieldOutOfRange is returned when a message contains a field whose
+	// type is outside of the ranges that are allowed for the message.
+	ErrFieldOutOfRange = errors.New("field type outside of allowed range")
+)
+
+// typeRange is an inclusive range of TLV types.
+type typeRange struct {
+	start uint64
+	end   uint64
+}
+
+// contains returns true if the type is part of the range.
+func (r typeRange) contains(typ uint64) bool {
+	return typ >= r.start && typ <= r.end
+}
+
+// encodeFields encodes the fields as a TLV stream, ordered by their types.
+func encodeFields(fields map[uint64][]byte) ([]byte, error) {
+	stream, err := tlv.NewStream(tlv.MapToRecords(fields)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeFields decodes the TLV stream into the raw values of its fields,
+// asserting that the stream is canonical.
+func decodeFields(b []byte) (map[uint64][]byte, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[uint64][]byte, len(parsedTypes))
+	for typ, value := range parsedTypes {
+		fields[uint64(typ)] = value
+	}
+
+	return fields, nil
+}
+
+// takeExtraFields removes the fields with types in the given ranges from the
+// map and returns them. As the known fields of a message must have been
+// removed before, an error is returned if any of them is even.
+func takeExtraFields(fields map[uint64][]byte,
+	ranges ...typeRange) (map[uint64][]byte, error) {
+
+	var extra map[uint64][]byte
+	for typ, value := range fields {
+		var inRange bool
+		for _, r := range ranges {
+			inRange = inRange || r.contains(typ)
+		}
+		if !inRange {
+			continue
+		}
+
+		if typ%2 == 0 {
+			return nil, fmt.Errorf("%w: %d", ErrUnknownRequiredField,
+				typ)
+		}
+
+		if extra == nil {
+			extra = make(map[uint64][]byte)
+		}
+		extra[typ] = value
+		delete(fields, typ)
+	}
+
+	return extra, nil
+}
+
+// assertNoFields returns an error if any field is left in the map after all
+// ranges allowed for a message have been taken from it.
+func assertNoFields(fields map[uint64][]byte) error {
+	for typ := range fields {
+		return fmt.Errorf("%w: %d", ErrFieldOutOfRange, typ)
+	}
+
+	return nil
+}
+
+// encodeTU64 encodes the value as a truncated uint64.
+func encodeTU64(v uint64) []byte {
+	var (
+		b   bytes.Buffer
+		buf [8]byte
+	)
+	_ = tlv.ETUint64T(&b, v, &buf)
+
+	return b.Bytes()
+}
+
+// decodeTU64 decodes a truncated uint64.
+func decodeTU64(value []byte) (uint64, error) {
+	var (
+		v   uint64
+		buf [8]byte
+	)
+	err := tlv.DTUint64(
+		bytes.NewReader(value), &v, &buf, uint64(len(value)),
+	)
+
+	return v, err
+}
+
+// decodeTU32 decodes a truncated uint32.
+func decodeTU32(value []byte) (uint32, error) {
+	var (
+		v   uint32
+		buf [8]byte
+	)
+	err := tlv.DTUint32(
+		bytes.NewReader(value), &v, &buf, uint64(len(value)),
+	)
+
+	return v, err
+}
+
+// encodeTimestamp encodes the time as a truncated uint64 of its unix
+// timestamp.
+func encodeTimestamp(t time.Time) []byte {
+	return encodeTU64(uint64(t.Unix()))
+}
+
+// decodeTimestamp decodes a unix timestamp encoded as truncated uint64.
+func decodeTimestamp(value []byte) (time.Time, error) {
+	v, err := decodeTU64(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(v), 0), nil
+}
+
+// decodeString decodes a utf8 string.
+func decodeString(value []byte) (string, error) {
+	if !utf8.Valid(value) {
+		return "", errors.New("invalid utf8 string")
+	}
+
+	return string(value), nil
+}
+
+// decodePubKey decodes a compressed public key.
+func decodePubKey(value []byte) (*bronec.PublicKey, error) {
+	return bronec.ParsePubKey(value, bronec.S256())
+}
+
+// encodeFeatures encodes the feature vector without length prefix.
+func encodeFeatures(features *lnwire.RawFeatureVector) ([]byte, error) {
+	var b bytes.Buffer
+	if err := features.EncodeBase256(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeFeatures decodes a feature vector, asserting that it doesn't require
+// any features that we don't know.
+func decodeFeatures(value []byte) (*lnwire.RawFeatureVector, error) {
+	raw := lnwire.NewRawFeatureVector()
+	err := raw.DecodeBase256(bytes.NewReader(value), len(value))
+	if err != nil {
+		return nil, err
+	}
+
+	features := lnwire.NewFeatureVector(raw, lnwire.Features)
+	if unknown := features.UnknownRequiredFeatures(); len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown required features: %v", unknown)
+	}
+
+	return raw, nil
+}
+
+// encodePaths encodes a list of blinded paths.
+func encodePaths(paths []*blindedpath.BlindedPath) ([]byte, error) {
+	var b bytes.Buffer
+	for _, path := range paths {
+		if err := record.EncodeBlindedPath(&b, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodePaths decodes a non-empty list of blinded paths.
+func decodePaths(value []byte) ([]*blindedpath.BlindedPath, error) {
+	if len(value) == 0 {
+		return nil, errors.New("empty blinded path list")
+	}
+
+	var (
+		r     = bytes.NewReader(value)
+		paths []*blindedpath.BlindedPath
+	)
+	for r.Len() > 0 {
+		path, err := record.DecodeBlindedPath(r)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// encodePayInfo encodes the fees and constraints of a list of blinded payment
+// paths, without the paths themselves.
+func encodePayInfo(payments []*blindedpath.BlindedPayment) []byte {
+	var b bytes.Buffer
+	for _, payment := range payments {
+		var info [28]byte
+		binary.BigEndian.PutUint32(info[0:4], payment.BaseFee)
+		binary.BigEndian.PutUint32(
+			info[4:8], payment.ProportionalFeeRate,
+		)
+		binary.BigEndian.PutUint16(info[8:10], payment.CltvExpiryDelta)
+		binary.BigEndian.PutUint64(info[10:18], payment.HtlcMinimum)
+		binary.BigEndian.PutUint64(info[18:26], payment.HtlcMaximum)
+
+		// We don't set any features for the blinded paths we create,
+		// so the feature length remains zero.
+		b.Write(info[:])
+	}
+
+	return b.Bytes()
+}
+
+// decodePayInfo decodes the fees and constraints of a list of blinded payment
+// paths. The returned payments don't have their paths set yet.
+func decodePayInfo(value []byte) ([]*blindedpath.BlindedPayment, error) {
+	var (
+		r        = bytes.NewReader(value)
+		payments []*blindedpath.BlindedPayment
+	)
+	for r.Len() > 0 {
+		var info [28]byte
+		if _, err := io.ReadFull(r, info[:]); err != nil {
+			return nil, err
+		}
+
+		featuresLen := binary.BigEndian.Uint16(info[26:28])
+		features := make([]byte, featuresLen)
+		if _, err := io.ReadFull(r, features); err != nil {
+			return nil, err
+		}
+		if _, err := decodeFeatures(features); err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, &blindedpath.BlindedPayment{
+			BaseFee:             binary.BigEndian.Uint32(info[0:4]),
+			ProportionalFeeRate: binary.BigEndian.Uint32(info[4:8]),
+			CltvExpiryDelta:     binary.BigEndian.Uint16(info[8:10]),
+			HtlcMinimum:         binary.BigEndian.Uint64(info[10:18]),
+			HtlcMaximum:         binary.BigEndian.Uint64(info[18:26]),
+		})
+	}
+
+	return payments, nil
+}
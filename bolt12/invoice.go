@@ -0,0 +1,515 @@
+package bolt12
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// InvoiceHrp is the human-readable part of bech32 encoded invoices.
+	InvoiceHrp = "lni"
+
+	// InvoiceOnionType is the type of the onion message record that
+	// carries an invoice.
+	InvoiceOnionType = 66
+
+	// InvoiceErrorOnionType is the type of the onion message record that
+	// carries an error in reply to an invoice request or invoice.
+	InvoiceErrorOnionType = 68
+
+	// DefaultInvoiceExpiry is the expiry of invoices that don't specify
+	// a relative expiry.
+	DefaultInvoiceExpiry = 2 * time.Hour
+
+	// invoiceMessage is the message name that is committed to by the
+	// signature of invoices.
+	invoiceMessage = "invoice"
+
+	// The following are the TLV types of the fields of an invoice.
+	invoicePathsType          = 160
+	invoiceBlindedPayType     = 162
+	invoiceCreatedAtType      = 164
+	invoiceRelativeExpiryType = 166
+	invoicePaymentHashType    = 168
+	invoiceAmountType         = 170
+	invoiceFallbacksType      = 172
+	invoiceFeaturesType       = 174
+	invoiceNodeIDType         = 176
+)
+
+var (
+	// invoiceRanges are the TLV type ranges of the fields of an invoice,
+	// excluding those of the invoice request.
+	invoiceRanges = []typeRange{
+		{start: 160, end: 239},
+		{start: 3000000000, end: 3999999999},
+	}
+
+	// ErrNoPaths is returned when an invoice doesn't have any blinded
+	// paths to pay it over.
+	ErrNoPaths = errors.New("invoice must have paths")
+
+	// ErrPayInfoMismatch is returned when the number of blinded payment
+	// infos of an invoice doesn't match its number of paths.
+	ErrPayInfoMismatch = errors.New("invoice must have a blinded pay " +
+		"info for each path")
+
+	// ErrInvalidNodeID is returned when the node ID of an invoice doesn't
+	// match the offer.
+	ErrInvalidNodeID = errors.New("invoice node id doesn't match offer")
+)
+
+// FallbackAddress is an on-chain address that an invoice may be paid to if
+// the payment over lightning fails.
+type FallbackAddress struct {
+	// Version is the witness version of the address.
+	Version uint8
+
+	// Address is the witness program of the address.
+	Address []byte
+}
+
+// Invoice is a request for payment that is sent in reply to an invoice
+// request. It mirrors all fields of the invoice request, apart from its
+// signature.
+type Invoice struct {
+	// InvoiceRequest contains the fields of the invoice request that the
+	// invoice was created for.
+	InvoiceRequest *InvoiceRequest
+
+	// Paths are the blinded payment paths that the invoice must be paid
+	// over, along with the fees and constraints of each path.
+	Paths []*blindedpath.BlindedPayment
+
+	// CreatedAt is the time that the invoice was created at.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the duration after CreatedAt after which the
+	// invoice must no longer be paid. If zero, DefaultInvoiceExpiry
+	// applies.
+	RelativeExpiry time.Duration
+
+	// PaymentHash is the hash of the preimage that is revealed when the
+	// invoice is paid.
+	PaymentHash lntypes.Hash
+
+	// Amount is the amount that must be paid.
+	Amount lnwire.MilliBronees
+
+	// Fallbacks are on-chain addresses to pay the invoice to.
+	Fallbacks []FallbackAddress
+
+	// Features are the features that are required or supported to pay
+	// the invoice.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the key that the invoice is signed with.
+	NodeID *bronec.PublicKey
+
+	// Signature is the BIP340 signature of the recipient over the merkle
+	// root of the invoice.
+	Signature *[64]byte
+
+	// ExtraRecords are fields with unknown odd types.
+	ExtraRecords map[uint64][]byte
+}
+
+// Expiry returns the time after which the invoice must no longer be paid.
+func (i *Invoice) Expiry() time.Time {
+	expiry := i.RelativeExpiry
+	if expiry == 0 {
+		expiry = DefaultInvoiceExpiry
+	}
+
+	return i.CreatedAt.Add(expiry)
+}
+
+// Validate asserts that the invoice contains all fields required for it to be
+// paid, and that it was created by the issuer of the offer.
+func (i *Invoice) Validate() error {
+	switch {
+	case len(i.Paths) == 0:
+		return ErrNoPaths
+
+	case i.CreatedAt.IsZero():
+		return errors.New("invoice must have creation time")
+
+	case i.PaymentHash == lntypes.ZeroHash:
+		return errors.New("invoice must have payment hash")
+
+	case i.Amount == 0:
+		return errors.New("invoice must have amount")
+
+	case i.NodeID == nil:
+		return errors.New("invoice must have node id")
+
+	case i.Signature == nil:
+		return ErrNoSignature
+	}
+
+	if i.InvoiceRequest == nil || i.InvoiceRequest.Offer == nil {
+		return nil
+	}
+
+	// An invoice for an offer must be signed by the issuer of the offer.
+	// If the offer doesn't reveal the issuer, the invoice is signed by the
+	// final node of one of the blinded paths of the offer.
+	offer := i.InvoiceRequest.Offer
+	switch {
+	case offer.IssuerID != nil:
+		if !i.NodeID.IsEqual(offer.IssuerID) {
+			return ErrInvalidNodeID
+		}
+
+	case len(offer.Paths) > 0:
+		for _, path := range offer.Paths {
+			lastHop := path.BlindedHops[len(path.BlindedHops)-1]
+			if i.NodeID.IsEqual(lastHop.BlindedNodePub) {
+				return nil
+			}
+		}
+
+		return ErrInvalidNodeID
+	}
+
+	return nil
+}
+
+// encodeFields adds the fields of the invoice, excluding its signature, to the
+// map.
+func (i *Invoice) encodeFields(fields map[uint64][]byte) error {
+	if i.InvoiceRequest != nil {
+		if err := i.InvoiceRequest.encodeFields(fields); err != nil {
+			return err
+		}
+	}
+
+	if len(i.Paths) > 0 {
+		paths := make([]*blindedpath.BlindedPath, 0, len(i.Paths))
+		for _, payment := range i.Paths {
+			paths = append(paths, payment.BlindedPath)
+		}
+
+		encodedPaths, err := encodePaths(paths)
+		if err != nil {
+			return err
+		}
+		fields[invoicePathsType] = encodedPaths
+		fields[invoiceBlindedPayType] = encodePayInfo(i.Paths)
+	}
+	if !i.CreatedAt.IsZero() {
+		fields[invoiceCreatedAtType] = encodeTimestamp(i.CreatedAt)
+	}
+	if i.RelativeExpiry != 0 {
+		fields[invoiceRelativeExpiryType] = encodeTU64(
+			uint64(i.RelativeExpiry / time.Second),
+		)
+	}
+	if i.PaymentHash != lntypes.ZeroHash {
+		fields[invoicePaymentHashType] = i.PaymentHash[:]
+	}
+	if i.Amount != 0 {
+		fields[invoiceAmountType] = encodeTU64(uint64(i.Amount))
+	}
+	if len(i.Fallbacks) > 0 {
+		fallbacks, err := encodeFallbacks(i.Fallbacks)
+		if err != nil {
+			return err
+		}
+		fields[invoiceFallbacksType] = fallbacks
+	}
+	if i.Features != nil && i.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(i.Features)
+		if err != nil {
+			return err
+		}
+		fields[invoiceFeaturesType] = features
+	}
+	if i.NodeID != nil {
+		fields[invoiceNodeIDType] = i.NodeID.SerializeCompressed()
+	}
+
+	for typ, value := range i.ExtraRecords {
+		fields[typ] = value
+	}
+
+	return nil
+}
+
+// decodeInvoiceFields takes the fields of an invoice, excluding its signature,
+// from the map and decodes them.
+func decodeInvoiceFields(fields map[uint64][]byte) (*Invoice, error) {
+	req, err := decodeInvoiceRequestFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &Invoice{
+		InvoiceRequest: req,
+	}
+
+	var paths []*blindedpath.BlindedPath
+	if value, ok := fields[invoicePathsType]; ok {
+		paths, err = decodePaths(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invoiceBlindedPayType]; ok {
+		invoice.Paths, err = decodePayInfo(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(paths) != len(invoice.Paths) {
+		return nil, ErrPayInfoMismatch
+	}
+	for j, path := range paths {
+		invoice.Paths[j].BlindedPath = path
+	}
+
+	if value, ok := fields[invoiceCreatedAtType]; ok {
+		invoice.CreatedAt, err = decodeTimestamp(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invoiceRelativeExpiryType]; ok {
+		expiry, err := decodeTU32(value)
+		if err != nil {
+			return nil, err
+		}
+		invoice.RelativeExpiry = time.Duration(expiry) * time.Second
+	}
+	if value, ok := fields[invoicePaymentHashType]; ok {
+		hash, err := lntypes.MakeHash(value)
+		if err != nil {
+			return nil, err
+		}
+		invoice.PaymentHash = hash
+	}
+	if value, ok := fields[invoiceAmountType]; ok {
+		amount, err := decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+		invoice.Amount = lnwire.MilliBronees(amount)
+	}
+	if value, ok := fields[invoiceFallbacksType]; ok {
+		invoice.Fallbacks, err = decodeFallbacks(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invoiceFeaturesType]; ok {
+		invoice.Features, err = decodeFeatures(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invoiceNodeIDType]; ok {
+		invoice.NodeID, err = decodePubKey(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, typ := range []uint64{
+		invoicePathsType, invoiceBlindedPayType, invoiceCreatedAtType,
+		invoiceRelativeExpiryType, invoicePaymentHashType,
+		invoiceAmountType, invoiceFallbacksType, invoiceFeaturesType,
+		invoiceNodeIDType,
+	} {
+		delete(fields, typ)
+	}
+
+	invoice.ExtraRecords, err = takeExtraFields(fields, invoiceRanges...)
+	if err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// encodeFallbacks encodes a list of fallback addresses.
+func encodeFallbacks(fallbacks []FallbackAddress) ([]byte, error) {
+	var b bytes.Buffer
+	for _, fallback := range fallbacks {
+		if len(fallback.Address) > 0xffff {
+			return nil, fmt.Errorf("fallback address too long: %d",
+				len(fallback.Address))
+		}
+
+		var header [3]byte
+		header[0] = fallback.Version
+		binary.BigEndian.PutUint16(
+			header[1:], uint16(len(fallback.Address)),
+		)
+
+		b.Write(header[:])
+		b.Write(fallback.Address)
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeFallbacks decodes a list of fallback addresses.
+func decodeFallbacks(value []byte) ([]FallbackAddress, error) {
+	var (
+		r         = bytes.NewReader(value)
+		fallbacks []FallbackAddress
+	)
+	for r.Len() > 0 {
+		var header [3]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, err
+		}
+
+		address := make([]byte, binary.BigEndian.Uint16(header[1:]))
+		if _, err := io.ReadFull(r, address); err != nil {
+			return nil, err
+		}
+
+		fallbacks = append(fallbacks, FallbackAddress{
+			Version: header[0],
+			Address: address,
+		})
+	}
+
+	return fallbacks, nil
+}
+
+// SignatureDigest returns the digest that the recipient signs to create the
+// signature of the invoice.
+func (i *Invoice) SignatureDigest() ([32]byte, error) {
+	fields := make(map[uint64][]byte)
+	if err := i.encodeFields(fields); err != nil {
+		return [32]byte{}, err
+	}
+
+	b, err := encodeFields(fields)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	root, err := MerkleRoot(b)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return SignatureDigest(invoiceMessage, root), nil
+}
+
+// Sign signs the invoice with the private key of its node id.
+func (i *Invoice) Sign(nodeKey *bronec.PrivateKey) error {
+	if i.NodeID == nil || !i.NodeID.IsEqual(nodeKey.PubKey()) {
+		return errors.New("node key doesn't match node id")
+	}
+
+	digest, err := i.SignatureDigest()
+	if err != nil {
+		return err
+	}
+
+	sig, err := SignDigest(nodeKey, digest)
+	if err != nil {
+		return err
+	}
+	i.Signature = &sig
+
+	return nil
+}
+
+// VerifySignature returns an error if the invoice isn't signed by its node id.
+func (i *Invoice) VerifySignature() error {
+	switch {
+	case i.NodeID == nil:
+		return errors.New("invoice must have node id")
+
+	case i.Signature == nil:
+		return ErrNoSignature
+	}
+
+	digest, err := i.SignatureDigest()
+	if err != nil {
+		return err
+	}
+
+	if !VerifyDigest(i.NodeID, digest, *i.Signature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Serialize encodes the invoice as TLV stream.
+func (i *Invoice) Serialize() ([]byte, error) {
+	fields := make(map[uint64][]byte)
+	if err := i.encodeFields(fields); err != nil {
+		return nil, err
+	}
+
+	if i.Signature != nil {
+		fields[signatureType] = i.Signature[:]
+	}
+
+	return encodeFields(fields)
+}
+
+// DeserializeInvoice decodes an invoice from its TLV stream and validates it.
+// The signature isn't verified.
+func DeserializeInvoice(b []byte) (*Invoice, error) {
+	fields, err := decodeFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice, err := decodeInvoiceFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice.Signature, err = decodeSignature(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assertNoFields(fields); err != nil {
+		return nil, err
+	}
+
+	if err := invoice.Validate(); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// Encode encodes the invoice as bech32 string.
+func (i *Invoice) Encode() (string, error) {
+	b, err := i.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceHrp, b)
+}
+
+// DecodeInvoice decodes and validates a bech32 encoded invoice.
+func DecodeInvoice(invoice string) (*Invoice, error) {
+	b, err := decodeBech32(InvoiceHrp, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeserializeInvoice(b)
+}
@@ -0,0 +1,103 @@
+package bolt12
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// The following are the TLV types of the fields of an invoice error.
+	invoiceErrorFieldType          = 1
+	invoiceErrorSuggestedValueType = 3
+	invoiceErrorMessageType        = 5
+)
+
+var (
+	// invoiceErrorRange is the TLV type range of the fields of an invoice
+	// error. Invoice errors aren't mirrored by other messages, so any odd
+	// type is allowed.
+	invoiceErrorRange = typeRange{start: 0, end: math.MaxUint64}
+)
+
+// InvoiceError is sent in reply to an invoice request or invoice that can't
+// be processed by the recipient.
+type InvoiceError struct {
+	// ErroneousField is the TLV type of the field that caused the error,
+	// if the error is caused by a single field.
+	ErroneousField *uint64
+
+	// SuggestedValue is a value for the erroneous field that the recipient
+	// would accept.
+	SuggestedValue []byte
+
+	// Message is a description of the error.
+	Message string
+}
+
+// Error returns the description of the error.
+//
+// NOTE: Part of the error interface.
+func (e *InvoiceError) Error() string {
+	if e.ErroneousField == nil {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%v (field %d)", e.Message, *e.ErroneousField)
+}
+
+// Serialize encodes the invoice error as TLV stream.
+func (e *InvoiceError) Serialize() ([]byte, error) {
+	fields := make(map[uint64][]byte)
+	if e.ErroneousField != nil {
+		fields[invoiceErrorFieldType] = encodeTU64(*e.ErroneousField)
+	}
+	if len(e.SuggestedValue) > 0 {
+		fields[invoiceErrorSuggestedValueType] = e.SuggestedValue
+	}
+	fields[invoiceErrorMessageType] = []byte(e.Message)
+
+	return encodeFields(fields)
+}
+
+// DeserializeInvoiceError decodes an invoice error from its TLV stream.
+func DeserializeInvoiceError(b []byte) (*InvoiceError, error) {
+	fields, err := decodeFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceErr := &InvoiceError{}
+	if value, ok := fields[invoiceErrorFieldType]; ok {
+		field, err := decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+		invoiceErr.ErroneousField = &field
+	}
+	if value, ok := fields[invoiceErrorSuggestedValueType]; ok {
+		invoiceErr.SuggestedValue = value
+	}
+
+	value, ok := fields[invoiceErrorMessageType]
+	if !ok {
+		return nil, errors.New("invoice error must have message")
+	}
+	invoiceErr.Message, err = decodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typ := range []uint64{
+		invoiceErrorFieldType, invoiceErrorSuggestedValueType,
+		invoiceErrorMessageType,
+	} {
+		delete(fields, typ)
+	}
+
+	if _, err := takeExtraFields(fields, invoiceErrorRange); err != nil {
+		return nil, err
+	}
+
+	return invoiceErr, nil
+}
@@ -0,0 +1,496 @@
+package bolt12
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+)
+
+const (
+	// InvoiceRequestHrp is the human-readable part of bech32 encoded
+	// invoice requests, which are used to offer refunds.
+	InvoiceRequestHrp = "lnr"
+
+	// InvoiceRequestOnionType is the type of the onion message record that
+	// carries an invoice request.
+	InvoiceRequestOnionType = 64
+
+	// invoiceRequestMessage is the message name that is committed to by
+	// the signature of invoice requests.
+	invoiceRequestMessage = "invoice_request"
+
+	// The following are the TLV types of the fields of an invoice request,
+	// which are mirrored by the invoices for the request.
+	invreqMetadataType  = 0
+	invreqChainType     = 80
+	invreqAmountType    = 82
+	invreqFeaturesType  = 84
+	invreqQuantityType  = 86
+	invreqPayerIDType   = 88
+	invreqPayerNoteType = 89
+	invreqPathsType     = 90
+
+	// signatureType is the TLV type of the signature of invoice requests
+	// and invoices.
+	signatureType = 240
+)
+
+var (
+	// invoiceRequestRanges are the TLV type ranges of the fields of an
+	// invoice request, excluding those of the offer.
+	invoiceRequestRanges = []typeRange{
+		{start: 0, end: 0},
+		{start: 80, end: 159},
+		{start: 2000000000, end: 2999999999},
+	}
+
+	// ErrNoMetadata is returned when an invoice request doesn't have any
+	// metadata.
+	ErrNoMetadata = errors.New("invoice request must have metadata")
+
+	// ErrNoPayerID is returned when an invoice request doesn't have a payer
+	// id.
+	ErrNoPayerID = errors.New("invoice request must have payer id")
+
+	// ErrNoAmount is returned when an invoice request for an offer without
+	// amount doesn't specify an amount.
+	ErrNoAmount = errors.New("invoice request must have amount")
+
+	// ErrInvalidQuantity is returned when the quantity of an invoice
+	// request isn't allowed by the offer.
+	ErrInvalidQuantity = errors.New("invalid quantity for offer")
+
+	// ErrNoSignature is returned when an invoice request or invoice isn't
+	// signed.
+	ErrNoSignature = errors.New("missing signature")
+
+	// ErrAmountBelowOffer is returned when the amount of an invoice request
+	// is below the amount of the offer for the requested quantity.
+	ErrAmountBelowOffer = errors.New("amount below offer amount")
+
+	// ErrCurrencyNotSupported is returned when the amount of an invoice
+	// for an offer that is denominated in a currency is requested.
+	ErrCurrencyNotSupported = errors.New("offer currency not supported")
+)
+
+// InvoiceRequest requests an invoice for an offer from its issuer, or offers
+// a refund to the recipient of the request. It mirrors all fields of the
+// offer.
+type InvoiceRequest struct {
+	// Offer contains the fields of the offer that the invoice is
+	// requested for.
+	Offer *Offer
+
+	// Metadata is an unpredictable sequence of bytes chosen by the payer,
+	// which ensures that the merkle tree of the request can't be guessed.
+	Metadata []byte
+
+	// Chain is the genesis hash of the chain that the payer wants to pay
+	// on. If nil, the main chain is used.
+	Chain *chainhash.Hash
+
+	// Amount is the amount that the payer wants to pay. It is required if
+	// the offer doesn't have an amount.
+	Amount lnwire.MilliBronees
+
+	// Features are the features that the payer supports or requires.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items that the payer requests. It must be
+	// set if the offer has a maximum quantity, and zero otherwise.
+	Quantity uint64
+
+	// PayerID is the key that the request is signed with.
+	PayerID *bronec.PublicKey
+
+	// PayerNote is a note from the payer to the issuer.
+	PayerNote string
+
+	// Paths are blinded paths to the payer, which are used to reach the
+	// payer if the request isn't sent over an onion message.
+	Paths []*blindedpath.BlindedPath
+
+	// Signature is the BIP340 signature of the payer over the merkle root
+	// of the request.
+	Signature *[64]byte
+
+	// ExtraRecords are fields with unknown odd types, which are preserved
+	// such that they are mirrored in the invoice.
+	ExtraRecords map[uint64][]byte
+}
+
+// Validate asserts that the invoice request contains all fields required for
+// the issuer of the offer to reply with an invoice.
+func (r *InvoiceRequest) Validate() error {
+	switch {
+	case len(r.Metadata) == 0:
+		return ErrNoMetadata
+
+	case r.PayerID == nil:
+		return ErrNoPayerID
+
+	case r.Signature == nil:
+		return ErrNoSignature
+	}
+
+	// If the request doesn't have an issuer, it offers a refund rather than
+	// requesting an invoice for an offer.
+	offer := r.Offer
+	if offer == nil || (offer.IssuerID == nil && len(offer.Paths) == 0) {
+		if r.Amount == 0 {
+			return ErrNoAmount
+		}
+
+		return nil
+	}
+
+	if err := offer.Validate(); err != nil {
+		return err
+	}
+
+	if offer.Amount == 0 && r.Amount == 0 {
+		return ErrNoAmount
+	}
+
+	switch {
+	case offer.QuantityMax == nil && r.Quantity != 0:
+		return ErrInvalidQuantity
+
+	case offer.QuantityMax != nil && r.Quantity == 0:
+		return ErrInvalidQuantity
+
+	case offer.QuantityMax != nil && *offer.QuantityMax != 0 &&
+		r.Quantity > *offer.QuantityMax:
+
+		return ErrInvalidQuantity
+	}
+
+	// The payer may pay more than the offer asks for, but not less.
+	if offer.Amount != 0 && offer.Currency == "" && r.Amount != 0 {
+		offerAmount, err := r.offerAmount()
+		if err != nil {
+			return err
+		}
+
+		if r.Amount < offerAmount {
+			return ErrAmountBelowOffer
+		}
+	}
+
+	return nil
+}
+
+// offerAmount returns the amount of the offer for the requested quantity.
+func (r *InvoiceRequest) offerAmount() (lnwire.MilliBronees, error) {
+	quantity := r.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	hi, amount := bits.Mul64(r.Offer.Amount, quantity)
+	if hi != 0 {
+		return 0, errors.New("offer amount overflow")
+	}
+
+	return lnwire.MilliBronees(amount), nil
+}
+
+// InvoiceAmount returns the amount of the invoice for the request. This is the
+// amount of the request if set, and the amount of the offer for the requested
+// quantity otherwise.
+func (r *InvoiceRequest) InvoiceAmount() (lnwire.MilliBronees, error) {
+	switch {
+	case r.Amount != 0:
+		return r.Amount, nil
+
+	case r.Offer == nil || r.Offer.Amount == 0:
+		return 0, ErrNoAmount
+
+	case r.Offer.Currency != "":
+		return 0, ErrCurrencyNotSupported
+	}
+
+	return r.offerAmount()
+}
+
+// encodeFields adds the fields of the invoice request, excluding its
+// signature, to the map.
+func (r *InvoiceRequest) encodeFields(fields map[uint64][]byte) error {
+	if r.Offer != nil {
+		if err := r.Offer.encodeFields(fields); err != nil {
+			return err
+		}
+	}
+
+	if len(r.Metadata) > 0 {
+		fields[invreqMetadataType] = r.Metadata
+	}
+	if r.Chain != nil {
+		fields[invreqChainType] = r.Chain[:]
+	}
+	if r.Amount != 0 {
+		fields[invreqAmountType] = encodeTU64(uint64(r.Amount))
+	}
+	if r.Features != nil && r.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(r.Features)
+		if err != nil {
+			return err
+		}
+		fields[invreqFeaturesType] = features
+	}
+	if r.Quantity != 0 {
+		fields[invreqQuantityType] = encodeTU64(r.Quantity)
+	}
+	if r.PayerID != nil {
+		fields[invreqPayerIDType] = r.PayerID.SerializeCompressed()
+	}
+	if r.PayerNote != "" {
+		fields[invreqPayerNoteType] = []byte(r.PayerNote)
+	}
+	if len(r.Paths) > 0 {
+		paths, err := encodePaths(r.Paths)
+		if err != nil {
+			return err
+		}
+		fields[invreqPathsType] = paths
+	}
+
+	for typ, value := range r.ExtraRecords {
+		fields[typ] = value
+	}
+
+	return nil
+}
+
+// decodeInvoiceRequestFields takes the fields of an invoice request, excluding
+// its signature, from the map and decodes them.
+func decodeInvoiceRequestFields(
+	fields map[uint64][]byte) (*InvoiceRequest, error) {
+
+	offer, err := decodeOfferFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Offer: offer,
+	}
+
+	if value, ok := fields[invreqMetadataType]; ok {
+		req.Metadata = value
+	}
+	if value, ok := fields[invreqChainType]; ok {
+		chain, err := chainhash.NewHash(value)
+		if err != nil {
+			return nil, err
+		}
+		req.Chain = chain
+	}
+	if value, ok := fields[invreqAmountType]; ok {
+		amount, err := decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+		req.Amount = lnwire.MilliBronees(amount)
+	}
+	if value, ok := fields[invreqFeaturesType]; ok {
+		req.Features, err = decodeFeatures(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invreqQuantityType]; ok {
+		req.Quantity, err = decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invreqPayerIDType]; ok {
+		req.PayerID, err = decodePubKey(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invreqPayerNoteType]; ok {
+		req.PayerNote, err = decodeString(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[invreqPathsType]; ok {
+		req.Paths, err = decodePaths(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, typ := range []uint64{
+		invreqMetadataType, invreqChainType, invreqAmountType,
+		invreqFeaturesType, invreqQuantityType, invreqPayerIDType,
+		invreqPayerNoteType, invreqPathsType,
+	} {
+		delete(fields, typ)
+	}
+
+	req.ExtraRecords, err = takeExtraFields(
+		fields, invoiceRequestRanges...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// decodeSignature takes the signature from the map and decodes it, ignoring
+// any unknown odd fields of the signature range.
+func decodeSignature(fields map[uint64][]byte) (*[64]byte, error) {
+	var sig *[64]byte
+	if value, ok := fields[signatureType]; ok {
+		if len(value) != 64 {
+			return nil, errors.New("invalid signature length")
+		}
+
+		sig = &[64]byte{}
+		copy(sig[:], value)
+		delete(fields, signatureType)
+	}
+
+	if _, err := takeExtraFields(fields, signatureRange); err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+// SignatureDigest returns the digest that the payer signs to create the
+// signature of the invoice request.
+func (r *InvoiceRequest) SignatureDigest() ([32]byte, error) {
+	fields := make(map[uint64][]byte)
+	if err := r.encodeFields(fields); err != nil {
+		return [32]byte{}, err
+	}
+
+	b, err := encodeFields(fields)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	root, err := MerkleRoot(b)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return SignatureDigest(invoiceRequestMessage, root), nil
+}
+
+// Sign signs the invoice request with the private key of its payer id.
+func (r *InvoiceRequest) Sign(payerKey *bronec.PrivateKey) error {
+	if r.PayerID == nil || !r.PayerID.IsEqual(payerKey.PubKey()) {
+		return errors.New("payer key doesn't match payer id")
+	}
+
+	digest, err := r.SignatureDigest()
+	if err != nil {
+		return err
+	}
+
+	sig, err := SignDigest(payerKey, digest)
+	if err != nil {
+		return err
+	}
+	r.Signature = &sig
+
+	return nil
+}
+
+// VerifySignature returns an error if the invoice request isn't signed by its
+// payer id.
+func (r *InvoiceRequest) VerifySignature() error {
+	switch {
+	case r.PayerID == nil:
+		return ErrNoPayerID
+
+	case r.Signature == nil:
+		return ErrNoSignature
+	}
+
+	digest, err := r.SignatureDigest()
+	if err != nil {
+		return err
+	}
+
+	if !VerifyDigest(r.PayerID, digest, *r.Signature) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Serialize encodes the invoice request as TLV stream.
+func (r *InvoiceRequest) Serialize() ([]byte, error) {
+	fields := make(map[uint64][]byte)
+	if err := r.encodeFields(fields); err != nil {
+		return nil, err
+	}
+
+	if r.Signature != nil {
+		fields[signatureType] = r.Signature[:]
+	}
+
+	return encodeFields(fields)
+}
+
+// DeserializeInvoiceRequest decodes an invoice request from its TLV stream and
+// validates it. The signature isn't verified.
+func DeserializeInvoiceRequest(b []byte) (*InvoiceRequest, error) {
+	fields, err := decodeFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := decodeInvoiceRequestFields(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Signature, err = decodeSignature(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := assertNoFields(fields); err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// Encode encodes the invoice request as bech32 string, which is used to offer
+// refunds.
+func (r *InvoiceRequest) Encode() (string, error) {
+	b, err := r.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceRequestHrp, b)
+}
+
+// DecodeInvoiceRequest decodes and validates a bech32 encoded invoice request.
+func DecodeInvoiceRequest(req string) (*InvoiceRequest, error) {
+	b, err := decodeBech32(InvoiceRequestHrp, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeserializeInvoiceRequest(b)
+}
@@ -0,0 +1,417 @@
+package bolt12
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestInvoiceRequest(t *testing.T) *InvoiceRequest {
+	return &InvoiceRequest{
+		Offer:     newTestOffer(t),
+		Metadata:  []byte{4, 5, 6},
+		Quantity:  2,
+		PayerID:   newPubKey(t),
+		PayerNote: "thanks",
+		Signature: &[64]byte{1},
+		ExtraRecords: map[uint64][]byte{
+			2000000001: {8},
+		},
+	}
+}
+
+func newTestInvoice(t *testing.T) *Invoice {
+	req := newTestInvoiceRequest(t)
+
+	return &Invoice{
+		InvoiceRequest: req,
+		Paths: []*blindedpath.BlindedPayment{
+			{
+				BlindedPath:         newBlindedPath(t, 1),
+				BaseFee:             1,
+				ProportionalFeeRate: 2,
+				CltvExpiryDelta:     3,
+				HtlcMinimum:         4,
+				HtlcMaximum:         5,
+			},
+			{
+				BlindedPath: newBlindedPath(t, 3),
+				HtlcMaximum: 10,
+			},
+		},
+		CreatedAt:      time.Unix(1700000000, 0),
+		RelativeExpiry: time.Hour,
+		PaymentHash:    lntypes.Hash{9},
+		Amount:         2000,
+		Fallbacks: []FallbackAddress{
+			{Version: 0, Address: make([]byte, 20)},
+		},
+		NodeID:    req.Offer.IssuerID,
+		Signature: &[64]byte{2},
+		ExtraRecords: map[uint64][]byte{
+			3000000001: {9},
+		},
+	}
+}
+
+// TestInvoiceRequestEncoding asserts that invoice requests are decoded to the
+// request that was encoded, and that requests that don't match their offer are
+// rejected.
+func TestInvoiceRequestEncoding(t *testing.T) {
+	t.Parallel()
+
+	req := newTestInvoiceRequest(t)
+	b, err := req.Serialize()
+	require.NoError(t, err)
+
+	decoded, err := DeserializeInvoiceRequest(b)
+	require.NoError(t, err)
+	require.Equal(t, req, decoded)
+
+	encoded, err := req.Encode()
+	require.NoError(t, err)
+
+	decoded, err = DecodeInvoiceRequest(encoded)
+	require.NoError(t, err)
+	require.Equal(t, req, decoded)
+
+	// The signature doesn't commit to itself, but to the message name.
+	digest, err := req.SignatureDigest()
+	require.NoError(t, err)
+
+	req.Signature = &[64]byte{3}
+	digest2, err := req.SignatureDigest()
+	require.NoError(t, err)
+	require.Equal(t, digest, digest2)
+
+	root, err := MerkleRoot(b)
+	require.NoError(t, err)
+	require.Equal(t, SignatureDigest(invoiceRequestMessage, root), digest)
+	require.NotEqual(t, SignatureDigest(invoiceMessage, root), digest)
+
+	// A refund doesn't need an offer, but must have an amount.
+	refund := &InvoiceRequest{
+		Metadata:  []byte{1},
+		Amount:    1000,
+		PayerID:   newPubKey(t),
+		Signature: &[64]byte{},
+	}
+	b, err = refund.Serialize()
+	require.NoError(t, err)
+	_, err = DeserializeInvoiceRequest(b)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		modify func(req *InvoiceRequest)
+		err    error
+	}{
+		{
+			name: "no metadata",
+			modify: func(req *InvoiceRequest) {
+				req.Metadata = nil
+			},
+			err: ErrNoMetadata,
+		},
+		{
+			name: "no payer id",
+			modify: func(req *InvoiceRequest) {
+				req.PayerID = nil
+			},
+			err: ErrNoPayerID,
+		},
+		{
+			name: "no signature",
+			modify: func(req *InvoiceRequest) {
+				req.Signature = nil
+			},
+			err: ErrNoSignature,
+		},
+		{
+			name: "no amount",
+			modify: func(req *InvoiceRequest) {
+				req.Offer.Amount = 0
+			},
+			err: ErrNoAmount,
+		},
+		{
+			name: "quantity above maximum",
+			modify: func(req *InvoiceRequest) {
+				req.Quantity = 6
+			},
+			err: ErrInvalidQuantity,
+		},
+		{
+			name: "no quantity",
+			modify: func(req *InvoiceRequest) {
+				req.Quantity = 0
+			},
+			err: ErrInvalidQuantity,
+		},
+		{
+			name: "unexpected quantity",
+			modify: func(req *InvoiceRequest) {
+				req.Offer.QuantityMax = nil
+			},
+			err: ErrInvalidQuantity,
+		},
+		{
+			name: "invoice field",
+			modify: func(req *InvoiceRequest) {
+				req.ExtraRecords[161] = []byte{1}
+			},
+			err: ErrFieldOutOfRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			req := newTestInvoiceRequest(t)
+			testCase.modify(req)
+
+			b, err := req.Serialize()
+			require.NoError(t, err)
+
+			_, err = DeserializeInvoiceRequest(b)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestInvoiceEncoding asserts that invoices are decoded to the invoice that
+// was encoded, including the mirrored fields of the invoice request apart from
+// its signature, and that invalid invoices are rejected.
+func TestInvoiceEncoding(t *testing.T) {
+	t.Parallel()
+
+	invoice := newTestInvoice(t)
+	encoded, err := invoice.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeInvoice(encoded)
+	require.NoError(t, err)
+
+	require.Nil(t, decoded.InvoiceRequest.Signature)
+	invoice.InvoiceRequest.Signature = nil
+	require.Equal(t, invoice, decoded)
+
+	require.Equal(t, invoice.CreatedAt.Add(time.Hour), invoice.Expiry())
+	invoice.RelativeExpiry = 0
+	require.Equal(
+		t, invoice.CreatedAt.Add(DefaultInvoiceExpiry), invoice.Expiry(),
+	)
+
+	// The signature of an invoice commits to the fields of the request.
+	digest, err := invoice.SignatureDigest()
+	require.NoError(t, err)
+
+	invoice.InvoiceRequest.PayerNote = "changed"
+	digest2, err := invoice.SignatureDigest()
+	require.NoError(t, err)
+	require.NotEqual(t, digest, digest2)
+
+	// If the offer doesn't have an issuer id, the invoice must be signed
+	// by the final node of one of its paths.
+	invoice = newTestInvoice(t)
+	offer := invoice.InvoiceRequest.Offer
+	offer.IssuerID = nil
+	invoice.NodeID = offer.Paths[0].BlindedHops[1].BlindedNodePub
+
+	b, err := invoice.Serialize()
+	require.NoError(t, err)
+	_, err = DeserializeInvoice(b)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		modify func(invoice *Invoice)
+		err    error
+	}{
+		{
+			name: "no paths",
+			modify: func(invoice *Invoice) {
+				invoice.Paths = nil
+			},
+			err: ErrNoPaths,
+		},
+		{
+			name: "no signature",
+			modify: func(invoice *Invoice) {
+				invoice.Signature = nil
+			},
+			err: ErrNoSignature,
+		},
+		{
+			name: "wrong node id",
+			modify: func(invoice *Invoice) {
+				invoice.NodeID = newPubKey(t)
+			},
+			err: ErrInvalidNodeID,
+		},
+		{
+			name: "wrong path node id",
+			modify: func(invoice *Invoice) {
+				offer := invoice.InvoiceRequest.Offer
+				offer.IssuerID = nil
+				invoice.NodeID = offer.Paths[0].BlindedHops[0].
+					BlindedNodePub
+			},
+			err: ErrInvalidNodeID,
+		},
+		{
+			name: "unknown even field",
+			modify: func(invoice *Invoice) {
+				invoice.ExtraRecords[238] = []byte{1}
+			},
+			err: ErrUnknownRequiredField,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			invoice := newTestInvoice(t)
+			testCase.modify(invoice)
+
+			b, err := invoice.Serialize()
+			require.NoError(t, err)
+
+			_, err = DeserializeInvoice(b)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+
+	// An invoice must have a pay info for each path.
+	invoice = newTestInvoice(t)
+	fields := make(map[uint64][]byte)
+	require.NoError(t, invoice.encodeFields(fields))
+	fields[invoiceBlindedPayType] = encodePayInfo(invoice.Paths[:1])
+
+	b, err = encodeFields(fields)
+	require.NoError(t, err)
+	_, err = DeserializeInvoice(b)
+	require.ErrorIs(t, err, ErrPayInfoMismatch)
+}
+
+// TestInvoiceSignatures asserts that signed invoice requests and invoices are
+// verified against their signing keys, and that changes to their fields
+// invalidate their signatures.
+func TestInvoiceSignatures(t *testing.T) {
+	t.Parallel()
+
+	payerKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	nodeKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	invoice := newTestInvoice(t)
+	req := invoice.InvoiceRequest
+	req.PayerID = payerKey.PubKey()
+	req.Offer.IssuerID = nodeKey.PubKey()
+	invoice.NodeID = nodeKey.PubKey()
+
+	// Requests and invoices can only be signed with the key of their
+	// payer and node id.
+	require.Error(t, req.Sign(nodeKey))
+	require.Error(t, invoice.Sign(payerKey))
+
+	require.NoError(t, req.Sign(payerKey))
+	require.NoError(t, req.VerifySignature())
+	require.NoError(t, invoice.Sign(nodeKey))
+	require.NoError(t, invoice.VerifySignature())
+
+	// The signatures survive encoding.
+	b, err := req.Serialize()
+	require.NoError(t, err)
+	decodedReq, err := DeserializeInvoiceRequest(b)
+	require.NoError(t, err)
+	require.NoError(t, decodedReq.VerifySignature())
+
+	b, err = invoice.Serialize()
+	require.NoError(t, err)
+	decodedInvoice, err := DeserializeInvoice(b)
+	require.NoError(t, err)
+	require.NoError(t, decodedInvoice.VerifySignature())
+
+	// Changing a signed field invalidates the signatures. As the invoice
+	// mirrors the request, this includes the fields of the request.
+	req.PayerNote = "changed"
+	require.ErrorIs(t, req.VerifySignature(), ErrInvalidSignature)
+	require.ErrorIs(t, invoice.VerifySignature(), ErrInvalidSignature)
+
+	req.Signature = nil
+	require.ErrorIs(t, req.VerifySignature(), ErrNoSignature)
+}
+
+// TestInvoiceRequestAmount asserts that the amount of an invoice for a
+// request is derived from the offer if the request doesn't set it, and that
+// requests below the amount of the offer are rejected.
+func TestInvoiceRequestAmount(t *testing.T) {
+	t.Parallel()
+
+	req := newTestInvoiceRequest(t)
+	amt, err := req.InvoiceAmount()
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, amt)
+
+	req.Amount = 2500
+	require.NoError(t, req.Validate())
+	amt, err = req.InvoiceAmount()
+	require.NoError(t, err)
+	require.EqualValues(t, 2500, amt)
+
+	req.Amount = 1999
+	require.ErrorIs(t, req.Validate(), ErrAmountBelowOffer)
+
+	// The amount of an offer in a currency can't be converted.
+	req.Amount = 0
+	req.Offer.Currency = "USD"
+	_, err = req.InvoiceAmount()
+	require.ErrorIs(t, err, ErrCurrencyNotSupported)
+
+	req.Offer.Currency = ""
+	req.Offer.Amount = 0
+	_, err = req.InvoiceAmount()
+	require.ErrorIs(t, err, ErrNoAmount)
+}
+
+// TestInvoiceErrorEncoding asserts that invoice errors are decoded to the
+// error that was encoded.
+func TestInvoiceErrorEncoding(t *testing.T) {
+	t.Parallel()
+
+	field := uint64(invreqQuantityType)
+	invoiceErr := &InvoiceError{
+		ErroneousField: &field,
+		SuggestedValue: encodeTU64(5),
+		Message:        "quantity too large",
+	}
+
+	b, err := invoiceErr.Serialize()
+	require.NoError(t, err)
+	decoded, err := DeserializeInvoiceError(b)
+	require.NoError(t, err)
+	require.Equal(t, invoiceErr, decoded)
+
+	invoiceErr = &InvoiceError{Message: "unknown offer"}
+	b, err = invoiceErr.Serialize()
+	require.NoError(t, err)
+	decoded, err = DeserializeInvoiceError(b)
+	require.NoError(t, err)
+	require.Equal(t, invoiceErr, decoded)
+
+	// An invoice error must have a message.
+	b, err = encodeFields(map[uint64][]byte{
+		invoiceErrorFieldType: encodeTU64(field),
+	})
+	require.NoError(t, err)
+	_, err = DeserializeInvoiceError(b)
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package bolt12
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/brsuite/broln/schnorr"
+	"github.com/brsuite/broln/tlv"
+)
+
+var (
+	// signatureRange is the range of TLV types that are reserved for
+	// signatures. These fields aren't part of the merkle tree.
+	signatureRange = typeRange{start: 240, end: 1000}
+
+	// errEmptyStream is returned when the merkle root of a TLV stream
+	// without any records is requested.
+	errEmptyStream = errors.New("no records in tlv stream")
+)
+
+// branchHash computes the hash of an inner node of the merkle tree from the
+// hashes of its children, which are ordered by their value.
+func branchHash(a, b [32]byte) [32]byte {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+
+	return schnorr.TaggedHash([]byte("LnBranch"), a[:], b[:])
+}
+
+// MerkleRoot computes the merkle root of the TLV stream of an offer, invoice
+// request or invoice, as is signed by the signature of the message. Every
+// record that isn't a signature is a leaf of the tree, which is paired with a
+// nonce leaf derived from its type and the first record of the stream, such
+// that the values of the other records can't be guessed from the hashes that
+// are revealed to prove the inclusion of a record.
+func MerkleRoot(tlvStream []byte) ([32]byte, error) {
+	var (
+		r        = bytes.NewReader(tlvStream)
+		buf      [8]byte
+		nonceTag []byte
+		leaves   [][32]byte
+	)
+	for r.Len() > 0 {
+		start := len(tlvStream) - r.Len()
+
+		typ, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		typeEnd := len(tlvStream) - r.Len()
+
+		length, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		if length > uint64(r.Len()) {
+			return [32]byte{}, io.ErrUnexpectedEOF
+		}
+		if _, err := r.Seek(int64(length), io.SeekCurrent); err != nil {
+			return [32]byte{}, err
+		}
+
+		if signatureRange.contains(typ) {
+			continue
+		}
+
+		record := tlvStream[start : len(tlvStream)-r.Len()]
+		if nonceTag == nil {
+			nonceTag = append([]byte("LnNonce"), record...)
+		}
+
+		leaf := schnorr.TaggedHash([]byte("LnLeaf"), record)
+		nonce := schnorr.TaggedHash(nonceTag, tlvStream[start:typeEnd])
+		leaves = append(leaves, branchHash(leaf, nonce))
+	}
+
+	if len(leaves) == 0 {
+		return [32]byte{}, errEmptyStream
+	}
+
+	// Combine the nodes of each level pairwise. If a level has an odd
+	// number of nodes, the last one is carried to the next level as is,
+	// such that the tree is deepest for the lowest types.
+	for len(leaves) > 1 {
+		next := make([][32]byte, 0, (len(leaves)+1)/2)
+		for i := 0; i < len(leaves); i += 2 {
+			if i+1 == len(leaves) {
+				next = append(next, leaves[i])
+				break
+			}
+
+			next = append(next, branchHash(leaves[i], leaves[i+1]))
+		}
+		leaves = next
+	}
+
+	return leaves[0], nil
+}
+
+// SignatureDigest returns the digest that is signed by the signature field of
+// the message with the given name, such as "invoice_request" or "invoice",
+// whose TLV stream has the given merkle root.
+func SignatureDigest(messageName string, merkleRoot [32]byte) [32]byte {
+	tag := "lightning" + messageName + "signature"
+
+	return schnorr.TaggedHash([]byte(tag), merkleRoot[:])
+}
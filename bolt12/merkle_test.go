@@ -0,0 +1,61 @@
+package bolt12
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMerkleRoot asserts that the merkle root of TLV streams is computed as
+// in the test vectors of the specification.
+func TestMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		stream string
+		root   string
+	}{
+		{
+			name:   "single record",
+			stream: "010203e8",
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2fa" +
+				"8b6c08938caa6f93",
+		},
+		{
+			name:   "two records",
+			stream: "010203e802080000010000020003",
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c620" +
+				"a252534f434bc0d1",
+		},
+		{
+			// Signature records aren't part of the tree.
+			name: "signature record",
+			stream: "010203e802080000010000020003f040" +
+				strings.Repeat("00", 64),
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c620" +
+				"a252534f434bc0d1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			stream, err := hex.DecodeString(testCase.stream)
+			require.NoError(t, err)
+
+			root, err := MerkleRoot(stream)
+			require.NoError(t, err)
+			require.Equal(t, testCase.root, hex.EncodeToString(root[:]))
+		})
+	}
+
+	_, err := MerkleRoot(nil)
+	require.Error(t, err)
+
+	_, err = MerkleRoot([]byte{0x01, 0x05, 0x00})
+	require.Error(t, err)
+}
@@ -0,0 +1,358 @@
+package bolt12
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+)
+
+const (
+	// OfferHrp is the human-readable part of bech32 encoded offers.
+	OfferHrp = "lno"
+
+	// The following are the TLV types of the fields of an offer, which are
+	// mirrored by the invoice requests and invoices for the offer.
+	offerChainsType         = 2
+	offerMetadataType       = 4
+	offerCurrencyType       = 6
+	offerAmountType         = 8
+	offerDescriptionType    = 10
+	offerFeaturesType       = 12
+	offerAbsoluteExpiryType = 14
+	offerPathsType          = 16
+	offerIssuerType         = 18
+	offerQuantityMaxType    = 20
+	offerIssuerIDType       = 22
+)
+
+var (
+	// offerRanges are the TLV type ranges of the fields of an offer.
+	offerRanges = []typeRange{
+		{start: 1, end: 79},
+		{start: 1000000000, end: 1999999999},
+	}
+
+	// ErrNoIssuer is returned when an offer has neither an issuer ID nor
+	// blinded paths to reach the issuer.
+	ErrNoIssuer = errors.New("offer must have issuer id or paths")
+
+	// ErrNoDescription is returned when an offer has an amount but no
+	// description.
+	ErrNoDescription = errors.New("offer with amount must have " +
+		"description")
+
+	// ErrCurrencyWithoutAmount is returned when an offer has a currency
+	// but no amount.
+	ErrCurrencyWithoutAmount = errors.New("offer with currency must have " +
+		"amount")
+)
+
+// Offer is a reusable request for payment, which is sent to payers out of
+// band. To pay an offer, the payer sends an invoice request to the issuer of
+// the offer over onion messages, which replies with an invoice.
+type Offer struct {
+	// Chains are the genesis hashes of the chains that the offer may be
+	// paid on. If empty, the offer is for the main chain.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data of the issuer, which is mirrored in the
+	// invoice requests for the offer.
+	Metadata []byte
+
+	// Currency is the ISO 4217 code of the currency that Amount is
+	// denominated in. If empty, Amount is denominated in millibroneess.
+	Currency string
+
+	// Amount is the expected amount per item. If zero, the payer chooses
+	// the amount.
+	Amount uint64
+
+	// Description is a description of the purpose of the payment.
+	Description string
+
+	// Features are the features that are required or supported to pay
+	// the offer.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry is the time after which the offer must no longer be
+	// paid. If zero, the offer doesn't expire.
+	AbsoluteExpiry time.Time
+
+	// Paths are blinded paths to the issuer, over which invoice requests
+	// must be sent.
+	Paths []*blindedpath.BlindedPath
+
+	// Issuer is a description of the issuer of the offer.
+	Issuer string
+
+	// QuantityMax is the maximum number of items that a payer may request.
+	// If nil, a single item can be requested. If it points to zero, any
+	// number of items can be requested.
+	QuantityMax *uint64
+
+	// IssuerID is the public key of the issuer, which signs the invoices
+	// for the offer. It is the destination of invoice requests if Paths is
+	// empty.
+	IssuerID *bronec.PublicKey
+
+	// ExtraRecords are fields with unknown odd types, which are preserved
+	// such that they are mirrored in invoice requests.
+	ExtraRecords map[uint64][]byte
+}
+
+// Validate asserts that the offer contains all fields required for it to be
+// paid.
+func (o *Offer) Validate() error {
+	switch {
+	case o.IssuerID == nil && len(o.Paths) == 0:
+		return ErrNoIssuer
+
+	case o.Amount != 0 && o.Description == "":
+		return ErrNoDescription
+
+	case o.Currency != "" && o.Amount == 0:
+		return ErrCurrencyWithoutAmount
+	}
+
+	return nil
+}
+
+// SupportsChain returns true if the offer can be paid on the chain with the
+// given genesis hash.
+func (o *Offer) SupportsChain(chain, mainChain chainhash.Hash) bool {
+	if len(o.Chains) == 0 {
+		return chain == mainChain
+	}
+
+	for _, offerChain := range o.Chains {
+		if offerChain == chain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expired returns true if the offer has an expiry that has passed.
+func (o *Offer) Expired(now time.Time) bool {
+	return !o.AbsoluteExpiry.IsZero() && now.After(o.AbsoluteExpiry)
+}
+
+// encodeFields adds the fields of the offer to the map.
+func (o *Offer) encodeFields(fields map[uint64][]byte) error {
+	if len(o.Chains) > 0 {
+		chains := make([]byte, 0, len(o.Chains)*chainhash.HashSize)
+		for _, chain := range o.Chains {
+			chains = append(chains, chain[:]...)
+		}
+		fields[offerChainsType] = chains
+	}
+	if len(o.Metadata) > 0 {
+		fields[offerMetadataType] = o.Metadata
+	}
+	if o.Currency != "" {
+		fields[offerCurrencyType] = []byte(o.Currency)
+	}
+	if o.Amount != 0 {
+		fields[offerAmountType] = encodeTU64(o.Amount)
+	}
+	if o.Description != "" {
+		fields[offerDescriptionType] = []byte(o.Description)
+	}
+	if o.Features != nil && o.Features.SerializeSize() > 0 {
+		features, err := encodeFeatures(o.Features)
+		if err != nil {
+			return err
+		}
+		fields[offerFeaturesType] = features
+	}
+	if !o.AbsoluteExpiry.IsZero() {
+		fields[offerAbsoluteExpiryType] = encodeTimestamp(
+			o.AbsoluteExpiry,
+		)
+	}
+	if len(o.Paths) > 0 {
+		paths, err := encodePaths(o.Paths)
+		if err != nil {
+			return err
+		}
+		fields[offerPathsType] = paths
+	}
+	if o.Issuer != "" {
+		fields[offerIssuerType] = []byte(o.Issuer)
+	}
+	if o.QuantityMax != nil {
+		fields[offerQuantityMaxType] = encodeTU64(*o.QuantityMax)
+	}
+	if o.IssuerID != nil {
+		fields[offerIssuerIDType] = o.IssuerID.SerializeCompressed()
+	}
+
+	for typ, value := range o.ExtraRecords {
+		fields[typ] = value
+	}
+
+	return nil
+}
+
+// decodeOfferFields takes the fields of an offer from the map and decodes
+// them.
+func decodeOfferFields(fields map[uint64][]byte) (*Offer, error) {
+	var (
+		offer = &Offer{}
+		err   error
+	)
+
+	if value, ok := fields[offerChainsType]; ok {
+		if len(value) == 0 || len(value)%chainhash.HashSize != 0 {
+			return nil, errors.New("invalid offer chains length")
+		}
+		for i := 0; i < len(value); i += chainhash.HashSize {
+			var chain chainhash.Hash
+			copy(chain[:], value[i:])
+			offer.Chains = append(offer.Chains, chain)
+		}
+	}
+	if value, ok := fields[offerMetadataType]; ok {
+		offer.Metadata = value
+	}
+	if value, ok := fields[offerCurrencyType]; ok {
+		offer.Currency, err = decodeString(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerAmountType]; ok {
+		offer.Amount, err = decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerDescriptionType]; ok {
+		offer.Description, err = decodeString(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerFeaturesType]; ok {
+		offer.Features, err = decodeFeatures(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerAbsoluteExpiryType]; ok {
+		offer.AbsoluteExpiry, err = decodeTimestamp(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerPathsType]; ok {
+		offer.Paths, err = decodePaths(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerIssuerType]; ok {
+		offer.Issuer, err = decodeString(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := fields[offerQuantityMaxType]; ok {
+		quantityMax, err := decodeTU64(value)
+		if err != nil {
+			return nil, err
+		}
+		offer.QuantityMax = &quantityMax
+	}
+	if value, ok := fields[offerIssuerIDType]; ok {
+		offer.IssuerID, err = decodePubKey(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, typ := range []uint64{
+		offerChainsType, offerMetadataType, offerCurrencyType,
+		offerAmountType, offerDescriptionType, offerFeaturesType,
+		offerAbsoluteExpiryType, offerPathsType, offerIssuerType,
+		offerQuantityMaxType, offerIssuerIDType,
+	} {
+		delete(fields, typ)
+	}
+
+	offer.ExtraRecords, err = takeExtraFields(fields, offerRanges...)
+	if err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// Serialize encodes the offer as TLV stream.
+func (o *Offer) Serialize() ([]byte, error) {
+	fields := make(map[uint64][]byte)
+	if err := o.encodeFields(fields); err != nil {
+		return nil, err
+	}
+
+	return encodeFields(fields)
+}
+
+// DeserializeOffer decodes an offer from its TLV stream and validates it.
+func DeserializeOffer(b []byte) (*Offer, error) {
+	fields, err := decodeFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := decodeOfferFields(fields)
+	if err != nil {
+		return nil, err
+	}
+	if err := assertNoFields(fields); err != nil {
+		return nil, err
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// ID returns the offer id, which is the merkle root of the TLV stream of the
+// offer. As invoice requests mirror all fields of their offer, the issuer can
+// identify the offer of a request by its id.
+func (o *Offer) ID() ([32]byte, error) {
+	b, err := o.Serialize()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return MerkleRoot(b)
+}
+
+// Encode encodes the offer as bech32 string, as is shared with payers.
+func (o *Offer) Encode() (string, error) {
+	b, err := o.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(OfferHrp, b)
+}
+
+// DecodeOffer decodes and validates a bech32 encoded offer.
+func DecodeOffer(offer string) (*Offer, error) {
+	b, err := decodeBech32(OfferHrp, offer)
+	if err != nil {
+		return nil, err
+	}
+
+	return DeserializeOffer(b)
+}
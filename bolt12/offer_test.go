@@ -0,0 +1,209 @@
+package bolt12
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+func newPubKey(t *testing.T) *bronec.PublicKey {
+	privKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	return privKey.PubKey()
+}
+
+func newBlindedPath(t *testing.T, numHops int) *blindedpath.BlindedPath {
+	path := &blindedpath.BlindedPath{
+		IntroductionPoint: newPubKey(t),
+		BlindingPoint:     newPubKey(t),
+	}
+	for i := 0; i < numHops; i++ {
+		path.BlindedHops = append(path.BlindedHops,
+			&blindedpath.BlindedHop{
+				BlindedNodePub: newPubKey(t),
+				CipherText:     []byte{byte(i), 1, 2, 3},
+			},
+		)
+	}
+
+	return path
+}
+
+func newTestOffer(t *testing.T) *Offer {
+	quantityMax := uint64(5)
+
+	return &Offer{
+		Chains: []chainhash.Hash{
+			*chaincfg.RegressionNetParams.GenesisHash,
+		},
+		Metadata:       []byte{1, 2, 3},
+		Amount:         1000,
+		Description:    "coffee",
+		Features:       lnwire.NewRawFeatureVector(9),
+		AbsoluteExpiry: time.Unix(1700000000, 0),
+		Paths:          []*blindedpath.BlindedPath{newBlindedPath(t, 2)},
+		Issuer:         "shop",
+		QuantityMax:    &quantityMax,
+		IssuerID:       newPubKey(t),
+		ExtraRecords: map[uint64][]byte{
+			77: {7},
+		},
+	}
+}
+
+// TestOfferEncoding asserts that offers are decoded to the offer that was
+// encoded, and that invalid offers are rejected.
+func TestOfferEncoding(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestOffer(t)
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, OfferHrp+"1"))
+
+	decoded, err := DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// Offers may be split into several parts, and be all uppercase.
+	split := encoded[:20] + "+\n  " + encoded[20:40] + "+" + encoded[40:]
+	decoded, err = DecodeOffer(strings.ToUpper(split))
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	_, err = DecodeOffer(encoded[:20] + "+")
+	require.Error(t, err)
+
+	_, err = DecodeOffer(encoded[:20] + strings.ToUpper(encoded[20:]))
+	require.Error(t, err)
+
+	// Offers can't be decoded as invoices.
+	_, err = DecodeInvoice(encoded)
+	require.Error(t, err)
+
+	// An offer without amount doesn't need a description.
+	minimal := &Offer{IssuerID: newPubKey(t)}
+	encoded, err = minimal.Encode()
+	require.NoError(t, err)
+
+	decoded, err = DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, minimal, decoded)
+
+	testCases := []struct {
+		name   string
+		modify func(offer *Offer)
+		err    error
+	}{
+		{
+			name: "no issuer",
+			modify: func(offer *Offer) {
+				offer.IssuerID = nil
+				offer.Paths = nil
+			},
+			err: ErrNoIssuer,
+		},
+		{
+			name: "no description",
+			modify: func(offer *Offer) {
+				offer.Description = ""
+			},
+			err: ErrNoDescription,
+		},
+		{
+			name: "currency without amount",
+			modify: func(offer *Offer) {
+				offer.Currency = "USD"
+				offer.Amount = 0
+			},
+			err: ErrCurrencyWithoutAmount,
+		},
+		{
+			name: "unknown even field",
+			modify: func(offer *Offer) {
+				offer.ExtraRecords[78] = []byte{1}
+			},
+			err: ErrUnknownRequiredField,
+		},
+		{
+			name: "field out of range",
+			modify: func(offer *Offer) {
+				offer.ExtraRecords[81] = []byte{1}
+			},
+			err: ErrFieldOutOfRange,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			offer := newTestOffer(t)
+			testCase.modify(offer)
+
+			encoded, err := offer.Encode()
+			require.NoError(t, err)
+
+			_, err = DecodeOffer(encoded)
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
+
+// TestOfferChains asserts that offers without chains are only paid on the main
+// chain.
+func TestOfferChains(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mainChain = *chaincfg.MainNetParams.GenesisHash
+		regtest   = *chaincfg.RegressionNetParams.GenesisHash
+	)
+
+	offer := &Offer{}
+	require.True(t, offer.SupportsChain(mainChain, mainChain))
+	require.False(t, offer.SupportsChain(regtest, mainChain))
+
+	offer.Chains = []chainhash.Hash{regtest}
+	require.False(t, offer.SupportsChain(mainChain, mainChain))
+	require.True(t, offer.SupportsChain(regtest, mainChain))
+}
+
+// TestOfferID asserts that the id of an offer is the same for the offer that
+// is mirrored by an invoice request, and differs between offers.
+func TestOfferID(t *testing.T) {
+	t.Parallel()
+
+	offer := newTestOffer(t)
+	id, err := offer.ID()
+	require.NoError(t, err)
+
+	req := &InvoiceRequest{
+		Offer:     offer,
+		Metadata:  []byte{1},
+		Quantity:  1,
+		PayerID:   newPubKey(t),
+		Signature: &[64]byte{},
+	}
+	b, err := req.Serialize()
+	require.NoError(t, err)
+	decoded, err := DeserializeInvoiceRequest(b)
+	require.NoError(t, err)
+
+	reqID, err := decoded.Offer.ID()
+	require.NoError(t, err)
+	require.Equal(t, id, reqID)
+
+	offer.Description = "tea"
+	changedID, err := offer.ID()
+	require.NoError(t, err)
+	require.NotEqual(t, id, changedID)
+}
@@ -0,0 +1,30 @@
+package bolt12
+
+import (
+	"errors"
+
+	"github.com/brsuite/broln/schnorr"
+	"github.com/brsuite/brond/bronec"
+)
+
+var (
+	// ErrInvalidSignature is returned when the signature of an invoice
+	// request or invoice isn't valid for its signing key.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+// SignDigest creates a BIP340 schnorr signature for the digest with the
+// private key, as is used to sign invoice requests and invoices.
+func SignDigest(privKey *bronec.PrivateKey,
+	digest [32]byte) ([64]byte, error) {
+
+	return schnorr.Sign(privKey, digest[:])
+}
+
+// VerifyDigest returns true if the signature is a valid BIP340 schnorr
+// signature of the digest for the x-only form of the public key.
+func VerifyDigest(pubKey *bronec.PublicKey, digest [32]byte,
+	sig [64]byte) bool {
+
+	return schnorr.Verify(schnorr.XOnly(pubKey), digest[:], sig)
+}
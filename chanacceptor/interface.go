@@ -24,8 +24,13 @@ type ChannelAcceptRequest struct {
 	Node *bronec.PublicKey
 
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
-	// sent to us.
+	// sent to us. If the channel is dual funded, it holds the fields of
+	// the OpenChannel2 message that the peer sent instead.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// DualFunded is true if the channel is opened with the dual funding
+	// protocol, which allows us to contribute funds to the channel.
+	DualFunded bool
 }
 
 // ChannelAcceptResponse is a struct containing the response to a request to
@@ -62,6 +67,15 @@ type ChannelAcceptResponse struct {
 	// MinAcceptDepth is the minimum depth that the initiator of the
 	// channel should wait before considering the channel open.
 	MinAcceptDepth uint16
+
+	// ZeroConf indicates that the acceptor is willing to accept a
+	// zero-conf channel, trusting the initiator not to double spend the
+	// funding transaction.
+	ZeroConf bool
+
+	// FundingAmt is the amount that the acceptor wants us to contribute
+	// to a dual funded channel.
+	FundingAmt bronutil.Amount
 }
 
 // NewChannelAcceptResponse is a constructor for a channel accept response,
@@ -72,7 +86,8 @@ type ChannelAcceptResponse struct {
 func NewChannelAcceptResponse(accept bool, acceptErr error,
 	upfrontShutdown lnwire.DeliveryAddress, csvDelay, htlcLimit,
 	minDepth uint16, reserve bronutil.Amount, inFlight,
-	minHtlcIn lnwire.MilliBronees) *ChannelAcceptResponse {
+	minHtlcIn lnwire.MilliBronees, zeroConf bool,
+	fundingAmt bronutil.Amount) *ChannelAcceptResponse {
 
 	resp := &ChannelAcceptResponse{
 		UpfrontShutdown: upfrontShutdown,
@@ -82,6 +97,8 @@ func NewChannelAcceptResponse(accept bool, acceptErr error,
 		HtlcLimit:       htlcLimit,
 		MinHtlcIn:       minHtlcIn,
 		MinAcceptDepth:  minDepth,
+		ZeroConf:        zeroConf,
+		FundingAmt:      fundingAmt,
 	}
 
 	// If we want to accept the channel, we return a response with a nil
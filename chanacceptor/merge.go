@@ -18,6 +18,7 @@ const (
 	fieldMinIn           = "min htlc in"
 	fieldInFlightTotal   = "in flight total"
 	fieldUpfrontShutdown = "upfront shutdown"
+	fieldFundingAmt      = "funding amount"
 )
 
 // fieldMismatchError returns a merge error for a named field when we get two
@@ -140,6 +141,19 @@ func mergeResponse(current, new ChannelAcceptResponse) (ChannelAcceptResponse,
 		return current, err
 	}
 
+	// A zero-conf channel is accepted as long as one of the acceptors
+	// opted into it.
+	current.ZeroConf = current.ZeroConf || new.ZeroConf
+
+	fundingAmt, err := mergeInt64(
+		fieldFundingAmt, int64(current.FundingAmt),
+		int64(new.FundingAmt),
+	)
+	if err != nil {
+		return current, err
+	}
+	current.FundingAmt = bronutil.Amount(fundingAmt)
+
 	current.UpfrontShutdown, err = mergeDeliveryAddress(
 		fieldUpfrontShutdown, current.UpfrontShutdown,
 		new.UpfrontShutdown,
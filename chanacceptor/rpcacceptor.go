@@ -43,6 +43,11 @@ var (
 	errMaxHtlcTooHigh = fmt.Errorf("htlc limit exceeds spec limit of: %v",
 		input.MaxHTLCNumber/2)
 
+	// errNotDualFunded is returned if we're asked to contribute funds to
+	// a channel that isn't dual funded.
+	errNotDualFunded = errors.New("funding amount set for channel that " +
+		"isn't dual funded")
+
 	// maxErrorLength is the maximum error length we allow the error we
 	// send to our peer to be.
 	maxErrorLength = 500
@@ -107,7 +112,7 @@ func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse {
 	// Create a rejection response which we can use for the cases where we
 	// reject the channel.
 	rejectChannel := NewChannelAcceptResponse(
-		false, errChannelRejected, nil, 0, 0, 0, 0, 0, 0,
+		false, errChannelRejected, nil, 0, 0, 0, 0, 0, 0, false, 0,
 	)
 
 	// Send the request to the newRequests channel.
@@ -216,6 +221,8 @@ func (r *RPCAcceptor) receiveResponses(errChan chan error,
 			MaxHtlcCount:    resp.MaxHtlcCount,
 			MinHtlcIn:       resp.MinHtlcIn,
 			MinAcceptDepth:  resp.MinAcceptDepth,
+			ZeroConf:        resp.ZeroConf,
+			FundingAmt:      resp.FundingAmt,
 		}
 
 		// We have received a decision for one of our channel
@@ -310,6 +317,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				MaxAcceptedHtlcs: uint32(req.OpenChanMsg.MaxAcceptedHTLCs),
 				ChannelFlags:     uint32(req.OpenChanMsg.ChannelFlags),
 				CommitmentType:   commitmentType,
+				DualFunded:       req.DualFunded,
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -333,8 +341,10 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 			// Validate the response we have received. If it is not
 			// valid, we log our error and proceed to deliver the
 			// rejection.
+			request := requestInfo.request
 			accept, acceptErr, shutdown, err := r.validateAcceptorResponse(
-				requestInfo.request.OpenChanMsg.DustLimit, resp,
+				request.OpenChanMsg.DustLimit,
+				request.DualFunded, resp,
 			)
 			if err != nil {
 				log.Errorf("Invalid acceptor response: %v", err)
@@ -348,6 +358,8 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				bronutil.Amount(resp.ReserveSat),
 				lnwire.MilliBronees(resp.InFlightMaxMsat),
 				lnwire.MilliBronees(resp.MinHtlcIn),
+				resp.ZeroConf,
+				bronutil.Amount(resp.FundingAmt),
 			)
 
 			// Delete the channel from the acceptRequests map.
@@ -369,8 +381,8 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 // acceptor, returning a boolean indicating whether to accept the channel, an
 // error to send to the peer, and any validation errors that occurred.
 func (r *RPCAcceptor) validateAcceptorResponse(dustLimit bronutil.Amount,
-	req *lnrpc.ChannelAcceptResponse) (bool, error, lnwire.DeliveryAddress,
-	error) {
+	dualFunded bool, req *lnrpc.ChannelAcceptResponse) (bool, error,
+	lnwire.DeliveryAddress, error) {
 
 	channelStr := hex.EncodeToString(req.PendingChanId)
 
@@ -397,6 +409,14 @@ func (r *RPCAcceptor) validateAcceptorResponse(dustLimit bronutil.Amount,
 		return false, errChannelRejected, nil, errInsufficientReserve
 	}
 
+	// We can only contribute funds to channels that are dual funded.
+	if req.FundingAmt != 0 && !dualFunded {
+		log.Errorf("Funding amount: %v sat set for channel: %v that "+
+			"isn't dual funded", req.FundingAmt, channelStr)
+
+		return false, errChannelRejected, nil, errNotDualFunded
+	}
+
 	// Attempt to parse the upfront shutdown address provided.
 	upfront, err := chancloser.ParseUpfrontShutdownAddress(
 		req.UpfrontShutdown, r.params,
@@ -364,6 +364,24 @@ func (c *ChannelEventStore) consume(subscriptions *subscriptions) {
 				c.closeChannel(
 					event.CloseSummary.ChanPoint, peerKey,
 				)
+
+			// A channel has been spliced, so we continue tracking
+			// it under its new channel point.
+			case channelnotifier.SplicedChannelEvent:
+				compressed := event.Channel.IdentityPub.SerializeCompressed()
+				peerKey, err := route.NewVertexFromBytes(
+					compressed,
+				)
+				if err != nil {
+					log.Errorf("Could not get vertex "+
+						"from: %v", compressed)
+					continue
+				}
+
+				c.closeChannel(*event.OldChannelPoint, peerKey)
+				c.addChannel(
+					event.Channel.FundingOutpoint, peerKey,
+				)
 			}
 
 		// Process peer online and offline events.
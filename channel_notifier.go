@@ -156,6 +156,24 @@ func (c *channelNotifier) SubscribeChans(startingChans map[wire.OutPoint]struct{
 						return
 					}
 
+				// A channel has been spliced, so the backup of
+				// its old channel point is replaced by one for
+				// the new channel point.
+				case channelnotifier.SplicedChannelEvent:
+					sendChanOpenUpdate(event.Channel)
+
+					chanEvent := chanbackup.ChannelEvent{
+						ClosedChans: []wire.OutPoint{
+							*event.OldChannelPoint,
+						},
+					}
+
+					select {
+					case chanUpdates <- chanEvent:
+					case <-quit:
+						return
+					}
+
 				// A channel was fully resolved on chain. This
 				// should only really interest us if it was a
 				// locally force closed channel where we didn't
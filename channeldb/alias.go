@@ -0,0 +1,447 @@
+package channeldb
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/lnwire"
+)
+
+var (
+	// aliasBucket stores all local aliases that have been assigned to our
+	// channels, mapping each alias to the base ShortChannelID of the
+	// channel it refers to.
+	//
+	// alias-bucket
+	//    |
+	//    |-- <alias scid>: <base scid>
+	//    |-- <alias scid>: <base scid>
+	aliasBucket = []byte("alias-bucket")
+
+	// peerAliasBucket stores the aliases that our channel peers have sent
+	// us in their funding_locked messages. These are used when creating
+	// hop hints and when sending channel updates to the peer.
+	//
+	// peer-alias-bucket
+	//    |
+	//    |-- <channel id>: <peer alias scid>
+	peerAliasBucket = []byte("peer-alias-bucket")
+
+	// aliasAllocBucket stores the last alias that was handed out so that
+	// allocation can resume from where it left off after a restart.
+	//
+	// alias-alloc-bucket
+	//    |
+	//    |-- last-alias: <last allocated alias scid>
+	aliasAllocBucket = []byte("alias-alloc-bucket")
+
+	// lastAliasKey is the key under which the last allocated alias is
+	// stored within the aliasAllocBucket.
+	lastAliasKey = []byte("last-alias")
+
+	// ErrAliasNotFound is returned when an alias is not known to the
+	// alias store.
+	ErrAliasNotFound = errors.New("alias not found")
+
+	// ErrNoPeerAlias is returned when the peer hasn't sent us an alias for
+	// the given channel.
+	ErrNoPeerAlias = errors.New("no peer alias found")
+
+	// ErrAliasesExhausted is returned when the range of aliases has been
+	// exhausted.
+	ErrAliasesExhausted = errors.New("alias range exhausted")
+)
+
+const (
+	// AliasStartBlockHeight is the starting block height of the alias
+	// range. As it is far beyond the current height of the chain, an
+	// alias can never collide with a confirmed ShortChannelID.
+	AliasStartBlockHeight uint32 = 16_000_000
+
+	// AliasEndBlockHeight is the exclusive upper bound of the block height
+	// of the alias range.
+	AliasEndBlockHeight uint32 = 16_250_000
+
+	// maxTxIndex is the largest TxIndex that can be encoded within a
+	// ShortChannelID.
+	maxTxIndex uint32 = (1 << 24) - 1
+)
+
+// StartingAlias is the first alias that is handed out by the AliasStore.
+var StartingAlias = lnwire.ShortChannelID{
+	BlockHeight: AliasStartBlockHeight,
+	TxIndex:     0,
+	TxPosition:  0,
+}
+
+// IsAlias returns true if the passed ShortChannelID falls within the range
+// reserved for aliases.
+func IsAlias(scid lnwire.ShortChannelID) bool {
+	return scid.BlockHeight >= AliasStartBlockHeight &&
+		scid.BlockHeight < AliasEndBlockHeight
+}
+
+// AliasStore is a persistent store for ShortChannelID aliases. It is
+// responsible for allocating new aliases for our channels, for keeping track
+// of which channel an alias refers to, and for storing the aliases that our
+// peers have assigned to our shared channels. All entries are cached in
+// memory so that lookups on the forwarding path don't hit the database.
+type AliasStore struct {
+	db kvdb.Backend
+
+	// baseToAliases maps the base ShortChannelID of a channel to the set
+	// of local aliases it can be referred to by.
+	baseToAliases map[lnwire.ShortChannelID][]lnwire.ShortChannelID
+
+	// aliasToBase is the reverse index of baseToAliases.
+	aliasToBase map[lnwire.ShortChannelID]lnwire.ShortChannelID
+
+	// peerAliases maps a channel to the alias our peer has assigned to it.
+	peerAliases map[lnwire.ChannelID]lnwire.ShortChannelID
+
+	mu sync.RWMutex
+}
+
+// NewAliasStore creates a new AliasStore backed by the passed database,
+// loading all existing aliases into memory.
+func NewAliasStore(db kvdb.Backend) (*AliasStore, error) {
+	s := &AliasStore{
+		db: db,
+	}
+
+	// Ensure that the buckets exist, as they may not for databases that
+	// were created before aliases were supported.
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		buckets := [][]byte{
+			aliasBucket, peerAliasBucket, aliasAllocBucket,
+		}
+		for _, bucket := range buckets {
+			_, err := tx.CreateTopLevelBucket(bucket)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		aliases := tx.ReadBucket(aliasBucket)
+		err := aliases.ForEach(func(k, v []byte) error {
+			alias := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(k),
+			)
+			base := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(v),
+			)
+
+			s.aliasToBase[alias] = base
+			s.baseToAliases[base] = append(
+				s.baseToAliases[base], alias,
+			)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		peerAliases := tx.ReadBucket(peerAliasBucket)
+		return peerAliases.ForEach(func(k, v []byte) error {
+			var chanID lnwire.ChannelID
+			copy(chanID[:], k)
+
+			s.peerAliases[chanID] = lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(v),
+			)
+
+			return nil
+		})
+	}, func() {
+		s.baseToAliases = make(
+			map[lnwire.ShortChannelID][]lnwire.ShortChannelID,
+		)
+		s.aliasToBase = make(
+			map[lnwire.ShortChannelID]lnwire.ShortChannelID,
+		)
+		s.peerAliases = make(
+			map[lnwire.ChannelID]lnwire.ShortChannelID,
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// AddLocalAlias adds a local alias for the channel identified by the passed
+// base ShortChannelID. For zero-conf channels, the base itself is an alias,
+// and the confirmed ShortChannelID is added once the funding transaction
+// confirms.
+func (s *AliasStore) AddLocalAlias(alias, base lnwire.ShortChannelID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if existing, ok := s.aliasToBase[alias]; ok {
+		if existing == base {
+			return nil
+		}
+
+		return fmt.Errorf("alias %v already assigned to %v", alias,
+			existing)
+	}
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		aliases := tx.ReadWriteBucket(aliasBucket)
+		if aliases == nil {
+			return ErrAliasNotFound
+		}
+
+		var aliasBytes, baseBytes [8]byte
+		byteOrder.PutUint64(aliasBytes[:], alias.ToUint64())
+		byteOrder.PutUint64(baseBytes[:], base.ToUint64())
+
+		return aliases.Put(aliasBytes[:], baseBytes[:])
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	s.aliasToBase[alias] = base
+	s.baseToAliases[base] = append(s.baseToAliases[base], alias)
+
+	return nil
+}
+
+// GetAliases returns the set of local aliases of the channel identified by
+// the passed base ShortChannelID.
+func (s *AliasStore) GetAliases(
+	base lnwire.ShortChannelID) []lnwire.ShortChannelID {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	aliases := make([]lnwire.ShortChannelID, len(s.baseToAliases[base]))
+	copy(aliases, s.baseToAliases[base])
+
+	return aliases
+}
+
+// FindBaseSCID returns the base ShortChannelID of the channel the passed alias
+// refers to. ErrAliasNotFound is returned if the alias is unknown.
+func (s *AliasStore) FindBaseSCID(
+	alias lnwire.ShortChannelID) (lnwire.ShortChannelID, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	base, ok := s.aliasToBase[alias]
+	if !ok {
+		return lnwire.ShortChannelID{}, ErrAliasNotFound
+	}
+
+	return base, nil
+}
+
+// DeleteAliases removes all local aliases of the channel identified by the
+// passed base ShortChannelID. This should be called once the channel has been
+// closed.
+func (s *AliasStore) DeleteAliases(base lnwire.ShortChannelID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	aliases, ok := s.baseToAliases[base]
+	if !ok {
+		return nil
+	}
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		aliasIndex := tx.ReadWriteBucket(aliasBucket)
+		if aliasIndex == nil {
+			return ErrAliasNotFound
+		}
+
+		for _, alias := range aliases {
+			var aliasBytes [8]byte
+			byteOrder.PutUint64(aliasBytes[:], alias.ToUint64())
+
+			if err := aliasIndex.Delete(aliasBytes[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	for _, alias := range aliases {
+		delete(s.aliasToBase, alias)
+	}
+	delete(s.baseToAliases, base)
+
+	return nil
+}
+
+// PutPeerAlias stores the alias that our peer has assigned to the channel
+// identified by the passed ChannelID.
+func (s *AliasStore) PutPeerAlias(chanID lnwire.ChannelID,
+	alias lnwire.ShortChannelID) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		peerAliases := tx.ReadWriteBucket(peerAliasBucket)
+		if peerAliases == nil {
+			return ErrNoPeerAlias
+		}
+
+		var aliasBytes [8]byte
+		byteOrder.PutUint64(aliasBytes[:], alias.ToUint64())
+
+		return peerAliases.Put(chanID[:], aliasBytes[:])
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	s.peerAliases[chanID] = alias
+
+	return nil
+}
+
+// GetPeerAlias returns the alias that our peer has assigned to the channel
+// identified by the passed ChannelID. ErrNoPeerAlias is returned if the peer
+// hasn't sent us one.
+func (s *AliasStore) GetPeerAlias(
+	chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	alias, ok := s.peerAliases[chanID]
+	if !ok {
+		return lnwire.ShortChannelID{}, ErrNoPeerAlias
+	}
+
+	return alias, nil
+}
+
+// DeletePeerAlias removes the alias our peer has assigned to the channel
+// identified by the passed ChannelID.
+func (s *AliasStore) DeletePeerAlias(chanID lnwire.ChannelID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.peerAliases[chanID]; !ok {
+		return nil
+	}
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		peerAliases := tx.ReadWriteBucket(peerAliasBucket)
+		if peerAliases == nil {
+			return ErrNoPeerAlias
+		}
+
+		return peerAliases.Delete(chanID[:])
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	delete(s.peerAliases, chanID)
+
+	return nil
+}
+
+// RequestAlias allocates a new, unused alias from the alias range. The alias
+// is persisted as allocated before it is returned, so it will never be handed
+// out twice.
+func (s *AliasStore) RequestAlias() (lnwire.ShortChannelID, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var nextAlias lnwire.ShortChannelID
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		allocs := tx.ReadWriteBucket(aliasAllocBucket)
+		if allocs == nil {
+			return ErrAliasNotFound
+		}
+
+		lastBytes := allocs.Get(lastAliasKey)
+		if lastBytes == nil {
+			nextAlias = StartingAlias
+		} else {
+			lastAlias := lnwire.NewShortChanIDFromInt(
+				byteOrder.Uint64(lastBytes),
+			)
+			nextAlias = getNextAlias(lastAlias)
+		}
+
+		if !IsAlias(nextAlias) {
+			return ErrAliasesExhausted
+		}
+
+		var aliasBytes [8]byte
+		byteOrder.PutUint64(aliasBytes[:], nextAlias.ToUint64())
+
+		return allocs.Put(lastAliasKey, aliasBytes[:])
+	}, func() {
+		nextAlias = lnwire.ShortChannelID{}
+	})
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	return nextAlias, nil
+}
+
+// ListAliases returns a copy of the mapping from base ShortChannelID to the
+// set of local aliases of every channel known to the store.
+func (s *AliasStore) ListAliases() map[lnwire.ShortChannelID][]lnwire.ShortChannelID {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	aliasMap := make(
+		map[lnwire.ShortChannelID][]lnwire.ShortChannelID,
+		len(s.baseToAliases),
+	)
+	for base, aliases := range s.baseToAliases {
+		aliasCopy := make([]lnwire.ShortChannelID, len(aliases))
+		copy(aliasCopy, aliases)
+
+		aliasMap[base] = aliasCopy
+	}
+
+	return aliasMap
+}
+
+// getNextAlias returns the alias that follows the passed alias. The TxPosition
+// is incremented first, overflowing into the TxIndex and finally into the
+// BlockHeight.
+func getNextAlias(last lnwire.ShortChannelID) lnwire.ShortChannelID {
+	next := last
+
+	next.TxPosition++
+	if next.TxPosition != 0 {
+		return next
+	}
+
+	next.TxIndex++
+	if next.TxIndex <= maxTxIndex {
+		return next
+	}
+
+	next.TxIndex = 0
+	next.BlockHeight++
+
+	return next
+}
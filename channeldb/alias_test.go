@@ -0,0 +1,158 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAliasStore tests that aliases can be allocated, stored, looked up and
+// deleted, and that they survive a restart of the store.
+func TestAliasStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	store, err := NewAliasStore(db)
+	require.NoError(t, err)
+
+	// The first alias handed out should be the starting alias, with the
+	// following ones being strictly increasing.
+	alias1, err := store.RequestAlias()
+	require.NoError(t, err)
+	require.Equal(t, StartingAlias, alias1)
+	require.True(t, IsAlias(alias1))
+
+	alias2, err := store.RequestAlias()
+	require.NoError(t, err)
+	require.Greater(t, alias2.ToUint64(), alias1.ToUint64())
+
+	base := lnwire.ShortChannelID{
+		BlockHeight: 700_000,
+		TxIndex:     12,
+		TxPosition:  1,
+	}
+	require.False(t, IsAlias(base))
+
+	require.NoError(t, store.AddLocalAlias(alias1, base))
+	require.NoError(t, store.AddLocalAlias(alias2, base))
+
+	// Adding the same mapping twice is a no-op, while re-assigning an
+	// alias to another channel isn't allowed.
+	require.NoError(t, store.AddLocalAlias(alias1, base))
+	require.Error(t, store.AddLocalAlias(alias1, alias2))
+
+	require.ElementsMatch(
+		t, []lnwire.ShortChannelID{alias1, alias2},
+		store.GetAliases(base),
+	)
+
+	foundBase, err := store.FindBaseSCID(alias2)
+	require.NoError(t, err)
+	require.Equal(t, base, foundBase)
+
+	_, err = store.FindBaseSCID(base)
+	require.ErrorIs(t, err, ErrAliasNotFound)
+
+	var chanID lnwire.ChannelID
+	chanID[0] = 1
+
+	_, err = store.GetPeerAlias(chanID)
+	require.ErrorIs(t, err, ErrNoPeerAlias)
+
+	peerAlias := lnwire.ShortChannelID{BlockHeight: AliasStartBlockHeight}
+	require.NoError(t, store.PutPeerAlias(chanID, peerAlias))
+
+	// Re-open the store to ensure everything was persisted, and that
+	// allocation continues where it left off.
+	store, err = NewAliasStore(db)
+	require.NoError(t, err)
+
+	require.ElementsMatch(
+		t, []lnwire.ShortChannelID{alias1, alias2},
+		store.GetAliases(base),
+	)
+	require.Equal(
+		t, map[lnwire.ShortChannelID][]lnwire.ShortChannelID{
+			base: store.GetAliases(base),
+		}, store.ListAliases(),
+	)
+
+	storedPeerAlias, err := store.GetPeerAlias(chanID)
+	require.NoError(t, err)
+	require.Equal(t, peerAlias, storedPeerAlias)
+
+	alias3, err := store.RequestAlias()
+	require.NoError(t, err)
+	require.Greater(t, alias3.ToUint64(), alias2.ToUint64())
+
+	// Finally, deleting the aliases should remove them from both indexes.
+	require.NoError(t, store.DeleteAliases(base))
+	require.Empty(t, store.GetAliases(base))
+
+	_, err = store.FindBaseSCID(alias1)
+	require.ErrorIs(t, err, ErrAliasNotFound)
+
+	require.NoError(t, store.DeletePeerAlias(chanID))
+	_, err = store.GetPeerAlias(chanID)
+	require.ErrorIs(t, err, ErrNoPeerAlias)
+}
+
+// TestGetNextAlias tests that the alias following the passed one is computed
+// correctly, including when the TxPosition and TxIndex overflow.
+func TestGetNextAlias(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		last     lnwire.ShortChannelID
+		expected lnwire.ShortChannelID
+	}{
+		{
+			name: "increment tx position",
+			last: StartingAlias,
+			expected: lnwire.ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+				TxPosition:  1,
+			},
+		},
+		{
+			name: "tx position overflow",
+			last: lnwire.ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+				TxIndex:     5,
+				TxPosition:  (1 << 16) - 1,
+			},
+			expected: lnwire.ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+				TxIndex:     6,
+			},
+		},
+		{
+			name: "tx index overflow",
+			last: lnwire.ShortChannelID{
+				BlockHeight: AliasStartBlockHeight,
+				TxIndex:     maxTxIndex,
+				TxPosition:  (1 << 16) - 1,
+			},
+			expected: lnwire.ShortChannelID{
+				BlockHeight: AliasStartBlockHeight + 1,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(
+				t, testCase.expected, getNextAlias(testCase.last),
+			)
+		})
+	}
+}
@@ -74,6 +74,12 @@ var (
 	// shutdown script for the remote peer.
 	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
 
+	// dualFundingTxKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the funding
+	// transaction of a dual funded channel, which both parties construct
+	// and sign interactively.
+	dualFundingTxKey = []byte("dual-funding-tx-key")
+
 	// chanCommitmentKey can be accessed within the sub-bucket for a
 	// particular channel. This key stores the up to date commitment state
 	// for a particular channel party. Appending a 0 to the end of this key
@@ -198,6 +204,10 @@ const (
 	// A tlv type definition used to serialize and deserialize a KeyLocator
 	// from the database.
 	keyLocType tlv.Type = 1
+
+	// A tlv type definition used to serialize and deserialize the
+	// confirmed ShortChannelID of a zero-conf channel.
+	realScidType tlv.Type = 2
 )
 
 // indexStatus is an enum-like type that describes what state the
@@ -219,7 +229,7 @@ const (
 // fee negotiation, channel closing, the format of HTLCs, etc. Structure-wise,
 // a ChannelType is a bit field, with each bit denoting a modification from the
 // base channel type of single funder.
-type ChannelType uint8
+type ChannelType uint64
 
 const (
 	// NOTE: iota isn't used here for this enum needs to be stable
@@ -262,6 +272,16 @@ const (
 	// period of time, constraining every output that pays to the channel
 	// initiator with an additional CLTV of the lease maturity.
 	LeaseExpirationBit ChannelType = 1 << 6
+
+	// ZeroConfBit indicates that the channel is a zero-conf channel, which
+	// can be used to route payments before its funding transaction has
+	// confirmed.
+	ZeroConfBit ChannelType = 1 << 7
+
+	// ScidAliasChanBit indicates that the channel has negotiated the
+	// scid-alias channel type, meaning that the confirmed ShortChannelID
+	// is never revealed to the counterparty or in invoices.
+	ScidAliasChanBit ChannelType = 1 << 8
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -311,6 +331,16 @@ func (c ChannelType) HasLeaseExpiration() bool {
 	return c&LeaseExpirationBit == LeaseExpirationBit
 }
 
+// IsZeroConf returns true if the channel is a zero-conf channel.
+func (c ChannelType) IsZeroConf() bool {
+	return c&ZeroConfBit == ZeroConfBit
+}
+
+// HasScidAliasChan returns true if the scid-alias channel type was negotiated.
+func (c ChannelType) HasScidAliasChan() bool {
+	return c&ScidAliasChanBit == ScidAliasChanBit
+}
+
 // ChannelConstraints represents a set of constraints meant to allow a node to
 // limit their exposure, enact flow control and ensure that all HTLCs are
 // economically relevant. This struct will be mirrored for both sides of the
@@ -612,12 +642,21 @@ type OpenChannel struct {
 	// ShortChannelID encodes the exact location in the chain in which the
 	// channel was initially confirmed. This includes: the block height,
 	// transaction index, and the output within the target transaction.
+	//
+	// NOTE: For zero-conf channels, this is an alias assigned by us, as
+	// the channel is usable before the funding transaction confirms. The
+	// confirmed ShortChannelID can be retrieved via ZeroConfRealScid.
 	ShortChannelID lnwire.ShortChannelID
 
 	// IsPending indicates whether a channel's funding transaction has been
 	// confirmed.
 	IsPending bool
 
+	// confirmedScid is the confirmed ShortChannelID of a zero-conf
+	// channel. It is only set once the funding transaction of the channel
+	// has confirmed.
+	confirmedScid lnwire.ShortChannelID
+
 	// IsInitiator is a bool which indicates if we were the original
 	// initiator for the channel. This value may affect how higher levels
 	// negotiate fees, or close the channel.
@@ -738,6 +777,19 @@ type OpenChannel struct {
 	// have private key isolation from broln.
 	RevocationKeyLocator keychain.KeyLocator
 
+	// splicedChanID is the ID of the channel once it has been spliced, as
+	// it can't be derived from the new funding outpoint anymore. It is
+	// the zero value if the channel hasn't been spliced.
+	splicedChanID lnwire.ChannelID
+
+	// pendingSplices are the splices of the channel whose transactions
+	// haven't confirmed yet.
+	pendingSplices []*ChannelSplice
+
+	// awaitingSpliceLocked is true if a splice of the channel has
+	// confirmed, but the remote party hasn't sent splice_locked yet.
+	awaitingSpliceLocked bool
+
 	// TODO(roasbeef): eww
 	Db *ChannelStateDB
 
@@ -754,6 +806,65 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// ChanID returns the ID that identifies the channel within the wire protocol.
+// Dual funded channels derive it from the revocation base points of both
+// parties, as their funding outpoint isn't known when the ID is first used.
+// All other channels derive it from their funding outpoint. The ID doesn't
+// change if the channel is spliced.
+func (c *OpenChannel) ChanID() lnwire.ChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.chanID()
+}
+
+// chanID returns the ID of the channel.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) chanID() lnwire.ChannelID {
+	if c.splicedChanID != (lnwire.ChannelID{}) {
+		return c.splicedChanID
+	}
+
+	localRevBase := c.LocalChanCfg.RevocationBasePoint.PubKey
+	remoteRevBase := c.RemoteChanCfg.RevocationBasePoint.PubKey
+	if c.ChanType.IsDualFunder() && localRevBase != nil &&
+		remoteRevBase != nil {
+
+		return lnwire.NewChanIDFromRevocationBasepoints(
+			localRevBase, remoteRevBase,
+		)
+	}
+
+	return lnwire.NewChanIDFromOutPoint(&c.FundingOutpoint)
+}
+
+// IsZeroConf returns whether the channel is a zero-conf channel.
+func (c *OpenChannel) IsZeroConf() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ChanType.IsZeroConf()
+}
+
+// ZeroConfConfirmed returns whether the zero-conf channel has confirmed
+// on-chain.
+func (c *OpenChannel) ZeroConfConfirmed() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return !c.confirmedScid.IsDefault()
+}
+
+// ZeroConfRealScid returns the confirmed ShortChannelID of a zero-conf
+// channel. The zero value is returned if the channel hasn't confirmed yet.
+func (c *OpenChannel) ZeroConfRealScid() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.confirmedScid
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -1033,6 +1144,81 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 	return nil
 }
 
+// MarkRealScid marks the zero-conf channel's confirmed ShortChannelID. This
+// should only be called once the funding transaction has confirmed.
+func (c *OpenChannel) MarkRealScid(realScid lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.confirmedScid = realScid
+
+		return putOpenChannel(chanBucket, channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.confirmedScid = realScid
+
+	return nil
+}
+
+// UpdateFundingTxn replaces the funding transaction of a dual funded channel
+// with the given one. The funding transaction is first stored without any
+// witnesses once the commitment transactions have been signed, and updated
+// with the fully signed transaction once both parties have exchanged the
+// signatures for their inputs.
+func (c *OpenChannel) UpdateFundingTxn(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.ChanType.IsDualFunder() {
+		return fmt.Errorf("channel %v isn't dual funded",
+			c.FundingOutpoint)
+	}
+
+	if fundingTx.TxHash() != c.FundingOutpoint.Hash {
+		return fmt.Errorf("funding transaction %v doesn't match "+
+			"channel %v", fundingTx.TxHash(), c.FundingOutpoint)
+	}
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.FundingTxn = fundingTx
+
+		return putOpenChannel(chanBucket, channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.FundingTxn = fundingTx
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -1171,9 +1357,7 @@ func (c *OpenChannel) ChanSyncMsg() (*lnwire.ChannelReestablish, error) {
 	}
 
 	return &lnwire.ChannelReestablish{
-		ChanID: lnwire.NewChanIDFromOutPoint(
-			&c.FundingOutpoint,
-		),
+		ChanID:                 c.chanID(),
 		NextLocalCommitHeight:  nextLocalCommitHeight,
 		RemoteCommitTailHeight: remoteChainTipHeight,
 		LastRemoteCommitSecret: lastCommitSecret,
@@ -1461,6 +1645,10 @@ func fetchOpenChannel(chanBucket kvdb.RBucket,
 		return nil, fmt.Errorf("unable to fetch chan revocations: %v", err)
 	}
 
+	if err := fetchSpliceState(chanBucket, channel); err != nil {
+		return nil, fmt.Errorf("unable to fetch splices: %v", err)
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return channel, nil
@@ -3347,12 +3535,25 @@ func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// Write the RevocationKeyLocator as the first entry in a tlv stream.
+	// Write the RevocationKeyLocator as the first entry in a tlv stream,
+	// followed by the confirmed ShortChannelID of zero-conf channels and
+	// the ID of spliced channels.
 	keyLocRecord := MakeKeyLocRecord(
 		keyLocType, &channel.RevocationKeyLocator,
 	)
+	realScidRecord := tlv.MakeStaticRecord(
+		realScidType, &channel.confirmedScid, 8,
+		lnwire.EShortChannelID, lnwire.DShortChannelID,
+	)
+	records := []tlv.Record{keyLocRecord, realScidRecord}
+	if channel.splicedChanID != (lnwire.ChannelID{}) {
+		records = append(records, tlv.MakePrimitiveRecord(
+			splicedChanIDType,
+			(*[32]byte)(&channel.splicedChanID),
+		))
+	}
 
-	tlvStream, err := tlv.NewStream(keyLocRecord)
+	tlvStream, err := tlv.NewStream(records...)
 	if err != nil {
 		return err
 	}
@@ -3365,17 +3566,32 @@ func putChanInfo(chanBucket kvdb.RwBucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// Finally, add optional shutdown scripts for the local and remote peer if
-	// they are present.
+	// Add optional shutdown scripts for the local and remote peer if they
+	// are present.
 	if err := putOptionalUpfrontShutdownScript(
 		chanBucket, localUpfrontShutdownKey, channel.LocalShutdownScript,
 	); err != nil {
 		return err
 	}
 
-	return putOptionalUpfrontShutdownScript(
+	if err := putOptionalUpfrontShutdownScript(
 		chanBucket, remoteUpfrontShutdownKey, channel.RemoteShutdownScript,
-	)
+	); err != nil {
+		return err
+	}
+
+	// Finally, both parties of a dual funded channel know its funding
+	// transaction, so we'll store it if it's present.
+	if !channel.ChanType.IsDualFunder() || channel.FundingTxn == nil {
+		return nil
+	}
+
+	var txBuf bytes.Buffer
+	if err := WriteElement(&txBuf, channel.FundingTxn); err != nil {
+		return err
+	}
+
+	return chanBucket.Put(dualFundingTxKey, txBuf.Bytes())
 }
 
 // putOptionalUpfrontShutdownScript adds a shutdown script under the key
@@ -3551,7 +3767,16 @@ func fetchChanInfo(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 	}
 
 	keyLocRecord := MakeKeyLocRecord(keyLocType, &channel.RevocationKeyLocator)
-	tlvStream, err := tlv.NewStream(keyLocRecord)
+	realScidRecord := tlv.MakeStaticRecord(
+		realScidType, &channel.confirmedScid, 8,
+		lnwire.EShortChannelID, lnwire.DShortChannelID,
+	)
+	splicedChanIDRecord := tlv.MakePrimitiveRecord(
+		splicedChanIDType, (*[32]byte)(&channel.splicedChanID),
+	)
+	tlvStream, err := tlv.NewStream(
+		keyLocRecord, realScidRecord, splicedChanIDRecord,
+	)
 	if err != nil {
 		return err
 	}
@@ -3562,16 +3787,27 @@ func fetchChanInfo(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
-	// Finally, read the optional shutdown scripts.
+	// Read the optional shutdown scripts.
 	if err := getOptionalUpfrontShutdownScript(
 		chanBucket, localUpfrontShutdownKey, &channel.LocalShutdownScript,
 	); err != nil {
 		return err
 	}
 
-	return getOptionalUpfrontShutdownScript(
+	if err := getOptionalUpfrontShutdownScript(
 		chanBucket, remoteUpfrontShutdownKey, &channel.RemoteShutdownScript,
-	)
+	); err != nil {
+		return err
+	}
+
+	// Finally, read the funding transaction of dual funded channels if it
+	// has been stored.
+	txBytes := chanBucket.Get(dualFundingTxKey)
+	if !channel.ChanType.IsDualFunder() || txBytes == nil {
+		return nil
+	}
+
+	return ReadElement(bytes.NewReader(txBytes), &channel.FundingTxn)
 }
 
 func deserializeChanCommit(r io.Reader) (ChannelCommitment, error) {
@@ -1271,6 +1271,110 @@ func TestRefreshShortChanID(t *testing.T) {
 	}
 }
 
+// TestMarkRealScid tests that the confirmed ShortChannelID of a zero-conf
+// channel is persisted and picked up when refreshing another instance of the
+// same channel.
+func TestMarkRealScid(t *testing.T) {
+	t.Parallel()
+
+	fullDB, cleanUp, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	cdb := fullDB.ChannelStateDB()
+
+	// Create a zero-conf channel that is marked open under an alias.
+	state := createTestChannel(t, cdb, openChannelOption())
+	require.False(t, state.ZeroConfConfirmed())
+
+	pendingChannels, err := cdb.FetchAllOpenChannels()
+	require.NoError(t, err)
+	require.Len(t, pendingChannels, 1)
+	otherState := pendingChannels[0]
+
+	realScid := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	require.NoError(t, state.MarkRealScid(realScid))
+	require.True(t, state.ZeroConfConfirmed())
+	require.Equal(t, realScid, state.ZeroConfRealScid())
+
+	// The base ShortChannelID must remain untouched.
+	require.NotEqual(t, realScid, state.ShortChanID())
+
+	// The other instance only learns of the confirmed ShortChannelID once
+	// it has been refreshed.
+	require.False(t, otherState.ZeroConfConfirmed())
+	require.NoError(t, otherState.RefreshShortChanID())
+	require.Equal(t, realScid, otherState.ZeroConfRealScid())
+}
+
+// TestDualFundedChannel tests that dual funded channels are identified by the
+// channel ID derived from their revocation base points, and that their funding
+// transaction is persisted and can be updated once it has been signed.
+func TestDualFundedChannel(t *testing.T) {
+	t.Parallel()
+
+	fullDB, cleanUp, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	cdb := fullDB.ChannelStateDB()
+
+	// The funding transaction is first stored without the witnesses of
+	// its inputs. We change its lock time, so that the original test
+	// funding transaction doesn't match the channel's funding outpoint.
+	unsignedTx := channels.TestFundingTx.Copy()
+	unsignedTx.LockTime++
+	fundingPoint := wire.OutPoint{Hash: unsignedTx.TxHash()}
+
+	_, remoteRevBase := bronec.PrivKeyFromBytes(bronec.S256(), rev[:])
+	state := createTestChannel(t, cdb, func(params *testChannelParams) {
+		params.channel.ChanType = DualFunderBit |
+			SingleFunderTweaklessBit
+		params.channel.FundingOutpoint = fundingPoint
+		params.channel.FundingTxn = unsignedTx
+		params.channel.RemoteChanCfg.RevocationBasePoint.PubKey =
+			remoteRevBase
+	})
+
+	// The channel ID must be derived from both revocation base points
+	// rather than the funding outpoint.
+	expectedChanID := lnwire.NewChanIDFromRevocationBasepoints(
+		state.LocalChanCfg.RevocationBasePoint.PubKey, remoteRevBase,
+	)
+	require.Equal(t, expectedChanID, state.ChanID())
+	require.NotEqual(t, lnwire.NewChanIDFromOutPoint(&fundingPoint),
+		state.ChanID())
+
+	syncMsg, err := state.ChanSyncMsg()
+	require.NoError(t, err)
+	require.Equal(t, expectedChanID, syncMsg.ChanID)
+
+	pendingChannels, err := cdb.FetchPendingChannels()
+	require.NoError(t, err)
+	require.Len(t, pendingChannels, 1)
+	require.Equal(t, unsignedTx, pendingChannels[0].FundingTxn)
+	require.Equal(t, expectedChanID, pendingChannels[0].ChanID())
+
+	// A transaction that doesn't match the funding outpoint must be
+	// rejected.
+	require.Error(t, state.UpdateFundingTxn(channels.TestFundingTx))
+
+	// Once signed, the funding transaction with the witnesses is stored.
+	signedTx := unsignedTx.Copy()
+	signedTx.TxIn[0].Witness = wire.TxWitness{{0x01, 0x02}}
+	require.NoError(t, state.UpdateFundingTxn(signedTx))
+	require.Equal(t, signedTx, state.FundingTxn)
+
+	pendingChannels, err = cdb.FetchPendingChannels()
+	require.NoError(t, err)
+	require.Len(t, pendingChannels, 1)
+	require.Equal(t, signedTx, pendingChannels[0].FundingTxn)
+}
+
 // TestCloseInitiator tests the setting of close initiator statuses for
 // cooperative closes and local force closes.
 func TestCloseInitiator(t *testing.T) {
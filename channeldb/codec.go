@@ -10,6 +10,7 @@ import (
 	"github.com/brsuite/broln/keychain"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/shachain"
+	"github.com/brsuite/broln/tlv"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/wire"
@@ -85,7 +86,8 @@ func WriteElement(w io.Writer, element interface{}) error {
 
 		return binary.Write(w, byteOrder, false)
 	case ChannelType:
-		if err := binary.Write(w, byteOrder, e); err != nil {
+		var buf [8]byte
+		if err := tlv.WriteVarInt(w, uint64(e), &buf); err != nil {
 			return err
 		}
 
@@ -270,10 +272,14 @@ func ReadElement(r io.Reader, element interface{}) error {
 		}
 
 	case *ChannelType:
-		if err := binary.Read(r, byteOrder, e); err != nil {
+		var buf [8]byte
+		ctype, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
 			return err
 		}
 
+		*e = ChannelType(ctype)
+
 	case *chainhash.Hash:
 		if _, err := io.ReadFull(r, e[:]); err != nil {
 			return err
@@ -326,6 +326,10 @@ var dbTopLevelBuckets = [][]byte{
 	closeSummaryBucket,
 	outpointBucket,
 	historicalChannelBucket,
+	aliasBucket,
+	peerAliasBucket,
+	aliasAllocBucket,
+	offerBucket,
 }
 
 // Wipe completely deletes all saved state within all used buckets within the
@@ -1 +1,129 @@
 package channeldb
+
+import (
+	"bytes"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/tlv"
+)
+
+// InboundFee is the fee that a node charges for htlcs that arrive over a
+// specific channel. It is charged on top of the fee of the outgoing channel
+// and may be negative to offer a discount.
+type InboundFee struct {
+	// Base is the fixed part of the fee in milli-bronees.
+	Base int32
+
+	// Rate is the proportional part of the fee in parts per million.
+	Rate int32
+}
+
+// NewInboundFeeFromWire converts a wire fee into an inbound fee.
+func NewInboundFeeFromWire(fee lnwire.Fee) InboundFee {
+	return InboundFee{
+		Base: fee.BaseFee,
+		Rate: fee.FeeRate,
+	}
+}
+
+// ToWire converts the inbound fee into its wire representation.
+func (i *InboundFee) ToWire() lnwire.Fee {
+	return lnwire.Fee{
+		BaseFee: i.Base,
+		FeeRate: i.Rate,
+	}
+}
+
+// IsZero returns true if the inbound fee charges nothing.
+func (i *InboundFee) IsZero() bool {
+	return i.Base == 0 && i.Rate == 0
+}
+
+// CalcFee calculates the inbound fee for the given amount. The amount is the
+// outgoing amount plus the fee of the outgoing channel, which is the amount
+// that the htlc would carry without the inbound fee. The result can be
+// negative.
+func (i *InboundFee) CalcFee(amt lnwire.MilliBronees) int64 {
+	// To keep the integer math simple, positive proportional fees are
+	// rounded down and negative ones are rounded up.
+	return int64(i.Base) + int64(i.Rate)*int64(amt)/feeRateParts
+}
+
+// InboundFee returns the inbound fee that is carried in the extra opaque data
+// of the policy. A zero fee is returned if the policy doesn't carry one.
+func (c *ChannelEdgePolicy) InboundFee() (InboundFee, error) {
+	var fee lnwire.Fee
+	extraData := lnwire.ExtraOpaqueData(c.ExtraOpaqueData)
+	if _, err := extraData.ExtractRecords(&fee); err != nil {
+		return InboundFee{}, err
+	}
+
+	return NewInboundFeeFromWire(fee), nil
+}
+
+// inboundFeeOrZero returns the inbound fee of the given policy. A missing
+// policy or one that carries malformed extra data is treated as if it didn't
+// charge an inbound fee.
+func inboundFeeOrZero(policy *ChannelEdgePolicy) InboundFee {
+	if policy == nil {
+		return InboundFee{}
+	}
+
+	inboundFee, err := policy.InboundFee()
+	if err != nil {
+		log.Debugf("Unable to parse inbound fee of channel %v: %v",
+			policy.ChannelID, err)
+
+		return InboundFee{}
+	}
+
+	return inboundFee
+}
+
+// SetInboundFee stores the inbound fee in the extra opaque data of the policy.
+// Any other records in the extra opaque data are preserved. A zero fee removes
+// the inbound fee record.
+func (c *ChannelEdgePolicy) SetInboundFee(inboundFee InboundFee) error {
+	var oldFee lnwire.Fee
+	extraData := lnwire.ExtraOpaqueData(c.ExtraOpaqueData)
+	typeMap, err := extraData.ExtractRecords(&oldFee)
+	if err != nil {
+		return err
+	}
+
+	// Nothing changes if there is no fee to remove or to add.
+	_, hasFee := typeMap[lnwire.FeeRecordType]
+	if !hasFee && inboundFee.IsZero() {
+		return nil
+	}
+
+	// Carry over all records that we don't know.
+	rawRecords := make(map[uint64][]byte, len(typeMap))
+	for recordType, value := range typeMap {
+		if recordType == lnwire.FeeRecordType {
+			continue
+		}
+
+		rawRecords[uint64(recordType)] = value
+	}
+	records := tlv.MapToRecords(rawRecords)
+
+	fee := inboundFee.ToWire()
+	if !inboundFee.IsZero() {
+		records = append(records, fee.Record())
+		tlv.SortRecords(records)
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return err
+	}
+	c.ExtraOpaqueData = b.Bytes()
+
+	return nil
+}
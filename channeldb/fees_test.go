@@ -0,0 +1,69 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInboundFeeCalcFee tests the calculation of positive and negative
+// inbound fees.
+func TestInboundFeeCalcFee(t *testing.T) {
+	t.Parallel()
+
+	fee := InboundFee{Base: 1000, Rate: 1500}
+	require.EqualValues(t, 2500, fee.CalcFee(1_000_000))
+	require.EqualValues(t, 1002, fee.CalcFee(1_999))
+
+	// Negative proportional fees are rounded towards zero.
+	discount := InboundFee{Base: -1000, Rate: -1500}
+	require.EqualValues(t, -2500, discount.CalcFee(1_000_000))
+	require.EqualValues(t, -1002, discount.CalcFee(1_999))
+}
+
+// TestChannelEdgePolicyInboundFee tests that the inbound fee can be stored in
+// the extra opaque data of a policy without losing other records.
+func TestChannelEdgePolicyInboundFee(t *testing.T) {
+	t.Parallel()
+
+	// A policy without extra data doesn't charge an inbound fee, and
+	// setting a zero fee leaves its extra data untouched.
+	policy := &ChannelEdgePolicy{}
+	fee, err := policy.InboundFee()
+	require.NoError(t, err)
+	require.True(t, fee.IsZero())
+
+	require.NoError(t, policy.SetInboundFee(InboundFee{}))
+	require.Nil(t, policy.ExtraOpaqueData)
+
+	// Start out with an unknown record in the extra data.
+	var unknown uint64 = 7
+	var extraData lnwire.ExtraOpaqueData
+	require.NoError(t, extraData.PackRecords(&unknownRecordProducer{
+		record: tlv.MakePrimitiveRecord(1, &unknown),
+	}))
+	policy.ExtraOpaqueData = extraData
+
+	inboundFee := InboundFee{Base: -200, Rate: -300}
+	require.NoError(t, policy.SetInboundFee(inboundFee))
+
+	fee, err = policy.InboundFee()
+	require.NoError(t, err)
+	require.Equal(t, inboundFee, fee)
+
+	// Removing the fee restores the original extra data.
+	require.NoError(t, policy.SetInboundFee(InboundFee{}))
+	require.Equal(t, []byte(extraData), policy.ExtraOpaqueData)
+}
+
+// unknownRecordProducer wraps a record that the policy doesn't know.
+type unknownRecordProducer struct {
+	record tlv.Record
+}
+
+// Record returns the wrapped record.
+func (u *unknownRecordProducer) Record() tlv.Record {
+	return u.record
+}
@@ -488,6 +488,11 @@ func (c *ChannelGraph) ForEachNodeChannel(tx kvdb.RTx, node route.Vertex,
 			cachedInPolicy.ToNodeFeatures = toNodeFeatures
 		}
 
+		inboundFee := inboundFeeOrZero(p1)
+		if cachedInPolicy != nil {
+			cachedInPolicy.InboundFee = inboundFee
+		}
+
 		directedChannel := &DirectedChannel{
 			ChannelID:    e.ChannelID,
 			IsNode1:      node == e.NodeKey1Bytes,
@@ -495,6 +500,7 @@ func (c *ChannelGraph) ForEachNodeChannel(tx kvdb.RTx, node route.Vertex,
 			Capacity:     e.Capacity,
 			OutPolicySet: p1 != nil,
 			InPolicy:     cachedInPolicy,
+			InboundFee:   inboundFee,
 		}
 
 		if node == e.NodeKey2Bytes {
@@ -581,6 +587,7 @@ func (c *ChannelGraph) ForEachNodeCached(cb func(node route.Vertex,
 				Capacity:     e.Capacity,
 				OutPolicySet: p1 != nil,
 				InPolicy:     cachedInPolicy,
+				InboundFee:   inboundFeeOrZero(p1),
 			}
 
 			if node.PubKeyBytes == e.NodeKey2Bytes {
@@ -82,6 +82,11 @@ type CachedEdgePolicy struct {
 	// the edge is in the cache, only on the copy that is returned in
 	// ForEachChannel().
 	ToNodeFeatures *lnwire.FeatureVector
+
+	// InboundFee is the fee that the to node charges for htlcs that arrive
+	// over this channel. Like the to node's features, it is only set on
+	// the copy that is returned in ForEachChannel().
+	InboundFee InboundFee
 }
 
 // ComputeFee computes the fee to forward an HTLC of `amt` milli-broneess over
@@ -144,6 +149,10 @@ type DirectedChannel struct {
 	// source, so we're always interested in the edge that arrives to us
 	// from the other node.
 	InPolicy *CachedEdgePolicy
+
+	// InboundFee is the fee that this node charges for htlcs that arrive
+	// over the channel. It is taken from the node's outgoing policy.
+	InboundFee InboundFee
 }
 
 // DeepCopy creates a deep copy of the channel, including the incoming policy.
@@ -160,6 +169,7 @@ func (c *DirectedChannel) DeepCopy() *DirectedChannel {
 		// be sure they don't contain any old data.
 		channelCopy.InPolicy.ToNodePubKey = nil
 		channelCopy.InPolicy.ToNodeFeatures = nil
+		channelCopy.InPolicy.InboundFee = InboundFee{}
 	}
 
 	return &channelCopy
@@ -305,6 +315,8 @@ func (c *GraphCache) updateOrAddEdge(node route.Vertex, edge *DirectedChannel) {
 func (c *GraphCache) UpdatePolicy(policy *ChannelEdgePolicy, fromNode,
 	toNode route.Vertex, edge1 bool) {
 
+	inboundFee := inboundFeeOrZero(policy)
+
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
@@ -325,11 +337,13 @@ func (c *GraphCache) UpdatePolicy(policy *ChannelEdgePolicy, fromNode,
 		// policy for node 1.
 		case channel.IsNode1 && edge1:
 			channel.OutPolicySet = true
+			channel.InboundFee = inboundFee
 
 		// This is node 2, and it is edge 2, so this is the outgoing
 		// policy for node 2.
 		case !channel.IsNode1 && !edge1:
 			channel.OutPolicySet = true
+			channel.InboundFee = inboundFee
 
 		// The other two cases left mean it's the inbound policy for the
 		// node.
@@ -443,6 +457,7 @@ func (c *GraphCache) getChannels(node route.Vertex) []*DirectedChannel {
 		if channelCopy.InPolicy != nil {
 			channelCopy.InPolicy.ToNodePubKey = toNodeCallback
 			channelCopy.InPolicy.ToNodeFeatures = features
+			channelCopy.InPolicy.InboundFee = channel.InboundFee
 		}
 
 		channelsCopy[i] = channelCopy
@@ -86,6 +86,14 @@ func TestGraphCacheAddNode(t *testing.T) {
 				Features:    lnwire.EmptyFeatureVector(),
 			},
 		}
+
+		// Both nodes charge an inbound fee, which is carried by their
+		// outgoing policies.
+		inboundFeeA := InboundFee{Base: -1000, Rate: -100}
+		inboundFeeB := InboundFee{Base: 500, Rate: 10}
+		require.NoError(t, outPolicy1.SetInboundFee(inboundFeeA))
+		require.NoError(t, inPolicy1.SetInboundFee(inboundFeeB))
+
 		node := &node{
 			pubKey:   nodeA,
 			features: lnwire.EmptyFeatureVector(),
@@ -121,6 +129,17 @@ func TestGraphCacheAddNode(t *testing.T) {
 		require.Equal(t, inPolicy1 != nil, toChannels[0].OutPolicySet)
 		assertCachedPolicyEqual(t, outPolicy1, toChannels[0].InPolicy)
 
+		// The inbound fee of a node applies to htlcs arriving over its
+		// incoming policy.
+		require.Equal(t, inboundFeeA, fromChannels[0].InboundFee)
+		require.Equal(
+			t, inboundFeeA, fromChannels[0].InPolicy.InboundFee,
+		)
+		require.Equal(t, inboundFeeB, toChannels[0].InboundFee)
+		require.Equal(
+			t, inboundFeeB, toChannels[0].InPolicy.InboundFee,
+		)
+
 		// Now that we've inserted two nodes into the graph, check that
 		// we'll recover the same set of channels during ForEachNode.
 		nodes := make(map[route.Vertex]struct{})
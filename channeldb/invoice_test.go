@@ -1049,6 +1049,70 @@ func TestScanInvoices(t *testing.T) {
 	require.Equal(t, 1, resetCount)
 }
 
+// TestScanInvoiceBatch tests that all invoices are scanned exactly once when
+// iterating over them in batches.
+func TestScanInvoiceBatch(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	defer cleanup()
+	require.NoError(t, err, "unable to make test db")
+
+	const (
+		numInvoices = 5
+		batchSize   = 2
+	)
+
+	testInvoices := make(map[lntypes.Hash]*Invoice)
+	for i := 1; i <= numInvoices; i++ {
+		invoice, err := randInvoice(lnwire.MilliBronees(i))
+		require.NoError(t, err)
+
+		paymentHash := invoice.Terms.PaymentPreimage.Hash()
+		testInvoices[paymentHash] = invoice
+
+		_, err = db.AddInvoice(invoice, paymentHash)
+		require.NoError(t, err)
+	}
+
+	var (
+		invoices  = make(map[lntypes.Hash]*Invoice)
+		batch     []lntypes.Hash
+		lastHash  *lntypes.Hash
+		batchLens []int
+	)
+
+	reset := func() {
+		batch = nil
+	}
+
+	scanFunc := func(paymentHash lntypes.Hash, invoice *Invoice) error {
+		batch = append(batch, paymentHash)
+		invoices[paymentHash] = invoice
+
+		return nil
+	}
+
+	for {
+		err := db.ScanInvoiceBatch(lastHash, batchSize, scanFunc, reset)
+		require.NoError(t, err)
+
+		batchLens = append(batchLens, len(batch))
+		if len(batch) < batchSize {
+			break
+		}
+
+		// The invoices are scanned in the order of their payment
+		// hashes.
+		require.Equal(t, -1, bytes.Compare(batch[0][:], batch[1][:]))
+
+		lastHash = &batch[len(batch)-1]
+	}
+
+	require.Equal(t, []int{2, 2, 1}, batchLens)
+	require.Equal(t, testInvoices, invoices)
+}
+
 // TestDuplicateSettleInvoice tests that if we add a new invoice and settle it
 // twice, then the second time we also receive the invoice that we settled as a
 // return argument.
@@ -1236,6 +1236,68 @@ func (d *DB) ScanInvoices(
 	}, reset)
 }
 
+// ScanInvoiceBatch scans through up to batchSize invoices in the order of their
+// payment hashes and calls the passed scanFunc for each of them. The scan
+// starts with the first invoice whose payment hash is greater than startAfter,
+// or with the very first invoice if startAfter is nil. This allows callers to
+// iterate over a large number of invoices without holding a single read
+// transaction open for the whole iteration. The reset closure is used in the
+// same way as in ScanInvoices.
+func (d *DB) ScanInvoiceBatch(startAfter *lntypes.Hash, batchSize int,
+	scanFunc func(lntypes.Hash, *Invoice) error, reset func()) error {
+
+	return kvdb.View(d, func(tx kvdb.RTx) error {
+		invoices := tx.ReadBucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		invoiceIndex := invoices.NestedReadBucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			// Mask the error if there's no invoice index as that
+			// simply means there are no invoices added yet.
+			return nil
+		}
+
+		cursor := invoiceIndex.ReadCursor()
+
+		var k, v []byte
+		if startAfter == nil {
+			k, v = cursor.First()
+		} else {
+			k, v = cursor.Seek(startAfter[:])
+			if bytes.Equal(k, startAfter[:]) {
+				k, v = cursor.Next()
+			}
+		}
+
+		var numScanned int
+		for ; k != nil && numScanned < batchSize; k, v = cursor.Next() {
+			// Skip the special numInvoicesKey as that does not
+			// point to a valid invoice, as well as sub-buckets.
+			if bytes.Equal(k, numInvoicesKey) || v == nil {
+				continue
+			}
+
+			invoice, err := fetchInvoice(v, invoices)
+			if err != nil {
+				return err
+			}
+
+			var paymentHash lntypes.Hash
+			copy(paymentHash[:], k)
+
+			if err := scanFunc(paymentHash, &invoice); err != nil {
+				return err
+			}
+
+			numScanned++
+		}
+
+		return nil
+	}, reset)
+}
+
 // InvoiceQuery represents a query to the invoice database. The query allows a
 // caller to retrieve all invoices starting from a particular add index and
 // limit the number of results returned.
@@ -1570,6 +1632,18 @@ func putInvoice(invoices, invoiceIndex, payAddrIndex, addIndex kvdb.RwBucket,
 	return nextAddSeqNo, nil
 }
 
+// SerializeInvoice serializes an invoice to a writer in the same format that
+// is used to store it in the database.
+func SerializeInvoice(w io.Writer, i *Invoice) error {
+	return serializeInvoice(w, i)
+}
+
+// DeserializeInvoice deserializes an invoice that was serialized with
+// SerializeInvoice. The reader must not contain any data after the invoice.
+func DeserializeInvoice(r io.Reader) (Invoice, error) {
+	return deserializeInvoice(r)
+}
+
 // serializeInvoice serializes an invoice to a writer.
 //
 // Note: this function is in use for a migration. Before making changes that
@@ -0,0 +1,214 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/tlv"
+)
+
+var (
+	// offerBucket stores the offers that we've issued, keyed by their
+	// offer id.
+	//
+	// offer-bucket
+	//    |
+	//    |-- <offer id>: <offer record>
+	//    |-- <offer id>: <offer record>
+	offerBucket = []byte("offer-bucket")
+
+	// ErrOfferNotFound is returned when an offer is not known to the offer
+	// store.
+	ErrOfferNotFound = errors.New("offer not found")
+
+	// ErrDuplicateOffer is returned when an offer with the same id has
+	// already been added to the offer store.
+	ErrDuplicateOffer = errors.New("offer already exists")
+)
+
+const (
+	// The tlv types of the fields of a stored offer.
+	offerCreationDateType tlv.Type = 0
+	offerDisabledType     tlv.Type = 1
+	offerEncodedType      tlv.Type = 2
+)
+
+// Offer is an offer that we've issued, which payers can request invoices
+// for.
+type Offer struct {
+	// ID is the offer id, which identifies the offer within invoice
+	// requests.
+	ID [32]byte
+
+	// CreationDate is the time at which the offer was created.
+	CreationDate time.Time
+
+	// Disabled is true once the offer has been disabled, after which
+	// invoice requests for it are rejected.
+	Disabled bool
+
+	// Offer is the TLV stream of the offer.
+	Offer []byte
+}
+
+// OfferStore is a persistent store for the offers that we've issued.
+type OfferStore struct {
+	db kvdb.Backend
+}
+
+// NewOfferStore creates a new OfferStore backed by the passed database.
+func NewOfferStore(db kvdb.Backend) (*OfferStore, error) {
+	// Ensure that the bucket exists, as it may not for databases that
+	// were created before offers were supported.
+	err := kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(offerBucket)
+		return err
+	}, func() {})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OfferStore{
+		db: db,
+	}, nil
+}
+
+// AddOffer adds a new offer to the store.
+func (s *OfferStore) AddOffer(offer *Offer) error {
+	var b bytes.Buffer
+	if err := serializeOffer(&b, offer); err != nil {
+		return err
+	}
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		offers := tx.ReadWriteBucket(offerBucket)
+		if offers.Get(offer.ID[:]) != nil {
+			return ErrDuplicateOffer
+		}
+
+		return offers.Put(offer.ID[:], b.Bytes())
+	}, func() {})
+}
+
+// FetchOffer returns the offer with the given id.
+func (s *OfferStore) FetchOffer(id [32]byte) (*Offer, error) {
+	var offer *Offer
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		v := tx.ReadBucket(offerBucket).Get(id[:])
+		if v == nil {
+			return ErrOfferNotFound
+		}
+
+		var err error
+		offer, err = deserializeOffer(id, v)
+		return err
+	}, func() {
+		offer = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// FetchOffers returns all offers in the store.
+func (s *OfferStore) FetchOffers() ([]*Offer, error) {
+	var offers []*Offer
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(offerBucket)
+		return bucket.ForEach(func(k, v []byte) error {
+			var id [32]byte
+			copy(id[:], k)
+
+			offer, err := deserializeOffer(id, v)
+			if err != nil {
+				return err
+			}
+			offers = append(offers, offer)
+
+			return nil
+		})
+	}, func() {
+		offers = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+// DisableOffer disables the offer with the given id.
+func (s *OfferStore) DisableOffer(id [32]byte) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		offers := tx.ReadWriteBucket(offerBucket)
+		v := offers.Get(id[:])
+		if v == nil {
+			return ErrOfferNotFound
+		}
+
+		offer, err := deserializeOffer(id, v)
+		if err != nil {
+			return err
+		}
+		offer.Disabled = true
+
+		var b bytes.Buffer
+		if err := serializeOffer(&b, offer); err != nil {
+			return err
+		}
+
+		return offers.Put(id[:], b.Bytes())
+	}, func() {})
+}
+
+// serializeOffer writes the offer, excluding its id, as tlv stream.
+func serializeOffer(b *bytes.Buffer, offer *Offer) error {
+	creationDate := uint64(offer.CreationDate.Unix())
+
+	var disabled uint8
+	if offer.Disabled {
+		disabled = 1
+	}
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(offerCreationDateType, &creationDate),
+		tlv.MakePrimitiveRecord(offerDisabledType, &disabled),
+		tlv.MakePrimitiveRecord(offerEncodedType, &offer.Offer),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(b)
+}
+
+// deserializeOffer reads the offer with the given id from its tlv stream.
+func deserializeOffer(id [32]byte, v []byte) (*Offer, error) {
+	var (
+		creationDate uint64
+		disabled     uint8
+		offer        = &Offer{ID: id}
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(offerCreationDateType, &creationDate),
+		tlv.MakePrimitiveRecord(offerDisabledType, &disabled),
+		tlv.MakePrimitiveRecord(offerEncodedType, &offer.Offer),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(v)); err != nil {
+		return nil, err
+	}
+
+	offer.CreationDate = time.Unix(int64(creationDate), 0)
+	offer.Disabled = disabled == 1
+
+	return offer, nil
+}
@@ -0,0 +1,55 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestOfferStore tests that offers can be added, fetched and disabled, and
+// that they survive a restart of the store.
+func TestOfferStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	store, err := NewOfferStore(db)
+	require.NoError(t, err)
+
+	offer1 := &Offer{
+		ID:           [32]byte{1},
+		CreationDate: time.Unix(1700000000, 0),
+		Offer:        []byte{1, 2, 3},
+	}
+	offer2 := &Offer{
+		ID:           [32]byte{2},
+		CreationDate: time.Unix(1700000001, 0),
+		Offer:        []byte{4, 5, 6},
+	}
+
+	_, err = store.FetchOffer(offer1.ID)
+	require.ErrorIs(t, err, ErrOfferNotFound)
+
+	require.NoError(t, store.AddOffer(offer1))
+	require.NoError(t, store.AddOffer(offer2))
+	require.ErrorIs(t, store.AddOffer(offer1), ErrDuplicateOffer)
+
+	fetched, err := store.FetchOffer(offer1.ID)
+	require.NoError(t, err)
+	require.Equal(t, offer1, fetched)
+
+	require.NoError(t, store.DisableOffer(offer2.ID))
+	require.ErrorIs(t, store.DisableOffer([32]byte{3}), ErrOfferNotFound)
+
+	// The offers are read from the database by a new store.
+	store, err = NewOfferStore(db)
+	require.NoError(t, err)
+
+	offer2.Disabled = true
+	offers, err := store.FetchOffers()
+	require.NoError(t, err)
+	require.Equal(t, []*Offer{offer1, offer2}, offers)
+}
@@ -1142,6 +1142,35 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		records = append(records, h.MPP.Record())
 	}
 
+	// Add the fields of hops within a blinded route if present.
+	if h.EncryptedData != nil {
+		records = append(
+			records, record.NewEncryptedDataRecord(&h.EncryptedData),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		records = append(
+			records, record.NewBlindingPointRecord(&h.BlindingPoint),
+		)
+	}
+
+	totalAmt := uint64(h.TotalAmtMsat)
+	if h.TotalAmtMsat != 0 {
+		records = append(
+			records, record.NewTotalAmtMsatBlinded(&totalAmt),
+		)
+	}
+
+	// Add the trampoline onion if present.
+	if h.TrampolineOnion != nil {
+		records = append(
+			records, record.NewTrampolineOnionRecord(
+				&h.TrampolineOnion,
+			),
+		)
+	}
+
 	// Final sanity check to absolutely rule out custom records that are not
 	// custom and write into the standard range.
 	if err := h.CustomRecords.Validate(); err != nil {
@@ -1256,6 +1285,51 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		h.MPP = mpp
 	}
 
+	// Likewise, extract the fields of hops within a blinded route.
+	encryptedDataType := uint64(record.EncryptedDataOnionType)
+	if data, ok := tlvMap[encryptedDataType]; ok {
+		delete(tlvMap, encryptedDataType)
+
+		h.EncryptedData = data
+	}
+
+	blindingPointType := uint64(record.BlindingPointOnionType)
+	if pointBytes, ok := tlvMap[blindingPointType]; ok {
+		delete(tlvMap, blindingPointType)
+
+		var (
+			rec = record.NewBlindingPointRecord(&h.BlindingPoint)
+			r   = bytes.NewReader(pointBytes)
+		)
+		err := rec.Decode(r, uint64(len(pointBytes)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totalAmtType := uint64(record.TotalAmtMsatBlindedType)
+	if amtBytes, ok := tlvMap[totalAmtType]; ok {
+		delete(tlvMap, totalAmtType)
+
+		var (
+			totalAmt uint64
+			rec      = record.NewTotalAmtMsatBlinded(&totalAmt)
+			r        = bytes.NewReader(amtBytes)
+		)
+		err := rec.Decode(r, uint64(len(amtBytes)))
+		if err != nil {
+			return nil, err
+		}
+		h.TotalAmtMsat = lnwire.MilliBronees(totalAmt)
+	}
+
+	trampolineOnionType := uint64(record.TrampolineOnionType)
+	if onion, ok := tlvMap[trampolineOnionType]; ok {
+		delete(tlvMap, trampolineOnionType)
+
+		h.TrampolineOnion = onion
+	}
+
 	h.CustomRecords = tlvMap
 
 	return h, nil
@@ -31,7 +31,8 @@ var (
 			65536: []byte{},
 			80001: []byte{},
 		},
-		MPP: record.NewMPP(32, [32]byte{0x42}),
+		MPP:             record.NewMPP(32, [32]byte{0x42}),
+		TrampolineOnion: []byte{1, 2, 3},
 	}
 
 	testHop2 = &route.Hop{
@@ -51,6 +52,33 @@ var (
 			testHop1,
 		},
 	}
+
+	testBlindedRoute = route.Route{
+		TotalTimeLock: 150,
+		TotalAmount:   1000,
+		SourcePubKey:  route.NewVertex(pub),
+		Hops: []*route.Hop{
+			{
+				PubKeyBytes:      route.NewVertex(pub),
+				ChannelID:        12345,
+				OutgoingTimeLock: 111,
+				AmtToForward:     900,
+				CustomRecords:    record.CustomSet{},
+				EncryptedData:    []byte{1, 2, 3},
+				BlindingPoint:    pub,
+			},
+			{
+				PubKeyBytes:      route.NewVertex(pub),
+				OutgoingTimeLock: 111,
+				AmtToForward:     900,
+				EncryptedData:    []byte{4, 5, 6},
+				TotalAmtMsat:     1800,
+				CustomRecords: record.CustomSet{
+					65536: []byte{7},
+				},
+			},
+		},
+	}
 )
 
 func makeFakeInfo() (*PaymentCreationInfo, *HTLCAttemptInfo) {
@@ -146,23 +174,26 @@ func assertRouteEqual(a, b *route.Route) error {
 func TestRouteSerialization(t *testing.T) {
 	t.Parallel()
 
-	var b bytes.Buffer
-	if err := SerializeRoute(&b, testRoute); err != nil {
-		t.Fatal(err)
-	}
+	for _, testRoute := range []route.Route{testRoute, testBlindedRoute} {
+		var b bytes.Buffer
+		if err := SerializeRoute(&b, testRoute); err != nil {
+			t.Fatal(err)
+		}
 
-	r := bytes.NewReader(b.Bytes())
-	route2, err := DeserializeRoute(r)
-	if err != nil {
-		t.Fatal(err)
-	}
+		r := bytes.NewReader(b.Bytes())
+		route2, err := DeserializeRoute(r)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	// First we verify all the records match up porperly, as they aren't
-	// able to be properly compared using reflect.DeepEqual.
-	err = assertRouteEqual(&testRoute, &route2)
-	if err != nil {
-		t.Fatalf("routes not equal: \n%v vs \n%v",
-			spew.Sdump(testRoute), spew.Sdump(route2))
+		// First we verify all the records match up porperly, as they
+		// aren't able to be properly compared using
+		// reflect.DeepEqual.
+		err = assertRouteEqual(&testRoute, &route2)
+		if err != nil {
+			t.Fatalf("routes not equal: \n%v vs \n%v",
+				spew.Sdump(testRoute), spew.Sdump(route2))
+		}
 	}
 }
 
@@ -0,0 +1,511 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+)
+
+var (
+	// pendingSplicesKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the splices of the
+	// channel whose transactions haven't confirmed yet.
+	pendingSplicesKey = []byte("pending-splices-key")
+
+	// awaitingSpliceLockedKey is present within the bucket for a channel
+	// once a splice of the channel has confirmed, until the remote party
+	// has sent its splice_locked message.
+	awaitingSpliceLockedKey = []byte("awaiting-splice-locked-key")
+)
+
+const (
+	// A tlv type definition used to serialize and deserialize the ID of
+	// a channel that has been spliced, as the ID of a channel doesn't
+	// change when its funding outpoint does.
+	splicedChanIDType tlv.Type = 3
+)
+
+// ChannelSplice is a splice of a channel, which replaces its funding output
+// with a new one of a different value. Until the splice transaction
+// confirms, both the current and the new funding output may be spent, so
+// both parties also hold signed commitments that spend the new one.
+type ChannelSplice struct {
+	// SpliceTx is the splice transaction, which spends the current
+	// funding output of the channel. It only contains the witnesses of
+	// all inputs once both parties have exchanged their signatures.
+	SpliceTx *wire.MsgTx
+
+	// FundingOutpoint is the new funding outpoint of the channel.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the new capacity of the channel.
+	Capacity bronutil.Amount
+
+	// LocalCommitment is our current commitment, spending the new funding
+	// output.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the current commitment of the remote party,
+	// spending the new funding output.
+	RemoteCommitment ChannelCommitment
+
+	// LocalSigs is the tx_signatures message that we sent for the splice
+	// transaction, which is retransmitted on reconnection until we've
+	// received the signatures of the remote party.
+	LocalSigs *lnwire.TxSignatures
+}
+
+// FullySigned returns true if all inputs of the splice transaction have been
+// signed.
+func (s *ChannelSplice) FullySigned() bool {
+	for _, txIn := range s.SpliceTx.TxIn {
+		if len(txIn.Witness) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PendingSplices returns the splices of the channel whose transactions
+// haven't confirmed yet.
+func (c *OpenChannel) PendingSplices() []*ChannelSplice {
+	c.RLock()
+	defer c.RUnlock()
+
+	splices := make([]*ChannelSplice, len(c.pendingSplices))
+	copy(splices, c.pendingSplices)
+
+	return splices
+}
+
+// AddSplice adds a splice of the channel, whose transaction hasn't been
+// broadcast yet. Once the splice transaction has confirmed, PromoteSplice
+// must be called.
+func (c *OpenChannel) AddSplice(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	splices := append(c.pendingSplices, splice)
+	if err := c.putPendingSplices(splices); err != nil {
+		return err
+	}
+
+	c.pendingSplices = splices
+
+	return nil
+}
+
+// UpdateSpliceTx replaces the transaction of a pending splice with the given
+// one, which is signed by both parties.
+func (c *OpenChannel) UpdateSpliceTx(spliceTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	txid := spliceTx.TxHash()
+	splices := make([]*ChannelSplice, len(c.pendingSplices))
+	var found bool
+	for i, splice := range c.pendingSplices {
+		splices[i] = splice
+		if splice.FundingOutpoint.Hash != txid {
+			continue
+		}
+
+		updated := *splice
+		updated.SpliceTx = spliceTx
+		splices[i] = &updated
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("unknown splice transaction %v", txid)
+	}
+
+	if err := c.putPendingSplices(splices); err != nil {
+		return err
+	}
+
+	c.pendingSplices = splices
+
+	return nil
+}
+
+// putPendingSplices stores the pending splices of the channel.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) putPendingSplices(splices []*ChannelSplice) error {
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeSplices(&b, splices); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(pendingSplicesKey, b.Bytes())
+	}, func() {})
+}
+
+// PromoteSplice makes the pending splice with the given funding outpoint the
+// current funding of the channel, once the splice transaction has confirmed
+// at the given location. All data of the channel is moved to the new funding
+// outpoint, and all other pending splices are discarded, as they spend the
+// same funding output. If the channel has already been moved by another
+// instance of it, only the in-memory state is updated.
+func (c *OpenChannel) PromoteSplice(fundingOutpoint wire.OutPoint,
+	openLoc lnwire.ShortChannelID) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	var promoted *OpenChannel
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == ErrChannelNotFound:
+			chanBucket, err = fetchChanBucketRw(
+				tx, c.IdentityPub, &fundingOutpoint,
+				c.ChainHash,
+			)
+			if err != nil {
+				return err
+			}
+
+			promoted, err = fetchOpenChannel(
+				chanBucket, &fundingOutpoint,
+			)
+			return err
+
+		case err != nil:
+			return err
+		}
+
+		promoted, err = c.moveToSplice(
+			tx, chanBucket, fundingOutpoint, openLoc,
+		)
+		return err
+	}, func() {
+		promoted = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.FundingOutpoint = promoted.FundingOutpoint
+	c.Capacity = promoted.Capacity
+	c.ShortChannelID = promoted.ShortChannelID
+	c.FundingTxn = promoted.FundingTxn
+	c.LocalCommitment = promoted.LocalCommitment
+	c.RemoteCommitment = promoted.RemoteCommitment
+	c.splicedChanID = promoted.splicedChanID
+	c.pendingSplices = promoted.pendingSplices
+	c.awaitingSpliceLocked = promoted.awaitingSpliceLocked
+	c.Packager = NewChannelPackager(c.ShortChannelID)
+
+	return nil
+}
+
+// moveToSplice moves the data of the channel to the bucket of the new funding
+// outpoint of the given splice, and returns the promoted channel.
+//
+// NOTE: The primary mutex should already be held before this method is called.
+func (c *OpenChannel) moveToSplice(tx kvdb.RwTx, oldBucket kvdb.RwBucket,
+	fundingOutpoint wire.OutPoint,
+	openLoc lnwire.ShortChannelID) (*OpenChannel, error) {
+
+	channel, err := fetchOpenChannel(oldBucket, &c.FundingOutpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var splice *ChannelSplice
+	for _, s := range channel.pendingSplices {
+		if s.FundingOutpoint == fundingOutpoint {
+			splice = s
+		}
+	}
+	if splice == nil {
+		return nil, fmt.Errorf("unknown splice %v of channel %v",
+			fundingOutpoint, c.FundingOutpoint)
+	}
+
+	// The ID of the channel stays the same, so we'll store it if it was
+	// derived from the funding outpoint.
+	channel.splicedChanID = channel.chanID()
+	channel.FundingOutpoint = splice.FundingOutpoint
+	channel.Capacity = splice.Capacity
+	channel.ShortChannelID = openLoc
+	channel.FundingTxn = splice.SpliceTx
+	channel.LocalCommitment = splice.LocalCommitment
+	channel.RemoteCommitment = splice.RemoteCommitment
+	channel.pendingSplices = nil
+	channel.awaitingSpliceLocked = true
+
+	chainBucket := tx.ReadWriteBucket(openChannelBucket).
+		NestedReadWriteBucket(c.IdentityPub.SerializeCompressed()).
+		NestedReadWriteBucket(c.ChainHash[:])
+
+	var oldKey, newKey bytes.Buffer
+	if err := writeOutpoint(&oldKey, &c.FundingOutpoint); err != nil {
+		return nil, err
+	}
+	if err := writeOutpoint(&newKey, &fundingOutpoint); err != nil {
+		return nil, err
+	}
+
+	newBucket, err := chainBucket.CreateBucket(newKey.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	if err := copyBucket(newBucket, oldBucket); err != nil {
+		return nil, err
+	}
+	if err := chainBucket.DeleteNestedBucket(oldKey.Bytes()); err != nil {
+		return nil, err
+	}
+
+	if err := putOpenChannel(newBucket, channel); err != nil {
+		return nil, err
+	}
+	if err := newBucket.Delete(pendingSplicesKey); err != nil {
+		return nil, err
+	}
+	err = newBucket.Put(awaitingSpliceLockedKey, []byte{1})
+	if err != nil {
+		return nil, err
+	}
+
+	// The old funding outpoint is now closed, while the new one is open.
+	err = putOutpointStatus(tx, oldKey.Bytes(), outpointClosed)
+	if err != nil {
+		return nil, err
+	}
+	err = putOutpointStatus(tx, newKey.Bytes(), outpointOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, the forwarding packages of the channel are keyed by its
+	// ShortChannelID, so we'll move them as well.
+	fwdPkgBkt := tx.ReadWriteBucket(fwdPackagesKey)
+	if fwdPkgBkt == nil {
+		return channel, nil
+	}
+
+	oldSource := makeLogKey(c.ShortChannelID.ToUint64())
+	srcBucket := fwdPkgBkt.NestedReadWriteBucket(oldSource[:])
+	if srcBucket == nil {
+		return channel, nil
+	}
+
+	newSource := makeLogKey(openLoc.ToUint64())
+	dstBucket, err := fwdPkgBkt.CreateBucket(newSource[:])
+	if err != nil {
+		return nil, err
+	}
+	if err := copyBucket(dstBucket, srcBucket); err != nil {
+		return nil, err
+	}
+
+	return channel, fwdPkgBkt.DeleteNestedBucket(oldSource[:])
+}
+
+// AwaitingSpliceLocked returns true if a splice of the channel has confirmed,
+// but the remote party hasn't sent its splice_locked message yet.
+func (c *OpenChannel) AwaitingSpliceLocked() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.awaitingSpliceLocked
+}
+
+// MarkSpliceLocked marks that the remote party has sent its splice_locked
+// message for the last splice of the channel.
+func (c *OpenChannel) MarkSpliceLocked() error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(awaitingSpliceLockedKey)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.awaitingSpliceLocked = false
+
+	return nil
+}
+
+// fetchSpliceState reads the pending splices of the channel, and whether it
+// awaits the splice_locked message of the remote party.
+func fetchSpliceState(chanBucket kvdb.RBucket, channel *OpenChannel) error {
+	channel.awaitingSpliceLocked = chanBucket.Get(
+		awaitingSpliceLockedKey,
+	) != nil
+
+	splicesBytes := chanBucket.Get(pendingSplicesKey)
+	if splicesBytes == nil {
+		return nil
+	}
+
+	splices, err := deserializeSplices(bytes.NewReader(splicesBytes))
+	if err != nil {
+		return err
+	}
+	channel.pendingSplices = splices
+
+	return nil
+}
+
+// putOutpointStatus writes the status of the outpoint to the outpoint index.
+func putOutpointStatus(tx kvdb.RwTx, outpoint []byte,
+	status indexStatus) error {
+
+	opBucket := tx.ReadWriteBucket(outpointBucket)
+
+	statusByte := uint8(status)
+	statusRecord := tlv.MakePrimitiveRecord(indexStatusType, &statusByte)
+	opStream, err := tlv.NewStream(statusRecord)
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	if err := opStream.Encode(&b); err != nil {
+		return err
+	}
+
+	return opBucket.Put(outpoint, b.Bytes())
+}
+
+// copyBucket recursively copies all keys and nested buckets of src to dst.
+func copyBucket(dst kvdb.RwBucket, src kvdb.RwBucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		if v != nil {
+			return dst.Put(
+				append([]byte(nil), k...),
+				append([]byte(nil), v...),
+			)
+		}
+
+		nestedDst, err := dst.CreateBucket(append([]byte(nil), k...))
+		if err != nil {
+			return err
+		}
+
+		return copyBucket(nestedDst, src.NestedReadWriteBucket(k))
+	})
+}
+
+func serializeSplices(w io.Writer, splices []*ChannelSplice) error {
+	numSplices := uint16(len(splices))
+	if err := binary.Write(w, byteOrder, numSplices); err != nil {
+		return err
+	}
+
+	for _, splice := range splices {
+		err := WriteElements(w,
+			splice.SpliceTx, splice.FundingOutpoint,
+			splice.Capacity,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = serializeChanCommit(w, &splice.LocalCommitment)
+		if err != nil {
+			return err
+		}
+		err = serializeChanCommit(w, &splice.RemoteCommitment)
+		if err != nil {
+			return err
+		}
+
+		hasSigs := splice.LocalSigs != nil
+		if err := WriteElements(w, hasSigs); err != nil {
+			return err
+		}
+		if !hasSigs {
+			continue
+		}
+
+		if err := WriteElements(w, splice.LocalSigs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializeSplices(r io.Reader) ([]*ChannelSplice, error) {
+	var numSplices uint16
+	if err := binary.Read(r, byteOrder, &numSplices); err != nil {
+		return nil, err
+	}
+
+	splices := make([]*ChannelSplice, numSplices)
+	for i := range splices {
+		var (
+			splice ChannelSplice
+			err    error
+		)
+		err = ReadElements(r,
+			&splice.SpliceTx, &splice.FundingOutpoint,
+			&splice.Capacity,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		splice.LocalCommitment, err = deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+		splice.RemoteCommitment, err = deserializeChanCommit(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var hasSigs bool
+		if err := ReadElements(r, &hasSigs); err != nil {
+			return nil, err
+		}
+		if hasSigs {
+			var msg lnwire.Message
+			if err := ReadElements(r, &msg); err != nil {
+				return nil, err
+			}
+			sigs, ok := msg.(*lnwire.TxSignatures)
+			if !ok {
+				return nil, fmt.Errorf("expected "+
+					"lnwire.TxSignatures, instead read: "+
+					"%T", msg)
+			}
+			splice.LocalSigs = sigs
+		}
+
+		splices[i] = &splice
+	}
+
+	return splices, nil
+}
@@ -0,0 +1,146 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPromoteSplice tests that a pending splice of a channel is persisted,
+// and that the channel is moved to the new funding outpoint once the splice
+// is promoted, while keeping its channel ID.
+func TestPromoteSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, cleanUp, err := MakeTestDB()
+	require.NoError(t, err)
+	defer cleanUp()
+
+	cdb := fullDB.ChannelStateDB()
+
+	state := createTestChannel(t, cdb, openChannelOption())
+	oldChanPoint := state.FundingOutpoint
+	oldScid := state.ShortChanID()
+	chanID := state.ChanID()
+
+	fwdPkg := NewFwdPkg(oldScid, 1, nil, nil)
+	err = kvdb.Update(cdb.backend, func(tx kvdb.RwTx) error {
+		return state.Packager.AddFwdPkg(tx, fwdPkg)
+	}, func() {})
+	require.NoError(t, err)
+
+	openChannels, err := cdb.FetchAllOpenChannels()
+	require.NoError(t, err)
+	require.Len(t, openChannels, 1)
+	otherState := openChannels[0]
+
+	// The splice transaction spends the current funding output, and is
+	// first stored without witnesses.
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&oldChanPoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(state.Capacity)*2, nil))
+
+	localCommit := state.LocalCommitment
+	localCommit.LocalBalance *= 2
+	remoteCommit := state.RemoteCommitment
+	remoteCommit.RemoteBalance *= 2
+
+	splice := &ChannelSplice{
+		SpliceTx: spliceTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		Capacity:         state.Capacity * 2,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+		LocalSigs: &lnwire.TxSignatures{
+			ChannelID: chanID,
+			TxID:      spliceTx.TxHash(),
+		},
+	}
+	require.NoError(t, state.AddSplice(splice))
+	require.False(t, splice.FullySigned())
+
+	openChannels, err = cdb.FetchAllOpenChannels()
+	require.NoError(t, err)
+	require.Len(t, openChannels, 1)
+	pendingSplices := openChannels[0].PendingSplices()
+	require.Len(t, pendingSplices, 1)
+	require.Equal(t, splice.FundingOutpoint,
+		pendingSplices[0].FundingOutpoint)
+	require.Equal(t, splice.Capacity, pendingSplices[0].Capacity)
+	assertCommitmentEqual(
+		t, &localCommit, &pendingSplices[0].LocalCommitment,
+	)
+	assertCommitmentEqual(
+		t, &remoteCommit, &pendingSplices[0].RemoteCommitment,
+	)
+	require.Equal(t, splice.LocalSigs.TxID,
+		pendingSplices[0].LocalSigs.TxID)
+
+	// Once signed, the splice transaction with the witnesses is stored.
+	signedTx := spliceTx.Copy()
+	signedTx.TxIn[0].Witness = wire.TxWitness{{0x01}, {0x02}}
+	require.NoError(t, state.UpdateSpliceTx(signedTx))
+	require.True(t, state.PendingSplices()[0].FullySigned())
+	require.Error(t, state.UpdateSpliceTx(wire.NewMsgTx(1)))
+
+	newScid := lnwire.ShortChannelID{
+		BlockHeight: oldScid.BlockHeight + 100,
+		TxIndex:     3,
+	}
+	require.NoError(t, state.PromoteSplice(
+		splice.FundingOutpoint, newScid,
+	))
+
+	require.Equal(t, splice.FundingOutpoint, state.FundingOutpoint)
+	require.Equal(t, splice.Capacity, state.Capacity)
+	require.Equal(t, newScid, state.ShortChanID())
+	require.Equal(t, chanID, state.ChanID())
+	require.Equal(t, signedTx.TxHash(), state.FundingTxn.TxHash())
+	require.Equal(t, signedTx.TxIn[0].Witness,
+		state.FundingTxn.TxIn[0].Witness)
+	require.Empty(t, state.PendingSplices())
+	require.True(t, state.AwaitingSpliceLocked())
+	assertCommitmentEqual(t, &localCommit, &state.LocalCommitment)
+	assertCommitmentEqual(t, &remoteCommit, &state.RemoteCommitment)
+
+	// The other instance of the channel only updates its in-memory state.
+	require.NoError(t, otherState.PromoteSplice(
+		splice.FundingOutpoint, newScid,
+	))
+	require.Equal(t, splice.FundingOutpoint, otherState.FundingOutpoint)
+	require.Equal(t, newScid, otherState.ShortChanID())
+	require.Equal(t, chanID, otherState.ChanID())
+
+	// The channel can only be fetched by its new funding outpoint.
+	_, err = cdb.FetchChannel(nil, oldChanPoint)
+	require.ErrorIs(t, err, ErrChannelNotFound)
+
+	dbState, err := cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.Equal(t, chanID, dbState.ChanID())
+	require.Equal(t, splice.Capacity, dbState.Capacity)
+	require.True(t, dbState.AwaitingSpliceLocked())
+
+	// The revocation state and forwarding packages are moved as well.
+	require.Equal(t, state.RemoteCurrentRevocation,
+		dbState.RemoteCurrentRevocation)
+	require.Empty(t, loadFwdPkgs(
+		t, cdb.backend, NewChannelPackager(oldScid),
+	))
+	fwdPkgs := loadFwdPkgs(t, cdb.backend, state.Packager)
+	require.Len(t, fwdPkgs, 1)
+	require.Equal(t, newScid, fwdPkgs[0].Source)
+
+	require.NoError(t, state.MarkSpliceLocked())
+	require.False(t, state.AwaitingSpliceLocked())
+
+	dbState, err = cdb.FetchChannel(nil, splice.FundingOutpoint)
+	require.NoError(t, err)
+	require.False(t, dbState.AwaitingSpliceLocked())
+}
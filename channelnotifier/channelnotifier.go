@@ -65,6 +65,17 @@ type ClosedChannelEvent struct {
 	CloseSummary *channeldb.ChannelCloseSummary
 }
 
+// SplicedChannelEvent represents a new event where a channel moves to a new
+// funding outpoint, as a splice of the channel has been confirmed.
+type SplicedChannelEvent struct {
+	// OldChannelPoint is the channel point of the channel before it was
+	// spliced.
+	OldChannelPoint *wire.OutPoint
+
+	// Channel is the spliced channel.
+	Channel *channeldb.OpenChannel
+}
+
 // FullyResolvedChannelEvent represents a new event where a channel becomes
 // fully resolved.
 type FullyResolvedChannelEvent struct {
@@ -163,6 +174,20 @@ func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
 	}
 }
 
+// NotifySplicedChannelEvent notifies the channelEventNotifier goroutine that a
+// channel has moved to a new funding outpoint.
+func (c *ChannelNotifier) NotifySplicedChannelEvent(oldChanPoint wire.OutPoint,
+	channel *channeldb.OpenChannel) {
+
+	event := SplicedChannelEvent{
+		OldChannelPoint: &oldChanPoint,
+		Channel:         channel,
+	}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send spliced channel update: %v", err)
+	}
+}
+
 // NotifyFullyResolvedChannelEvent notifies the channelEventNotifier goroutine
 // that a channel was fully resolved on chain.
 func (c *ChannelNotifier) NotifyFullyResolvedChannelEvent(
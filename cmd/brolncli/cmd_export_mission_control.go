@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/brsuite/broln/lnrpc/routerrpc"
+	"github.com/brsuite/broln/routing"
+	"github.com/lightninglabs/protobuf-hex-display/jsonpb"
+	"github.com/urfave/cli"
+)
+
+var exportMissionControlCommand = cli.Command{
+	Name:      "exportmc",
+	Category:  "Mission Control",
+	Usage:     "Export the internal mission control state to a file.",
+	ArgsUsage: "--output_file [--include_results]",
+	Description: `
+	Writes the mission control state to a file in a versioned binary
+	format. The file can be imported into another node with
+	importmc --input_file, and inspected offline with decodemc.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "output_file",
+			Usage: "the file to write the export to",
+		},
+		cli.BoolFlag{
+			Name: "include_results",
+			Usage: "whether to include the raw payment results " +
+				"in addition to the node pair state",
+		},
+	},
+	Action: actionDecorator(exportMissionControl),
+}
+
+func exportMissionControl(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if !ctx.IsSet("output_file") {
+		return errors.New("output_file must be set")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.ExportMissionControl(
+		ctxc, &routerrpc.ExportMissionControlRequest{
+			IncludeResults: ctx.Bool("include_results"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(ctx.String("output_file"), resp.Data, 0600)
+}
+
+var decodeMissionControlCommand = cli.Command{
+	Name:      "decodemc",
+	Category:  "Mission Control",
+	Usage:     "Decode a mission control export file.",
+	ArgsUsage: "--input_file",
+	Description: `
+	Prints the format version, the number of raw payment results and the
+	node pair state contained in a file that was written by exportmc as
+	JSON. This command doesn't require a running node.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "input_file",
+			Usage: "the export file to decode",
+		},
+	},
+	Action: actionDecorator(decodeMissionControl),
+}
+
+func decodeMissionControl(ctx *cli.Context) error {
+	if !ctx.IsSet("input_file") {
+		return errors.New("input_file must be set")
+	}
+
+	data, err := ioutil.ReadFile(ctx.String("input_file"))
+	if err != nil {
+		return err
+	}
+
+	export, err := routing.DecodeMissionControlExport(
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return err
+	}
+
+	// The pairs are proto messages, so we marshal them with the proto
+	// marshaler to get the same field names as querymc.
+	jsonMarshaler := &jsonpb.Marshaler{
+		EmitDefaults: true,
+		OrigName:     true,
+	}
+
+	pairs := make([]json.RawMessage, 0, len(export.Pairs))
+	for _, pair := range routerrpc.MarshalPairHistory(export.Pairs) {
+		pairJSON, err := jsonMarshaler.MarshalToString(pair)
+		if err != nil {
+			return err
+		}
+
+		pairs = append(pairs, json.RawMessage(pairJSON))
+	}
+
+	printJSON(struct {
+		Version    uint16            `json:"version"`
+		NumResults int               `json:"num_results"`
+		Pairs      []json.RawMessage `json:"pairs"`
+	}{
+		Version:    export.Version,
+		NumResults: export.NumResults(),
+		Pairs:      pairs,
+	})
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/brsuite/broln/lnrpc/routerrpc"
@@ -17,9 +18,19 @@ var importMissionControlCommand = cli.Command{
 	Name:      "importmc",
 	Category:  "Payments",
 	Usage:     "Import a result to the internal mission control state.",
-	ArgsUsage: fmt.Sprintf("importmc %v", argsStr),
-	Action:    actionDecorator(importMissionControl),
+	ArgsUsage: fmt.Sprintf("importmc %v | --input_file", argsStr),
+	Description: `
+	Imports a single node pair result that is given as arguments, or the
+	mission control state that was written to a file by exportmc if
+	--input_file is set.
+	`,
+	Action: actionDecorator(importMissionControl),
 	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "input_file",
+			Usage: "import the mission control export stored in " +
+				"this file",
+		},
 		cli.BoolFlag{
 			Name:  "failure",
 			Usage: "whether the routing history entry was a failure",
@@ -32,6 +43,10 @@ var importMissionControlCommand = cli.Command{
 }
 
 func importMissionControl(ctx *cli.Context) error {
+	if ctx.IsSet("input_file") {
+		return importMissionControlFile(ctx)
+	}
+
 	conn := getClientConn(ctx, false)
 	defer conn.Close()
 
@@ -97,3 +112,35 @@ func importMissionControl(ctx *cli.Context) error {
 	_, err = client.XImportMissionControl(rpcCtx, req)
 	return err
 }
+
+// importMissionControlFile imports a mission control export file.
+func importMissionControlFile(ctx *cli.Context) error {
+	if ctx.NArg() != 0 || ctx.IsSet("failure") {
+		return errors.New("input_file can't be combined with a " +
+			"single result")
+	}
+
+	data, err := ioutil.ReadFile(ctx.String("input_file"))
+	if err != nil {
+		return err
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.ImportMissionControl(
+		context.Background(), &routerrpc.ImportMissionControlRequest{
+			Data:  data,
+			Force: ctx.IsSet("force"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -71,6 +71,12 @@ var addInvoiceCommand = cli.Command{
 			Usage: "creates an AMP invoice. If true, preimage " +
 				"should not be set.",
 		},
+		cli.BoolFlag{
+			Name: "blind",
+			Usage: "hide the identity of the node behind blinded " +
+				"paths instead of including routing hints. " +
+				"Requires route blinding to be enabled.",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -123,8 +129,9 @@ func addInvoice(ctx *cli.Context) error {
 		DescriptionHash: descHash,
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
-		Private:         ctx.Bool("private"),
+		Private:         ctx.Bool("private") && !ctx.Bool("blind"),
 		IsAmp:           ctx.Bool("amp"),
+		IsBlinded:       ctx.Bool("blind"),
 	}
 
 	resp, err := client.AddInvoice(ctxc, invoice)
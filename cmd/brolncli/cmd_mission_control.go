@@ -6,6 +6,7 @@ import (
 
 	"github.com/brsuite/broln/lnrpc/routerrpc"
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/bronutil"
 	"github.com/urfave/cli"
@@ -45,10 +46,29 @@ var setCfgCommand = cli.Command{
 	Category: "Mission Control",
 	Usage:    "Set mission control's config.",
 	Description: `
-	Update the config values being used by mission control to calculate 
-	the probability that payment routes will succeed.
+	Update the config values being used by mission control to calculate the
+	probability that payment routes will succeed. The estimator type must be
+	provided to set estimator-related parameters.
 	`,
 	Flags: []cli.Flag{
+		// General settings.
+		cli.UintFlag{
+			Name: "pmtnr",
+			Usage: "the number of payments mission control " +
+				"should store",
+		},
+		cli.DurationFlag{
+			Name: "failrelax",
+			Usage: "the amount of time to wait after a failure " +
+				"before raising failure amount",
+		},
+		// Probability estimator.
+		cli.StringFlag{
+			Name: "estimator",
+			Usage: "the probability estimator to use, choose " +
+				"between 'apriori' or 'bimodal'",
+		},
+		// Apriori config.
 		cli.DurationFlag{
 			Name: "halflife",
 			Usage: "the amount of time taken to restore a node " +
@@ -64,15 +84,30 @@ var setCfgCommand = cli.Command{
 			Usage: "the degree to which mission control should " +
 				"rely on historical results, expressed as " +
 				"value in [0;1]",
-		}, cli.UintFlag{
-			Name: "pmtnr",
-			Usage: "the number of payments mission control " +
-				"should store",
 		},
+		cli.Float64Flag{
+			Name: "capfraction",
+			Usage: "the fraction of a channel's capacity around " +
+				"which its success probability is reduced, " +
+				"expressed as value in [0;1), zero disables " +
+				"the capacity penalty",
+		},
+		// Bimodal config.
 		cli.DurationFlag{
-			Name: "failrelax",
-			Usage: "the amount of time to wait after a failure " +
-				"before raising failure amount",
+			Name: "decaytime",
+			Usage: "the time span after which we phase out " +
+				"learnings from previous payment attempts",
+		},
+		cli.Uint64Flag{
+			Name: "scale",
+			Usage: "the scale in msat over which channel " +
+				"liquidity is assumed to be distributed",
+		},
+		cli.Float64Flag{
+			Name: "nodeweight",
+			Usage: "the degree to which other channels of a " +
+				"node should be taken into account, " +
+				"expressed as value in [0;1]",
 		},
 	},
 	Action: actionDecorator(setCfg),
@@ -85,42 +120,167 @@ func setCfg(ctx *cli.Context) error {
 
 	client := routerrpc.NewRouterClient(conn)
 
+	// Fetch current mission control config which we update to create our
+	// response.
 	resp, err := client.GetMissionControlConfig(
 		ctxc, &routerrpc.GetMissionControlConfigRequest{},
 	)
 	if err != nil {
 		return err
 	}
+	mcCfg := resp.Config
 
 	var haveValue bool
 
-	if ctx.IsSet("halflife") {
+	if ctx.IsSet("pmtnr") {
 		haveValue = true
-		resp.Config.HalfLifeSeconds = uint64(ctx.Duration(
-			"halflife",
-		).Seconds())
+		mcCfg.MaximumPaymentResults = uint32(ctx.Int("pmtnr"))
 	}
 
-	if ctx.IsSet("hopprob") {
+	if ctx.IsSet("failrelax") {
 		haveValue = true
-		resp.Config.HopProbability = float32(ctx.Float64("hopprob"))
+		mcCfg.MinimumFailureRelaxInterval = uint64(ctx.Duration(
+			"failrelax",
+		).Seconds())
 	}
 
-	if ctx.IsSet("weight") {
+	// If we switch to a different estimator, we start out with its default
+	// parameters.
+	if ctx.IsSet("estimator") {
 		haveValue = true
-		resp.Config.Weight = float32(ctx.Float64("weight"))
-	}
 
-	if ctx.IsSet("pmtnr") {
-		haveValue = true
-		resp.Config.MaximumPaymentResults = uint32(ctx.Int("pmtnr"))
+		current := mcCfg.Model
+		switch ctx.String("estimator") {
+		case routing.AprioriEstimatorName:
+			if current == routerrpc.MissionControlConfig_APRIORI {
+				break
+			}
+
+			aCfg := routing.DefaultAprioriConfig()
+			params := &routerrpc.AprioriParameters{
+				HalfLifeSeconds: uint64(
+					aCfg.PenaltyHalfLife.Seconds(),
+				),
+				HopProbability:   aCfg.AprioriHopProbability,
+				Weight:           aCfg.AprioriWeight,
+				CapacityFraction: aCfg.CapacityFraction,
+			}
+			apriori := &routerrpc.MissionControlConfig_Apriori{
+				Apriori: params,
+			}
+			mcCfg.Model = routerrpc.MissionControlConfig_APRIORI
+			mcCfg.EstimatorConfig = apriori
+
+		case routing.BimodalEstimatorName:
+			if current == routerrpc.MissionControlConfig_BIMODAL {
+				break
+			}
+
+			bCfg := routing.DefaultBimodalConfig()
+			params := &routerrpc.BimodalParameters{
+				NodeWeight: bCfg.BimodalNodeWeight,
+				ScaleMsat:  uint64(bCfg.BimodalScaleMsat),
+				DecayTime: uint64(
+					bCfg.BimodalDecayTime.Seconds(),
+				),
+			}
+			bimodal := &routerrpc.MissionControlConfig_Bimodal{
+				Bimodal: params,
+			}
+			mcCfg.Model = routerrpc.MissionControlConfig_BIMODAL
+			mcCfg.EstimatorConfig = bimodal
+
+		default:
+			return fmt.Errorf("unknown estimator type %v",
+				ctx.String("estimator"))
+		}
 	}
 
-	if ctx.IsSet("failrelax") {
-		haveValue = true
-		resp.Config.MinimumFailureRelaxInterval = uint64(ctx.Duration(
-			"failrelax",
-		).Seconds())
+	aprioriSet := ctx.IsSet("halflife") || ctx.IsSet("hopprob") ||
+		ctx.IsSet("weight") || ctx.IsSet("capfraction")
+	bimodalSet := ctx.IsSet("decaytime") || ctx.IsSet("scale") ||
+		ctx.IsSet("nodeweight")
+
+	switch mcCfg.Model {
+	case routerrpc.MissionControlConfig_APRIORI:
+		if bimodalSet {
+			return fmt.Errorf("bimodal parameters can't be set " +
+				"for the apriori estimator")
+		}
+
+		// Nodes that don't populate the estimator config only report
+		// the deprecated apriori fields.
+		estimatorCfg := mcCfg.EstimatorConfig
+		v, ok := estimatorCfg.(*routerrpc.MissionControlConfig_Apriori)
+		if !ok {
+			v = &routerrpc.MissionControlConfig_Apriori{
+				Apriori: &routerrpc.AprioriParameters{
+					HalfLifeSeconds: mcCfg.HalfLifeSeconds,
+					HopProbability: float64(
+						mcCfg.HopProbability,
+					),
+					Weight: float64(mcCfg.Weight),
+					CapacityFraction: routing.
+						DefaultCapacityFraction,
+				},
+			}
+			mcCfg.EstimatorConfig = v
+		}
+
+		if ctx.IsSet("halflife") {
+			haveValue = true
+			v.Apriori.HalfLifeSeconds = uint64(ctx.Duration(
+				"halflife",
+			).Seconds())
+		}
+
+		if ctx.IsSet("hopprob") {
+			haveValue = true
+			v.Apriori.HopProbability = ctx.Float64("hopprob")
+		}
+
+		if ctx.IsSet("weight") {
+			haveValue = true
+			v.Apriori.Weight = ctx.Float64("weight")
+		}
+
+		if ctx.IsSet("capfraction") {
+			haveValue = true
+			v.Apriori.CapacityFraction = ctx.Float64("capfraction")
+		}
+
+	case routerrpc.MissionControlConfig_BIMODAL:
+		if aprioriSet {
+			return fmt.Errorf("apriori parameters can't be set " +
+				"for the bimodal estimator")
+		}
+
+		estimatorCfg := mcCfg.EstimatorConfig
+		v, ok := estimatorCfg.(*routerrpc.MissionControlConfig_Bimodal)
+		if !ok {
+			return fmt.Errorf("bimodal estimator active, but " +
+				"no bimodal config returned")
+		}
+
+		if ctx.IsSet("decaytime") {
+			haveValue = true
+			v.Bimodal.DecayTime = uint64(ctx.Duration(
+				"decaytime",
+			).Seconds())
+		}
+
+		if ctx.IsSet("scale") {
+			haveValue = true
+			v.Bimodal.ScaleMsat = ctx.Uint64("scale")
+		}
+
+		if ctx.IsSet("nodeweight") {
+			haveValue = true
+			v.Bimodal.NodeWeight = ctx.Float64("nodeweight")
+		}
+
+	default:
+		return fmt.Errorf("unknown estimator type %v", mcCfg.Model)
 	}
 
 	if !haveValue {
@@ -129,7 +289,7 @@ func setCfg(ctx *cli.Context) error {
 
 	_, err = client.SetMissionControlConfig(
 		ctxc, &routerrpc.SetMissionControlConfigRequest{
-			Config: resp.Config,
+			Config: mcCfg,
 		},
 	)
 	return err
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendOnionCommand = cli.Command{
+	Name:     "sendonion",
+	Category: "Peers",
+	Usage:    "Send an onion message to a node.",
+	Description: `
+	Send an onion message to the node with the given public key. The message
+	is routed over a path of nodes that support onion messages, and carries
+	a single record for the recipient, whose type must be at least 64.
+
+	If --reply_path is set, a blinded path back to our node is included in
+	the message, which the recipient may use to reply to it. Replies can be
+	received with the subscribeonion command.`,
+	ArgsUsage: "dest",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "dest",
+			Usage: "the public key of the node to send the message to",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the type of the record for the recipient",
+			Value: 64,
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex encoded value of the record for the recipient",
+		},
+		cli.BoolFlag{
+			Name: "reply_path",
+			Usage: "include a blinded path that the recipient may use " +
+				"to reply to the message",
+		},
+	},
+	Action: actionDecorator(sendOnion),
+}
+
+func sendOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var destStr string
+	switch {
+	case ctx.IsSet("dest"):
+		destStr = ctx.String("dest")
+	case ctx.Args().Present():
+		destStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("dest argument missing")
+	}
+
+	dest, err := hex.DecodeString(destStr)
+	if err != nil {
+		return err
+	}
+
+	data, err := hex.DecodeString(ctx.String("data"))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SendOnionMessage(
+		ctxc,
+		&lnrpc.SendOnionMessageRequest{
+			Destination: dest,
+			FinalHopTlvs: map[uint64][]byte{
+				ctx.Uint64("type"): data,
+			},
+			ReplyPath: ctx.Bool("reply_path"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var subscribeOnionCommand = cli.Command{
+	Name:     "subscribeonion",
+	Category: "Peers",
+	Usage:    "Subscribe to onion messages sent to our node.",
+	Action:   actionDecorator(subscribeOnion),
+}
+
+func subscribeOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeOnionMessages(
+		ctxc,
+		&lnrpc.SubscribeOnionMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
@@ -210,6 +210,26 @@ var openChannelCommand = cli.Command{
 				"propose to the remote peer (%q, %q)",
 				channelTypeTweakless, channelTypeAnchors),
 		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) whether a zero-conf channel open " +
+				"should be attempted, which can be used right " +
+				"away before the funding transaction confirms",
+		},
+		cli.BoolFlag{
+			Name: "scid_alias",
+			Usage: "(optional) whether an option-scid-alias " +
+				"channel should be opened, which is only " +
+				"referred to by an alias to the remote peer " +
+				"(requires --private)",
+		},
+		cli.BoolFlag{
+			Name: "dual_fund",
+			Usage: "(optional) whether the channel should be " +
+				"opened with the dual funding protocol, " +
+				"which allows the remote peer to contribute " +
+				"funds to the channel as well",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -316,6 +336,9 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.ZeroConf = ctx.Bool("zero_conf")
+	req.ScidAlias = ctx.Bool("scid_alias")
+	req.DualFund = ctx.Bool("dual_fund")
 
 	// Parse the channel type and map it to its RPC representation.
 	channelType := ctx.String("channel_type")
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/brsuite/broln/bolt12"
 	"github.com/brsuite/broln/chainreg"
 	"github.com/brsuite/broln/lnrpc"
 	"github.com/brsuite/broln/lnrpc/routerrpc"
@@ -239,6 +240,26 @@ func confirmPayReq(resp *lnrpc.PayReq, amt, feeLimit int64) error {
 	return nil
 }
 
+func confirmOffer(offer *bolt12.Offer, amt, feeLimit int64) error {
+	fmt.Printf("Description: %v\n", offer.Description)
+	if offer.Issuer != "" {
+		fmt.Printf("Issuer: %v\n", offer.Issuer)
+	}
+	fmt.Printf("Amount (in broneess): %v\n", amt)
+	fmt.Printf("Fee limit (in broneess): %v\n", feeLimit)
+	if offer.IssuerID != nil {
+		fmt.Printf("Issuer id: %x\n",
+			offer.IssuerID.SerializeCompressed())
+	}
+
+	confirm := promptForConfirmation("Confirm payment (yes/no): ")
+	if !confirm {
+		return fmt.Errorf("payment not confirmed")
+	}
+
+	return nil
+}
+
 func parsePayAddr(ctx *cli.Context) ([]byte, error) {
 	var (
 		payAddr []byte
@@ -484,7 +505,8 @@ func sendPaymentRequest(ctx *cli.Context,
 	}
 
 	var feeLimit int64
-	if req.PaymentRequest != "" {
+	switch {
+	case req.PaymentRequest != "":
 		// Decode payment request to find out the amount.
 		decodeReq := &lnrpc.PayReqString{PayReq: req.PaymentRequest}
 		decodeResp, err := client.DecodePayReq(ctxc, decodeReq)
@@ -514,7 +536,39 @@ func sendPaymentRequest(ctx *cli.Context,
 				return err
 			}
 		}
-	} else {
+
+	case req.Offer != "":
+		offer, err := bolt12.DecodeOffer(req.Offer)
+		if err != nil {
+			return err
+		}
+
+		// Unless the amount is set explicitly, the payment is for the
+		// amount of the offer times the quantity.
+		amt := req.Amt
+		if amt == 0 && offer.Currency == "" {
+			quantity := req.OfferQuantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			amt = int64(lnwire.MilliBronees(
+				offer.Amount * quantity,
+			).ToBroneess())
+		}
+
+		feeLimit, err = retrieveFeeLimit(ctx, amt)
+		if err != nil {
+			return err
+		}
+
+		if !ctx.Bool("force") {
+			err := confirmOffer(offer, amt, feeLimit)
+			if err != nil {
+				return err
+			}
+		}
+
+	default:
 		var err error
 		feeLimit, err = retrieveFeeLimit(ctx, req.Amt)
 		if err != nil {
@@ -826,6 +880,62 @@ func payInvoice(ctx *cli.Context) error {
 	return sendPaymentRequest(ctx, req)
 }
 
+var payOfferCommand = cli.Command{
+	Name:     "payoffer",
+	Category: "Payments",
+	Usage:    "Pay a BOLT12 offer over lightning.",
+	Description: `
+	Fetches an invoice for the offer from its issuer over onion messages
+	and pays it. This requires onion messages and route blinding to be
+	enabled.`,
+	ArgsUsage: "offer",
+	Flags: append(paymentFlags(),
+		cli.StringFlag{
+			Name:  "offer",
+			Usage: "the bech32 encoded offer to pay",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "(optional) number of broneess to pay, " +
+				"required if the offer has no amount",
+		},
+		cli.Uint64Flag{
+			Name: "quantity",
+			Usage: "(optional) the number of items to pay for, " +
+				"if the offer allows more than one",
+		},
+		cli.StringFlag{
+			Name:  "payer_note",
+			Usage: "(optional) a note for the issuer of the offer",
+		},
+	),
+	Action: actionDecorator(payOffer),
+}
+
+func payOffer(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	var offer string
+	switch {
+	case ctx.IsSet("offer"):
+		offer = ctx.String("offer")
+	case args.Present():
+		offer = args.First()
+	default:
+		return fmt.Errorf("offer argument missing")
+	}
+
+	req := &routerrpc.SendPaymentRequest{
+		Offer:             offer,
+		OfferQuantity:     ctx.Uint64("quantity"),
+		PayerNote:         ctx.String("payer_note"),
+		Amt:               ctx.Int64("amt"),
+		DestCustomRecords: make(map[uint64][]byte),
+	}
+
+	return sendPaymentRequest(ctx, req)
+}
+
 var sendToRouteCommand = cli.Command{
 	Name:     "sendtoroute",
 	Category: "Payments",
@@ -1019,6 +1129,11 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) the channel id of the channel " +
 				"that must be taken to the first hop",
 		},
+		cli.UintFlag{
+			Name: "num_routes",
+			Usage: "(optional) the maximum number of alternative " +
+				"routes to return, in order of increasing cost",
+		},
 		cltvLimitFlag,
 	},
 	Action: actionDecorator(queryRoutes),
@@ -1072,6 +1187,7 @@ func queryRoutes(ctx *cli.Context) error {
 		UseMissionControl: ctx.Bool("use_mc"),
 		CltvLimit:         uint32(ctx.Uint64(cltvLimitFlag.Name)),
 		OutgoingChanId:    ctx.Uint64("outgoing_chanid"),
+		NumRoutes:         uint32(ctx.Uint("num_routes")),
 	}
 
 	route, err := client.QueryRoutes(ctxc, req)
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+
+	"github.com/brsuite/broln/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var proberStatusCommand = cli.Command{
+	Name:     "proberstatus",
+	Category: "Mission Control",
+	Usage:    "Display the state of the prober.",
+	Description: `
+	Returns whether the prober is active, its budget, the nodes that it
+	probes and the results of the most recent probes.
+	`,
+	Action: actionDecorator(proberStatus),
+}
+
+func proberStatus(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	resp, err := client.ProberStatus(ctxc, &routerrpc.ProberStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var updateProberCommand = cli.Command{
+	Name:     "updateprober",
+	Category: "Mission Control",
+	Usage:    "Start or stop the prober and update its budget.",
+	ArgsUsage: "[--start | --stop] [--interval] [--amt_msat] " +
+		"[--max_in_flight_msat]",
+	Description: `
+	The prober sends probe payments with a random payment hash to discover
+	the liquidity of channels. Probes never settle, and their outcome is
+	recorded by mission control. Budget values that aren't set keep their
+	current value.
+	`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "start",
+			Usage: "start sending probes",
+		},
+		cli.BoolFlag{
+			Name: "stop",
+			Usage: "stop sending probes, probes that are in " +
+				"flight aren't affected",
+		},
+		cli.DurationFlag{
+			Name:  "interval",
+			Usage: "the time between two probes",
+		},
+		cli.Uint64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount of a probe in msat",
+		},
+		cli.Uint64Flag{
+			Name: "max_in_flight_msat",
+			Usage: "the maximum total amount in msat of the " +
+				"probes that are in flight at the same time",
+		},
+	},
+	Action: actionDecorator(updateProber),
+}
+
+func updateProber(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "updateprober")
+		return nil
+	}
+
+	req := &routerrpc.UpdateProberRequest{
+		IntervalSeconds: uint32(ctx.Duration("interval").Seconds()),
+		AmtMsat:         ctx.Uint64("amt_msat"),
+		MaxInFlightMsat: ctx.Uint64("max_in_flight_msat"),
+	}
+
+	switch {
+	case ctx.Bool("start") && ctx.Bool("stop"):
+		return errors.New("start and stop can't be combined")
+
+	case ctx.Bool("start"):
+		req.Action = routerrpc.ProberAction_START
+
+	case ctx.Bool("stop"):
+		req.Action = routerrpc.ProberAction_STOP
+	}
+
+	if ctx.IsSet("interval") && req.IntervalSeconds == 0 {
+		return errors.New("interval must be at least one second")
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	_, err := client.UpdateProber(ctxc, req)
+	return err
+}
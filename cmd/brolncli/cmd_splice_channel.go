@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var spliceChannelCommand = cli.Command{
+	Name:     "splicechannel",
+	Category: "Channels",
+	Usage:    "Splice funds into or out of an existing channel.",
+	Description: `
+	Splice funds into or out of an existing channel, which requires the
+	remote peer to support splicing.
+
+	With --amt, the amount is added to the channel from the wallet, or
+	removed from the channel to a new wallet address if it is negative,
+	for example --amt=-100000. The fees of the splice transaction are paid
+	on top of the amount.
+
+	Alternatively, --psbt specifies a base64 encoded PSBT whose wallet
+	inputs are spent by the splice transaction and whose outputs are added
+	to it. The difference between them, minus the fees, is added to the
+	channel.
+
+	The command returns once the splice transaction is signed by both
+	parties and broadcast. The channel can't be used until the splice
+	transaction is confirmed and locked by both parties, after which its
+	channel_point is the output of the splice transaction.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of " +
+				"the funding transaction",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the number of broneess to add to the " +
+				"channel, or to remove from it if negative",
+		},
+		cli.StringFlag{
+			Name: "psbt",
+			Usage: "a base64 encoded PSBT with the wallet inputs " +
+				"and outputs to add to the splice transaction",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the transaction",
+		},
+	},
+	Action: actionDecorator(spliceChannel),
+}
+
+func spliceChannel(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "splicechannel")
+		return nil
+	}
+
+	if _, err := checkNotBothSet(ctx, "amt", "psbt"); err != nil {
+		return err
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	}
+
+	if ctx.IsSet("psbt") {
+		req.Psbt, err = base64.StdEncoding.DecodeString(
+			ctx.String("psbt"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode PSBT: %v", err)
+		}
+	}
+
+	resp, err := client.SpliceChannel(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -1426,6 +1426,31 @@ func listChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var listAliasesCommand = cli.Command{
+	Name:     "listaliases",
+	Category: "Channels",
+	Usage:    "List all aliases.",
+	Flags:    []cli.Flag{},
+	Action:   actionDecorator(listAliases),
+}
+
+func listAliases(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListAliasesRequest{}
+
+	resp, err := client.ListAliases(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var closedChannelsCommand = cli.Command{
 	Name:     "closedchannels",
 	Category: "Channels",
@@ -1981,6 +2006,23 @@ var updateChannelPolicyCommand = cli.Command{
 				"to all forwarded HTLCs. If unset, the max HTLC " +
 				"is left unchanged.",
 		},
+		cli.Int64Flag{
+			Name: "inbound_base_fee_msat",
+			Usage: "if set, the base fee in milli-broneess that " +
+				"will be charged for each HTLC arriving over " +
+				"the channel. A negative value is a " +
+				"discount. If unset, the inbound fee is left " +
+				"unchanged.",
+		},
+		cli.Int64Flag{
+			Name: "inbound_fee_rate_ppm",
+			Usage: "if set, the fee rate ppm (parts per million) " +
+				"that will be charged proportionally for " +
+				"each HTLC arriving over the channel. A " +
+				"negative " +
+				"value is a discount. If unset, the inbound " +
+				"fee is left unchanged.",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -2106,6 +2148,29 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		req.MinHtlcMsatSpecified = true
 	}
 
+	if ctx.IsSet("inbound_base_fee_msat") ||
+		ctx.IsSet("inbound_fee_rate_ppm") {
+
+		inboundBaseFee := ctx.Int64("inbound_base_fee_msat")
+		if inboundBaseFee < math.MinInt32 ||
+			inboundBaseFee > math.MaxInt32 {
+
+			return fmt.Errorf("inbound_base_fee_msat out of range")
+		}
+
+		inboundFeeRate := ctx.Int64("inbound_fee_rate_ppm")
+		if inboundFeeRate < math.MinInt32 ||
+			inboundFeeRate > math.MaxInt32 {
+
+			return fmt.Errorf("inbound_fee_rate_ppm out of range")
+		}
+
+		req.InboundFee = &lnrpc.InboundFee{
+			BaseFeeMsat: int32(inboundBaseFee),
+			FeeRatePpm:  int32(inboundFeeRate),
+		}
+	}
+
 	if chanPoint != nil {
 		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{
 			ChanPoint: chanPoint,
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"strconv"
+	"time"
 
 	"github.com/brsuite/broln/lnrpc/invoicesrpc"
 	"github.com/urfave/cli"
@@ -19,6 +20,11 @@ func invoicesCommands() []cli.Command {
 		cancelInvoiceCommand,
 		addHoldInvoiceCommand,
 		settleInvoiceCommand,
+		deleteCanceledInvoiceCommand,
+		deleteInvoicesCommand,
+		addOfferCommand,
+		listOffersCommand,
+		disableOfferCommand,
 	}
 }
 
@@ -253,3 +259,307 @@ func addHoldInvoice(ctx *cli.Context) error {
 
 	return nil
 }
+
+var deleteCanceledInvoiceCommand = cli.Command{
+	Name:     "deletecanceledinvoice",
+	Category: "Invoices",
+	Usage:    "Deletes a canceled invoice from the database.",
+	Description: `
+	Deletes the canceled invoice with the given payment hash from the
+	database. Invoices that aren't canceled can't be deleted this way.`,
+	ArgsUsage: "paymenthash",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "paymenthash",
+			Usage: "the hex-encoded payment hash (32 byte) " +
+				"of the canceled invoice to delete.",
+		},
+	},
+	Action: actionDecorator(deleteCanceledInvoice),
+}
+
+func deleteCanceledInvoice(ctx *cli.Context) error {
+	var (
+		paymentHash []byte
+		err         error
+	)
+
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("paymenthash"):
+		paymentHash, err = hex.DecodeString(ctx.String("paymenthash"))
+	case args.Present():
+		paymentHash, err = hex.DecodeString(args.First())
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to parse payment hash: %v", err)
+	}
+
+	req := &invoicesrpc.DeleteCanceledInvoiceMsg{
+		PaymentHash: paymentHash,
+	}
+
+	resp, err := client.DeleteCanceledInvoice(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteInvoicesCommand = cli.Command{
+	Name:     "deleteinvoices",
+	Category: "Invoices",
+	Usage:    "Deletes canceled and settled invoices from the database.",
+	Description: `
+	Deletes all canceled and/or settled invoices that match the given
+	filters from the database. Settled invoices are appended to the invoice
+	archive file before they are deleted. Open and accepted invoices are
+	never deleted.
+
+	Example: delete canceled invoices that were created more than 30 days
+	ago:
+
+	    brolncli deleteinvoices --canceled --canceled_age=720h`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "canceled",
+			Usage: "delete canceled invoices",
+		},
+		cli.DurationFlag{
+			Name: "canceled_age",
+			Usage: "if set, only canceled invoices that were " +
+				"created longer ago than this duration are " +
+				"deleted",
+		},
+		cli.BoolFlag{
+			Name:  "settled",
+			Usage: "archive and delete settled invoices",
+		},
+		cli.DurationFlag{
+			Name: "settled_age",
+			Usage: "if set, only settled invoices that were " +
+				"settled longer ago than this duration are " +
+				"archived and deleted",
+		},
+	},
+	Action: actionDecorator(deleteInvoices),
+}
+
+func deleteInvoices(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	now := time.Now()
+	req := &invoicesrpc.DeleteInvoicesMsg{
+		Canceled: ctx.Bool("canceled"),
+		Settled:  ctx.Bool("settled"),
+	}
+	if ctx.IsSet("canceled_age") {
+		req.CanceledCreatedBefore = now.Add(
+			-ctx.Duration("canceled_age"),
+		).Unix()
+	}
+	if ctx.IsSet("settled_age") {
+		req.SettledBefore = now.Add(-ctx.Duration("settled_age")).Unix()
+	}
+
+	resp, err := client.DeleteInvoices(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var addOfferCommand = cli.Command{
+	Name:     "addoffer",
+	Category: "Invoices",
+	Usage:    "Add a new BOLT12 offer.",
+	Description: `
+	Creates a reusable BOLT12 offer. Payers fetch an invoice for the offer
+	from this node over onion messages each time they pay it, which
+	requires the node to run with onion messages and route blinding
+	enabled.`,
+	ArgsUsage: "description [amt]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "a description of what the offer is for",
+		},
+		cli.Uint64Flag{
+			Name: "amt_msat",
+			Usage: "the amount in millibronees that is asked " +
+				"for per item, if not set the payer chooses " +
+				"the amount",
+		},
+		cli.StringFlag{
+			Name: "issuer",
+			Usage: "a human readable name of the issuer of " +
+				"the offer",
+		},
+		cli.Uint64Flag{
+			Name: "quantity_max",
+			Usage: "the maximum number of items that can be " +
+				"requested in a single invoice",
+		},
+		cli.BoolFlag{
+			Name: "unlimited_quantity",
+			Usage: "allow any number of items to be requested " +
+				"in a single invoice",
+		},
+		cli.DurationFlag{
+			Name: "expiry",
+			Usage: "if set, the offer expires after this " +
+				"duration",
+		},
+	},
+	Action: actionDecorator(addOffer),
+}
+
+func addOffer(ctx *cli.Context) error {
+	var (
+		description string
+		amtMsat     uint64
+		err         error
+	)
+
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("description"):
+		description = ctx.String("description")
+	case args.Present():
+		description = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("description argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt_msat"):
+		amtMsat = ctx.Uint64("amt_msat")
+	case args.Present():
+		amtMsat, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt_msat "+
+				"argument: %v", err)
+		}
+	}
+
+	req := &invoicesrpc.AddOfferRequest{
+		Description:       description,
+		AmountMsat:        amtMsat,
+		Issuer:            ctx.String("issuer"),
+		QuantityMax:       ctx.Uint64("quantity_max"),
+		UnlimitedQuantity: ctx.Bool("unlimited_quantity"),
+	}
+	if ctx.IsSet("expiry") {
+		req.AbsoluteExpiry = time.Now().Add(
+			ctx.Duration("expiry"),
+		).Unix()
+	}
+
+	resp, err := client.AddOffer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listOffersCommand = cli.Command{
+	Name:     "listoffers",
+	Category: "Invoices",
+	Usage:    "List all BOLT12 offers issued by this node.",
+	Action:   actionDecorator(listOffers),
+}
+
+func listOffers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListOffers(ctxc, &invoicesrpc.ListOffersRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var disableOfferCommand = cli.Command{
+	Name:     "disableoffer",
+	Category: "Invoices",
+	Usage:    "Disable a BOLT12 offer.",
+	Description: `
+	Disables the offer with the given offer id. Invoice requests for
+	disabled offers are rejected. Invoices that were already created for
+	the offer can still be paid.`,
+	ArgsUsage: "offer_id",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "offer_id",
+			Usage: "the hex-encoded id (32 byte) of the offer",
+		},
+	},
+	Action: actionDecorator(disableOffer),
+}
+
+func disableOffer(ctx *cli.Context) error {
+	var (
+		offerID []byte
+		err     error
+	)
+
+	ctxc := getContext()
+	client, cleanUp := getInvoicesClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("offer_id"):
+		offerID, err = hex.DecodeString(ctx.String("offer_id"))
+	case args.Present():
+		offerID, err = hex.DecodeString(args.First())
+	default:
+		return fmt.Errorf("offer_id argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to parse offer id: %v", err)
+	}
+
+	req := &invoicesrpc.DisableOfferRequest{
+		OfferId: offerID,
+	}
+
+	resp, err := client.DisableOffer(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
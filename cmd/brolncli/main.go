@@ -340,6 +340,7 @@ func main() {
 		openChannelCommand,
 		batchOpenChannelCommand,
 		closeChannelCommand,
+		spliceChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
 		listPeersCommand,
@@ -350,11 +351,13 @@ func main() {
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
+		payOfferCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
 		lookupInvoiceCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
+		listAliasesCommand,
 		closedChannelsCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
@@ -387,6 +390,8 @@ func main() {
 		deletePaymentsCommand,
 		sendCustomCommand,
 		subscribeCustomCommand,
+		sendOnionCommand,
+		subscribeOnionCommand,
 	}
 
 	// Add any extra commands determined by build flags.
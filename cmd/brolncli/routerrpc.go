@@ -7,11 +7,15 @@ func routerCommands() []cli.Command {
 	return []cli.Command{
 		queryMissionControlCommand,
 		importMissionControlCommand,
+		exportMissionControlCommand,
+		decodeMissionControlCommand,
 		queryProbCommand,
 		resetMissionControlCommand,
 		buildRouteCommand,
 		getCfgCommand,
 		setCfgCommand,
 		updateChanStatusCommand,
+		proberStatusCommand,
+		updateProberCommand,
 	}
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/brsuite/broln/htlcswitch"
 	"github.com/brsuite/broln/htlcswitch/hodl"
 	"github.com/brsuite/broln/input"
+	"github.com/brsuite/broln/invoices"
 	"github.com/brsuite/broln/lncfg"
 	"github.com/brsuite/broln/lnrpc"
 	"github.com/brsuite/broln/lnrpc/routerrpc"
@@ -37,6 +38,7 @@ import (
 	"github.com/brsuite/broln/routing"
 	"github.com/brsuite/broln/signal"
 	"github.com/brsuite/broln/tor"
+	"github.com/brsuite/broln/trampoline"
 	"github.com/brsuite/brond/chaincfg"
 	"github.com/brsuite/bronutil"
 	"github.com/brsuite/neutrino"
@@ -350,6 +352,10 @@ type Config struct {
 
 	RejectHTLC bool `long:"rejecthtlc" description:"If true, broln will not forward any HTLCs that are meant as onward payments. This option will still allow broln to send HTLCs and receive HTLCs but broln won't be used as a hop."`
 
+	RequireInterceptor bool `long:"requireinterceptor" description:"If true, broln will not forward any HTLCs while no HTLC interceptor is connected. Held HTLCs are replayed to the interceptor once it connects."`
+
+	InterceptorCltvRejectDelta uint32 `long:"interceptorcltvrejectdelta" description:"The number of blocks before the expiry of an incoming HTLC held by an HTLC interceptor at which the HTLC is failed back automatically."`
+
 	StaggerInitialReconnect bool `long:"stagger-initial-reconnect" description:"If true, will apply a randomized staggering between 0s and 30s when reconnecting to persistent peers on startup. The first 10 reconnections will be attempted instantly, regardless of the flag's value"`
 
 	MaxOutgoingCltvExpiry uint32 `long:"max-cltv-expiry" description:"The maximum number of blocks funds could be locked up for when forwarding payments."`
@@ -382,6 +388,8 @@ type Config struct {
 
 	Gossip *lncfg.Gossip `group:"gossip" namespace:"gossip"`
 
+	Trampoline *lncfg.Trampoline `group:"trampoline" namespace:"trampoline"`
+
 	Workers *lncfg.Workers `group:"workers" namespace:"workers"`
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
@@ -581,6 +589,13 @@ func DefaultConfig() Config {
 		},
 		Invoices: &lncfg.Invoices{
 			HoldExpiryDelta: lncfg.DefaultHoldInvoiceExpiryDelta,
+			GcInterval:      invoices.DefaultInvoiceGcInterval,
+		},
+		Trampoline: &lncfg.Trampoline{
+			BaseFee:    uint64(trampoline.DefaultFeeBase),
+			FeeRate:    uint64(trampoline.DefaultFeeRate),
+			CltvDelta:  trampoline.DefaultCltvDelta,
+			MppTimeout: trampoline.DefaultMppTimeout,
 		},
 		MaxOutgoingCltvExpiry:   htlcswitch.DefaultMaxOutgoingCltvExpiry,
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
@@ -598,6 +613,7 @@ func DefaultConfig() Config {
 		RemoteSigner: &lncfg.RemoteSigner{
 			Timeout: lncfg.DefaultRemoteSignerRPCTimeout,
 		},
+		InterceptorCltvRejectDelta: lncfg.DefaultInterceptorCltvRejectDelta,
 	}
 }
 
@@ -826,6 +842,9 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 	cfg.WalletUnlockPasswordFile = CleanAndExpandPath(
 		cfg.WalletUnlockPasswordFile,
 	)
+	cfg.SubRPCServers.RouterRPC.NodeTagsFile = CleanAndExpandPath(
+		cfg.SubRPCServers.RouterRPC.NodeTagsFile,
+	)
 
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
@@ -901,6 +920,22 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		)
 	}
 
+	// Zero-conf channels can only be referred to by an alias before they
+	// confirm, so the option-scid-alias feature must also be enabled.
+	if cfg.ProtocolOptions.ZeroConf() && !cfg.ProtocolOptions.ScidAlias() {
+		return nil, mkErr("protocol.zero-conf requires " +
+			"protocol.option-scid-alias to be set")
+	}
+
+	// Onion messages are routed over blinded paths, so the route-blinding
+	// feature must also be enabled.
+	if cfg.ProtocolOptions.OnionMessages() &&
+		!cfg.ProtocolOptions.RouteBlinding() {
+
+		return nil, mkErr("protocol.onion-messages requires " +
+			"protocol.route-blinding to be set")
+	}
+
 	// Ensure a valid max channel fee allocation was set.
 	if cfg.MaxChannelFeeAllocation <= 0 || cfg.MaxChannelFeeAllocation > 1 {
 		return nil, mkErr("invalid max channel fee allocation: %v, "+
@@ -1567,6 +1602,19 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 			lncfg.DefaultIncomingBroadcastDelta)
 	}
 
+	// Settled invoices are archived next to the graph database unless
+	// another file is specified.
+	if cfg.Invoices.ArchiveFile == "" {
+		cfg.Invoices.ArchiveFile = filepath.Join(
+			cfg.graphDatabaseDir(),
+			lncfg.DefaultInvoiceArchiveFilename,
+		)
+	} else {
+		cfg.Invoices.ArchiveFile = CleanAndExpandPath(
+			cfg.Invoices.ArchiveFile,
+		)
+	}
+
 	// Validate the subconfigs for workers, caches, and the tower client.
 	err = lncfg.Validate(
 		cfg.Workers,
@@ -1577,6 +1625,8 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.HealthChecks,
 		cfg.RPCMiddleware,
 		cfg.RemoteSigner,
+		cfg.Trampoline,
+		cfg.Invoices,
 	)
 	if err != nil {
 		return nil, err
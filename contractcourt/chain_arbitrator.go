@@ -165,6 +165,13 @@ type ChainArbitratorConfig struct {
 	// resolved (which includes sweeping any time locked funds).
 	NotifyFullyResolvedChannel func(point wire.OutPoint)
 
+	// NotifySplicedChannel is a function closure that the ChainArbitrator
+	// will use to notify the rest of the daemon that a splice of a channel
+	// has been promoted to its funding, which moved the channel from the
+	// passed outpoint to a new one.
+	NotifySplicedChannel func(oldChanPoint wire.OutPoint,
+		channel *channeldb.OpenChannel)
+
 	// OnionProcessor is used to decode onion payloads for on-chain
 	// resolution.
 	OnionProcessor OnionProcessor
@@ -522,6 +529,15 @@ func (c *ChainArbitrator) Start() error {
 				isOurAddr:           c.cfg.IsOurAddress,
 				contractBreach:      breachClosure,
 				extractStateNumHint: lnwallet.GetStateNumHint,
+				promoteSplice: func(
+					splice *channeldb.ChannelSplice,
+					openLoc lnwire.ShortChannelID) (
+					*chainWatcher, error) {
+
+					return c.promoteSplice(
+						channel, splice, openLoc,
+					)
+				},
 			},
 		)
 		if err != nil {
@@ -1126,6 +1142,12 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 				)
 			},
 			extractStateNumHint: lnwallet.GetStateNumHint,
+			promoteSplice: func(splice *channeldb.ChannelSplice,
+				openLoc lnwire.ShortChannelID) (*chainWatcher,
+				error) {
+
+				return c.promoteSplice(newChan, splice, openLoc)
+			},
 		},
 	)
 	if err != nil {
@@ -1154,6 +1176,70 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 	return chainWatcher.Start()
 }
 
+// promoteSplice makes the splice of a channel the funding of the channel once
+// its transaction has confirmed. As the arbitrator and chain watcher of a
+// channel are tied to its funding outpoint, the current ones are replaced with
+// ones at the new funding outpoint, whose chain watcher is returned.
+//
+// NOTE: This is called by the chain watcher of the current funding outpoint,
+// which exits afterwards.
+func (c *ChainArbitrator) promoteSplice(channel *channeldb.OpenChannel,
+	splice *channeldb.ChannelSplice,
+	openLoc lnwire.ShortChannelID) (*chainWatcher, error) {
+
+	oldChanPoint := channel.FundingOutpoint
+	err := channel.PromoteSplice(splice.FundingOutpoint, openLoc)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Moving ChannelArbitrator for ChannelPoint(%v) to "+
+		"ChannelPoint(%v) after splice", oldChanPoint,
+		splice.FundingOutpoint)
+
+	// The arbitrator of the current funding outpoint is stopped, and its
+	// log is wiped, as there's nothing to resolve for the funding output
+	// that has been spent by the splice.
+	c.Lock()
+	channelArb := c.activeChannels[oldChanPoint]
+	delete(c.activeChannels, oldChanPoint)
+	delete(c.activeWatchers, oldChanPoint)
+	c.Unlock()
+
+	if channelArb != nil {
+		if err := channelArb.Stop(); err != nil {
+			log.Warnf("unable to stop ChannelArbitrator(%v): %v",
+				oldChanPoint, err)
+		}
+		if channelArb.cfg.ChainEvents.Cancel != nil {
+			channelArb.cfg.ChainEvents.Cancel()
+		}
+		if err := channelArb.log.WipeHistory(); err != nil {
+			return nil, err
+		}
+	}
+
+	newChan, err := c.chanSource.ChannelStateDB().FetchChannel(
+		nil, splice.FundingOutpoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.WatchNewChannel(newChan); err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	chainWatcher := c.activeWatchers[splice.FundingOutpoint]
+	c.Unlock()
+
+	if c.cfg.NotifySplicedChannel != nil {
+		c.cfg.NotifySplicedChannel(oldChanPoint, newChan)
+	}
+
+	return chainWatcher, nil
+}
+
 // SubscribeChannelEvents returns a new active subscription for the set of
 // possible on-chain events for a particular channel. The struct can be used by
 // callers to be notified whenever an event that changes the state of the
@@ -11,6 +11,7 @@ import (
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/input"
 	"github.com/brsuite/broln/lnwallet"
+	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg"
 	"github.com/brsuite/brond/chaincfg/chainhash"
@@ -124,6 +125,21 @@ func (c *CommitSet) toActiveHTLCSets() map[HtlcSetKey]htlcSet {
 	return htlcSets
 }
 
+// SpliceConfirmedInfo encapsulates the information about a splice of a
+// channel whose transaction has confirmed with sufficient depth, after which
+// the splice became the funding of the channel.
+type SpliceConfirmedInfo struct {
+	// FundingOutpoint is the new funding outpoint of the channel.
+	FundingOutpoint wire.OutPoint
+
+	// ShortChanID is the new short channel ID of the channel.
+	ShortChanID lnwire.ShortChannelID
+
+	// ChainEvents is a subscription to the on-chain events of the channel
+	// at its new funding outpoint, which replaces the current one.
+	ChainEvents *ChainEventSubscription
+}
+
 // ChainEventSubscription is a struct that houses a subscription to be notified
 // for any on-chain events related to a channel. There are three types of
 // possible on-chain events: a cooperative channel closure, a unilateral
@@ -150,6 +166,11 @@ type ChainEventSubscription struct {
 	// material required to bring the cheating channel peer to justice.
 	ContractBreach chan *BreachCloseInfo
 
+	// SpliceConfirmed is a channel that will be sent upon once a splice
+	// transaction of the channel has confirmed with sufficient depth. No
+	// further events are sent on this subscription afterwards.
+	SpliceConfirmed chan *SpliceConfirmedInfo
+
 	// Cancel cancels the subscription to the event stream for a particular
 	// channel. This method should be called once the caller no longer needs to
 	// be notified of any on-chain events for a particular channel.
@@ -187,6 +208,13 @@ type chainWatcherConfig struct {
 	// obfuscater. This is used by the chain watcher to identify which
 	// state was broadcast and confirmed on-chain.
 	extractStateNumHint func(*wire.MsgTx, [lnwallet.StateHintSize]byte) uint64
+
+	// promoteSplice is called once a splice transaction of the channel has
+	// confirmed with sufficient depth. It makes the splice the funding of
+	// the channel, and returns the chain watcher of the channel at its new
+	// funding outpoint.
+	promoteSplice func(*channeldb.ChannelSplice,
+		lnwire.ShortChannelID) (*chainWatcher, error)
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -333,6 +361,7 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *BreachCloseInfo, 1),
+		SpliceConfirmed:         make(chan *SpliceConfirmedInfo, 1),
 		Cancel: func() {
 			c.Lock()
 			delete(c.clientSubscriptions, clientID)
@@ -567,6 +596,23 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 			return
 		}
 
+		// A spend by the transaction of a pending splice doesn't close
+		// the channel, but moves it to the new funding output.
+		splice, err := c.pendingSplice(*commitSpend.SpenderTxHash)
+		if err != nil {
+			log.Errorf("Unable to fetch pending splices: %v", err)
+			return
+		}
+		if splice != nil {
+			err := c.dispatchSpliceConfirmed(
+				splice, uint32(commitSpend.SpendingHeight),
+			)
+			if err != nil {
+				log.Errorf("Unable to handle splice: %v", err)
+			}
+			return
+		}
+
 		// Otherwise, the remote party might have broadcast a prior
 		// revoked state...!!!
 		commitTxBroadcast := commitSpend.SpendingTx
@@ -985,6 +1031,98 @@ func (c *chainWatcher) dispatchCooperativeClose(commitSpend *chainntnfs.SpendDet
 	return nil
 }
 
+// pendingSplice returns the pending splice of the channel with the given
+// transaction, if any. The splices are read from the database, as they're
+// added while the channel is watched.
+func (c *chainWatcher) pendingSplice(
+	txid chainhash.Hash) (*channeldb.ChannelSplice, error) {
+
+	chanState, err := c.cfg.chanState.Db.FetchChannel(
+		nil, c.cfg.chanState.FundingOutpoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, splice := range chanState.PendingSplices() {
+		if splice.FundingOutpoint.Hash == txid {
+			return splice, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// dispatchSpliceConfirmed waits for the transaction of a splice that spent
+// the funding output to reach the confirmation depth required for the
+// channel, after which the splice is promoted to the funding of the channel.
+// We'll then dispatch a notification to all subscribers, which carries a
+// subscription to the chain watcher of the new funding outpoint.
+func (c *chainWatcher) dispatchSpliceConfirmed(
+	splice *channeldb.ChannelSplice, heightHint uint32) error {
+
+	chanPoint := c.cfg.chanState.FundingOutpoint
+	log.Infof("Splice %v of ChannelPoint(%v) confirmed",
+		splice.FundingOutpoint, chanPoint)
+
+	numConfs := uint32(c.cfg.chanState.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
+
+	fundingOutput := splice.SpliceTx.TxOut[splice.FundingOutpoint.Index]
+	confNtfn, err := c.cfg.notifier.RegisterConfirmationsNtfn(
+		&splice.FundingOutpoint.Hash, fundingOutput.PkScript, numConfs,
+		heightHint,
+	)
+	if err != nil {
+		return err
+	}
+	defer confNtfn.Cancel()
+
+	var conf *chainntnfs.TxConfirmation
+	select {
+	case txConf, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return fmt.Errorf("confirmation notification of "+
+				"splice %v closed", splice.FundingOutpoint)
+		}
+		conf = txConf
+
+	case <-c.quit:
+		return fmt.Errorf("exiting")
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: conf.BlockHeight,
+		TxIndex:     conf.TxIndex,
+		TxPosition:  uint16(splice.FundingOutpoint.Index),
+	}
+	newWatcher, err := c.cfg.promoteSplice(splice, shortChanID)
+	if err != nil {
+		return err
+	}
+
+	c.Lock()
+	for _, sub := range c.clientSubscriptions {
+		spliceInfo := &SpliceConfirmedInfo{
+			FundingOutpoint: splice.FundingOutpoint,
+			ShortChanID:     shortChanID,
+			ChainEvents:     newWatcher.SubscribeChannelEvents(),
+		}
+
+		select {
+		case sub.SpliceConfirmed <- spliceInfo:
+		case <-c.quit:
+			c.Unlock()
+			return fmt.Errorf("exiting")
+		}
+	}
+	c.Unlock()
+
+	return nil
+}
+
 // dispatchLocalForceClose processes a unilateral close by us being confirmed.
 func (c *chainWatcher) dispatchLocalForceClose(
 	commitSpend *chainntnfs.SpendDetail,
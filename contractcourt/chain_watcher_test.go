@@ -625,3 +625,142 @@ func TestChainWatcherLocalForceCloseDetect(t *testing.T) {
 		})
 	}
 }
+
+// TestChainWatcherSpliceConfirmed tests that the chain watcher doesn't treat
+// a spend by the transaction of a pending splice as a close of the channel,
+// but promotes the splice once its transaction has confirmed and hands the
+// subscribers over to the chain watcher of the new funding outpoint.
+func TestChainWatcherSpliceConfirmed(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, cleanUp, err := lnwallet.CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// The splice transaction spends the funding output into a new one of
+	// the same size, with the commitments of the channel carried over.
+	chanState := aliceChannel.State()
+	oldChanPoint := chanState.FundingOutpoint
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&oldChanPoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(chanState.Capacity), nil))
+	splice := &channeldb.ChannelSplice{
+		SpliceTx: spliceTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash: spliceTx.TxHash(),
+		},
+		Capacity:         chanState.Capacity,
+		LocalCommitment:  chanState.LocalCommitment,
+		RemoteCommitment: chanState.RemoteCommitment,
+	}
+
+	aliceNotifier := &mock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	newWatcher, err := newChainWatcher(chainWatcherConfig{
+		notifier:            aliceNotifier,
+		chanState:           chanState,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+
+	promoted := make(chan lnwire.ShortChannelID, 1)
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           chanState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+		promoteSplice: func(s *channeldb.ChannelSplice,
+			openLoc lnwire.ShortChannelID) (*chainWatcher, error) {
+
+			err := chanState.PromoteSplice(
+				s.FundingOutpoint, openLoc,
+			)
+			if err != nil {
+				return nil, err
+			}
+			promoted <- openLoc
+
+			return newWatcher, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// The splice is only persisted after the chain watcher has started.
+	if err := chanState.AddSplice(splice); err != nil {
+		t.Fatalf("unable to add splice: %v", err)
+	}
+
+	spliceTxHash := spliceTx.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint:  &oldChanPoint,
+		SpenderTxHash:  &spliceTxHash,
+		SpendingTx:     spliceTx,
+		SpendingHeight: 200,
+	}
+
+	// The splice is only promoted once its transaction has sufficient
+	// confirmations.
+	select {
+	case <-promoted:
+		t.Fatalf("splice promoted before confirmation")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	select {
+	case aliceNotifier.ConfChan <- &chainntnfs.TxConfirmation{
+		BlockHeight: 202,
+		TxIndex:     5,
+	}:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("chain watcher didn't wait for confirmation")
+	}
+
+	var spliceInfo *SpliceConfirmedInfo
+	select {
+	case spliceInfo = <-chanEvents.SpliceConfirmed:
+	case <-chanEvents.CooperativeClosure:
+		t.Fatalf("splice detected as cooperative close")
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive splice confirmed event")
+	}
+
+	expectedScid := lnwire.ShortChannelID{
+		BlockHeight: 202,
+		TxIndex:     5,
+	}
+	if <-promoted != expectedScid {
+		t.Fatalf("splice promoted with wrong short channel id")
+	}
+	if spliceInfo.ShortChanID != expectedScid {
+		t.Fatalf("expected short channel id %v, got %v",
+			expectedScid, spliceInfo.ShortChanID)
+	}
+	if spliceInfo.FundingOutpoint != splice.FundingOutpoint {
+		t.Fatalf("expected funding outpoint %v, got %v",
+			splice.FundingOutpoint, spliceInfo.FundingOutpoint)
+	}
+	if spliceInfo.ChainEvents == nil {
+		t.Fatalf("no subscription to new chain watcher")
+	}
+	if chanState.FundingOutpoint != splice.FundingOutpoint {
+		t.Fatalf("channel not moved to new funding outpoint")
+	}
+}
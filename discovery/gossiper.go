@@ -228,6 +228,11 @@ type Config struct {
 	// here?
 	AnnSigner lnwallet.MessageSigner
 
+	// GetPeerAlias returns the alias our peer assigned to the channel with
+	// the given ID, if any. Updates for private channels that are sent
+	// directly to the peer will use this alias as their short channel ID.
+	GetPeerAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
 	// NumActiveSyncers is the number of peers for which we should have
 	// active syncers with. After reaching NumActiveSyncers, any future
 	// gossip syncers will be passive.
@@ -1525,8 +1530,11 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 			remotePubKey := remotePubFromChanInfo(
 				edgeInfo.Info, chanUpdate.ChannelFlags,
 			)
+			peerUpdate := d.peerAliasUpdate(
+				edgeInfo.Info, chanUpdate,
+			)
 			err := d.reliableSender.sendMessage(
-				chanUpdate, remotePubKey,
+				peerUpdate, remotePubKey,
 			)
 			if err != nil {
 				log.Errorf("Unable to reliably send %v for "+
@@ -1565,6 +1573,42 @@ func remotePubFromChanInfo(chanInfo *channeldb.ChannelEdgeInfo,
 	return remotePubKey
 }
 
+// peerAliasUpdate returns the ChannelUpdate that should be sent directly to
+// the counterparty of the given private channel. If the peer assigned an alias
+// to the channel, which is the case for zero-conf and option-scid-alias
+// channels, a copy of the update re-signed under that alias is returned, as
+// that's the only short channel ID the peer knows the channel by. Otherwise,
+// the update is returned unmodified.
+func (d *AuthenticatedGossiper) peerAliasUpdate(
+	chanInfo *channeldb.ChannelEdgeInfo,
+	update *lnwire.ChannelUpdate) *lnwire.ChannelUpdate {
+
+	if d.cfg.GetPeerAlias == nil {
+		return update
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&chanInfo.ChannelPoint)
+	peerAlias, err := d.cfg.GetPeerAlias(chanID)
+	if err != nil || peerAlias == update.ShortChannelID {
+		return update
+	}
+
+	aliasUpdate := *update
+	err = netann.SignChannelUpdate(
+		d.cfg.AnnSigner, d.selfKeyLoc, &aliasUpdate,
+		netann.ChanUpdSetScid(peerAlias),
+	)
+	if err != nil {
+		log.Errorf("Unable to sign %v for channel=%v under peer "+
+			"alias=%v: %v", update.MsgType(),
+			update.ShortChannelID, peerAlias, err)
+
+		return update
+	}
+
+	return &aliasUpdate
+}
+
 // processRejectedEdge examines a rejected edge to see if we can extract any
 // new announcements from it.  An edge will get rejected if we already added
 // the same edge without AuthProof to the graph. If the received announcement
@@ -2348,8 +2392,12 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			// Now, we'll attempt to send the channel update message
 			// reliably to the remote peer in the background, so
 			// that we don't block if the peer happens to be offline
-			// at the moment.
-			err := d.reliableSender.sendMessage(msg, remotePubKey)
+			// at the moment. If our peer assigned an alias to the
+			// channel, the update must refer to it instead.
+			peerUpdate := d.peerAliasUpdate(chanInfo, msg)
+			err := d.reliableSender.sendMessage(
+				peerUpdate, remotePubKey,
+			)
 			if err != nil {
 				err := fmt.Errorf("unable to reliably send %v "+
 					"for channel=%v to peer=%x: %v",
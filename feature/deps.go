@@ -69,6 +69,24 @@ var deps = depDesc{
 		lnwire.ExplicitChannelTypeOptional:  {},
 		lnwire.AnchorsZeroFeeHtlcTxOptional: {},
 	},
+	lnwire.ScidAliasOptional: {
+		lnwire.ExplicitChannelTypeOptional: {},
+	},
+	lnwire.ZeroConfOptional: {
+		lnwire.ScidAliasOptional: {},
+	},
+	lnwire.RouteBlindingOptional: {
+		lnwire.TLVOnionPayloadOptional: {},
+	},
+	lnwire.OnionMessagesOptional: {
+		lnwire.RouteBlindingOptional: {},
+	},
+	lnwire.TrampolineRoutingOptional: {
+		lnwire.MPPOptional: {},
+	},
+	lnwire.SpliceOptional: {
+		lnwire.QuiescenceOptional: {},
+	},
 }
 
 // ValidateDeps asserts that a feature vector sets all features and their
@@ -27,6 +27,35 @@ type Config struct {
 	// NoScriptEnforcementLease unsets any bits signaling support for script
 	// enforced leases.
 	NoScriptEnforcementLease bool
+
+	// NoOptionScidAlias unsets any bits signalling support for
+	// option_scid_alias. This also implicitly disables zero-conf channels.
+	NoOptionScidAlias bool
+
+	// NoZeroConf unsets any bits signalling support for zero-conf
+	// channels. This should be used instead of NoOptionScidAlias to still
+	// keep option-scid-alias support.
+	NoZeroConf bool
+
+	// NoRouteBlinding unsets any bits signalling support for forwarding
+	// payments within blinded routes.
+	NoRouteBlinding bool
+
+	// NoOnionMessages unsets any bits signalling support for forwarding
+	// onion messages.
+	NoOnionMessages bool
+
+	// NoTrampolineRouting unsets any bits signalling support for
+	// forwarding trampoline payments.
+	NoTrampolineRouting bool
+
+	// NoDualFund unsets any bits signalling support for opening dual
+	// funded channels.
+	NoDualFund bool
+
+	// NoSplice unsets any bits signalling support for splicing active
+	// channels.
+	NoSplice bool
 }
 
 // Manager is responsible for generating feature vectors for different requested
@@ -83,6 +112,12 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.MPPRequired)
 			raw.Unset(lnwire.AMPOptional)
 			raw.Unset(lnwire.AMPRequired)
+			raw.Unset(lnwire.RouteBlindingOptional)
+			raw.Unset(lnwire.RouteBlindingRequired)
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
 		}
 		if cfg.NoStaticRemoteKey {
 			raw.Unset(lnwire.StaticRemoteKeyOptional)
@@ -115,6 +150,44 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.ScriptEnforcedLeaseOptional)
 			raw.Unset(lnwire.ScriptEnforcedLeaseRequired)
 		}
+		if cfg.NoOptionScidAlias {
+			raw.Unset(lnwire.ScidAliasOptional)
+			raw.Unset(lnwire.ScidAliasRequired)
+
+			// Zero-conf channels rely on aliases to be usable
+			// before confirmation, so they're disabled as well.
+			raw.Unset(lnwire.ZeroConfOptional)
+			raw.Unset(lnwire.ZeroConfRequired)
+		}
+		if cfg.NoZeroConf {
+			raw.Unset(lnwire.ZeroConfOptional)
+			raw.Unset(lnwire.ZeroConfRequired)
+		}
+		if cfg.NoRouteBlinding {
+			raw.Unset(lnwire.RouteBlindingOptional)
+			raw.Unset(lnwire.RouteBlindingRequired)
+
+			// Onion messages are routed over blinded paths, so
+			// they're disabled as well.
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
+		if cfg.NoOnionMessages {
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
+		if cfg.NoTrampolineRouting {
+			raw.Unset(lnwire.TrampolineRoutingOptional)
+			raw.Unset(lnwire.TrampolineRoutingRequired)
+		}
+		if cfg.NoDualFund {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+		}
+		if cfg.NoSplice {
+			raw.Unset(lnwire.SpliceOptional)
+			raw.Unset(lnwire.SpliceRequired)
+		}
 
 		// Ensure that all of our feature sets properly set any
 		// dependent features.
@@ -61,6 +61,23 @@ func explicitNegotiateCommitmentType(channelType lnwire.ChannelType,
 	local, remote *lnwire.FeatureVector) (lnwallet.CommitmentType, error) {
 
 	channelFeatures := lnwire.RawFeatureVector(channelType)
+	channelFeatures = *channelFeatures.Clone()
+
+	// The scid-alias and zero-conf bits don't influence the commitment
+	// format, so after making sure both peers understand them, they're
+	// stripped before matching the remaining bits below.
+	if channelFeatures.IsSet(lnwire.ScidAliasRequired) {
+		if !hasFeatures(local, remote, lnwire.ScidAliasOptional) {
+			return 0, errUnsupportedChannelType
+		}
+		channelFeatures.Unset(lnwire.ScidAliasRequired)
+	}
+	if channelFeatures.IsSet(lnwire.ZeroConfRequired) {
+		if !hasFeatures(local, remote, lnwire.ZeroConfOptional) {
+			return 0, errUnsupportedChannelType
+		}
+		channelFeatures.Unset(lnwire.ZeroConfRequired)
+	}
 
 	switch {
 	// Lease script enforcement + anchors zero fee + static remote key
@@ -158,3 +175,18 @@ func hasFeatures(local, remote *lnwire.FeatureVector,
 	}
 	return true
 }
+
+// aliasChanTypeFlags returns whether the given explicit channel type asks for
+// a zero-conf channel and whether it asks for the option-scid-alias channel
+// type.
+func aliasChanTypeFlags(channelType *lnwire.ChannelType) (bool, bool) {
+	if channelType == nil {
+		return false, false
+	}
+
+	channelFeatures := lnwire.RawFeatureVector(*channelType)
+	zeroConf := channelFeatures.IsSet(lnwire.ZeroConfRequired)
+	scidAlias := channelFeatures.IsSet(lnwire.ScidAliasRequired)
+
+	return zeroConf, scidAlias
+}
@@ -0,0 +1,1074 @@
+package funding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/brsuite/broln/chainntnfs"
+	"github.com/brsuite/broln/chanacceptor"
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/broln/labels"
+	"github.com/brsuite/broln/lnpeer"
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/brsuite/broln/lnwallet"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/txscript"
+	"github.com/davecgh/go-spew/spew"
+)
+
+var (
+	// errDualFundNotSupported is returned when a dual funded channel is
+	// proposed while either party doesn't support dual funding.
+	errDualFundNotSupported = errors.New("dual funding not supported")
+)
+
+// validateDualFundRequest checks whether the channel of a local funding
+// request can be opened with the dual funding protocol.
+func validateDualFundRequest(msg *InitFundingMsg, zeroConf bool,
+	commitType lnwallet.CommitmentType) error {
+
+	switch {
+	case !msg.Peer.LocalFeatures().HasFeature(lnwire.DualFundOptional),
+		!msg.Peer.RemoteFeatures().HasFeature(lnwire.DualFundOptional):
+
+		return errDualFundNotSupported
+
+	case msg.PushAmt != 0:
+		return errors.New("dual funded channels can't push funds to " +
+			"the remote peer")
+
+	case zeroConf:
+		return errors.New("dual funded channels can't be zero-conf")
+
+	case commitType == lnwallet.CommitmentTypeScriptEnforcedLease:
+		return errors.New("dual funded channels can't be leased")
+
+	default:
+		return nil
+	}
+}
+
+// newOpenChannel2 creates the open_channel2 message that proposes a dual
+// funded channel from the open_channel message we'd send for a single funded
+// channel with the same parameters.
+func newOpenChannel2(msg *lnwire.OpenChannel,
+	reservation *lnwallet.ChannelReservation,
+	fundingFeePerKw chainfee.SatPerKWeight,
+	lockTime uint32) *lnwire.OpenChannel2 {
+
+	ourContribution := reservation.OurContribution()
+
+	return &lnwire.OpenChannel2{
+		ChainHash:               msg.ChainHash,
+		PendingChannelID:        msg.PendingChannelID,
+		FundingFeePerKiloWeight: uint32(fundingFeePerKw),
+		CommitFeePerKiloWeight:  msg.FeePerKiloWeight,
+		FundingAmount:           ourContribution.FundingAmount,
+		DustLimit:               msg.DustLimit,
+		MaxValueInFlight:        msg.MaxValueInFlight,
+		HtlcMinimum:             msg.HtlcMinimum,
+		CsvDelay:                msg.CsvDelay,
+		MaxAcceptedHTLCs:        msg.MaxAcceptedHTLCs,
+		LockTime:                lockTime,
+		FundingKey:              msg.FundingKey,
+		RevocationPoint:         msg.RevocationPoint,
+		PaymentPoint:            msg.PaymentPoint,
+		DelayedPaymentPoint:     msg.DelayedPaymentPoint,
+		HtlcPoint:               msg.HtlcPoint,
+		FirstCommitmentPoint:    msg.FirstCommitmentPoint,
+		SecondCommitmentPoint:   ourContribution.SecondCommitmentPoint,
+		ChannelFlags:            msg.ChannelFlags,
+		UpfrontShutdownScript:   msg.UpfrontShutdownScript,
+		ChannelType:             msg.ChannelType,
+	}
+}
+
+// openChannelFromV2 maps an open_channel2 message to the fields of the
+// open_channel message that the channel acceptors are queried with. The
+// channel reserve isn't part of the message, as it's derived from the final
+// capacity of the channel.
+func openChannelFromV2(msg *lnwire.OpenChannel2) *lnwire.OpenChannel {
+	return &lnwire.OpenChannel{
+		ChainHash:             msg.ChainHash,
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         msg.FundingAmount,
+		DustLimit:             msg.DustLimit,
+		MaxValueInFlight:      msg.MaxValueInFlight,
+		HtlcMinimum:           msg.HtlcMinimum,
+		FeePerKiloWeight:      msg.CommitFeePerKiloWeight,
+		CsvDelay:              msg.CsvDelay,
+		MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+		FundingKey:            msg.FundingKey,
+		RevocationPoint:       msg.RevocationPoint,
+		PaymentPoint:          msg.PaymentPoint,
+		DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+		HtlcPoint:             msg.HtlcPoint,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		ChannelFlags:          msg.ChannelFlags,
+		UpfrontShutdownScript: msg.UpfrontShutdownScript,
+		ChannelType:           msg.ChannelType,
+	}
+}
+
+// handleOpenChannel2 creates a reservation for a dual funded channel proposed
+// by the remote peer, contributing the amount requested by our channel
+// acceptors, and responds with an accept_channel2 message. The funding
+// transaction is then constructed interactively, starting with the first
+// message of the initiator.
+func (f *Manager) handleOpenChannel2(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel2) {
+
+	peerPubKey := peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+	amt := msg.FundingAmount
+
+	// We'll only accept dual funded channels if we signal support for
+	// them ourselves.
+	if !peer.LocalFeatures().HasFeature(lnwire.DualFundOptional) {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID, errDualFundNotSupported,
+		)
+		return
+	}
+
+	// The same limit of pending channels applies as for single funded
+	// channels.
+	numPending, err := f.numPendingChannels(peerPubKey)
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+	if numPending >= f.cfg.MaxPendingChannels {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwire.ErrMaxPendingChannels,
+		)
+		return
+	}
+
+	isSynced, _, err := f.cfg.Wallet.IsSynced()
+	if err != nil || !isSynced {
+		if err != nil {
+			log.Errorf("unable to query wallet: %v", err)
+		}
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwire.ErrSynchronizingChain,
+		)
+		return
+	}
+
+	// Our channel acceptors decide whether we accept the channel, and how
+	// much we contribute to it.
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node:        peerPubKey,
+		OpenChanMsg: openChannelFromV2(msg),
+		DualFunded:  true,
+	}
+	acceptorResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if acceptorResp.RejectChannel() {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			acceptorResp.ChanAcceptError,
+		)
+		return
+	}
+	if acceptorResp.ZeroConf {
+		err := errors.New("dual funded channels can't be zero-conf")
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// The size limits apply to the capacity of the channel, which
+	// includes our own contribution.
+	localAmt := acceptorResp.FundingAmt
+	capacity := amt + localAmt
+	if capacity > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwallet.ErrChanTooLarge(capacity, f.cfg.MaxChanSize),
+		)
+		return
+	}
+	if capacity < f.cfg.MinChanSize {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			lnwallet.ErrChanTooSmall(capacity, f.cfg.MinChanSize),
+		)
+		return
+	}
+
+	log.Infof("Recv'd dual fundingRequest(amt=%v, local_amt=%v, "+
+		"delay=%v, pendingId=%x) from peer(%x)", amt, localAmt,
+		msg.CsvDelay, msg.PendingChannelID,
+		peerPubKey.SerializeCompressed())
+
+	wasExplicit, _, commitType, err := negotiateCommitmentType(
+		msg.ChannelType, peer.LocalFeatures(), peer.RemoteFeatures(),
+		false,
+	)
+	if err != nil {
+		log.Errorf("channel type negotiation failed: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	var (
+		chanTypeFeatureBits *lnwire.ChannelType
+		zeroConf, scidAlias bool
+	)
+	if wasExplicit {
+		chanTypeFeatureBits = msg.ChannelType
+		zeroConf, scidAlias = aliasChanTypeFlags(msg.ChannelType)
+	}
+
+	public := msg.ChannelFlags&lnwire.FFAnnounceChannel != 0
+	switch {
+	case zeroConf:
+		err = errors.New("dual funded channels can't be zero-conf")
+
+	case scidAlias && public:
+		err = errors.New("option-scid-alias is only supported for " +
+			"private channels")
+
+	case commitType == lnwallet.CommitmentTypeScriptEnforcedLease:
+		err = errors.New("dual funded channels can't be leased")
+	}
+	if err != nil {
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	chainHash := msg.ChainHash
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:        &chainHash,
+		PendingChanID:    msg.PendingChannelID,
+		NodeID:           peerPubKey,
+		NodeAddr:         peer.Address(),
+		LocalFundingAmt:  localAmt,
+		RemoteFundingAmt: amt,
+		CommitFeePerKw: chainfee.SatPerKWeight(
+			msg.CommitFeePerKiloWeight,
+		),
+		FundingFeePerKw: chainfee.SatPerKWeight(
+			msg.FundingFeePerKiloWeight,
+		),
+		Flags:           msg.ChannelFlags,
+		MinConfs:        1,
+		CommitType:      commitType,
+		OptionScidAlias: scidAlias,
+		DualFund:        true,
+		LockTime:        msg.LockTime,
+		LocalNodeID:     f.cfg.IDKey,
+	}
+
+	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil {
+		log.Errorf("Unable to initialize reservation: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// As the responder, we get to specify the number of confirmations
+	// that we require before both of us consider the channel open.
+	numConfsReq := f.cfg.NumRequiredConfs(capacity, 0)
+	if acceptorResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptorResp.MinAcceptDepth
+	}
+	reservation.SetNumConfsRequired(numConfsReq)
+
+	// The channel reserve of both parties isn't negotiated, but is set to
+	// one percent of the capacity, and at least to the dust limits of both
+	// parties.
+	maxDustLimit := reservation.OurContribution().DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, maxDustLimit)
+
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		channelConstraints, f.cfg.MaxLocalCSVDelay, true,
+	)
+	if err != nil {
+		log.Errorf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, peer, acceptorResp.UpfrontShutdown,
+		func() (lnwire.DeliveryAddress, error) {
+			addr, err := f.cfg.Wallet.NewAddress(
+				lnwallet.WitnessPubKey, false,
+				lnwallet.DefaultAccountName,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return txscript.PayToAddrScript(addr)
+		},
+	)
+	if err != nil {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID,
+			fmt.Errorf("getUpfrontShutdownScript error: %v", err),
+		)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
+	// Generate our required constraints for the remote party, using the
+	// values provided by the channel acceptor if they are non-zero.
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	if acceptorResp.CSVDelay != 0 {
+		remoteCsvDelay = acceptorResp.CSVDelay
+	}
+
+	remoteReserve := chanReserve
+	if acceptorResp.Reserve != 0 {
+		remoteReserve = acceptorResp.Reserve
+	}
+
+	remoteMaxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	if acceptorResp.InFlightTotal != 0 {
+		remoteMaxValue = acceptorResp.InFlightTotal
+	}
+
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	if acceptorResp.HtlcLimit != 0 {
+		maxHtlcs = acceptorResp.HtlcLimit
+	}
+
+	minHtlc := f.cfg.DefaultMinHtlcIn
+	if acceptorResp.MinHtlcIn != 0 {
+		minHtlc = acceptorResp.MinHtlcIn
+	}
+
+	f.resMtx.Lock()
+	if _, ok := f.activeReservations[peerIDKey]; !ok {
+		f.activeReservations[peerIDKey] = make(pendingChannels)
+	}
+	resCtx := &reservationWithCtx{
+		reservation:    reservation,
+		chanAmt:        capacity,
+		remoteCsvDelay: remoteCsvDelay,
+		remoteMinHtlc:  minHtlc,
+		remoteMaxValue: remoteMaxValue,
+		remoteMaxHtlcs: maxHtlcs,
+		maxLocalCsv:    f.cfg.MaxLocalCSVDelay,
+		channelType:    msg.ChannelType,
+		err:            make(chan error, 1),
+		peer:           peer,
+	}
+	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
+	f.resMtx.Unlock()
+
+	// Update the timestamp once the open_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:         amt,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		SecondCommitmentPoint: msg.SecondCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: remoteMaxValue,
+				ChanReserve:      remoteReserve,
+				MinHTLC:          minHtlc,
+				MaxAcceptedHtlcs: maxHtlcs,
+				CsvDelay:         remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+	err = reservation.ProcessContribution(remoteContribution)
+	if err != nil {
+		log.Errorf("unable to add contribution reservation: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// From now on, the channel is referred to by its permanent channel ID,
+	// which is derived from the revocation base points of both parties.
+	f.resMtx.Lock()
+	f.signedReservations[reservation.ChanID()] = msg.PendingChannelID
+	f.resMtx.Unlock()
+
+	log.Infof("Sending dual fundingResp for pending_id(%x)",
+		msg.PendingChannelID)
+	log.Debugf("Remote party accepted commitment constraints: %v",
+		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+
+	ourContribution := reservation.OurContribution()
+	revocationPoint := ourContribution.RevocationBasePoint.PubKey
+	fundingAccept := &lnwire.AcceptChannel2{
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         ourContribution.FundingAmount,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      remoteMaxValue,
+		HtlcMinimum:           minHtlc,
+		MinAcceptDepth:        uint32(numConfsReq),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       revocationPoint,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: ourContribution.SecondCommitmentPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		ChannelType:           chanTypeFeatureBits,
+	}
+	if err := peer.SendMessage(true, fundingAccept); err != nil {
+		log.Errorf("unable to send funding response to peer: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+}
+
+// handleAcceptChannel2 processes the response of the remote peer to a dual
+// funded channel that we proposed, and starts the interactive construction of
+// the funding transaction.
+func (f *Manager) handleAcceptChannel2(peer lnpeer.Peer,
+	msg *lnwire.AcceptChannel2) {
+
+	pendingChanID := msg.PendingChannelID
+	peerKey := peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		log.Warnf("Can't find reservation (peerKey:%v, chan_id:%v)",
+			peerKey, pendingChanID)
+		return
+	}
+
+	// Update the timestamp once the accept_channel2 message has been
+	// handled.
+	defer resCtx.updateTimestamp()
+
+	log.Infof("Recv'd dual fundingResponse for pending_id(%x)",
+		pendingChanID[:])
+
+	if !resCtx.reservation.IsDualFunded() {
+		err := errors.New("accept_channel2 for channel that isn't " +
+			"dual funded")
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// The remote peer must echo back the channel type that we proposed.
+	if resCtx.channelType != nil {
+		if msg.ChannelType == nil {
+			err := errors.New("explicit channel type not " +
+				"echoed back")
+			f.failFundingFlow(peer, pendingChanID, err)
+			return
+		}
+		proposedFeatures := lnwire.RawFeatureVector(*resCtx.channelType)
+		ackedFeatures := lnwire.RawFeatureVector(*msg.ChannelType)
+		if !proposedFeatures.Equals(&ackedFeatures) {
+			err := errors.New("channel type mismatch")
+			f.failFundingFlow(peer, pendingChanID, err)
+			return
+		}
+	}
+
+	// Dual funded channels can't be zero-conf, so they require at least a
+	// single confirmation.
+	switch {
+	case msg.MinAcceptDepth > chainntnfs.MaxNumConfs:
+		err := lnwallet.ErrNumConfsTooLarge(
+			msg.MinAcceptDepth, chainntnfs.MaxNumConfs,
+		)
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+
+	case msg.MinAcceptDepth == 0:
+		err := errors.New("zero min_depth for dual funded channel")
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// Now that we know the contribution of the remote peer, we can
+	// determine the capacity and the balances of the channel.
+	err = resCtx.reservation.SetRemoteFundingAmt(msg.FundingAmount)
+	if err != nil {
+		log.Errorf("Unable to set remote funding amount: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	resCtx.chanAmt = resCtx.reservation.Capacity()
+
+	maxDustLimit := resCtx.reservation.OurContribution().DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	chanReserve := f.cfg.RequiredRemoteChanReserve(
+		resCtx.chanAmt, maxDustLimit,
+	)
+
+	resCtx.reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	channelConstraints := &channeldb.ChannelConstraints{
+		DustLimit:        msg.DustLimit,
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+		CsvDelay:         msg.CsvDelay,
+	}
+	err = resCtx.reservation.CommitConstraints(
+		channelConstraints, resCtx.maxLocalCsv, false,
+	)
+	if err != nil {
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:         msg.FundingAmount,
+		FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+		SecondCommitmentPoint: msg.SecondCommitmentPoint,
+		ChannelConfig: &channeldb.ChannelConfig{
+			ChannelConstraints: channeldb.ChannelConstraints{
+				DustLimit:        msg.DustLimit,
+				MaxPendingAmount: resCtx.remoteMaxValue,
+				ChanReserve:      chanReserve,
+				MinHTLC:          resCtx.remoteMinHtlc,
+				MaxAcceptedHtlcs: resCtx.remoteMaxHtlcs,
+				CsvDelay:         resCtx.remoteCsvDelay,
+			},
+			MultiSigKey: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.FundingKey),
+			},
+			RevocationBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.RevocationPoint),
+			},
+			PaymentBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.PaymentPoint),
+			},
+			DelayBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.DelayedPaymentPoint),
+			},
+			HtlcBasePoint: keychain.KeyDescriptor{
+				PubKey: copyPubKey(msg.HtlcPoint),
+			},
+		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
+	}
+	err = resCtx.reservation.ProcessContribution(remoteContribution)
+	if err != nil {
+		log.Errorf("Unable to process contribution from %v: %v",
+			peerKey, err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	f.resMtx.Lock()
+	f.signedReservations[resCtx.reservation.ChanID()] = pendingChanID
+	f.resMtx.Unlock()
+
+	log.Infof("pendingChan(%x): remote party contributes amt=%v, "+
+		"proposes num_confs=%v, csv_delay=%v", pendingChanID[:],
+		msg.FundingAmount, msg.MinAcceptDepth, msg.CsvDelay)
+
+	// As the initiator, we send the first message of the interactive
+	// construction of the funding transaction.
+	f.sendNextInteractiveTxMsg(peer, resCtx, pendingChanID)
+}
+
+// getDualFundedReservation returns the reservation of a dual funded channel
+// that is referred to by its permanent channel ID, along with its pending
+// channel ID.
+func (f *Manager) getDualFundedReservation(peerKey *bronec.PublicKey,
+	chanID lnwire.ChannelID) (*reservationWithCtx, [32]byte, error) {
+
+	f.resMtx.RLock()
+	pendingChanID, ok := f.signedReservations[chanID]
+	f.resMtx.RUnlock()
+	if !ok {
+		return nil, pendingChanID, fmt.Errorf("unable to find dual "+
+			"funded reservation for chan_id=%v", chanID)
+	}
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		return nil, pendingChanID, err
+	}
+
+	if !resCtx.reservation.IsDualFunded() {
+		return nil, pendingChanID, fmt.Errorf("reservation for "+
+			"chan_id=%v isn't dual funded", chanID)
+	}
+
+	return resCtx, pendingChanID, nil
+}
+
+// handleInteractiveTxMsg applies a message of the remote peer to the funding
+// transaction of a dual funded channel, and responds with our next message.
+func (f *Manager) handleInteractiveTxMsg(peer lnpeer.Peer,
+	chanID lnwire.ChannelID, msg lnwire.Message) {
+
+	resCtx, pendingChanID, err := f.getDualFundedReservation(
+		peer.IdentityKey(), chanID,
+	)
+	if err != nil {
+		log.Warnf("Unable to handle %v: %v", msg.MsgType(), err)
+		return
+	}
+
+	// Update the timestamp once the message has been handled.
+	defer resCtx.updateTimestamp()
+
+	err = resCtx.reservation.ProcessInteractiveTxMsg(msg)
+	if err != nil {
+		log.Errorf("Invalid %v for pending_id(%x): %v", msg.MsgType(),
+			pendingChanID[:], err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	// If the message was the tx_complete that concluded the construction,
+	// we don't respond to it, but continue with the commitment signatures
+	// right away.
+	if resCtx.reservation.InteractiveTxDone() {
+		f.sendDualFundedCommitSig(peer, resCtx, pendingChanID)
+		return
+	}
+
+	f.sendNextInteractiveTxMsg(peer, resCtx, pendingChanID)
+}
+
+// sendNextInteractiveTxMsg sends our next message for the construction of the
+// funding transaction of a dual funded channel. If it concluded the
+// construction, we continue with the commitment signatures.
+func (f *Manager) sendNextInteractiveTxMsg(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte) {
+
+	msg, err := resCtx.reservation.NextInteractiveTxMsg()
+	if err != nil {
+		log.Errorf("Unable to construct funding tx for "+
+			"pending_id(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	if err := peer.SendMessage(true, msg); err != nil {
+		log.Errorf("Unable to send %v: %v", msg.MsgType(), err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	if resCtx.reservation.InteractiveTxDone() {
+		f.sendDualFundedCommitSig(peer, resCtx, pendingChanID)
+	}
+}
+
+// sendDualFundedCommitSig signs the remote peer's commitment transaction of a
+// dual funded channel once the funding transaction has been constructed.
+func (f *Manager) sendDualFundedCommitSig(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte) {
+
+	if err := resCtx.reservation.ProcessInteractiveTx(); err != nil {
+		log.Errorf("Unable to process funding tx for pending_id(%x): "+
+			"%v", pendingChanID[:], err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	_, sig := resCtx.reservation.OurSignatures()
+	commitSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		log.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	log.Infof("Sending CommitSig for pending_id(%x) over "+
+		"ChannelPoint(%v)", pendingChanID[:],
+		resCtx.reservation.FundingOutpoint())
+
+	msg := &lnwire.CommitSig{
+		ChanID:    resCtx.reservation.ChanID(),
+		CommitSig: commitSig,
+	}
+	if err := peer.SendMessage(true, msg); err != nil {
+		log.Errorf("Unable to send CommitSig message: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+}
+
+// handleDualFundedCommitSig processes the remote peer's signature for our
+// commitment transaction of a dual funded channel, which allows us to commit
+// the channel to the database. Afterwards, the signatures for the funding
+// transaction are exchanged.
+func (f *Manager) handleDualFundedCommitSig(peer lnpeer.Peer,
+	msg *lnwire.CommitSig) {
+
+	peerKey := peer.IdentityKey()
+	resCtx, pendingChanID, err := f.getDualFundedReservation(
+		peerKey, msg.ChanID,
+	)
+	if err != nil {
+		log.Warnf("Unable to handle CommitSig: %v", err)
+		return
+	}
+
+	// Update the timestamp once the CommitSig message has been handled.
+	defer resCtx.updateTimestamp()
+
+	switch {
+	case !resCtx.reservation.InteractiveTxDone(),
+		resCtx.completeChan != nil:
+
+		err = errors.New("unexpected CommitSig")
+
+	case len(msg.HtlcSigs) != 0:
+		err = errors.New("unexpected htlc signatures")
+	}
+	if err != nil {
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	commitSig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		log.Errorf("Unable to parse signature: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	completeChan, err := resCtx.reservation.CompleteReservation(
+		nil, commitSig,
+	)
+	if err != nil {
+		log.Errorf("Unable to complete reservation: %v", err)
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+	resCtx.completeChan = completeChan
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	chanID := completeChan.ChanID()
+	f.barrierMtx.Lock()
+	log.Debugf("Creating chan barrier for ChanID(%v)", chanID)
+	f.newChanBarriers[chanID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[chanID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	// The party that contributed the lower amount sends its signatures for
+	// the funding transaction first, while the other party waits for them.
+	if !resCtx.reservation.SendTxSignaturesFirst() {
+		return
+	}
+
+	if err := f.sendTxSignatures(peer, resCtx); err != nil {
+		f.failDualFundedChan(peer, resCtx, pendingChanID, false, err)
+		return
+	}
+}
+
+// sendTxSignatures sends our signatures for the funding transaction of a dual
+// funded channel. As the remote peer is able to publish the funding
+// transaction from then on, the channel is handed to the ChainArbitrator.
+func (f *Manager) sendTxSignatures(peer lnpeer.Peer,
+	resCtx *reservationWithCtx) error {
+
+	msg, err := resCtx.reservation.TxSignatures()
+	if err != nil {
+		return err
+	}
+
+	if err := peer.SendMessage(true, msg); err != nil {
+		return err
+	}
+
+	completeChan := resCtx.completeChan
+	err = f.cfg.WatchNewChannel(completeChan, peer.IdentityKey())
+	if err != nil {
+		log.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", completeChan.FundingOutpoint, err)
+	}
+
+	return nil
+}
+
+// handleTxSignatures processes the remote peer's signatures for the funding
+// transaction of a dual funded channel. Once both parties exchanged their
+// signatures, the funding transaction is broadcast and we wait for it to
+// confirm.
+func (f *Manager) handleTxSignatures(peer lnpeer.Peer,
+	msg *lnwire.TxSignatures) {
+
+	peerKey := peer.IdentityKey()
+	resCtx, pendingChanID, err := f.getDualFundedReservation(
+		peerKey, msg.ChannelID,
+	)
+	if err != nil {
+		log.Warnf("Unable to handle TxSignatures: %v", err)
+		return
+	}
+
+	completeChan := resCtx.completeChan
+	if completeChan == nil {
+		err := errors.New("unexpected TxSignatures")
+		f.failFundingFlow(peer, pendingChanID, err)
+		return
+	}
+
+	sentFirst := resCtx.reservation.SendTxSignaturesFirst()
+	if err := resCtx.reservation.ProcessTxSignatures(msg); err != nil {
+		log.Errorf("Invalid TxSignatures for pending_id(%x): %v",
+			pendingChanID[:], err)
+		f.failDualFundedChan(
+			peer, resCtx, pendingChanID, sentFirst, err,
+		)
+		return
+	}
+
+	if !sentFirst {
+		if err := f.sendTxSignatures(peer, resCtx); err != nil {
+			f.failDualFundedChan(
+				peer, resCtx, pendingChanID, false, err,
+			)
+			return
+		}
+	}
+
+	fundingTx, err := resCtx.reservation.SignedFundingTx()
+	if err != nil {
+		f.failDualFundedChan(peer, resCtx, pendingChanID, true, err)
+		return
+	}
+
+	// The signed funding transaction replaces the unsigned one that was
+	// stored along with the channel, so we're able to rebroadcast it on
+	// startup.
+	if err := completeChan.UpdateFundingTxn(fundingTx); err != nil {
+		log.Errorf("Unable to store funding tx for ChannelPoint(%v): "+
+			"%v", completeChan.FundingOutpoint, err)
+	}
+
+	// The channel is now fully funded, so we can delete it from our set of
+	// active reservations.
+	f.deleteReservationCtx(peerKey, pendingChanID)
+	f.resMtx.Lock()
+	delete(f.signedReservations, msg.ChannelID)
+	f.resMtx.Unlock()
+
+	var fundingTxBuf bytes.Buffer
+	if err := fundingTx.Serialize(&fundingTxBuf); err != nil {
+		log.Errorf("Unable to serialize funding transaction %v: %v",
+			fundingTx.TxHash(), err)
+
+		// Clear the buffer of any bytes that were written before the
+		// serialization error to prevent logging an incomplete
+		// transaction.
+		fundingTxBuf.Reset()
+	}
+
+	fundingPoint := completeChan.FundingOutpoint
+	log.Infof("Broadcasting funding tx for ChannelPoint(%v): %x",
+		fundingPoint, fundingTxBuf.Bytes())
+
+	// Set a nil short channel ID at this stage because we do not know it
+	// until our funding tx confirms.
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+
+	// If the broadcast fails, we'll retry it at startup. The remote peer
+	// is able to broadcast the transaction as well.
+	err = f.cfg.PublishTransaction(fundingTx, label)
+	if err != nil {
+		log.Errorf("Unable to broadcast funding tx %x for "+
+			"ChannelPoint(%v): %v", fundingTxBuf.Bytes(),
+			fundingPoint, err)
+	}
+
+	log.Infof("Finalizing pending_id(%x) over ChannelPoint(%v), "+
+		"waiting for channel open on-chain", pendingChanID[:],
+		fundingPoint)
+
+	// Only the initiator has a local caller that awaits updates on the
+	// progress of the funding flow.
+	if resCtx.updates != nil {
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid:        fundingPoint.Hash[:],
+					OutputIndex: fundingPoint.Index,
+				},
+			},
+			PendingChanId: pendingChanID[:],
+		}
+
+		select {
+		case resCtx.updates <- upd:
+		case <-f.quit:
+			return
+		}
+	}
+
+	// Inform the ChannelNotifier that the channel has entered pending open
+	// state.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingPoint, completeChan)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(completeChan, pendingChanID, resCtx.updates)
+}
+
+// handleTxAbort cancels the funding flow of a dual funded channel that the
+// remote peer aborted, and acknowledges the abort.
+func (f *Manager) handleTxAbort(peer lnpeer.Peer, msg *lnwire.TxAbort) {
+	peerKey := peer.IdentityKey()
+	resCtx, pendingChanID, err := f.getDualFundedReservation(
+		peerKey, msg.ChannelID,
+	)
+	if err != nil {
+		log.Warnf("Unable to handle TxAbort: %v", err)
+		return
+	}
+
+	abortErr := fmt.Errorf("funding aborted by %x: %v",
+		peerKey.SerializeCompressed(), string(msg.Data))
+	log.Errorf(abortErr.Error())
+
+	// Once the channel has been written to the database, the reservation
+	// can't be canceled anymore, but the channel is removed again.
+	if resCtx.completeChan != nil {
+		sentTxSigs := resCtx.reservation.SendTxSignaturesFirst()
+		f.forgetDualFundedChan(
+			peerKey, resCtx, pendingChanID, sentTxSigs,
+		)
+	} else {
+		_, err := f.cancelReservationCtx(peerKey, pendingChanID, true)
+		if err != nil {
+			log.Errorf("unable to cancel reservation: %v", err)
+		}
+
+		f.resMtx.Lock()
+		delete(f.signedReservations, msg.ChannelID)
+		f.resMtx.Unlock()
+	}
+
+	resCtx.err <- abortErr
+
+	ack := &lnwire.TxAbort{
+		ChannelID: msg.ChannelID,
+	}
+	if err := peer.SendMessage(false, ack); err != nil {
+		log.Errorf("unable to send TxAbort to peer: %v", err)
+	}
+}
+
+// forgetDualFundedChan removes a dual funded channel that was written to the
+// database, as both parties exchanged the signatures for their commitment
+// transactions, from our set of reservations. If we haven't sent our
+// signatures for the funding transaction yet, it can't be published and the
+// channel is removed from the database as well. Otherwise, we keep waiting
+// for the funding transaction to confirm.
+func (f *Manager) forgetDualFundedChan(peerKey *bronec.PublicKey,
+	resCtx *reservationWithCtx, pendingChanID [32]byte, sentTxSigs bool) {
+
+	completeChan := resCtx.completeChan
+
+	f.deleteReservationCtx(peerKey, pendingChanID)
+	f.resMtx.Lock()
+	delete(f.signedReservations, completeChan.ChanID())
+	f.resMtx.Unlock()
+
+	if sentTxSigs {
+		f.wg.Add(1)
+		go f.advanceFundingState(completeChan, pendingChanID, nil)
+
+		return
+	}
+
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToBroneess()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	err := completeChan.CloseChannel(
+		closeInfo, channeldb.ChanStatusLocalCloseInitiator,
+	)
+	if err != nil {
+		log.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
+
+// failDualFundedChan fails the funding flow of a dual funded channel that was
+// already written to the database, and sends the error to the remote peer.
+func (f *Manager) failDualFundedChan(peer lnpeer.Peer,
+	resCtx *reservationWithCtx, pendingChanID [32]byte, sentTxSigs bool,
+	fundingErr error) {
+
+	log.Debugf("Failing dual funded channel with pending_id=%x: %v",
+		pendingChanID, fundingErr)
+
+	f.forgetDualFundedChan(
+		peer.IdentityKey(), resCtx, pendingChanID, sentTxSigs,
+	)
+
+	resCtx.err <- fundingErr
+
+	errMsg := &lnwire.Error{
+		ChanID: pendingChanID,
+		Data: lnwire.ErrorData(
+			"funding failed due to internal error",
+		),
+	}
+	if err := peer.SendMessage(false, errMsg); err != nil {
+		log.Errorf("unable to send error message to peer %v", err)
+	}
+}
@@ -150,6 +150,12 @@ type reservationWithCtx struct {
 	// the channel.
 	channelType *lnwire.ChannelType
 
+	// completeChan is the channel that was written to the database once
+	// both parties exchanged the signatures for their commitment
+	// transactions of a dual funded channel. It's only set while the
+	// signatures for the funding transaction are still being exchanged.
+	completeChan *channeldb.OpenChannel
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -248,6 +254,11 @@ type InitFundingMsg struct {
 	// support explicit channel type negotiation.
 	ChannelType *lnwire.ChannelType
 
+	// DualFund is true if the channel should be opened with the dual
+	// funding protocol, which allows the remote peer to contribute funds
+	// to the channel as well.
+	DualFund bool
+
 	// Updates is a channel which updates to the opening status of the channel
 	// are sent on.
 	Updates chan *lnrpc.OpenStatusUpdate
@@ -462,6 +473,35 @@ type Config struct {
 	// MaxAnchorsCommitFeeRate is the max commitment fee rate we'll use as
 	// the initiator for channels of the anchor type.
 	MaxAnchorsCommitFeeRate chainfee.SatPerKWeight
+
+	// AliasManager is used to allocate and store the SCID aliases of
+	// zero-conf and option-scid-alias channels, as well as the aliases
+	// handed to us by our peers.
+	AliasManager AliasManager
+
+	// DeleteAliasEdge removes the edge of a public zero-conf channel that
+	// was added to the graph under its alias, so that it can be re-added
+	// and announced under its confirmed SCID.
+	DeleteAliasEdge func(scid lnwire.ShortChannelID) error
+}
+
+// AliasManager is an interface that abstracts away the storage of the SCID
+// aliases used by zero-conf and option-scid-alias channels.
+type AliasManager interface {
+	// RequestAlias returns a fresh alias that can be assigned to a
+	// channel.
+	RequestAlias() (lnwire.ShortChannelID, error)
+
+	// AddLocalAlias maps the passed alias to the base SCID of a channel.
+	AddLocalAlias(alias, baseScid lnwire.ShortChannelID) error
+
+	// GetAliases returns the set of aliases stored for a base SCID.
+	GetAliases(baseScid lnwire.ShortChannelID) []lnwire.ShortChannelID
+
+	// PutPeerAlias stores the alias our peer wants us to use when
+	// referring to the channel in messages to them.
+	PutPeerAlias(chanID lnwire.ChannelID,
+		alias lnwire.ShortChannelID) error
 }
 
 // Manager acts as an orchestrator/bridge between the wallet's
@@ -608,7 +648,7 @@ func (f *Manager) start() error {
 	}
 
 	for _, channel := range allChannels {
-		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		chanID := channel.ChanID()
 
 		// For any channels that were in a pending state when the
 		// daemon was last connected, the Funding Manager will
@@ -626,11 +666,13 @@ func (f *Manager) start() error {
 			f.localDiscoverySignals[chanID] = make(chan struct{})
 
 			// Rebroadcast the funding transaction for any pending
-			// channel that we initiated. No error will be returned
-			// if the transaction already has been broadcast.
+			// channel that we initiated or contributed funds to. No
+			// error will be returned if the transaction already has
+			// been broadcast.
 			chanType := channel.ChanType
-			if chanType.IsSingleFunder() && chanType.HasFundingTx() &&
-				channel.IsInitiator {
+			isFunder := channel.IsInitiator ||
+				chanType.IsDualFunder()
+			if chanType.HasFundingTx() && isFunder {
 
 				var fundingTxBuf bytes.Buffer
 				err := channel.FundingTxn.Serialize(&fundingTxBuf)
@@ -836,6 +878,36 @@ func (f *Manager) reservationCoordinator() {
 			case *lnwire.FundingLocked:
 				f.wg.Add(1)
 				go f.handleFundingLocked(fmsg.peer, msg)
+			case *lnwire.OpenChannel2:
+				f.handleOpenChannel2(fmsg.peer, msg)
+			case *lnwire.AcceptChannel2:
+				f.handleAcceptChannel2(fmsg.peer, msg)
+			case *lnwire.TxAddInput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+			case *lnwire.TxAddOutput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+			case *lnwire.TxRemoveInput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+			case *lnwire.TxRemoveOutput:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+			case *lnwire.TxComplete:
+				f.handleInteractiveTxMsg(
+					fmsg.peer, msg.ChannelID, msg,
+				)
+			case *lnwire.CommitSig:
+				f.handleDualFundedCommitSig(fmsg.peer, msg)
+			case *lnwire.TxSignatures:
+				f.handleTxSignatures(fmsg.peer, msg)
+			case *lnwire.TxAbort:
+				f.handleTxAbort(fmsg.peer, msg)
 			case *lnwire.Error:
 				f.handleErrorMsg(fmsg.peer, msg)
 			}
@@ -924,6 +996,25 @@ func (f *Manager) advanceFundingState(channel *channeldb.OpenChannel,
 	}
 }
 
+// ProcessSplicedChannel adds a channel to the router graph and announces it
+// under its new short channel ID once a splice of the channel has been
+// confirmed. The edge of the channel before the splice is pruned from the
+// graph as its funding output is spent.
+func (f *Manager) ProcessSplicedChannel(channel *channeldb.OpenChannel) {
+	shortChanID := channel.ShortChanID()
+	err := f.saveChannelOpeningState(
+		&channel.FundingOutpoint, fundingLockedSent, &shortChanID,
+	)
+	if err != nil {
+		log.Errorf("Unable to save opening state of spliced "+
+			"ChannelPoint(%v): %v", channel.FundingOutpoint, err)
+		return
+	}
+
+	f.wg.Add(1)
+	go f.advanceFundingState(channel, channel.ChanID(), nil)
+}
+
 // stateStep advances the confirmed channel one step in the funding state
 // machine. This method is synchronous and the new channel opening state will
 // have been written to the database when it successfully returns. The
@@ -934,7 +1025,7 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 	channelState channelOpeningState,
 	updateChan chan<- *lnrpc.OpenStatusUpdate) error {
 
-	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+	chanID := channel.ChanID()
 	log.Debugf("Channel(%v) with ShortChanID %v has opening state %v",
 		chanID, shortChanID, channelState)
 
@@ -970,7 +1061,19 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 	// fundingLocked was sent to peer, but the channel was not added to the
 	// router graph and the channel announcement was not sent.
 	case fundingLockedSent:
-		err := f.addToRouterGraph(channel, shortChanID)
+		// Channels of the option-scid-alias type are added to the
+		// graph under their alias, as that's what our peer will use
+		// to refer to the channel in its updates.
+		graphScid := *shortChanID
+		if channel.ChanType.HasScidAliasChan() {
+			alias, err := f.localAlias(*shortChanID)
+			if err != nil {
+				return err
+			}
+			graphScid = alias
+		}
+
+		err := f.addToRouterGraph(channel, &graphScid)
 		if err != nil {
 			return fmt.Errorf("failed adding to "+
 				"router graph: %v", err)
@@ -1056,6 +1159,12 @@ func (f *Manager) stateStep(channel *channeldb.OpenChannel,
 func (f *Manager) advancePendingChannelState(
 	channel *channeldb.OpenChannel, pendingChanID [32]byte) error {
 
+	// Zero-conf channels don't wait for their funding transaction to
+	// confirm, they're marked open right away using their alias.
+	if channel.IsZeroConf() {
+		return f.handleZeroConfOpen(channel)
+	}
+
 	confChannel, err := f.waitForFundingWithTimeout(channel)
 	if err == ErrConfirmationTimeout {
 		// We'll get a timeout if the number of blocks mined
@@ -1120,7 +1229,7 @@ func (f *Manager) advancePendingChannelState(
 	}
 
 	// Success, funding transaction was confirmed.
-	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+	chanID := channel.ChanID()
 	log.Debugf("ChannelID(%v) is now fully confirmed! "+
 		"(shortChanID=%v)", chanID, confChannel.shortChanID)
 
@@ -1144,25 +1253,14 @@ func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
 	}
 }
 
-// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
-// then responds to the source peer with an accept channel message progressing
-// the funding workflow.
-//
-// TODO(roasbeef): add error chan to all, let channelManager handle
-// error+propagate
-func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
-	msg *lnwire.OpenChannel) {
+// numPendingChannels returns the number of channels with the given peer that
+// count towards the limit of pending channels. These are the active
+// reservations and the channels pending open in the database.
+func (f *Manager) numPendingChannels(peerPubKey *bronec.PublicKey) (int,
+	error) {
 
-	// Check number of pending channels to be smaller than maximum allowed
-	// number and send ErrorGeneric to remote peer if condition is
-	// violated.
-	peerPubKey := peer.IdentityKey()
 	peerIDKey := newSerializedKey(peerPubKey)
 
-	amt := msg.FundingAmount
-
-	// We get all pending channels for this peer. This is the list of the
-	// active reservations and the channels pending open in the database.
 	f.resMtx.RLock()
 	reservations := f.activeReservations[peerIDKey]
 
@@ -1181,10 +1279,7 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 	// the underlying intent anymore, unfortunately.
 	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
 	if err != nil {
-		f.failFundingFlow(
-			peer, msg.PendingChannelID, err,
-		)
-		return
+		return 0, err
 	}
 
 	for _, c := range channels {
@@ -1199,6 +1294,36 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		}
 	}
 
+	return numPending, nil
+}
+
+// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
+// then responds to the source peer with an accept channel message progressing
+// the funding workflow.
+//
+// TODO(roasbeef): add error chan to all, let channelManager handle
+// error+propagate
+func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) {
+
+	// Check number of pending channels to be smaller than maximum allowed
+	// number and send ErrorGeneric to remote peer if condition is
+	// violated.
+	peerPubKey := peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	amt := msg.FundingAmount
+
+	// We get all pending channels for this peer. This is the list of the
+	// active reservations and the channels pending open in the database.
+	numPending, err := f.numPendingChannels(peerPubKey)
+	if err != nil {
+		f.failFundingFlow(
+			peer, msg.PendingChannelID, err,
+		)
+		return
+	}
+
 	// TODO(roasbeef): modify to only accept a _single_ pending channel per
 	// block unless white listed
 	if numPending >= f.cfg.MaxPendingChannels {
@@ -1300,9 +1425,41 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 
 	// Only echo back a channel type in AcceptChannel if we actually used
 	// explicit negotiation above.
-	var chanTypeFeatureBits *lnwire.ChannelType
+	var (
+		chanTypeFeatureBits *lnwire.ChannelType
+		zeroConf, scidAlias bool
+	)
 	if wasExplicit {
 		chanTypeFeatureBits = msg.ChannelType
+		zeroConf, scidAlias = aliasChanTypeFlags(msg.ChannelType)
+	}
+
+	// A zero-conf channel requires us to trust the initiator not to
+	// double spend the funding transaction, so we'll only accept one if
+	// our channel acceptor explicitly opted into it. Likewise, the
+	// acceptor can't ask for a zero-conf channel if the initiator didn't
+	// propose one.
+	switch {
+	case zeroConf && !acceptorResp.ZeroConf:
+		err := errors.New("zero-conf channel not accepted")
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+
+	case !zeroConf && acceptorResp.ZeroConf:
+		err := errors.New("zero-conf requested by acceptor, but " +
+			"not proposed by initiator")
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
+	// The option-scid-alias channel type is only valid for private
+	// channels, as public ones are announced under their confirmed SCID.
+	public := msg.ChannelFlags&lnwire.FFAnnounceChannel != 0
+	if scidAlias && public {
+		err := errors.New("option-scid-alias is only supported for " +
+			"private channels")
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
 	}
 
 	chainHash := chainhash.Hash(msg.ChainHash)
@@ -1319,6 +1476,8 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		Flags:            msg.ChannelFlags,
 		MinConfs:         1,
 		CommitType:       commitType,
+		ZeroConf:         zeroConf,
+		OptionScidAlias:  scidAlias,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -1328,16 +1487,34 @@ func (f *Manager) handleFundingOpen(peer lnpeer.Peer,
 		return
 	}
 
+	// As zero-conf channels are used before their funding transaction
+	// confirms, they'll be referred to by an alias until then.
+	if zeroConf {
+		alias, err := f.cfg.AliasManager.RequestAlias()
+		if err != nil {
+			log.Errorf("Unable to request alias: %v", err)
+			f.failFundingFlow(peer, msg.PendingChannelID, err)
+			return
+		}
+
+		reservation.AddAlias(alias)
+	}
+
 	// As we're the responder, we get to specify the number of confirmations
 	// that we require before both of us consider the channel open. We'll
 	// use our mapping to derive the proper number of confirmations based on
 	// the amount of the channel, and also if any funds are being pushed to
 	// us. If a depth value was set by our channel acceptor, we will use
 	// that value instead.
+	//
+	// Zero-conf channels don't require any confirmations at all.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
 	if acceptorResp.MinAcceptDepth != 0 {
 		numConfsReq = acceptorResp.MinAcceptDepth
 	}
+	if zeroConf {
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1652,6 +1829,23 @@ func (f *Manager) handleFundingAccept(peer lnpeer.Peer,
 		return
 	}
 
+	// Zero-conf channels must have a min_depth of zero, while all other
+	// channels require at least a single confirmation.
+	zeroConf := resCtx.reservation.IsZeroConf()
+	switch {
+	case zeroConf && msg.MinAcceptDepth != 0:
+		err := errors.New("non-zero min_depth for zero-conf channel")
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+
+	case !zeroConf && msg.MinAcceptDepth == 0:
+		err := errors.New("zero min_depth for non-zero-conf channel")
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, msg.PendingChannelID, err)
+		return
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -2238,8 +2432,11 @@ func (f *Manager) waitForFundingWithTimeout(
 
 	// If we are not the initiator, we have no money at stake and will
 	// timeout waiting for the funding transaction to confirm after a
-	// while.
-	if !ch.IsInitiator {
+	// while. This doesn't apply to dual funded channels that we
+	// contributed funds to.
+	contributed := ch.ChanType.IsDualFunder() &&
+		ch.LocalCommitment.LocalBalance > 0
+	if !ch.IsInitiator && !contributed {
 		f.wg.Add(1)
 		go f.waitForTimeout(ch, cancelChan, timeoutChan)
 	}
@@ -2307,6 +2504,14 @@ func (f *Manager) waitForFundingConfirmation(
 		return
 	}
 	numConfs := uint32(completeChan.NumConfsRequired)
+
+	// Zero-conf channels don't require any confirmations before being
+	// used, but we still wait for the first one to learn the confirmed
+	// ShortChannelID of the channel.
+	if numConfs == 0 {
+		numConfs = 1
+	}
+
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs,
 		completeChan.FundingBroadcastHeight,
@@ -2352,7 +2557,7 @@ func (f *Manager) waitForFundingConfirmation(
 
 	fundingPoint := completeChan.FundingOutpoint
 	log.Infof("ChannelPoint(%v) is now active: ChannelID(%v)",
-		fundingPoint, lnwire.NewChanIDFromOutPoint(&fundingPoint))
+		fundingPoint, completeChan.ChanID())
 
 	// With the block height and the transaction index known, we can
 	// construct the compact chanID which is used on the network to unique
@@ -2441,7 +2646,7 @@ func (f *Manager) handleFundingConfirmation(
 	confChannel *confirmedChannel) error {
 
 	fundingPoint := completeChan.FundingOutpoint
-	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	chanID := completeChan.ChanID()
 
 	// TODO(roasbeef): ideally persistent state update for chan above
 	// should be abstracted
@@ -2521,6 +2726,110 @@ func (f *Manager) handleFundingConfirmation(
 	return nil
 }
 
+// handleZeroConfOpen marks a zero-conf channel as open in the database using
+// its alias as ShortChannelID, without waiting for the funding transaction to
+// confirm. The confirmed ShortChannelID is recorded later on in
+// waitForZeroConfChannel.
+func (f *Manager) handleZeroConfOpen(completeChan *channeldb.OpenChannel) error {
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := completeChan.ChanID()
+	baseScid := completeChan.ShortChannelID
+
+	// The alias the channel was created with acts as its base SCID, so
+	// we'll map it to itself to make it known to the rest of the system.
+	err := f.cfg.AliasManager.AddLocalAlias(baseScid, baseScid)
+	if err != nil {
+		return fmt.Errorf("unable to add local alias: %v", err)
+	}
+
+	err = f.saveChannelOpeningState(&fundingPoint, markedOpen, &baseScid)
+	if err != nil {
+		return fmt.Errorf("error setting zero-conf channel state to "+
+			"markedOpen: %v", err)
+	}
+
+	err = completeChan.MarkAsOpen(baseScid)
+	if err != nil {
+		return fmt.Errorf("error setting zero-conf channel pending "+
+			"flag to false: %v", err)
+	}
+
+	log.Debugf("Zero-conf ChannelID(%v) is now open (alias=%v)", chanID,
+		baseScid)
+
+	// Inform the ChannelNotifier that the channel has transitioned from
+	// pending open to open.
+	f.cfg.NotifyOpenChannelEvent(fundingPoint)
+
+	// Close the discoverySignal channel, allowing the funding locked
+	// message of the peer to be processed.
+	f.localDiscoveryMtx.Lock()
+	if discoverySignal, ok := f.localDiscoverySignals[chanID]; ok {
+		close(discoverySignal)
+	}
+	f.localDiscoveryMtx.Unlock()
+
+	return nil
+}
+
+// waitForZeroConfChannel waits for the funding transaction of a zero-conf
+// channel to confirm. Once it does, the channel is validated, and its
+// confirmed ShortChannelID is stored.
+func (f *Manager) waitForZeroConfChannel(
+	completeChan *channeldb.OpenChannel) (*lnwire.ShortChannelID, error) {
+
+	// If the funding transaction already confirmed, the ChainNotifier
+	// will dispatch the confirmation right away.
+	confChannel, err := f.waitForFundingWithTimeout(completeChan)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for zero-conf funding "+
+			"confirmation for ChannelPoint(%v): %v",
+			completeChan.FundingOutpoint, err)
+	}
+
+	err = f.cfg.Wallet.ValidateChannel(completeChan, confChannel.fundingTx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to validate zero-conf "+
+			"channel: %v", err)
+	}
+
+	confirmedScid := confChannel.shortChanID
+	if err := completeChan.MarkRealScid(confirmedScid); err != nil {
+		return nil, fmt.Errorf("unable to store confirmed SCID: %v",
+			err)
+	}
+
+	// The confirmed SCID is mapped to the base alias of the channel as
+	// well, so that HTLCs forwarded over it can be resolved to the
+	// channel's link, which remains indexed by the base alias.
+	err = f.cfg.AliasManager.AddLocalAlias(
+		confirmedScid, completeChan.ShortChannelID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to add confirmed SCID: %v", err)
+	}
+
+	// Now that the funding transaction confirmed, we can update its label
+	// in the same way as for regular channels.
+	if completeChan.IsInitiator && completeChan.ChanType.HasFundingTx() {
+		label := labels.MakeLabel(
+			labels.LabelTypeChannelOpen, &confirmedScid,
+		)
+
+		err = f.cfg.UpdateLabel(
+			completeChan.FundingOutpoint.Hash, label,
+		)
+		if err != nil {
+			log.Errorf("unable to update label: %v", err)
+		}
+	}
+
+	log.Infof("Zero-conf ChannelPoint(%v) confirmed: short_chan_id=%v",
+		completeChan.FundingOutpoint, confirmedScid)
+
+	return &confirmedScid, nil
+}
+
 // sendFundingLocked creates and sends the fundingLocked message.
 // This should be called after the funding transaction has been confirmed,
 // and the channelState is 'markedOpen'.
@@ -2528,7 +2837,7 @@ func (f *Manager) sendFundingLocked(
 	completeChan *channeldb.OpenChannel, channel *lnwallet.LightningChannel,
 	shortChanID *lnwire.ShortChannelID) error {
 
-	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+	chanID := completeChan.ChanID()
 
 	var peerKey [33]byte
 	copy(peerKey[:], completeChan.IdentityPub.SerializeCompressed())
@@ -2543,6 +2852,16 @@ func (f *Manager) sendFundingLocked(
 	}
 	fundingLockedMsg := lnwire.NewFundingLocked(chanID, nextRevocation)
 
+	// If the channel makes use of aliases, we'll include the alias the
+	// peer should use when referring to the channel.
+	if completeChan.IsZeroConf() || completeChan.ChanType.HasScidAliasChan() {
+		alias, err := f.localAlias(*shortChanID)
+		if err != nil {
+			return err
+		}
+		fundingLockedMsg.AliasScid = &alias
+	}
+
 	// If the peer has disconnected before we reach this point, we will need
 	// to wait for him to come back online before sending the fundingLocked
 	// message. This is special for fundingLocked, since failing to send any
@@ -2581,6 +2900,34 @@ func (f *Manager) sendFundingLocked(
 	return nil
 }
 
+// localAlias returns the first alias stored for the given base SCID. If there
+// is none yet, a fresh one is allocated and stored.
+func (f *Manager) localAlias(
+	baseScid lnwire.ShortChannelID) (lnwire.ShortChannelID, error) {
+
+	// The confirmed SCID of a zero-conf channel is stored alongside its
+	// aliases, so we'll make sure to skip it.
+	for _, alias := range f.cfg.AliasManager.GetAliases(baseScid) {
+		if channeldb.IsAlias(alias) {
+			return alias, nil
+		}
+	}
+
+	alias, err := f.cfg.AliasManager.RequestAlias()
+	if err != nil {
+		return lnwire.ShortChannelID{}, fmt.Errorf("unable to "+
+			"request alias: %v", err)
+	}
+
+	err = f.cfg.AliasManager.AddLocalAlias(alias, baseScid)
+	if err != nil {
+		return lnwire.ShortChannelID{}, fmt.Errorf("unable to add "+
+			"local alias: %v", err)
+	}
+
+	return alias, nil
+}
+
 // addToRouterGraph sends a ChannelAnnouncement and a ChannelUpdate to the
 // gossiper so that the channel is added to the Router's internal graph.
 // These announcement messages are NOT broadcasted to the greater network,
@@ -2589,7 +2936,7 @@ func (f *Manager) sendFundingLocked(
 func (f *Manager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 	shortChanID *lnwire.ShortChannelID) error {
 
-	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+	chanID := completeChan.ChanID()
 
 	// We'll obtain the min HTLC value we can forward in our direction, as
 	// we'll use this value within our ChannelUpdate. This constraint is
@@ -2674,10 +3021,39 @@ func (f *Manager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	shortChanID *lnwire.ShortChannelID) error {
 
+	announceChan := completeChan.ChannelFlags&lnwire.FFAnnounceChannel != 0
+
+	// Zero-conf channels have been in use under their alias up to this
+	// point. We'll now wait for the funding transaction to confirm, and
+	// if the channel is public, replace the edge of the alias with one
+	// using the confirmed SCID, which is what will be announced.
+	if completeChan.IsZeroConf() {
+		confirmedScid, err := f.waitForZeroConfChannel(completeChan)
+		if err != nil {
+			return err
+		}
+
+		if announceChan {
+			err := f.cfg.DeleteAliasEdge(*shortChanID)
+			if err != nil {
+				return fmt.Errorf("unable to delete alias "+
+					"edge: %v", err)
+			}
+
+			err = f.addToRouterGraph(completeChan, confirmedScid)
+			if err != nil {
+				return fmt.Errorf("failed adding confirmed "+
+					"zero-conf channel to router graph: %v",
+					err)
+			}
+
+			shortChanID = confirmedScid
+		}
+	}
+
 	// If this channel is not meant to be announced to the greater network,
 	// we'll only send our NodeAnnouncement to our counterparty to ensure we
 	// don't leak any of our information.
-	announceChan := completeChan.ChannelFlags&lnwire.FFAnnounceChannel != 0
 	if !announceChan {
 		log.Debugf("Will not announce private channel %v.",
 			shortChanID.ToUint64())
@@ -2702,9 +3078,7 @@ func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 				"announcement: %v", err)
 		}
 
-		chanID := lnwire.NewChanIDFromOutPoint(
-			&completeChan.FundingOutpoint,
-		)
+		chanID := completeChan.ChanID()
 		pubKey := peer.PubKey()
 		log.Debugf("Sending our NodeAnnouncement for "+
 			"ChannelID(%v) to %x", chanID, pubKey)
@@ -2768,7 +3142,7 @@ func (f *Manager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 		}
 
 		fundingPoint := completeChan.FundingOutpoint
-		chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+		chanID := completeChan.ChanID()
 
 		log.Infof("Announcing ChannelPoint(%v), short_chan_id=%v",
 			&fundingPoint, shortChanID)
@@ -2867,6 +3241,24 @@ func (f *Manager) handleFundingLocked(peer lnpeer.Peer,
 		return
 	}
 
+	// If the peer included an alias, we'll store it so we can use it
+	// whenever we refer to the channel in messages to them, or in the
+	// routing hints of our invoices.
+	if msg.AliasScid != nil {
+		// The alias store is keyed by the outpoint derived channel
+		// ID regardless of the channel type.
+		aliasChanID := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		err := f.cfg.AliasManager.PutPeerAlias(
+			aliasChanID, *msg.AliasScid,
+		)
+		if err != nil {
+			log.Errorf("unable to store peer alias: %v", err)
+			return
+		}
+	}
+
 	// The funding locked message contains the next commitment point we'll
 	// need to create the next commitment state for the remote party. So
 	// we'll insert that into the channel now before passing it along to
@@ -3281,6 +3673,23 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		return
 	}
 
+	// The option-scid-alias channel type is only valid for private
+	// channels, as public ones are announced under their confirmed SCID.
+	zeroConf, scidAlias := aliasChanTypeFlags(chanType)
+	if scidAlias && !msg.Private {
+		msg.Err <- errors.New("option-scid-alias is only supported " +
+			"for private channels")
+		return
+	}
+
+	if msg.DualFund {
+		err := validateDualFundRequest(msg, zeroConf, commitType)
+		if err != nil {
+			msg.Err <- err
+			return
+		}
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -3313,6 +3722,24 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		MinConfs:         msg.MinConfs,
 		CommitType:       commitType,
 		ChanFunder:       msg.ChanFunder,
+		ZeroConf:         zeroConf,
+		OptionScidAlias:  scidAlias,
+	}
+
+	// The funding transaction of a dual funded channel is constructed
+	// together with the remote party, and is locked to the current height
+	// to discourage fee sniping.
+	if msg.DualFund {
+		_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+		if err != nil {
+			msg.Err <- err
+			return
+		}
+
+		req.DualFund = true
+		req.Initiator = true
+		req.LockTime = uint32(bestHeight)
+		req.LocalNodeID = f.cfg.IDKey
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -3321,6 +3748,23 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		return
 	}
 
+	// As zero-conf channels are used before their funding transaction
+	// confirms, they'll be referred to by an alias until then.
+	if zeroConf {
+		alias, err := f.cfg.AliasManager.RequestAlias()
+		if err != nil {
+			if cancelErr := reservation.Cancel(); cancelErr != nil {
+				log.Errorf("unable to cancel reservation: %v",
+					cancelErr)
+			}
+
+			msg.Err <- err
+			return
+		}
+
+		reservation.AddAlias(alias)
+	}
+
 	// Set our upfront shutdown address in the existing reservation.
 	reservation.SetOurUpfrontShutdown(shutdown)
 
@@ -3431,7 +3875,17 @@ func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
 		ChannelType:           chanType,
 		LeaseExpiry:           leaseExpiry,
 	}
-	if err := msg.Peer.SendMessage(true, &fundingOpen); err != nil {
+
+	// Dual funded channels are proposed with an open_channel2 message
+	// instead, which carries mostly the same fields.
+	var openMsg lnwire.Message = &fundingOpen
+	if msg.DualFund {
+		openMsg = newOpenChannel2(
+			&fundingOpen, reservation, msg.FundingFeePerKw,
+			req.LockTime,
+		)
+	}
+	if err := msg.Peer.SendMessage(true, openMsg); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %v",
 			err)
 		log.Errorf(e.Error())
@@ -3457,6 +3911,15 @@ func (f *Manager) handleErrorMsg(peer lnpeer.Peer,
 	chanID := msg.ChanID
 	peerKey := peer.IdentityKey()
 
+	// If the error refers to a dual funded channel by its permanent
+	// channel ID, we'll map it to the pending channel ID.
+	f.resMtx.Lock()
+	if pendingChanID, ok := f.signedReservations[chanID]; ok {
+		delete(f.signedReservations, chanID)
+		chanID = pendingChanID
+	}
+	f.resMtx.Unlock()
+
 	// First, we'll attempt to retrieve and cancel the funding workflow
 	// that this error was tied to. If we're unable to do so, then we'll
 	// exit early as this was an unwarranted error.
@@ -3566,6 +4029,17 @@ func (f *Manager) cancelReservationCtx(peerKey *bronec.PublicKey,
 	if len(nodeReservations) == 0 {
 		delete(f.activeReservations, peerIDKey)
 	}
+
+	// Dual funded reservations may also be referred to by their permanent
+	// channel ID, which we'll no longer map to the canceled reservation.
+	if ctx.reservation.IsDualFunded() {
+		for chanID, id := range f.signedReservations {
+			if id == pendingChanID {
+				delete(f.signedReservations, chanID)
+			}
+		}
+	}
+
 	return ctx, nil
 }
 
@@ -3622,8 +4096,16 @@ func (f *Manager) IsPendingChannel(pendingChanID [32]byte,
 
 	peerIDKey := newSerializedKey(peer.IdentityKey())
 	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	// Dual funded channels are referred to by their permanent channel ID
+	// while they're still being funded, so we'll map it to the pending
+	// channel ID first.
+	if id, ok := f.signedReservations[pendingChanID]; ok {
+		pendingChanID = id
+	}
+
 	_, ok := f.activeReservations[peerIDKey][pendingChanID]
-	f.resMtx.RUnlock()
 
 	return ok
 }
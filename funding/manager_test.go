@@ -197,6 +197,7 @@ type testNode struct {
 	mockChanEvent   *mockChanEvent
 	testDir         string
 	shutdownChannel chan struct{}
+	localFeatures   []lnwire.FeatureBit
 	remoteFeatures  []lnwire.FeatureBit
 
 	remotePeer  *testNode
@@ -232,7 +233,9 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 }
 
 func (n *testNode) LocalFeatures() *lnwire.FeatureVector {
-	return lnwire.NewFeatureVector(nil, nil)
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(n.localFeatures...), nil,
+	)
 }
 
 func (n *testNode) RemoteFeatures() *lnwire.FeatureVector {
@@ -339,6 +342,11 @@ func createTestFundingManager(t *testing.T, privKey *bronec.PrivateKey,
 
 	cdb := fullDB.ChannelStateDB()
 
+	aliasStore, err := channeldb.NewAliasStore(fullDB)
+	if err != nil {
+		return nil, err
+	}
+
 	keyRing := &mock.SecretKeyRing{
 		RootKey: alicePrivKey,
 	}
@@ -450,6 +458,10 @@ func createTestFundingManager(t *testing.T, privKey *bronec.PrivateKey,
 		OpenChannelPredicate:          chainedAcceptor,
 		NotifyPendingOpenChannelEvent: evt.NotifyPendingOpenChannelEvent,
 		RegisteredChains:              chainreg.NewChainRegistry(),
+		AliasManager:                  aliasStore,
+		DeleteAliasEdge: func(lnwire.ShortChannelID) error {
+			return nil
+		},
 	}
 
 	for _, op := range options {
@@ -554,6 +566,8 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
 		ReservationTimeout:    oldCfg.ReservationTimeout,
 		OpenChannelPredicate:  chainedAcceptor,
+		AliasManager:          oldCfg.AliasManager,
+		DeleteAliasEdge:       oldCfg.DeleteAliasEdge,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -831,6 +845,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		sentMsg, ok = msg.(*lnwire.FundingSigned)
 	case "FundingLocked":
 		sentMsg, ok = msg.(*lnwire.FundingLocked)
+	case "NodeAnnouncement":
+		sentMsg, ok = msg.(*lnwire.NodeAnnouncement)
 	case "Error":
 		sentMsg, ok = msg.(*lnwire.Error)
 	default:
@@ -2583,6 +2599,173 @@ func TestFundingManagerPrivateRestart(t *testing.T) {
 	assertNoChannelState(t, alice, bob, fundingOutPoint)
 }
 
+// zeroConfAcceptor is a channel acceptor that accepts all channels as
+// zero-conf channels.
+type zeroConfAcceptor struct{}
+
+// Accept accepts the channel, opting into zero-conf.
+func (z *zeroConfAcceptor) Accept(
+	*chanacceptor.ChannelAcceptRequest) *chanacceptor.ChannelAcceptResponse {
+
+	return &chanacceptor.ChannelAcceptResponse{
+		ZeroConf: true,
+	}
+}
+
+// TestFundingManagerZeroConf tests that a private zero-conf channel is usable
+// right after the funding transaction has been published, that both sides
+// exchange their aliases, and that the confirmed SCID is recorded once the
+// funding transaction confirms.
+func TestFundingManagerZeroConf(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, func(cfg *Config) {
+		cfg.OpenChannelPredicate = &zeroConfAcceptor{}
+	})
+	defer tearDownFundingManagers(t, alice, bob)
+
+	features := []lnwire.FeatureBit{
+		lnwire.StaticRemoteKeyOptional,
+		lnwire.ExplicitChannelTypeOptional,
+		lnwire.ScidAliasOptional,
+		lnwire.ZeroConfOptional,
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.localFeatures = features
+		node.remoteFeatures = features
+	}
+
+	channelType := lnwire.ChannelType(*lnwire.NewRawFeatureVector(
+		lnwire.StaticRemoteKeyRequired, lnwire.ScidAliasRequired,
+		lnwire.ZeroConfRequired,
+	))
+
+	// We will consume the channel updates as we go, so no buffering is
+	// needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	localAmt := bronutil.Amount(500000)
+	initReq := &InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		LocalFundingAmt: localAmt,
+		FundingFeePerKw: 1000,
+		Private:         true,
+		ChannelType:     &channelType,
+		Updates:         updateChan,
+		Err:             make(chan error, 1),
+	}
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+
+	openChannelReq := expectOpenChannelMsg(t, alice.msgChan)
+	bob.fundingMgr.ProcessFundingMsg(openChannelReq, alice)
+
+	// As Bob accepts the zero-conf channel, he shouldn't require any
+	// confirmations.
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	require.Zero(t, acceptChannelResponse.MinAcceptDepth)
+
+	alice.fundingMgr.ProcessFundingMsg(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.ProcessFundingMsg(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.ProcessFundingMsg(fundingSigned, bob)
+
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	var fundingTx *wire.MsgTx
+	select {
+	case fundingTx = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	fundingOutPoint := &wire.OutPoint{
+		Hash:  fundingTx.TxHash(),
+		Index: 0,
+	}
+
+	for _, node := range []*testNode{alice, bob} {
+		select {
+		case <-node.mockChanEvent.pendingOpenEvent:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("pending channel event not sent")
+		}
+	}
+
+	// Without the funding transaction confirming, both sides should mark
+	// the channel as open and send FundingLocked including their alias.
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	require.NotNil(t, fundingLockedAlice.AliasScid)
+	require.True(t, channeldb.IsAlias(*fundingLockedAlice.AliasScid))
+	require.NotNil(t, fundingLockedBob.AliasScid)
+	require.True(t, channeldb.IsAlias(*fundingLockedBob.AliasScid))
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+	assertChannelAnnouncements(t, alice, bob, localAmt, nil, nil)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.ProcessFundingMsg(fundingLockedBob, bob)
+	bob.fundingMgr.ProcessFundingMsg(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	// Both sides should now know the alias of their peer.
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	aliceStore := alice.fundingMgr.cfg.AliasManager.(*channeldb.AliasStore)
+	peerAlias, err := aliceStore.GetPeerAlias(chanID)
+	require.NoError(t, err)
+	require.Equal(t, *fundingLockedBob.AliasScid, peerAlias)
+
+	// Once the funding transaction confirms, the confirmed SCID should be
+	// stored for the channel.
+	confirmedScid := lnwire.ShortChannelID{
+		BlockHeight: fundingBroadcastHeight + 1,
+		TxIndex:     2,
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{
+			Tx:          fundingTx,
+			BlockHeight: confirmedScid.BlockHeight,
+			TxIndex:     confirmedScid.TxIndex,
+		}
+	}
+
+	// As the channel is private, each side sends its node announcement
+	// to the other.
+	assertFundingMsgSent(t, alice.msgChan, "NodeAnnouncement")
+	assertFundingMsgSent(t, bob.msgChan, "NodeAnnouncement")
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+
+	for _, node := range []*testNode{alice, bob} {
+		channel, err := node.fundingMgr.cfg.FindChannel(chanID)
+		require.NoError(t, err)
+		require.True(t, channel.IsZeroConf())
+		require.True(t, channel.ZeroConfConfirmed())
+		require.Equal(t, confirmedScid, channel.ZeroConfRealScid())
+	}
+}
+
 // TestFundingManagerCustomChannelParameters checks that custom requirements we
 // specify during the channel funding flow is preserved correcly on both sides.
 func TestFundingManagerCustomChannelParameters(t *testing.T) {
@@ -3280,6 +3463,177 @@ func TestFundingManagerFundAll(t *testing.T) {
 	}
 }
 
+// TestFundingManagerDualFund tests that a dual funded channel is negotiated
+// with the open_channel2 flow, and that the initiator broadcasts the funding
+// transaction that was constructed interactively once both parties exchanged
+// their signatures.
+func TestFundingManagerDualFund(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	features := []lnwire.FeatureBit{
+		lnwire.StaticRemoteKeyOptional,
+		lnwire.DualFundOptional,
+	}
+	for _, node := range []*testNode{alice, bob} {
+		node.localFeatures = features
+		node.remoteFeatures = features
+	}
+
+	// Bob needs the transaction that Alice's input spends to verify its
+	// amount, so we'll give Alice's wallet a coin along with it. The coin
+	// only covers the channel and the fee, as the change address of the
+	// mock wallet isn't a P2WKH address.
+	localAmt := bronutil.Amount(500000)
+	coinAmt := localAmt + 500
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxOut(&wire.TxOut{
+		Value:    int64(coinAmt),
+		PkScript: mock.CoinPkScript,
+	})
+	wc := alice.fundingMgr.cfg.Wallet.WalletController
+	wc.(*mock.WalletController).Utxos = []*lnwallet.Utxo{{
+		AddressType: lnwallet.WitnessPubKey,
+		Value:       coinAmt,
+		PkScript:    mock.CoinPkScript,
+		OutPoint: wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: 0,
+		},
+		PrevTx: prevTx,
+	}}
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate, 1)
+	initReq := &InitFundingMsg{
+		Peer:            bob,
+		TargetPubkey:    bob.privKey.PubKey(),
+		ChainHash:       *fundingNetParams.GenesisHash,
+		LocalFundingAmt: localAmt,
+		FundingFeePerKw: 1000,
+		Private:         true,
+		DualFund:        true,
+		Updates:         updateChan,
+		Err:             make(chan error, 1),
+	}
+	alice.fundingMgr.InitFundingWorkflow(initReq)
+
+	// Alice should propose the channel with an open_channel2 message.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.Err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel2 message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel2)
+	if !ok {
+		t.Fatalf("expected OpenChannel2 to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+	if openChannelReq.FundingAmount != localAmt {
+		t.Fatalf("expected funding amount %v, got %v", localAmt,
+			openChannelReq.FundingAmount)
+	}
+
+	// Relay the messages between both parties until Alice broadcasts the
+	// funding transaction. As Bob doesn't contribute any inputs, he sends
+	// his signatures for the funding transaction first.
+	bob.fundingMgr.ProcessFundingMsg(openChannelReq, alice)
+
+	var (
+		fundingTx       *wire.MsgTx
+		bobSentTxSigs   bool
+		aliceSentTxSigs bool
+	)
+	for fundingTx == nil {
+		select {
+		case msg := <-alice.msgChan:
+			if _, ok := msg.(*lnwire.Error); ok {
+				t.Fatalf("alice sent error: %v", msg)
+			}
+			if _, ok := msg.(*lnwire.TxSignatures); ok {
+				aliceSentTxSigs = true
+			}
+			bob.fundingMgr.ProcessFundingMsg(msg, alice)
+
+		case msg := <-bob.msgChan:
+			if _, ok := msg.(*lnwire.Error); ok {
+				t.Fatalf("bob sent error: %v", msg)
+			}
+			if _, ok := msg.(*lnwire.TxSignatures); ok {
+				if aliceSentTxSigs {
+					t.Fatalf("bob sent tx_signatures " +
+						"after alice")
+				}
+				bobSentTxSigs = true
+			}
+			alice.fundingMgr.ProcessFundingMsg(msg, bob)
+
+		case fundingTx = <-alice.publTxChan:
+
+		case err := <-initReq.Err:
+			t.Fatalf("error during funding workflow: %v", err)
+
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not publish funding tx")
+		}
+	}
+	if !bobSentTxSigs {
+		t.Fatalf("bob didn't send tx_signatures")
+	}
+
+	// The funding transaction must spend Alice's coin, and commit to the
+	// full capacity of the channel.
+	if len(fundingTx.TxIn) != 1 ||
+		fundingTx.TxIn[0].PreviousOutPoint.Hash != prevTx.TxHash() {
+
+		t.Fatalf("funding tx doesn't spend alice's coin")
+	}
+	if len(fundingTx.TxIn[0].Witness) == 0 {
+		t.Fatalf("funding tx isn't signed")
+	}
+	if len(fundingTx.TxOut) != 1 ||
+		fundingTx.TxOut[0].Value != int64(localAmt) {
+
+		t.Fatalf("funding tx has no output for the channel capacity")
+	}
+
+	// Alice should notify the caller that the channel is pending, and
+	// track it under its permanent channel ID.
+	var pendingUpdate *lnrpc.OpenStatusUpdate
+	select {
+	case pendingUpdate = <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+	_, ok = pendingUpdate.Update.(*lnrpc.OpenStatusUpdate_ChanPending)
+	if !ok {
+		t.Fatalf("expected ChanPending update, got %T",
+			pendingUpdate.Update)
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingChannelsBecomes(t, alice, 1)
+
+	channels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchPendingChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch pending channels: %v", err)
+	}
+	if !channels[0].ChanType.IsDualFunder() {
+		t.Fatalf("expected dual funded channel")
+	}
+	if channels[0].ChanID() == lnwire.NewChanIDFromOutPoint(
+		&channels[0].FundingOutpoint,
+	) {
+
+		t.Fatalf("expected v2 channel ID")
+	}
+}
+
 // TestGetUpfrontShutdown tests different combinations of inputs for getting a
 // shutdown script. It varies whether the peer has the feature set, whether
 // the user has provided a script and our local configuration to test that
@@ -200,6 +200,15 @@ func (c *PaymentCircuit) Decode(r io.Reader) error {
 		// Sphinx encrypter was used as this is a forwarded HTLC.
 		c.ErrorEncrypter = hop.NewSphinxErrorEncrypter()
 
+	case hop.EncrypterTypeIntroduction:
+		// We were the introduction node of a blinded route.
+		c.ErrorEncrypter = hop.NewIntroductionErrorEncrypter()
+
+	case hop.EncrypterTypeRelaying:
+		// We were a hop after the introduction node of a blinded
+		// route.
+		c.ErrorEncrypter = hop.NewRelayingErrorEncrypter()
+
 	case hop.EncrypterTypeMock:
 		// Test encrypter.
 		c.ErrorEncrypter = NewMockObfuscator()
@@ -33,6 +33,11 @@ var (
 	// testExtracter is a precomputed extraction of testEphemeralKey, using
 	// the sphinxPrivKey.
 	testExtracter *hop.SphinxErrorEncrypter
+
+	// testIntroExtracter and testRelayExtracter wrap testExtracter as the
+	// error encrypters of HTLCs within a blinded route.
+	testIntroExtracter = hop.NewIntroductionErrorEncrypter()
+	testRelayExtracter = hop.NewRelayingErrorEncrypter()
 )
 
 func init() {
@@ -75,6 +80,10 @@ func initTestExtracter() {
 	}
 
 	testExtracter = sphinxExtracter
+	testIntroExtracter.SphinxErrorEncrypter = sphinxExtracter
+	testIntroExtracter.OnionSHA256 = hash1
+	testRelayExtracter.SphinxErrorEncrypter = sphinxExtracter
+	testRelayExtracter.OnionSHA256 = hash2
 
 	// We also set this error extracter on startup, otherwise it will be nil
 	// at compile-time.
@@ -93,7 +102,9 @@ func newOnionProcessor(t *testing.T) *hop.OnionProcessor {
 		t.Fatalf("unable to start sphinx router: %v", err)
 	}
 
-	return hop.NewOnionProcessor(sphinxRouter)
+	return hop.NewOnionProcessor(
+		sphinxRouter, &keychain.PrivKeyECDH{PrivKey: sphinxPrivKey},
+	)
 }
 
 // newCircuitMap creates a new htlcswitch.CircuitMap using a temp db and a
@@ -163,6 +174,22 @@ var halfCircuitTests = []struct {
 		// repopulate this encrypter.
 		encrypter: testExtracter,
 	},
+	{
+		hash:      hash1,
+		inValue:   10000,
+		outValue:  9000,
+		chanID:    lnwire.NewShortChanIDFromInt(4),
+		htlcID:    4,
+		encrypter: testIntroExtracter,
+	},
+	{
+		hash:      hash2,
+		inValue:   10000,
+		outValue:  9000,
+		chanID:    lnwire.NewShortChanIDFromInt(5),
+		htlcID:    5,
+		encrypter: testRelayExtracter,
+	},
 }
 
 // TestHalfCircuitSerialization checks that the half circuits can be properly
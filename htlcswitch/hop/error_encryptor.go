@@ -2,6 +2,7 @@ package hop
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
 
@@ -25,6 +26,16 @@ const (
 
 	// EncrypterTypeMock is used to identify a mock obfuscator instance.
 	EncrypterTypeMock = 2
+
+	// EncrypterTypeIntroduction is used to identify a sphinx onion error
+	// encrypter for HTLCs that we received as the introduction node of a
+	// blinded route.
+	EncrypterTypeIntroduction = 3
+
+	// EncrypterTypeRelaying is used to identify a sphinx onion error
+	// encrypter for HTLCs that we received as a hop after the
+	// introduction node of a blinded route.
+	EncrypterTypeRelaying = 4
 )
 
 // ErrorEncrypterExtracter defines a function signature that extracts an
@@ -203,3 +214,132 @@ func (s *SphinxErrorEncrypter) Reextract(
 // A compile time check to ensure SphinxErrorEncrypter implements the
 // ErrorEncrypter interface.
 var _ ErrorEncrypter = (*SphinxErrorEncrypter)(nil)
+
+// blindedErrorEncrypter is a sphinx error encrypter for HTLCs that we received
+// within a blinded route. Any failure that it encrypts is replaced with an
+// invalid_onion_blinding failure, so that the sender can't learn where in the
+// route the payment failed or why.
+type blindedErrorEncrypter struct {
+	*SphinxErrorEncrypter
+
+	// OnionSHA256 is the hash of the onion blob of the incoming HTLC.
+	OnionSHA256 [sha256.Size]byte
+}
+
+// EncryptFirstHop encrypts an invalid_onion_blinding failure in place of the
+// given failure.
+func (b *blindedErrorEncrypter) EncryptFirstHop(
+	_ lnwire.FailureMessage) (lnwire.OpaqueReason, error) {
+
+	return b.SphinxErrorEncrypter.EncryptFirstHop(
+		&lnwire.FailInvalidBlinding{OnionSHA256: b.OnionSHA256},
+	)
+}
+
+// EncryptMalformedError encrypts an invalid_onion_blinding failure in place of
+// the given malformed failure.
+func (b *blindedErrorEncrypter) EncryptMalformedError(
+	_ lnwire.OpaqueReason) lnwire.OpaqueReason {
+
+	return b.encryptInvalidBlinding()
+}
+
+// IntermediateEncrypt encrypts an invalid_onion_blinding failure in place of
+// the failure that was returned by the next hop in the blinded route.
+func (b *blindedErrorEncrypter) IntermediateEncrypt(
+	_ lnwire.OpaqueReason) lnwire.OpaqueReason {
+
+	return b.encryptInvalidBlinding()
+}
+
+// encryptInvalidBlinding encrypts an invalid_onion_blinding failure as if it
+// originated at our node.
+func (b *blindedErrorEncrypter) encryptInvalidBlinding() lnwire.OpaqueReason {
+	var (
+		failure = &lnwire.FailInvalidBlinding{
+			OnionSHA256: b.OnionSHA256,
+		}
+		buf bytes.Buffer
+	)
+
+	// Encoding a failure with a fixed size payload can't fail.
+	_ = lnwire.EncodeFailure(&buf, failure, 0)
+
+	return b.EncryptError(true, buf.Bytes())
+}
+
+// Encode serializes the error encrypter's ephemeral public key followed by the
+// hash of the incoming onion to the provided io.Writer.
+func (b *blindedErrorEncrypter) Encode(w io.Writer) error {
+	if err := b.SphinxErrorEncrypter.Encode(w); err != nil {
+		return err
+	}
+
+	_, err := w.Write(b.OnionSHA256[:])
+	return err
+}
+
+// Decode reconstructs the error encrypter's ephemeral public key and the hash
+// of the incoming onion from the provided io.Reader.
+func (b *blindedErrorEncrypter) Decode(r io.Reader) error {
+	if err := b.SphinxErrorEncrypter.Decode(r); err != nil {
+		return err
+	}
+
+	_, err := io.ReadFull(r, b.OnionSHA256[:])
+	return err
+}
+
+// IntroductionErrorEncrypter is the error encrypter of HTLCs that we received
+// as the introduction node of a blinded route. Failures are returned to the
+// sender as an encrypted invalid_onion_blinding failure.
+type IntroductionErrorEncrypter struct {
+	blindedErrorEncrypter
+}
+
+// NewIntroductionErrorEncrypter initializes a blank introduction error
+// encrypter, that should be used to deserialize an encoded
+// IntroductionErrorEncrypter.
+func NewIntroductionErrorEncrypter() *IntroductionErrorEncrypter {
+	return &IntroductionErrorEncrypter{
+		blindedErrorEncrypter: blindedErrorEncrypter{
+			SphinxErrorEncrypter: NewSphinxErrorEncrypter(),
+		},
+	}
+}
+
+// Type returns the identifier for an introduction error encrypter.
+func (i *IntroductionErrorEncrypter) Type() EncrypterType {
+	return EncrypterTypeIntroduction
+}
+
+// RelayingErrorEncrypter is the error encrypter of HTLCs that we received as a
+// hop after the introduction node of a blinded route. The link fails these
+// HTLCs with an update_fail_malformed_htlc carrying invalid_onion_blinding, so
+// that our predecessor in the route replaces the failure as well. The
+// encrypted failures it produces are only a fallback.
+type RelayingErrorEncrypter struct {
+	blindedErrorEncrypter
+}
+
+// NewRelayingErrorEncrypter initializes a blank relaying error encrypter, that
+// should be used to deserialize an encoded RelayingErrorEncrypter.
+func NewRelayingErrorEncrypter() *RelayingErrorEncrypter {
+	return &RelayingErrorEncrypter{
+		blindedErrorEncrypter: blindedErrorEncrypter{
+			SphinxErrorEncrypter: NewSphinxErrorEncrypter(),
+		},
+	}
+}
+
+// Type returns the identifier for a relaying error encrypter.
+func (r *RelayingErrorEncrypter) Type() EncrypterType {
+	return EncrypterTypeRelaying
+}
+
+// A compile time check to ensure the blinded error encrypters implement the
+// ErrorEncrypter interface.
+var (
+	_ ErrorEncrypter = (*IntroductionErrorEncrypter)(nil)
+	_ ErrorEncrypter = (*RelayingErrorEncrypter)(nil)
+)
@@ -2,6 +2,7 @@ package hop
 
 import (
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/bronec"
 )
 
 // ForwardingInfo contains all the information that is necessary to forward and
@@ -26,4 +27,8 @@ type ForwardingInfo struct {
 	// OutgoingCTLV is the specified value of the CTLV timelock to be used
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
+
+	// NextBlinding is the blinding point that should be sent along with
+	// the outgoing HTLC if it is forwarded within a blinded route.
+	NextBlinding *bronec.PublicKey
 }
@@ -2,15 +2,39 @@ package hop
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
 
+	"github.com/brsuite/broln/keychain"
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/brond/bronec"
 	sphinx "github.com/brsuite/lightning-onion"
 )
 
+var (
+	// ErrBlindingPointConflict is returned when a blinding point is
+	// provided both in the update_add_htlc message and in the onion
+	// payload of an HTLC.
+	ErrBlindingPointConflict = errors.New("blinding point provided in " +
+		"both update_add_htlc and onion payload")
+
+	// ErrNoBlindingPoint is returned when the onion payload of an HTLC
+	// contains encrypted data, but no blinding point was provided to
+	// decrypt it.
+	ErrNoBlindingPoint = errors.New("encrypted data provided without " +
+		"blinding point")
+
+	// ErrUnexpectedBlindingPoint is returned when an HTLC carries a
+	// blinding point, but its onion payload contains no encrypted data.
+	ErrUnexpectedBlindingPoint = errors.New("blinding point provided " +
+		"without encrypted data")
+)
+
 // Iterator is an interface that abstracts away the routing information
 // included in HTLC's which includes the entirety of the payment path of an
 // HTLC. This interface provides two basic method which carry out: how to
@@ -47,19 +71,199 @@ type sphinxHopIterator struct {
 	// includes the information required to properly forward the packet to
 	// the next hop.
 	processedPacket *sphinx.ProcessedPacket
+
+	// blindingKit contains the information required to process the
+	// payload of a hop within a blinded route.
+	blindingKit blindingKit
 }
 
 // makeSphinxHopIterator converts a processed packet returned from a sphinx
 // router and converts it into an hop iterator for usage in the link.
 func makeSphinxHopIterator(ogPacket *sphinx.OnionPacket,
-	packet *sphinx.ProcessedPacket) *sphinxHopIterator {
+	packet *sphinx.ProcessedPacket, kit blindingKit) *sphinxHopIterator {
 
 	return &sphinxHopIterator{
 		ogPacket:        ogPacket,
 		processedPacket: packet,
+		blindingKit:     kit,
 	}
 }
 
+// blindingKit contains the information required to process the payload of a
+// hop within a blinded route, which is only known to the link that received
+// the HTLC.
+type blindingKit struct {
+	// nodeKey is the key of our node, which is used to decrypt the route
+	// data that the creator of a blinded route provided for us.
+	nodeKey keychain.SingleKeyECDH
+
+	// updateAddBlinding is the blinding point that was sent along with
+	// the incoming HTLC, if any. It is only set for HTLCs that we receive
+	// as a hop after the introduction node of a blinded route.
+	updateAddBlinding *bronec.PublicKey
+
+	// incomingAmount is the amount of the incoming HTLC.
+	incomingAmount lnwire.MilliBronees
+
+	// incomingCltv is the expiry height of the incoming HTLC.
+	incomingCltv uint32
+
+	// onionSHA is the hash of the onion blob of the incoming HTLC, which
+	// is returned in invalid_onion_blinding failures.
+	onionSHA [sha256.Size]byte
+}
+
+// processPayload decrypts the route data of a hop within a blinded route and
+// populates the forwarding information of the payload with it. For
+// intermediate hops, the amount and expiry to forward are derived from the
+// relay parameters set by the creator of the route. For the final hop, the
+// path ID is used as the payment address of an MPP record so the payment can
+// be matched to its invoice.
+func (b *blindingKit) processPayload(payload *Payload, isFinalHop bool) error {
+	blindingPoint := b.updateAddBlinding
+	if payload.blindingPoint != nil {
+		if blindingPoint != nil {
+			return ErrBlindingPointConflict
+		}
+		blindingPoint = payload.blindingPoint
+	}
+	if blindingPoint == nil {
+		return ErrNoBlindingPoint
+	}
+
+	if b.nodeKey == nil {
+		return errors.New("unable to decrypt blinded route data")
+	}
+
+	plainText, err := blindedpath.DecryptBlindedHopData(
+		b.nodeKey, blindingPoint, payload.encryptedData,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt blinded route data: %v",
+			err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(plainText),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode blinded route data: %v",
+			err)
+	}
+
+	// Ensure that the incoming HTLC satisfies the constraints that the
+	// creator of the route placed on it.
+	if c := routeData.Constraints; c != nil {
+		if b.incomingCltv > c.MaxCltvExpiry {
+			return fmt.Errorf("incoming expiry %v exceeds blinded "+
+				"route max expiry %v", b.incomingCltv,
+				c.MaxCltvExpiry)
+		}
+
+		if b.incomingAmount < c.HtlcMinimumMsat {
+			return fmt.Errorf("incoming amount %v below blinded "+
+				"route minimum %v", b.incomingAmount,
+				c.HtlcMinimumMsat)
+		}
+	}
+
+	// If the route data doesn't tell us where to forward the HTLC, we are
+	// the recipient of the payment.
+	if routeData.ShortChannelID == nil {
+		if !isFinalHop {
+			return errors.New("blinded route data for final hop " +
+				"received by intermediate hop")
+		}
+
+		if len(routeData.PathID) != 32 {
+			return fmt.Errorf("invalid blinded path id length: %v",
+				len(routeData.PathID))
+		}
+
+		// The sender can't know the expiry delta that we require, as
+		// it is hidden in the aggregated relay parameters of the
+		// route. We'll only make sure that the HTLC doesn't expire
+		// before the height the sender committed to in the onion.
+		if b.incomingCltv < payload.FwdInfo.OutgoingCTLV {
+			return fmt.Errorf("incoming expiry %v below payload "+
+				"expiry %v", b.incomingCltv,
+				payload.FwdInfo.OutgoingCTLV)
+		}
+		payload.FwdInfo.OutgoingCTLV = b.incomingCltv
+
+		var pathID [32]byte
+		copy(pathID[:], routeData.PathID)
+		payload.MPP = record.NewMPP(payload.totalAmtMsat, pathID)
+
+		return nil
+	}
+
+	if isFinalHop {
+		return errors.New("blinded route data for intermediate hop " +
+			"received by final hop")
+	}
+
+	relayInfo := routeData.RelayInfo
+	if relayInfo == nil {
+		return errors.New("blinded route data missing relay info")
+	}
+
+	amtToForward, err := blindedForwardAmount(b.incomingAmount, relayInfo)
+	if err != nil {
+		return err
+	}
+
+	if b.incomingCltv < uint32(relayInfo.CltvExpiryDelta) {
+		return fmt.Errorf("incoming expiry %v below blinded route "+
+			"expiry delta %v", b.incomingCltv,
+			relayInfo.CltvExpiryDelta)
+	}
+
+	nextBlinding := routeData.NextBlindingOverride
+	if nextBlinding == nil {
+		nextBlinding, err = blindedpath.NextBlindingPoint(
+			b.nodeKey, blindingPoint,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload.FwdInfo = ForwardingInfo{
+		Network:         BrocoinNetwork,
+		NextHop:         *routeData.ShortChannelID,
+		AmountToForward: amtToForward,
+		OutgoingCTLV: b.incomingCltv - uint32(
+			relayInfo.CltvExpiryDelta,
+		),
+		NextBlinding: nextBlinding,
+	}
+
+	return nil
+}
+
+// blindedForwardAmount computes the amount that should be forwarded by an
+// intermediate hop of a blinded route, given the amount of the incoming HTLC
+// and the relay parameters of the hop. The fee is rounded in favor of the
+// forwarding node, as specified in BOLT 04.
+func blindedForwardAmount(incoming lnwire.MilliBronees,
+	relayInfo *record.PaymentRelayInfo) (lnwire.MilliBronees, error) {
+
+	baseFee := lnwire.MilliBronees(relayInfo.BaseFee)
+	if incoming < baseFee {
+		return 0, fmt.Errorf("incoming amount %v below blinded route "+
+			"base fee %v", incoming, baseFee)
+	}
+
+	const feeRateParts = 1_000_000
+	denominator := lnwire.MilliBronees(
+		feeRateParts + uint64(relayInfo.FeeRate),
+	)
+	numerator := (incoming - baseFee) * feeRateParts
+
+	return (numerator + denominator - 1) / denominator, nil
+}
+
 // A compile time check to ensure sphinxHopIterator implements the HopIterator
 // interface.
 var _ Iterator = (*sphinxHopIterator)(nil)
@@ -79,6 +283,32 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 //
 // NOTE: Part of the HopIterator interface.
 func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
+	payload, err := r.parsePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	// Payloads that aren't part of a blinded route can be handed to the
+	// caller as is.
+	if payload.encryptedData == nil {
+		if r.blindingKit.updateAddBlinding != nil {
+			return nil, ErrUnexpectedBlindingPoint
+		}
+
+		return payload, nil
+	}
+
+	isFinalHop := r.processedPacket.Action == sphinx.ExitNode
+	err = r.blindingKit.processPayload(payload, isFinalHop)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// parsePayload decodes the raw payload of the processed packet.
+func (r *sphinxHopIterator) parsePayload() (*Payload, error) {
 	switch r.processedPacket.Payload.Type {
 
 	// If this is the legacy payload, then we'll extract the information
@@ -109,7 +339,40 @@ func (r *sphinxHopIterator) HopPayload() (*Payload, error) {
 func (r *sphinxHopIterator) ExtractErrorEncrypter(
 	extracter ErrorEncrypterExtracter) (ErrorEncrypter, lnwire.FailCode) {
 
-	return extracter(r.ogPacket.EphemeralKey)
+	encrypter, failCode := extracter(r.ogPacket.EphemeralKey)
+	if failCode != lnwire.CodeNone {
+		return nil, failCode
+	}
+
+	// Failures of HTLCs within a blinded route must not reveal where or
+	// why the payment failed, so we'll wrap the encrypter of those HTLCs
+	// to replace any failure with invalid_onion_blinding. If the HTLC
+	// carried the blinding point, we're a hop after the introduction node.
+	// Otherwise we're the introduction node if the payload carries it.
+	isIntroduction := false
+	if r.blindingKit.updateAddBlinding == nil {
+		payload, err := r.parsePayload()
+		if err != nil || payload.blindingPoint == nil {
+			return encrypter, lnwire.CodeNone
+		}
+		isIntroduction = true
+	}
+
+	sphinxEncrypter, ok := encrypter.(*SphinxErrorEncrypter)
+	if !ok {
+		log.Errorf("unexpected error encrypter type: %T", encrypter)
+		return nil, lnwire.CodeInvalidOnionKey
+	}
+
+	blinded := blindedErrorEncrypter{
+		SphinxErrorEncrypter: sphinxEncrypter,
+		OnionSHA256:          r.blindingKit.onionSHA,
+	}
+	if isIntroduction {
+		return &IntroductionErrorEncrypter{blinded}, lnwire.CodeNone
+	}
+
+	return &RelayingErrorEncrypter{blinded}, lnwire.CodeNone
 }
 
 // OnionProcessor is responsible for keeping all sphinx dependent parts inside
@@ -123,11 +386,20 @@ func (r *sphinxHopIterator) ExtractErrorEncrypter(
 // tests dependent from the sphinx internal parts.
 type OnionProcessor struct {
 	router *sphinx.Router
+
+	// nodeKey is the key of our node, which is used to process onions
+	// that were sent to us as part of a blinded route.
+	nodeKey keychain.SingleKeyECDH
 }
 
 // NewOnionProcessor creates new instance of decoder.
-func NewOnionProcessor(router *sphinx.Router) *OnionProcessor {
-	return &OnionProcessor{router}
+func NewOnionProcessor(router *sphinx.Router,
+	nodeKey keychain.SingleKeyECDH) *OnionProcessor {
+
+	return &OnionProcessor{
+		router:  router,
+		nodeKey: nodeKey,
+	}
 }
 
 // Start spins up the onion processor's sphinx router.
@@ -147,11 +419,16 @@ func (p *OnionProcessor) Stop() error {
 // DecodeHopIterator attempts to decode a valid sphinx packet from the passed io.Reader
 // instance using the rHash as the associated data when checking the relevant
 // MACs during the decoding process.
+//
+// NOTE: Onions that are sent to us as part of a blinded route can only be
+// decoded using DecodeHopIterators, as they require the blinding point of the
+// incoming HTLC.
 func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 	incomingCltv uint32) (Iterator, lnwire.FailCode) {
 
+	onionHash := sha256.New()
 	onionPkt := &sphinx.OnionPacket{}
-	if err := onionPkt.Decode(r); err != nil {
+	if err := onionPkt.Decode(io.TeeReader(r, onionHash)); err != nil {
 		switch err {
 		case sphinx.ErrInvalidOnionVersion:
 			return nil, lnwire.CodeInvalidOnionVersion
@@ -185,7 +462,13 @@ func (p *OnionProcessor) DecodeHopIterator(r io.Reader, rHash []byte,
 		}
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), lnwire.CodeNone
+	kit := blindingKit{
+		nodeKey:      p.nodeKey,
+		incomingCltv: incomingCltv,
+	}
+	copy(kit.onionSHA[:], onionHash.Sum(nil))
+
+	return makeSphinxHopIterator(onionPkt, sphinxPacket, kit), lnwire.CodeNone
 }
 
 // ReconstructHopIterator attempts to decode a valid sphinx packet from the passed io.Reader
@@ -209,16 +492,22 @@ func (p *OnionProcessor) ReconstructHopIterator(r io.Reader, rHash []byte) (
 		return nil, err
 	}
 
-	return makeSphinxHopIterator(onionPkt, sphinxPacket), nil
+	kit := blindingKit{
+		nodeKey: p.nodeKey,
+	}
+
+	return makeSphinxHopIterator(onionPkt, sphinxPacket, kit), nil
 }
 
 // DecodeHopIteratorRequest encapsulates all date necessary to process an onion
 // packet, perform sphinx replay detection, and schedule the entry for garbage
 // collection.
 type DecodeHopIteratorRequest struct {
-	OnionReader  io.Reader
-	RHash        []byte
-	IncomingCltv uint32
+	OnionReader    io.Reader
+	RHash          []byte
+	IncomingCltv   uint32
+	IncomingAmount lnwire.MilliBronees
+	BlindingPoint  *bronec.PublicKey
 }
 
 // DecodeHopIteratorResponse encapsulates the outcome of a batched sphinx onion
@@ -250,6 +539,8 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 	var (
 		batchSize = len(reqs)
 		onionPkts = make([]sphinx.OnionPacket, batchSize)
+		onionKeys = make([]*bronec.PublicKey, batchSize)
+		onionSHAs = make([][sha256.Size]byte, batchSize)
 		resps     = make([]DecodeHopIteratorResponse, batchSize)
 	)
 
@@ -258,7 +549,9 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 	decode := func(seqNum uint16, onionPkt *sphinx.OnionPacket,
 		req DecodeHopIteratorRequest) lnwire.FailCode {
 
-		err := onionPkt.Decode(req.OnionReader)
+		onionHash := sha256.New()
+		err := onionPkt.Decode(io.TeeReader(req.OnionReader, onionHash))
+		copy(onionSHAs[seqNum][:], onionHash.Sum(nil))
 		switch err {
 		case nil:
 			// success
@@ -274,6 +567,25 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			return lnwire.CodeInvalidOnionKey
 		}
 
+		// If the HTLC carries a blinding point, the onion was
+		// encrypted to our blinded node ID. We tweak its ephemeral key
+		// such that the shared secret can be derived using our real
+		// node key, but keep the original key around to derive the
+		// ephemeral key of the next hop.
+		if req.BlindingPoint != nil {
+			onionKeys[seqNum] = onionPkt.EphemeralKey
+
+			onionPkt.EphemeralKey, err = blindedpath.TweakOnionKey(
+				p.nodeKey, req.BlindingPoint,
+				onionPkt.EphemeralKey,
+			)
+			if err != nil {
+				log.Errorf("unable to tweak blinded onion "+
+					"key: %v", err)
+				return lnwire.CodeInvalidOnionKey
+			}
+		}
+
 		err = tx.ProcessOnionPacket(
 			seqNum, onionPkt, req.RHash, req.IncomingCltv,
 		)
@@ -366,9 +678,35 @@ func (p *OnionProcessor) DecodeHopIterators(id []byte,
 			continue
 		}
 
+		// The sphinx router derived the ephemeral key of the next hop
+		// from the tweaked key of a blinded onion, so we'll replace it
+		// with the one derived from the original key.
+		if onionKeys[i] != nil && packets[i].NextPacket != nil {
+			nextKey, err := blindedpath.NextOnionKey(
+				p.nodeKey, onionPkts[i].EphemeralKey,
+				onionKeys[i],
+			)
+			if err != nil {
+				log.Errorf("unable to derive next blinded "+
+					"onion key: %v", err)
+				resp.FailCode = lnwire.CodeInvalidOnionKey
+				continue
+			}
+			packets[i].NextPacket.EphemeralKey = nextKey
+		}
+
 		// Finally, construct a hop iterator from our processed sphinx
 		// packet, simultaneously caching the original onion packet.
-		resp.HopIterator = makeSphinxHopIterator(&onionPkts[i], &packets[i])
+		kit := blindingKit{
+			nodeKey:           p.nodeKey,
+			updateAddBlinding: reqs[i].BlindingPoint,
+			incomingAmount:    reqs[i].IncomingAmount,
+			incomingCltv:      reqs[i].IncomingCltv,
+			onionSHA:          onionSHAs[i],
+		}
+		resp.HopIterator = makeSphinxHopIterator(
+			&onionPkts[i], &packets[i], kit,
+		)
 	}
 
 	return resps, nil
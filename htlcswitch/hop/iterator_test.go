@@ -5,11 +5,16 @@ import (
 	"encoding/binary"
 	"testing"
 
+	"github.com/brsuite/broln/keychain"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg"
 	sphinx "github.com/brsuite/lightning-onion"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/stretchr/testify/require"
 )
 
 // TestSphinxHopIteratorForwardingInstructions tests that we're able to
@@ -99,3 +104,276 @@ func TestSphinxHopIteratorForwardingInstructions(t *testing.T) {
 		}
 	}
 }
+
+// TestSphinxHopIteratorBlindedPayload tests that the payloads of hops within a
+// blinded route are decrypted, and that the forwarding information of each hop
+// is derived from the route data that was provided for it.
+func TestSphinxHopIteratorBlindedPayload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		scid   = lnwire.NewShortChanIDFromInt(1234)
+		pathID = bytes.Repeat([]byte{0x01}, 32)
+		relay  = &record.PaymentRelayInfo{
+			CltvExpiryDelta: 40,
+			FeeRate:         1000,
+			BaseFee:         1000,
+		}
+		constraints = &record.PaymentConstraints{
+			MaxCltvExpiry:   1000,
+			HtlcMinimumMsat: 1000,
+		}
+	)
+
+	introKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	recipientKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	introData, err := record.EncodeBlindedRouteData(&record.BlindedRouteData{
+		ShortChannelID: &scid,
+		RelayInfo:      relay,
+		Constraints:    constraints,
+	})
+	require.NoError(t, err)
+
+	recipientData, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID:      pathID,
+			Constraints: constraints,
+		},
+	)
+	require.NoError(t, err)
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	path, err := blindedpath.BuildBlindedPath(sessionKey, []*blindedpath.HopInfo{
+		{NodePub: introKey.PubKey(), PlainText: introData},
+		{NodePub: recipientKey.PubKey(), PlainText: recipientData},
+	})
+	require.NoError(t, err)
+
+	encodePayload := func(records ...tlv.Record) []byte {
+		var b bytes.Buffer
+		require.NoError(t, tlv.MustNewStream(records...).Encode(&b))
+
+		return b.Bytes()
+	}
+
+	// The introduction node receives the blinding point in its payload.
+	introCipherText := path.BlindedHops[0].CipherText
+	introPayload := encodePayload(
+		record.NewEncryptedDataRecord(&introCipherText),
+		record.NewBlindingPointRecord(&path.BlindingPoint),
+	)
+
+	introNodeKey := &keychain.PrivKeyECDH{PrivKey: introKey}
+	introIterator := sphinxHopIterator{
+		processedPacket: &sphinx.ProcessedPacket{
+			Action: sphinx.MoreHops,
+			Payload: sphinx.HopPayload{
+				Type:    sphinx.PayloadTLV,
+				Payload: introPayload,
+			},
+		},
+		blindingKit: blindingKit{
+			nodeKey:        introNodeKey,
+			incomingAmount: 102000,
+			incomingCltv:   500,
+		},
+	}
+
+	nextBlinding, err := blindedpath.NextBlindingPoint(
+		introNodeKey, path.BlindingPoint,
+	)
+	require.NoError(t, err)
+
+	// The amount to forward is (102000 - 1000) / 1.001, rounded up.
+	pld, err := introIterator.HopPayload()
+	require.NoError(t, err)
+	require.Equal(t, scid, pld.FwdInfo.NextHop)
+	require.Equal(t, lnwire.MilliBronees(100900), pld.FwdInfo.AmountToForward)
+	require.Equal(t, uint32(460), pld.FwdInfo.OutgoingCTLV)
+	require.True(t, nextBlinding.IsEqual(pld.FwdInfo.NextBlinding))
+
+	// The same payload must be rejected if the HTLC already carries a
+	// blinding point.
+	introIterator.blindingKit.updateAddBlinding = path.BlindingPoint
+	_, err = introIterator.HopPayload()
+	require.ErrorIs(t, err, ErrBlindingPointConflict)
+
+	// The recipient receives the blinding point along with the HTLC.
+	var (
+		amt                 = uint64(100900)
+		cltv                = uint32(450)
+		total               = uint64(201800)
+		recipientCipherText = path.BlindedHops[1].CipherText
+	)
+	recipientPayload := encodePayload(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		record.NewEncryptedDataRecord(&recipientCipherText),
+		record.NewTotalAmtMsatBlinded(&total),
+	)
+
+	recipientIterator := sphinxHopIterator{
+		processedPacket: &sphinx.ProcessedPacket{
+			Action: sphinx.ExitNode,
+			Payload: sphinx.HopPayload{
+				Type:    sphinx.PayloadTLV,
+				Payload: recipientPayload,
+			},
+		},
+		blindingKit: blindingKit{
+			nodeKey:           &keychain.PrivKeyECDH{PrivKey: recipientKey},
+			updateAddBlinding: nextBlinding,
+			incomingAmount:    100900,
+			incomingCltv:      460,
+		},
+	}
+
+	var paymentAddr [32]byte
+	copy(paymentAddr[:], pathID)
+
+	pld, err = recipientIterator.HopPayload()
+	require.NoError(t, err)
+	require.Equal(t, uint32(460), pld.FwdInfo.OutgoingCTLV)
+	require.NotNil(t, pld.MPP)
+	require.Equal(t, paymentAddr, pld.MPP.PaymentAddr())
+	require.Equal(t, lnwire.MilliBronees(total), pld.MPP.TotalMsat())
+
+	// An HTLC that expires later than the route allows must be rejected.
+	recipientIterator.blindingKit.incomingCltv = 1001
+	_, err = recipientIterator.HopPayload()
+	require.Error(t, err)
+
+	// Without the blinding point, the recipient can't decrypt its data.
+	recipientIterator.blindingKit.incomingCltv = 460
+	recipientIterator.blindingKit.updateAddBlinding = nil
+	_, err = recipientIterator.HopPayload()
+	require.ErrorIs(t, err, ErrNoBlindingPoint)
+}
+
+// TestSphinxHopIteratorBlindedErrorEncrypter tests that HTLCs within a blinded
+// route are given an error encrypter that replaces any failure with
+// invalid_onion_blinding, and that the encrypter can be restored from its
+// serialized form.
+func TestSphinxHopIteratorBlindedErrorEncrypter(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	blindingKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	nodeECDH := &keychain.PrivKeyECDH{PrivKey: nodeKey}
+	router := sphinx.NewRouter(
+		nodeECDH, &chaincfg.SimNetParams, sphinx.NewMemoryReplayLog(),
+	)
+	processor := NewOnionProcessor(router, nodeECDH)
+
+	// The sender decrypts failures using the shared secret of our node,
+	// which is the only hop of its route.
+	decrypter := sphinx.NewOnionErrorDecrypter(&sphinx.Circuit{
+		SessionKey:  sessionKey,
+		PaymentPath: []*bronec.PublicKey{nodeKey.PubKey()},
+	})
+	onionSHA := [32]byte{1, 2, 3}
+	requireInvalidBlinding := func(reason lnwire.OpaqueReason) {
+		decrypted, err := decrypter.DecryptError(reason)
+		require.NoError(t, err)
+		require.Equal(t, 1, decrypted.SenderIdx)
+
+		failure, err := lnwire.DecodeFailure(
+			bytes.NewReader(decrypted.Message), 0,
+		)
+		require.NoError(t, err)
+		require.Equal(t, &lnwire.FailInvalidBlinding{
+			OnionSHA256: onionSHA,
+		}, failure)
+	}
+
+	var b bytes.Buffer
+	blindingPoint := blindingKey.PubKey()
+	cipherText := []byte{4, 5, 6}
+	err = tlv.MustNewStream(
+		record.NewEncryptedDataRecord(&cipherText),
+		record.NewBlindingPointRecord(&blindingPoint),
+	).Encode(&b)
+	require.NoError(t, err)
+
+	iterator := sphinxHopIterator{
+		ogPacket: &sphinx.OnionPacket{
+			EphemeralKey: sessionKey.PubKey(),
+		},
+		processedPacket: &sphinx.ProcessedPacket{
+			Action: sphinx.MoreHops,
+			Payload: sphinx.HopPayload{
+				Type:    sphinx.PayloadTLV,
+				Payload: b.Bytes(),
+			},
+		},
+		blindingKit: blindingKit{
+			nodeKey:  nodeECDH,
+			onionSHA: onionSHA,
+		},
+	}
+
+	// As the introduction node, failures are encrypted back to the
+	// sender, no matter where they originated.
+	encrypter, code := iterator.ExtractErrorEncrypter(
+		processor.ExtractErrorEncrypter,
+	)
+	require.Equal(t, lnwire.CodeNone, code)
+	require.Equal(t, EncrypterType(EncrypterTypeIntroduction),
+		encrypter.Type())
+
+	reason, err := encrypter.EncryptFirstHop(
+		&lnwire.FailTemporaryNodeFailure{},
+	)
+	require.NoError(t, err)
+	requireInvalidBlinding(reason)
+	requireInvalidBlinding(encrypter.IntermediateEncrypt(reason))
+	requireInvalidBlinding(encrypter.EncryptMalformedError(reason))
+
+	// Hops after the introduction node receive the blinding point along
+	// with the HTLC.
+	iterator.blindingKit.updateAddBlinding = blindingPoint
+	encrypter, code = iterator.ExtractErrorEncrypter(
+		processor.ExtractErrorEncrypter,
+	)
+	require.Equal(t, lnwire.CodeNone, code)
+	require.Equal(t, EncrypterType(EncrypterTypeRelaying), encrypter.Type())
+
+	// The encrypter must survive a round trip through its serialized
+	// form, which is how it is persisted within a payment circuit.
+	b.Reset()
+	require.NoError(t, encrypter.Encode(&b))
+
+	restored := NewRelayingErrorEncrypter()
+	require.NoError(t, restored.Decode(&b))
+	require.NoError(t, restored.Reextract(processor.ExtractErrorEncrypter))
+	require.Equal(t, onionSHA, restored.OnionSHA256)
+
+	reason, err = restored.EncryptFirstHop(
+		&lnwire.FailTemporaryNodeFailure{},
+	)
+	require.NoError(t, err)
+	requireInvalidBlinding(reason)
+
+	// HTLCs outside of blinded routes keep using the plain sphinx error
+	// encrypter.
+	iterator.blindingKit.updateAddBlinding = nil
+	iterator.processedPacket.Payload = sphinx.HopPayload{
+		Type: sphinx.PayloadLegacy,
+	}
+	iterator.processedPacket.ForwardingInstructions = &sphinx.HopData{}
+	encrypter, code = iterator.ExtractErrorEncrypter(
+		processor.ExtractErrorEncrypter,
+	)
+	require.Equal(t, lnwire.CodeNone, code)
+	require.Equal(t, EncrypterType(EncrypterTypeSphinx), encrypter.Type())
+}
@@ -0,0 +1,175 @@
+package hop
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	sphinx "github.com/brsuite/lightning-onion"
+)
+
+var (
+	// ErrOnionMessageNoNextNode is returned when an onion message must be
+	// forwarded, but the route data of our hop doesn't specify the node to
+	// forward it to.
+	ErrOnionMessageNoNextNode = errors.New("onion message route data " +
+		"missing next node id")
+
+	// ErrOnionMessageInvalidPayload is returned when an onion message that
+	// must be forwarded carries records that are only allowed for the
+	// final recipient.
+	ErrOnionMessageInvalidPayload = errors.New("onion message payload " +
+		"for intermediate hop contains final hop records")
+)
+
+// ProcessedOnionMessage is the outcome of peeling a layer off an onion
+// message.
+type ProcessedOnionMessage struct {
+	// Payload is the onion payload for our hop.
+	Payload *record.OnionMessagePayload
+
+	// RouteData is the decrypted route data that the creator of the
+	// blinded path provided for our hop.
+	RouteData *record.BlindedRouteData
+
+	// NextNode is the node that the message should be forwarded to. It is
+	// nil if we are the final recipient of the message.
+	NextNode *bronec.PublicKey
+
+	// NextMessage is the message that should be forwarded to NextNode. It
+	// is nil if we are the final recipient of the message.
+	NextMessage *lnwire.OnionMessage
+}
+
+// IsFinal returns true if we are the final recipient of the onion message.
+func (p *ProcessedOnionMessage) IsFinal() bool {
+	return p.NextMessage == nil
+}
+
+// ProcessOnionMessage peels a layer off the passed onion message. As onion
+// messages are always routed over blinded paths, the onion was encrypted to
+// our blinded node ID, which is why its ephemeral key is tweaked with the
+// blinding point of the message before processing it.
+//
+// NOTE: Onion messages don't carry a payment hash, so there is nothing that
+// would make replaying them costly. We therefore don't add their shared
+// secrets to the replay log.
+func (p *OnionProcessor) ProcessOnionMessage(
+	msg *lnwire.OnionMessage) (*ProcessedOnionMessage, error) {
+
+	if msg.BlindingPoint == nil {
+		return nil, ErrNoBlindingPoint
+	}
+
+	onionReader := bytes.NewReader(msg.OnionBlob)
+	onionPkt := &sphinx.OnionPacket{}
+	if err := onionPkt.Decode(onionReader); err != nil {
+		return nil, fmt.Errorf("unable to decode onion packet: %v", err)
+	}
+	if onionReader.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after onion packet",
+			onionReader.Len())
+	}
+
+	onionKey := onionPkt.EphemeralKey
+	tweakedKey, err := blindedpath.TweakOnionKey(
+		p.nodeKey, msg.BlindingPoint, onionKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to tweak onion key: %v", err)
+	}
+	onionPkt.EphemeralKey = tweakedKey
+
+	packet, err := p.router.ReconstructOnionPacket(onionPkt, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to process onion packet: %v",
+			err)
+	}
+
+	if packet.Payload.Type != sphinx.PayloadTLV {
+		return nil, errors.New("onion message with legacy payload")
+	}
+
+	payload, err := record.DecodeOnionMessagePayload(
+		bytes.NewReader(packet.Payload.Payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	plainText, err := blindedpath.DecryptBlindedHopData(
+		p.nodeKey, msg.BlindingPoint, payload.EncryptedData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt blinded route data: %v",
+			err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(plainText),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode blinded route data: %v",
+			err)
+	}
+
+	processed := &ProcessedOnionMessage{
+		Payload:   payload,
+		RouteData: routeData,
+	}
+
+	// If we are the final recipient, there's nothing left to do.
+	if packet.Action == sphinx.ExitNode {
+		return processed, nil
+	}
+
+	// Otherwise, we'll need to forward the message to the next node. The
+	// records for the final recipient can't be part of our payload.
+	if payload.ReplyPath != nil || len(payload.FinalHopTLVs) > 0 {
+		return nil, ErrOnionMessageInvalidPayload
+	}
+
+	if routeData.NextNodeID == nil {
+		return nil, ErrOnionMessageNoNextNode
+	}
+
+	// The creator of the path may override the blinding point for the
+	// next node, which is the case if the message continues along a
+	// blinded path that was created by another node.
+	nextBlinding := routeData.NextBlindingOverride
+	if nextBlinding == nil {
+		nextBlinding, err = blindedpath.NextBlindingPoint(
+			p.nodeKey, msg.BlindingPoint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to derive next blinding "+
+				"point: %v", err)
+		}
+	}
+
+	// The sphinx router derived the ephemeral key of the next hop from the
+	// tweaked key, so we'll replace it with the one derived from the
+	// original key.
+	nextKey, err := blindedpath.NextOnionKey(
+		p.nodeKey, tweakedKey, onionKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive next onion key: %v",
+			err)
+	}
+	packet.NextPacket.EphemeralKey = nextKey
+
+	var b bytes.Buffer
+	if err := packet.NextPacket.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	processed.NextNode = routeData.NextNodeID
+	processed.NextMessage = lnwire.NewOnionMessage(nextBlinding, b.Bytes())
+
+	return processed, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
 	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
 	sphinx "github.com/brsuite/lightning-onion"
 )
 
@@ -90,6 +91,22 @@ type Payload struct {
 	// a TLV onion payload.
 	AMP *record.AMP
 
+	// encryptedData is the encrypted_recipient_data that the creator of a
+	// blinded route provided for this hop.
+	encryptedData []byte
+
+	// blindingPoint is the current_blinding_point that is given to the
+	// introduction node of a blinded route.
+	blindingPoint *bronec.PublicKey
+
+	// totalAmtMsat is the total amount of a payment made to the final hop
+	// of a blinded route.
+	totalAmtMsat lnwire.MilliBronees
+
+	// trampolineOnion is the serialized trampoline onion that the sender
+	// handed to us as a trampoline node.
+	trampolineOnion []byte
+
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
@@ -115,11 +132,15 @@ func NewLegacyPayload(f *sphinx.HopData) *Payload {
 // should correspond to the bytes encapsulated in a TLV onion payload.
 func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	var (
-		cid  uint64
-		amt  uint64
-		cltv uint32
-		mpp  = &record.MPP{}
-		amp  = &record.AMP{}
+		cid           uint64
+		amt           uint64
+		cltv          uint32
+		mpp           = &record.MPP{}
+		encryptedData []byte
+		blindingPoint *bronec.PublicKey
+		amp           = &record.AMP{}
+		totalAmtMsat  uint64
+		trampoline    []byte
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -127,7 +148,11 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
 		mpp.Record(),
+		record.NewEncryptedDataRecord(&encryptedData),
+		record.NewBlindingPointRecord(&blindingPoint),
 		amp.Record(),
+		record.NewTotalAmtMsatBlinded(&totalAmtMsat),
+		record.NewTrampolineOnionRecord(&trampoline),
 	)
 	if err != nil {
 		return nil, err
@@ -139,9 +164,16 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 	}
 
 	// Validate whether the sender properly included or omitted tlv records
-	// in accordance with BOLT 04.
+	// in accordance with BOLT 04. Payloads for hops within a blinded route
+	// follow a different set of rules, as most of the forwarding
+	// instructions are part of the encrypted data.
 	nextHop := lnwire.NewShortChanIDFromInt(cid)
-	err = ValidateParsedPayloadTypes(parsedTypes, nextHop)
+	_, isBlinded := parsedTypes[record.EncryptedDataOnionType]
+	if isBlinded {
+		err = ValidateBlindedPayloadTypes(parsedTypes)
+	} else {
+		err = ValidateParsedPayloadTypes(parsedTypes, nextHop)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +200,12 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		amp = nil
 	}
 
+	// If no blinding point was parsed, make sure that the corresponding
+	// field on the resulting payload is nil.
+	if _, ok := parsedTypes[record.BlindingPointOnionType]; !ok {
+		blindingPoint = nil
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -178,9 +216,13 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			AmountToForward: lnwire.MilliBronees(amt),
 			OutgoingCTLV:    cltv,
 		},
-		MPP:           mpp,
-		AMP:           amp,
-		customRecords: customRecords,
+		MPP:             mpp,
+		AMP:             amp,
+		encryptedData:   encryptedData,
+		blindingPoint:   blindingPoint,
+		totalAmtMsat:    lnwire.MilliBronees(totalAmtMsat),
+		trampolineOnion: trampoline,
+		customRecords:   customRecords,
 	}, nil
 }
 
@@ -217,6 +259,9 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
 	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasBlindingPoint := parsedTypes[record.BlindingPointOnionType]
+	_, hasTotalAmt := parsedTypes[record.TotalAmtMsatBlindedType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
 
 	switch {
 
@@ -261,6 +306,121 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Only hops within a blinded route may receive a blinding point.
+	case hasBlindingPoint:
+		return ErrInvalidPayload{
+			Type:      record.BlindingPointOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Only the final hop of a blinded route may receive a blinded total
+	// amount.
+	case hasTotalAmt:
+		return ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Intermediate nodes should never receive a trampoline onion.
+	case !isFinalHop && hasTrampoline:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+	}
+
+	return nil
+}
+
+// ValidateBlindedPayloadTypes checks the types parsed from the payload of a
+// hop within a blinded route to ensure that the proper fields are either
+// included or omitted. Only the final hop of a blinded route receives an
+// amount to forward, while intermediate hops find their forwarding
+// instructions in the encrypted data. The requirements for this method are
+// described in BOLT 04.
+func ValidateBlindedPayloadTypes(parsedTypes tlv.TypeMap) error {
+	_, hasAmt := parsedTypes[record.AmtOnionType]
+	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
+	_, hasNextHop := parsedTypes[record.NextHopOnionType]
+	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
+	_, hasTotalAmt := parsedTypes[record.TotalAmtMsatBlindedType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
+
+	isFinalHop := hasAmt
+
+	switch {
+
+	// The next hop is part of the encrypted data, so it must never be
+	// included in the payload itself.
+	case hasNextHop:
+		return ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Payments to blinded routes convey their total amount and path ID
+	// through other fields, so MPP fields must be omitted.
+	case hasMPP:
+		return ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// AMP payments aren't supported within blinded routes.
+	case hasAMP:
+		return ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// Trampoline payments aren't supported within blinded routes.
+	case hasTrampoline:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// The final hop must include a cltv expiry.
+	case isFinalHop && !hasLockTime:
+		return ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
+
+	// The final hop must include the total amount of the payment.
+	case isFinalHop && !hasTotalAmt:
+		return ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
+
+	// Intermediate hops derive their outgoing cltv expiry from the
+	// encrypted data.
+	case !isFinalHop && hasLockTime:
+		return ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  false,
+		}
+
+	// Intermediate hops should never receive the total amount.
+	case !isFinalHop && hasTotalAmt:
+		return ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: IncludedViolation,
+			FinalHop:  false,
+		}
 	}
 
 	return nil
@@ -278,6 +438,24 @@ func (h *Payload) AMPRecord() *record.AMP {
 	return h.AMP
 }
 
+// EncryptedData returns the encrypted_recipient_data that the creator of a
+// blinded route provided for this hop, if any.
+func (h *Payload) EncryptedData() []byte {
+	return h.encryptedData
+}
+
+// BlindingPoint returns the current_blinding_point that was given to this hop
+// as the introduction node of a blinded route, if any.
+func (h *Payload) BlindingPoint() *bronec.PublicKey {
+	return h.blindingPoint
+}
+
+// TrampolineOnion returns the serialized trampoline onion that was handed to
+// this hop as a trampoline node, if any.
+func (h *Payload) TrampolineOnion() []byte {
+	return h.trampolineOnion
+}
+
 // CustomRecords returns the custom tlv type records that were parsed from the
 // payload.
 func (h *Payload) CustomRecords() record.CustomSet {
@@ -258,6 +258,76 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 		shouldHaveAMP: true,
 	},
+	{
+		name:    "blinded intermediate hop valid",
+		payload: []byte{0x0a, 0x02, 0xaa, 0xbb},
+	},
+	{
+		name: "blinded final hop valid",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// encrypted data
+			0x0a, 0x01, 0xaa,
+			// total amount
+			0x12, 0x00,
+		},
+	},
+	{
+		name:    "blinded final hop no total amount",
+		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x0a, 0x01, 0xaa},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name:    "blinded intermediate hop with expiry",
+		payload: []byte{0x04, 0x00, 0x0a, 0x01, 0xaa},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.LockTimeOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "blinded intermediate hop with next hop id",
+		payload: []byte{0x06, 0x08, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x0a, 0x01, 0xaa,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.NextHopOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name:    "total amount without encrypted data",
+		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x12, 0x00},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TotalAmtMsatBlindedType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name:    "final hop with trampoline onion",
+		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x14, 0x02, 0xaa, 0xbb},
+	},
+	{
+		name: "intermediate hop with trampoline onion",
+		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x01, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x01, 0xaa,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
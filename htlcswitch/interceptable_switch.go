@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/go-errors/errors"
+	"github.com/brsuite/broln/chainntnfs"
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/htlcswitch/hop"
 	"github.com/brsuite/broln/lntypes"
@@ -17,37 +18,338 @@ var (
 	ErrFwdNotExists = errors.New("forward does not exist")
 )
 
+// InterceptableSwitchConfig contains the configuration of an
+// InterceptableSwitch.
+type InterceptableSwitchConfig struct {
+	// Switch is the underlying switch that forwards are passed on to.
+	Switch *Switch
+
+	// Notifier is used to receive new blocks, which trigger the automatic
+	// failing of held forwards.
+	Notifier chainntnfs.ChainNotifier
+
+	// RequireInterceptor indicates whether forwards must be offered to an
+	// interceptor before they are forwarded. If no interceptor is
+	// registered, forwards are held until one registers.
+	RequireInterceptor bool
+
+	// CltvRejectDelta is the number of blocks before the expiry of the
+	// incoming htlc at which a held forward is failed back automatically.
+	// This prevents the incoming channel from being force closed.
+	CltvRejectDelta uint32
+}
+
 // InterceptableSwitch is an implementation of ForwardingSwitch interface.
 // This implementation is used like a proxy that wraps the switch and
 // intercepts forward requests. A reference to the Switch is held in order
 // to communicate back the interception result where the options are:
 // Resume - forwards the original request to the switch as is.
+// ResumeModified - forwards the request with a modified channel or amount.
 // Settle - routes UpdateFulfillHTLC to the originating link.
 // Fail - routes UpdateFailHTLC to the originating link.
+//
+// Multiple interceptors can be registered. Forwards are offered to them in the
+// order of registration, and a forward that an interceptor doesn't hold or
+// resumes is offered to the next one. Forwards are intercepted before the
+// switch looks up the outgoing link, so forwards to unknown short channel ids
+// are offered to the interceptors as well, rather than being failed with
+// UnknownNextPeer right away.
+//
+// Held forwards are owned by the InterceptableSwitch rather than by the
+// interceptors. When an interceptor is removed, the forwards that it holds are
+// offered to the remaining interceptors. If an interceptor is required, the
+// forwards that no interceptor took are held until an interceptor registers
+// and are then replayed to it. Forwards that are held for too long are failed
+// back automatically.
 type InterceptableSwitch struct {
+	started sync.Once
+	stopped sync.Once
+
 	sync.RWMutex
 
+	cfg InterceptableSwitchConfig
+
 	// htlcSwitch is the underline switch
 	htlcSwitch *Switch
 
+	// interceptors are the registered interceptors in the order in which
+	// they are offered forwards.
+	interceptors []*registeredInterceptor
+
+	// nextInterceptorID is the id of the next registered interceptor. Ids
+	// increase in the order of registration.
+	nextInterceptorID uint64
+
+	// heldForwards contains the forwards that are currently held by an
+	// interceptor, keyed by their incoming circuit.
+	heldForwards map[channeldb.CircuitKey]*interceptedForward
+
+	// waitingForwards contains the forwards that wait for an interceptor
+	// to register, keyed by their incoming circuit. The interceptor id of
+	// a waiting forward is the id after which it is offered to the
+	// interceptors again.
+	waitingForwards map[channeldb.CircuitKey]*interceptedForward
+
+	// blockEpochStream is used to receive new blocks.
+	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// registeredInterceptor is an interceptor along with its registration id.
+type registeredInterceptor struct {
+	id uint64
+
 	// fwdInterceptor is the callback that is called for each forward of
-	// an incoming htlc. It should return true if it is interested in handling
-	// it.
+	// an incoming htlc. It should return true if it is interested in
+	// handling it.
 	fwdInterceptor ForwardInterceptor
 }
 
 // NewInterceptableSwitch returns an instance of InterceptableSwitch.
-func NewInterceptableSwitch(s *Switch) *InterceptableSwitch {
-	return &InterceptableSwitch{htlcSwitch: s}
+func NewInterceptableSwitch(
+	cfg *InterceptableSwitchConfig) *InterceptableSwitch {
+
+	return &InterceptableSwitch{
+		cfg:               *cfg,
+		htlcSwitch:        cfg.Switch,
+		nextInterceptorID: 1,
+		heldForwards: make(
+			map[channeldb.CircuitKey]*interceptedForward,
+		),
+		waitingForwards: make(
+			map[channeldb.CircuitKey]*interceptedForward,
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the InterceptableSwitch, which automatically fails held
+// forwards that are about to expire from then on.
+func (s *InterceptableSwitch) Start() error {
+	var err error
+	s.started.Do(func() {
+		log.Info("InterceptableSwitch starting")
+
+		s.blockEpochStream, err = s.cfg.Notifier.RegisterBlockEpochNtfn(
+			nil,
+		)
+		if err != nil {
+			return
+		}
+
+		s.wg.Add(1)
+		go s.autoFailHandler()
+	})
+
+	return err
+}
+
+// Stop signals the InterceptableSwitch for a graceful shutdown.
+func (s *InterceptableSwitch) Stop() error {
+	s.stopped.Do(func() {
+		log.Info("InterceptableSwitch shutting down")
+
+		close(s.quit)
+		if s.blockEpochStream != nil {
+			s.blockEpochStream.Cancel()
+		}
+		s.wg.Wait()
+	})
+
+	return nil
+}
+
+// autoFailHandler fails the held forwards that are about to expire whenever a
+// new block arrives.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *InterceptableSwitch) autoFailHandler() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case epoch, ok := <-s.blockEpochStream.Epochs:
+			if !ok {
+				return
+			}
+
+			s.failExpiringForwards(uint32(epoch.Height))
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// expiresSoon returns true if the incoming htlc of the packet expires within
+// the configured reject delta from the given height.
+func (s *InterceptableSwitch) expiresSoon(packet *htlcPacket,
+	height uint32) bool {
+
+	return packet.incomingTimeout <= height+s.cfg.CltvRejectDelta
+}
+
+// failExpiringForwards fails all held and waiting forwards that expire soon.
+func (s *InterceptableSwitch) failExpiringForwards(height uint32) {
+	var expiring []*interceptedForward
+
+	s.Lock()
+	for _, forwards := range []map[channeldb.CircuitKey]*interceptedForward{
+		s.heldForwards, s.waitingForwards,
+	} {
+		for key, f := range forwards {
+			if !s.expiresSoon(f.packet, height) {
+				continue
+			}
+
+			expiring = append(expiring, f)
+			delete(forwards, key)
+		}
+	}
+	s.Unlock()
+
+	for _, f := range expiring {
+		log.Infof("Failing held forward %v at height %v, because "+
+			"the incoming htlc expires at height %v",
+			f.packet.inKey(), height, f.packet.incomingTimeout)
+
+		if err := f.autoFail(); err != nil {
+			log.Errorf("Unable to fail held forward %v: %v",
+				f.packet.inKey(), err)
+		}
+	}
+}
+
+// AddInterceptor registers a ForwardInterceptor that is offered forwards after
+// all interceptors that were registered before. Forwards that wait for an
+// interceptor are replayed to it. The returned function removes the
+// interceptor again.
+func (s *InterceptableSwitch) AddInterceptor(
+	interceptor ForwardInterceptor) func() {
+
+	s.Lock()
+	id := s.nextInterceptorID
+	s.nextInterceptorID++
+
+	s.interceptors = append(s.interceptors, &registeredInterceptor{
+		id:             id,
+		fwdInterceptor: interceptor,
+	})
+
+	var waiting []*interceptedForward
+	for key, f := range s.waitingForwards {
+		waiting = append(waiting, f)
+		delete(s.waitingForwards, key)
+	}
+	s.Unlock()
+
+	// The interceptor is usually not ready to receive forwards before this
+	// method returns, so the waiting forwards are replayed in the
+	// background.
+	if len(waiting) > 0 {
+		log.Infof("Replaying %v held forwards to interceptor",
+			len(waiting))
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+			for _, f := range waiting {
+				s.reofferForward(f, f.interceptorID)
+			}
+		}()
+	}
+
+	return func() {
+		s.removeInterceptor(id)
+	}
+}
+
+// removeInterceptor removes the interceptor with the given id. The forwards
+// that it holds are offered to the interceptors that were registered after
+// it.
+func (s *InterceptableSwitch) removeInterceptor(id uint64) {
+	s.Lock()
+	for i, interceptor := range s.interceptors {
+		if interceptor.id != id {
+			continue
+		}
+
+		s.interceptors = append(
+			s.interceptors[:i:i], s.interceptors[i+1:]...,
+		)
+
+		break
+	}
+
+	var held []*interceptedForward
+	for key, f := range s.heldForwards {
+		if f.interceptorID != id {
+			continue
+		}
+
+		held = append(held, f)
+		delete(s.heldForwards, key)
+	}
+	s.Unlock()
+
+	for _, f := range held {
+		s.reofferForward(f, id)
+	}
 }
 
-// SetInterceptor sets the ForwardInterceptor to be used.
-func (s *InterceptableSwitch) SetInterceptor(
-	interceptor ForwardInterceptor) {
+// reofferForward offers a forward that wasn't resolved by an interceptor to
+// the interceptors that were registered after the given id, or forwards it if
+// there are none and no interceptor is required.
+func (s *InterceptableSwitch) reofferForward(f *interceptedForward,
+	afterID uint64) {
+
+	if s.interceptForward(
+		f.packet, f.linkQuit, afterID, s.cfg.RequireInterceptor,
+	) {
+
+		return
+	}
+
+	err := s.htlcSwitch.ForwardPackets(f.linkQuit, f.packet)
+	if err != nil {
+		log.Errorf("Unable to forward held forward %v: %v",
+			f.packet.inKey(), err)
+	}
+}
+
+// interceptorsAfter returns the interceptors that were registered after the
+// interceptor with the given id, in the order of registration.
+//
+// NOTE: The caller MUST hold the lock.
+func (s *InterceptableSwitch) interceptorsAfter(
+	id uint64) []*registeredInterceptor {
+
+	for i, interceptor := range s.interceptors {
+		if interceptor.id > id {
+			return s.interceptors[i:]
+		}
+	}
+
+	return nil
+}
 
+// release removes a forward that is held by an interceptor from the held
+// forwards. It returns false if the forward isn't held anymore, for example
+// because it was failed back automatically or its interceptor was removed.
+func (s *InterceptableSwitch) release(f *interceptedForward) bool {
 	s.Lock()
 	defer s.Unlock()
-	s.fwdInterceptor = interceptor
+
+	key := f.packet.inKey()
+	if s.heldForwards[key] != f {
+		return false
+	}
+	delete(s.heldForwards, key)
+
+	return true
 }
 
 // ForwardPackets attempts to forward the batch of htlcs through the
@@ -57,19 +359,22 @@ func (s *InterceptableSwitch) SetInterceptor(
 func (s *InterceptableSwitch) ForwardPackets(linkQuit chan struct{},
 	packets ...*htlcPacket) error {
 
-	var interceptor ForwardInterceptor
-	s.Lock()
-	interceptor = s.fwdInterceptor
-	s.Unlock()
+	s.RLock()
+	numInterceptors := len(s.interceptors)
+	s.RUnlock()
 
-	// Optimize for the case we don't have an interceptor.
-	if interceptor == nil {
+	// Optimize for the case we don't have an interceptor and don't need
+	// to hold forwards for one.
+	if numInterceptors == 0 && !s.cfg.RequireInterceptor {
 		return s.htlcSwitch.ForwardPackets(linkQuit, packets...)
 	}
 
 	var notIntercepted []*htlcPacket
 	for _, p := range packets {
-		if !s.interceptForward(p, interceptor, linkQuit) {
+		if !s.interceptForward(
+			p, linkQuit, 0, s.cfg.RequireInterceptor,
+		) {
+
 			notIntercepted = append(notIntercepted, p)
 		}
 	}
@@ -77,11 +382,15 @@ func (s *InterceptableSwitch) ForwardPackets(linkQuit chan struct{},
 }
 
 // interceptForward checks if there is any external interceptor interested in
-// this packet. Currently only htlc type of UpdateAddHTLC that are forwarded
+// this packet. The packet is only offered to the interceptors that were
+// registered after the interceptor with the given id. If no interceptor holds
+// the packet and interception is required, the packet waits for an interceptor
+// to register. Currently only htlc type of UpdateAddHTLC that are forwarded
 // are being checked for interception. It can be extended in the future given
 // the right use case.
 func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
-	interceptor ForwardInterceptor, linkQuit chan struct{}) bool {
+	linkQuit chan struct{}, afterID uint64,
+	requireInterception bool) bool {
 
 	switch htlc := packet.htlc.(type) {
 	case *lnwire.UpdateAddHTLC:
@@ -90,15 +399,92 @@ func (s *InterceptableSwitch) interceptForward(packet *htlcPacket,
 			return false
 		}
 
-		intercepted := &interceptedForward{
-			linkQuit:   linkQuit,
-			htlc:       htlc,
-			packet:     packet,
-			htlcSwitch: s.htlcSwitch,
+		inKey := packet.inKey()
+		newForward := func(interceptorID uint64) *interceptedForward {
+			return &interceptedForward{
+				linkQuit:      linkQuit,
+				htlc:          htlc,
+				packet:        packet,
+				htlcSwitch:    s.htlcSwitch,
+				interceptable: s,
+				interceptorID: interceptorID,
+			}
+		}
+
+		// The link replays forwards after a restart or a
+		// reconnection. Forwards that are already held are not
+		// offered again.
+		s.RLock()
+		_, held := s.heldForwards[inKey]
+		_, waiting := s.waitingForwards[inKey]
+		s.RUnlock()
+		if held || waiting {
+			log.Debugf("Forward %v is already held", inKey)
+			return true
+		}
+
+		// Don't hold forwards that would be failed back automatically
+		// right away.
+		if s.expiresSoon(packet, s.htlcSwitch.BestHeight()) {
+			log.Debugf("Failing forward %v that expires at height "+
+				"%v instead of intercepting it", inKey,
+				packet.incomingTimeout)
+
+			if err := newForward(0).autoFail(); err != nil {
+				log.Errorf("Unable to fail forward %v: %v",
+					inKey, err)
+			}
+
+			return true
+		}
+
+		for {
+			s.RLock()
+			interceptors := s.interceptorsAfter(afterID)
+			s.RUnlock()
+
+			for _, interceptor := range interceptors {
+				intercepted := newForward(interceptor.id)
+
+				s.Lock()
+				s.heldForwards[inKey] = intercepted
+				s.Unlock()
+
+				// If this htlc was intercepted, don't handle
+				// the forward.
+				if interceptor.fwdInterceptor(intercepted) {
+					return true
+				}
+
+				// If the forward was released in the meantime,
+				// it was already handled elsewhere.
+				if !s.release(intercepted) {
+					return true
+				}
+
+				afterID = interceptor.id
+			}
+
+			if !requireInterception {
+				return false
+			}
+
+			// Hold the forward until an interceptor registers,
+			// unless one registered while it was offered to the
+			// others.
+			s.Lock()
+			if len(s.interceptorsAfter(afterID)) == 0 {
+				s.waitingForwards[inKey] = newForward(afterID)
+				s.Unlock()
+
+				log.Debugf("Holding forward %v until an "+
+					"interceptor is registered", inKey)
+
+				return true
+			}
+			s.Unlock()
 		}
 
-		// If this htlc was intercepted, don't handle the forward.
-		return interceptor(intercepted)
 	default:
 		return false
 	}
@@ -112,6 +498,14 @@ type interceptedForward struct {
 	htlc       *lnwire.UpdateAddHTLC
 	packet     *htlcPacket
 	htlcSwitch *Switch
+
+	// interceptable is the switch that offers the forward to the
+	// remaining interceptors when it is resumed.
+	interceptable *InterceptableSwitch
+
+	// interceptorID is the id of the interceptor that the forward was
+	// offered to.
+	interceptorID uint64
 }
 
 // Packet returns the intercepted htlc packet.
@@ -129,29 +523,173 @@ func (f *interceptedForward) Packet() InterceptedPacket {
 		IncomingExpiry: f.packet.incomingTimeout,
 		CustomRecords:  f.packet.customRecords,
 		OnionBlob:      f.htlc.OnionBlob,
+		AutoFailHeight: f.autoFailHeight(),
+	}
+}
+
+// autoFailHeight returns the height at which the forward is failed back
+// automatically if it is still held.
+func (f *interceptedForward) autoFailHeight() uint32 {
+	delta := f.interceptable.cfg.CltvRejectDelta
+	if f.packet.incomingTimeout < delta {
+		return 0
 	}
+
+	return f.packet.incomingTimeout - delta
 }
 
 // Resume resumes the default behavior as if the packet was not intercepted.
+// The interceptors that were registered after the current one are offered the
+// packet first.
 func (f *interceptedForward) Resume() error {
+	if !f.interceptable.release(f) {
+		return ErrFwdNotExists
+	}
+
+	return f.resume()
+}
+
+// resume offers the packet to the interceptors that were registered after the
+// current one and forwards it if none of them holds it.
+func (f *interceptedForward) resume() error {
+	if f.interceptable.interceptForward(
+		f.packet, f.linkQuit, f.interceptorID, false,
+	) {
+
+		return nil
+	}
+
 	return f.htlcSwitch.ForwardPackets(f.linkQuit, f.packet)
 }
 
-// Fail forward a failed packet to the switch.
-func (f *interceptedForward) Fail() error {
-	update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
-		f.packet.incomingChanID,
-	)
+// ResumeModified resumes the default behavior with a modified outgoing channel
+// and amount. Nil values are left unchanged.
+func (f *interceptedForward) ResumeModified(
+	outgoingChanID *lnwire.ShortChannelID,
+	outgoingAmount *lnwire.MilliBronees) error {
+
+	// Forwarding more than we received would pay for the difference out
+	// of our own balance.
+	if outgoingAmount != nil && *outgoingAmount > f.packet.incomingAmount {
+		return fmt.Errorf("outgoing amount %v exceeds incoming "+
+			"amount %v", *outgoingAmount, f.packet.incomingAmount)
+	}
+
+	if !f.interceptable.release(f) {
+		return ErrFwdNotExists
+	}
+
+	if outgoingAmount != nil {
+		f.packet.amount = *outgoingAmount
+		f.htlc.Amount = *outgoingAmount
+	}
+
+	if outgoingChanID != nil {
+		f.packet.outgoingChanID = *outgoingChanID
+	}
+
+	return f.resume()
+}
+
+// Fail forwards a failed packet to the switch. The reason is an encrypted
+// failure that is obfuscated with the shared secret of this hop, as if it was
+// returned by a downstream node.
+func (f *interceptedForward) Fail(reason []byte) error {
+	if !f.interceptable.release(f) {
+		return ErrFwdNotExists
+	}
+
+	obfuscatedReason := f.packet.obfuscator.IntermediateEncrypt(reason)
+
+	return f.resolve(&lnwire.UpdateFailHTLC{
+		Reason: obfuscatedReason,
+	})
+}
+
+// FailWithMessage forwards a failed packet to the switch. The failure
+// originates at this node.
+func (f *interceptedForward) FailWithMessage(
+	failure lnwire.FailureMessage) error {
+
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt failure reason %v", err)
+	}
+
+	if !f.interceptable.release(f) {
+		return ErrFwdNotExists
+	}
+
+	return f.resolve(&lnwire.UpdateFailHTLC{
+		Reason: reason,
+	})
+}
+
+// FailWithCode forwards a failed packet with the failure message for the given
+// code to the switch. Only codes for which the fields of the failure message
+// are known are supported.
+func (f *interceptedForward) FailWithCode(code lnwire.FailCode) error {
+	failure, err := f.failureMessage(code)
 	if err != nil {
 		return err
 	}
 
-	reason, err := f.packet.obfuscator.EncryptFirstHop(
-		lnwire.NewTemporaryChannelFailure(update),
-	)
+	return f.FailWithMessage(failure)
+}
+
+// failureMessage returns the failure message for the given code.
+func (f *interceptedForward) failureMessage(
+	code lnwire.FailCode) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			f.packet.incomingChanID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return lnwire.NewTemporaryChannelFailure(update), nil
+
+	case lnwire.CodeIncorrectOrUnknownPaymentDetails:
+		return lnwire.NewFailIncorrectDetails(
+			f.packet.incomingAmount, f.htlcSwitch.BestHeight(),
+		), nil
+
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported failure code %v", code)
+	}
+}
+
+// autoFail fails a forward that is about to expire back to the incoming link.
+// The caller must have removed the forward from the held forwards already.
+func (f *interceptedForward) autoFail() error {
+	failure, err := f.failureMessage(lnwire.CodeTemporaryChannelFailure)
+	if err != nil {
+		log.Warnf("Unable to create temporary channel failure for "+
+			"forward %v: %v", f.packet.inKey(), err)
+
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	reason, err := f.packet.obfuscator.EncryptFirstHop(failure)
 	if err != nil {
 		return fmt.Errorf("failed to encrypt failure reason %v", err)
 	}
+
 	return f.resolve(&lnwire.UpdateFailHTLC{
 		Reason: reason,
 	})
@@ -162,6 +700,11 @@ func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
 	if !preimage.Matches(f.htlc.PaymentHash) {
 		return errors.New("preimage does not match hash")
 	}
+
+	if !f.interceptable.release(f) {
+		return ErrFwdNotExists
+	}
+
 	return f.resolve(&lnwire.UpdateFulfillHTLC{
 		PaymentPreimage: preimage,
 	})
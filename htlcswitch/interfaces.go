@@ -42,6 +42,37 @@ type InvoiceDatabase interface {
 	HodlUnsubscribeAll(subscriber chan<- interface{})
 }
 
+// TrampolinePayload is the part of an exit hop's onion payload that is needed
+// to forward a trampoline payment.
+type TrampolinePayload interface {
+	// MultiPath returns the record corresponding the option_mpp parsed
+	// from the onion payload.
+	MultiPath() *record.MPP
+
+	// TrampolineOnion returns the serialized trampoline onion parsed from
+	// the onion payload, if any.
+	TrampolineOnion() []byte
+}
+
+// TrampolineHandler is an interface which represents the subsystem that
+// forwards trampoline payments. Htlcs that carry a trampoline onion are
+// handed to it instead of the invoice registry.
+type TrampolineHandler interface {
+	// NotifyTrampolineHtlc hands an htlc that carries a trampoline onion
+	// to the handler. The return value describes how the htlc should be
+	// resolved. If the htlc cannot be resolved immediately, the
+	// resolution is sent on the passed in hodlChan later, once the
+	// payment has been forwarded.
+	NotifyTrampolineHtlc(payHash lntypes.Hash,
+		paidAmount lnwire.MilliBronees, expiry uint32,
+		currentHeight int32, circuitKey channeldb.CircuitKey,
+		hodlChan chan<- interface{},
+		payload TrampolinePayload) (invoices.HtlcResolution, error)
+
+	// HodlUnsubscribeAll unsubscribes from all htlc resolutions.
+	HodlUnsubscribeAll(subscriber chan<- interface{})
+}
+
 // packetHandler is an interface used exclusively by the Switch to handle
 // htlcPacket and pass them to the link implementation.
 type packetHandler interface {
@@ -110,6 +141,61 @@ type ChannelUpdateHandler interface {
 	// clean. This can be used with dynamic commitment negotiation or coop
 	// close negotiation which require a clean channel state.
 	ShutdownIfChannelClean() error
+
+	// Quiesce requests the channel to become quiescent by exchanging stfu
+	// messages with the remote peer. The returned channel receives the
+	// result once both parties have sent stfu and all updates are
+	// irrevocably committed. Until ExitQuiescence is called, no further
+	// updates are added to the channel, which allows other sub-systems to
+	// carry out protocols that require a stable channel state.
+	//
+	// NOTE: The remote peer must support the quiescence feature bit.
+	Quiesce() <-chan QuiescenceResult
+
+	// ExitQuiescence resumes adding updates to a quiescent channel. It must
+	// only be called once the protocol that required quiescence has been
+	// completed by both parties.
+	ExitQuiescence() error
+
+	// Splice splices funds into or out of the channel. The channel is made
+	// quiescent first, unless it is already, and we must be the initiator
+	// of the quiescence. The returned channel receives the result once the
+	// splice transaction is fully signed and broadcast. The channel stays
+	// quiescent until the splice transaction is confirmed and locked by
+	// both parties.
+	//
+	// NOTE: The remote peer must support the splice feature bit.
+	Splice(req *SpliceRequest) <-chan SpliceResult
+}
+
+// QuiescenceResult is the outcome of a request to make a channel quiescent.
+type QuiescenceResult struct {
+	// Initiator is true if we are the initiator of the quiescence, which
+	// leads the protocol that is carried out while quiescent.
+	Initiator bool
+
+	// Err is set if the channel didn't become quiescent.
+	Err error
+}
+
+// SpliceRequest is a request to splice funds into or out of a channel.
+type SpliceRequest struct {
+	// Intent holds our contribution to the channel, and the inputs and
+	// outputs that we add to the splice transaction.
+	Intent *lnwallet.SpliceIntent
+
+	// LockTime is the lock time of the splice transaction.
+	LockTime uint32
+}
+
+// SpliceResult is the outcome of a request to splice a channel.
+type SpliceResult struct {
+	// SpliceTx is the fully signed splice transaction, which has been
+	// broadcast.
+	SpliceTx *wire.MsgTx
+
+	// Err is set if the splice failed.
+	Err error
 }
 
 // ChannelLink is an interface which represents the subsystem for managing the
@@ -169,6 +255,7 @@ type ChannelLink interface {
 	CheckHtlcForward(payHash [32]byte, incomingAmt lnwire.MilliBronees,
 		amtToForward lnwire.MilliBronees,
 		incomingTimeout, outgoingTimeout uint32,
+		inboundFee channeldb.InboundFee,
 		heightNow uint32) *LinkError
 
 	// CheckHtlcTransit should return a nil error if the passed HTLC details
@@ -229,11 +316,16 @@ type TowerClient interface {
 		channeldb.ChannelType) error
 }
 
-// InterceptableHtlcForwarder is the interface to set the interceptor
-// implementation that intercepts htlc forwards.
+// InterceptableHtlcForwarder is the interface to register the interceptor
+// implementations that intercept htlc forwards.
 type InterceptableHtlcForwarder interface {
-	// SetInterceptor sets a ForwardInterceptor.
-	SetInterceptor(interceptor ForwardInterceptor)
+	// AddInterceptor registers a ForwardInterceptor. Forwards are offered
+	// to the interceptors in the order in which they were registered. The
+	// returned function removes the interceptor again. The forwards that
+	// the interceptor still holds at that point are offered to the other
+	// interceptors or, if an interceptor is required, replayed to the
+	// next interceptor that registers.
+	AddInterceptor(interceptor ForwardInterceptor) func()
 }
 
 // ForwardInterceptor is a function that is invoked from the switch for every
@@ -242,6 +334,9 @@ type InterceptableHtlcForwarder interface {
 // to resolve it manually later in case it is held.
 // The return value indicates if this handler will take control of this forward
 // and resolve it later or let the switch execute its default behavior.
+// The interceptor is invoked before the outgoing link is looked up, so it also
+// receives forwards to unknown channels. This allows it to open a channel just
+// in time and resume the forward onto it using ResumeModified.
 type ForwardInterceptor func(InterceptedForward) bool
 
 // InterceptedPacket contains the relevant information for the interceptor about
@@ -277,28 +372,53 @@ type InterceptedPacket struct {
 
 	// OnionBlob is the onion packet for the next hop
 	OnionBlob [lnwire.OnionPacketSize]byte
+
+	// AutoFailHeight is the block height at which the htlc is failed back
+	// automatically if it is still held, to prevent the incoming channel
+	// from being force closed.
+	AutoFailHeight uint32
 }
 
 // InterceptedForward is passed to the ForwardInterceptor for every forwarded
 // htlc. It contains all the information about the packet which accordingly
 // the interceptor decides if to hold or not.
 // In addition this interface allows a later resolution by calling either
-// Resume, Settle or Fail.
+// Resume, ResumeModified, Settle or one of the Fail methods. A forward can only
+// be resolved once, and not anymore after it was failed back automatically or
+// its interceptor was removed, in which case ErrFwdNotExists is returned.
 type InterceptedForward interface {
 	// Packet returns the intercepted packet.
 	Packet() InterceptedPacket
 
 	// Resume notifies the intention to resume an existing hold forward. This
 	// basically means the caller wants to resume with the default behavior for
-	// this htlc which usually means forward it.
+	// this htlc which usually means forward it. If there are interceptors
+	// registered after the current one, the forward is offered to them
+	// first.
 	Resume() error
 
+	// ResumeModified resumes an existing hold forward like Resume does,
+	// but forwards it over the given outgoing channel and with the given
+	// amount. Nil values are left unchanged.
+	ResumeModified(outgoingChanID *lnwire.ShortChannelID,
+		outgoingAmount *lnwire.MilliBronees) error
+
 	// Settle notifies the intention to settle an existing hold
 	// forward with a given preimage.
 	Settle(lntypes.Preimage) error
 
-	// Fails notifies the intention to fail an existing hold forward
-	Fail() error
+	// Fail notifies the intention to fail an existing hold forward with an
+	// encrypted failure reason, as if it was created by a downstream node.
+	Fail(reason []byte) error
+
+	// FailWithMessage notifies the intention to fail an existing hold
+	// forward with a failure message that originates at this node.
+	FailWithMessage(failure lnwire.FailureMessage) error
+
+	// FailWithCode notifies the intention to fail an existing hold forward
+	// with the failure message for the given code. The fields of the
+	// message are filled in by the switch.
+	FailWithCode(code lnwire.FailCode) error
 }
 
 // htlcNotifier is an interface which represents the input side of the
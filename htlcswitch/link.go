@@ -22,6 +22,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/queue"
 	"github.com/brsuite/broln/ticker"
+	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/wire"
 	"github.com/brsuite/bronlog"
 	"github.com/brsuite/bronutil"
@@ -86,6 +87,11 @@ type ForwardingPolicy struct {
 	// used to compute the required fee for a given HTLC.
 	FeeRate lnwire.MilliBronees
 
+	// InboundFee is the fee that must be paid for each HTLC that arrives
+	// over the channel, on top of the fee of the outgoing channel. It may
+	// be negative to offer a discount.
+	InboundFee channeldb.InboundFee
+
 	// TimeLockDelta is the absolute time-lock value, expressed in blocks,
 	// that will be subtracted from an incoming HTLC's timelock value to
 	// create the time-lock value for the forwarded outgoing HTLC. The
@@ -170,6 +176,12 @@ type ChannelLinkConfig struct {
 	// in thread-safe manner.
 	Registry InvoiceDatabase
 
+	// TrampolineHandler is the sub-system that forwards trampoline
+	// payments. Exit hop htlcs that carry a trampoline onion are handed
+	// to it instead of the Registry. If nil, such htlcs are treated as
+	// regular payments to us.
+	TrampolineHandler TrampolineHandler
+
 	// PreimageCache is a global witness beacon that houses any new
 	// preimages discovered by other links. We'll use this to add new
 	// witnesses that we discover which will notify any sub-systems
@@ -291,6 +303,18 @@ type ChannelLinkConfig struct {
 	// HtlcNotifier is an instance of a htlcNotifier which we will pipe htlc
 	// events through.
 	HtlcNotifier htlcNotifier
+
+	// NodeKey is the identity public key of our node, which determines the
+	// order in which the signatures of a splice transaction are sent.
+	NodeKey *bronec.PublicKey
+
+	// PublishTransaction broadcasts a splice transaction of the channel.
+	PublishTransaction func(*wire.MsgTx, string) error
+
+	// SplicedShortChanID notifies the switch of the new short channel ID
+	// of the link once a splice of its channel is confirmed.
+	SplicedShortChanID func(lnwire.ChannelID,
+		lnwire.ShortChannelID) error
 }
 
 // localUpdateAddMsg contains a locally initiated htlc and a channel that will
@@ -308,6 +332,36 @@ type shutdownReq struct {
 	err chan error
 }
 
+// quiescenceState tracks the progress of the quiescence protocol, during
+// which both parties exchange stfu messages to stop updating the channel.
+type quiescenceState struct {
+	// requested is true if quiescence was requested locally.
+	requested bool
+
+	// sent is true once we sent stfu, after which we must not send any
+	// further updates.
+	sent bool
+
+	// sentInitiator is the initiator flag of the stfu that we sent.
+	sentInitiator bool
+
+	// received is true once the remote party sent stfu, after which it
+	// must not send any further updates.
+	received bool
+
+	// receivedInitiator is the initiator flag of the stfu that the remote
+	// party sent.
+	receivedInitiator bool
+
+	// initiator is true if we are the initiator of the quiescence. It is
+	// only valid once the channel is quiescent.
+	initiator bool
+
+	// subscribers receive the result of the quiescence protocol once the
+	// channel is quiescent.
+	subscribers []chan QuiescenceResult
+}
+
 // channelLink is the service which drives a channel's commitment update
 // state-machine. In the event that an HTLC needs to be propagated to another
 // link, the forward handler from config is used which sends HTLC to the
@@ -318,6 +372,7 @@ type channelLink struct {
 	started       int32
 	reestablished int32
 	shutdown      int32
+	quiescing     int32
 
 	// failed should be set to true in case a link error happens, making
 	// sure we don't process any more updates.
@@ -380,6 +435,26 @@ type channelLink struct {
 	// service shutdown requests from ShutdownIfChannelClean calls.
 	shutdownRequest chan *shutdownReq
 
+	// quiesceRequests is a channel that the channelLink will listen on to
+	// service requests to make the channel quiescent from Quiesce calls.
+	quiesceRequests chan chan QuiescenceResult
+
+	// exitQuiescenceRequests is a channel that the channelLink will listen
+	// on to service ExitQuiescence calls.
+	exitQuiescenceRequests chan chan error
+
+	// quiescence tracks the state of the quiescence protocol. It is only
+	// accessed by the htlcManager goroutine.
+	quiescence quiescenceState
+
+	// spliceRequests is a channel that the channelLink will listen on to
+	// service requests to splice the channel from Splice calls.
+	spliceRequests chan *spliceRequest
+
+	// splice tracks the progress of a splice of the channel. It is only
+	// accessed by the htlcManager goroutine.
+	splice spliceState
+
 	// updateFeeTimer is the timer responsible for updating the link's
 	// commitment fee every time it fires.
 	updateFeeTimer *time.Timer
@@ -425,13 +500,16 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		channel:     channel,
 		shortChanID: channel.ShortChanID(),
 		// TODO(roasbeef): just do reserve here?
-		htlcUpdates:     make(chan *contractcourt.ContractUpdate),
-		shutdownRequest: make(chan *shutdownReq),
-		hodlMap:         make(map[channeldb.CircuitKey]hodlHtlc),
-		hodlQueue:       queue.NewConcurrentQueue(10),
-		log:             build.NewPrefixLog(logPrefix, log),
-		quit:            make(chan struct{}),
-		localUpdateAdd:  make(chan *localUpdateAddMsg),
+		htlcUpdates:            make(chan *contractcourt.ContractUpdate),
+		shutdownRequest:        make(chan *shutdownReq),
+		quiesceRequests:        make(chan chan QuiescenceResult),
+		exitQuiescenceRequests: make(chan chan error),
+		spliceRequests:         make(chan *spliceRequest),
+		hodlMap:                make(map[channeldb.CircuitKey]hodlHtlc),
+		hodlQueue:              queue.NewConcurrentQueue(10),
+		log:                    build.NewPrefixLog(logPrefix, log),
+		quit:                   make(chan struct{}),
+		localUpdateAdd:         make(chan *localUpdateAddMsg),
 	}
 }
 
@@ -530,9 +608,19 @@ func (l *channelLink) Stop() {
 	// As the link is stopping, we are no longer interested in htlc
 	// resolutions coming from the invoice registry.
 	l.cfg.Registry.HodlUnsubscribeAll(l.hodlQueue.ChanIn())
+	if l.cfg.TrampolineHandler != nil {
+		l.cfg.TrampolineHandler.HodlUnsubscribeAll(
+			l.hodlQueue.ChanIn(),
+		)
+	}
 
-	if l.cfg.ChainEvents.Cancel != nil {
-		l.cfg.ChainEvents.Cancel()
+	// The chain events are replaced by the htlcManager once a splice of
+	// the channel is confirmed.
+	l.RLock()
+	chainEvents := l.cfg.ChainEvents
+	l.RUnlock()
+	if chainEvents.Cancel != nil {
+		chainEvents.Cancel()
 	}
 
 	// Ensure the channel for the timer is drained.
@@ -582,7 +670,23 @@ func (l *channelLink) WaitForShutdown() {
 func (l *channelLink) EligibleToForward() bool {
 	return l.channel.RemoteNextRevocation() != nil &&
 		l.ShortChanID() != hop.Source &&
-		l.isReestablished()
+		l.isReestablished() &&
+		!l.isQuiescing()
+}
+
+// isQuiescing returns true if either party requested the channel to become
+// quiescent, in which case no new updates are added to the channel.
+func (l *channelLink) isQuiescing() bool {
+	return atomic.LoadInt32(&l.quiescing) == 1
+}
+
+// setQuiescing sets whether the channel is quiescing.
+func (l *channelLink) setQuiescing(quiescing bool) {
+	var val int32
+	if quiescing {
+		val = 1
+	}
+	atomic.StoreInt32(&l.quiescing, val)
 }
 
 // isReestablished returns true if the link has successfully completed the
@@ -931,6 +1035,17 @@ func (l *channelLink) loadAndRemove() error {
 func (l *channelLink) htlcManager() {
 	defer func() {
 		l.cfg.BatchTicker.Stop()
+
+		// Notify anyone waiting for the channel to become quiescent
+		// that it won't happen anymore.
+		for _, sub := range l.quiescence.subscribers {
+			sub <- QuiescenceResult{Err: ErrLinkShuttingDown}
+		}
+		l.quiescence.subscribers = nil
+
+		// The same goes for a splice that hasn't been signed yet.
+		l.failSplice(ErrLinkShuttingDown)
+
 		l.wg.Done()
 		l.log.Infof("exited")
 	}()
@@ -1045,7 +1160,11 @@ func (l *channelLink) htlcManager() {
 	// defer the inactive notification for when the link exits to ensure
 	// that every active notification is matched by an inactive one.
 	l.cfg.NotifyActiveChannel(*l.ChannelPoint())
-	defer l.cfg.NotifyInactiveChannel(*l.ChannelPoint())
+	// The channel point changes once a splice of the channel is
+	// confirmed, so it is only read when the link exits.
+	defer func() {
+		l.cfg.NotifyInactiveChannel(*l.ChannelPoint())
+	}()
 
 	// With the channel states synced, we now reset the mailbox to ensure
 	// we start processing all unacked packets in order. This is done here
@@ -1074,6 +1193,14 @@ func (l *channelLink) htlcManager() {
 		go l.fwdPkgGarbager()
 	}
 
+	// A splice that was signed before the link was restarted is resumed
+	// once the channel states are synced.
+	l.resumeSplices()
+	if l.failed {
+		l.log.Errorf("link failed, exiting htlcManager")
+		return
+	}
+
 	for {
 		// We must always check if we failed at some point processing
 		// the last update before processing the next.
@@ -1092,6 +1219,24 @@ func (l *channelLink) htlcManager() {
 			l.cfg.BatchTicker.Pause()
 		}
 
+		// If the previous event committed our last dangling update, we
+		// may be able to progress the quiescence protocol.
+		l.updateQuiescence()
+		if l.failed {
+			l.log.Errorf("link failed, exiting htlcManager")
+			return
+		}
+
+		// While the channel is quiescing, we must not send any new
+		// updates. Packets from the switch and htlc resolutions remain
+		// queued until the channel exits quiescence.
+		downstream := l.downstream
+		hodlQueue := l.hodlQueue.ChanOut()
+		if l.isQuiescing() {
+			downstream = nil
+			hodlQueue = nil
+		}
+
 		select {
 		// Our update fee timer has fired, so we'll check the network
 		// fee to see if we should adjust our commitment fee.
@@ -1099,8 +1244,9 @@ func (l *channelLink) htlcManager() {
 			l.updateFeeTimer.Reset(l.randomFeeUpdateTimeout())
 
 			// If we're not the initiator of the channel, don't we
-			// don't control the fees, so we can ignore this.
-			if !l.channel.IsInitiator() {
+			// don't control the fees, so we can ignore this. We
+			// also can't update the fee while quiescing.
+			if !l.channel.IsInitiator() || l.isQuiescing() {
 				continue
 			}
 
@@ -1175,11 +1321,17 @@ func (l *channelLink) htlcManager() {
 		// A message from the switch was just received. This indicates
 		// that the link is an intermediate hop in a multi-hop HTLC
 		// circuit.
-		case pkt := <-l.downstream:
+		case pkt := <-downstream:
 			l.handleDownstreamPkt(pkt)
 
 		// A message containing a locally initiated add was received.
+		// It is refused while the channel is quiescing.
 		case msg := <-l.localUpdateAdd:
+			if l.isQuiescing() {
+				msg.err <- ErrLinkQuiescing
+				continue
+			}
+
 			msg.err <- l.handleDownstreamUpdateAdd(msg.pkt)
 
 		// A message from the connected peer was just received. This
@@ -1190,7 +1342,7 @@ func (l *channelLink) htlcManager() {
 
 		// A htlc resolution is received. This means that we now have a
 		// resolution for a previously accepted htlc.
-		case hodlItem := <-l.hodlQueue.ChanOut():
+		case hodlItem := <-hodlQueue:
 			htlcResolution := hodlItem.(invoices.HtlcResolution)
 			err := l.processHodlQueue(htlcResolution)
 			if err != nil {
@@ -1215,6 +1367,20 @@ func (l *channelLink) htlcManager() {
 			// an error and continue.
 			req.err <- ErrLinkFailedShutdown
 
+		case resp := <-l.quiesceRequests:
+			l.handleQuiesceRequest(resp)
+
+		case errChan := <-l.exitQuiescenceRequests:
+			errChan <- l.exitQuiescence()
+
+		case req := <-l.spliceRequests:
+			l.handleSpliceRequest(req)
+
+		// A splice of the channel has been confirmed, so the chain
+		// watcher moved the channel to the new funding output.
+		case info := <-l.cfg.ChainEvents.SpliceConfirmed:
+			l.handleSpliceConfirmed(info)
+
 		case <-l.quit:
 			return
 		}
@@ -1318,6 +1484,19 @@ func getResolutionFailure(resolution *invoices.HtlcFailResolution,
 		)
 	}
 
+	// Trampoline payments that we failed to forward are failed with
+	// FailTemporaryNodeFailure, as the sender may retry the payment over
+	// a different trampoline node or with a higher fee.
+	switch resolution.Outcome {
+	case invoices.ResultTrampolineFeeInsufficient,
+		invoices.ResultTrampolineExpiryTooSoon,
+		invoices.ResultTrampolineFailed:
+
+		return NewDetailedLinkError(
+			&lnwire.FailTemporaryNodeFailure{}, resolution.Outcome,
+		)
+	}
+
 	// If the htlc is not a MPP timeout, we fail it with
 	// FailIncorrectDetails. This error is sent for invoice payment
 	// failures such as underpayment/ expiry too soon and hodl invoices
@@ -1509,16 +1688,37 @@ func (l *channelLink) handleDownstreamPkt(pkt *htlcPacket) {
 			return
 		}
 
+		// HTLCs that we relayed within a blinded route are failed with
+		// an invalid_onion_blinding malformed failure instead, so that
+		// the failure is replaced by every hop back to the introduction
+		// node.
+		encrypter := pkt.errorEncrypter()
+		relaying, isRelaying := encrypter.(*hop.RelayingErrorEncrypter)
+
 		// An HTLC cancellation has been triggered somewhere upstream,
 		// we'll remove then HTLC from our local state machine.
-		inKey := pkt.inKey()
-		err := l.channel.FailHTLC(
-			pkt.incomingHTLCID,
-			htlc.Reason,
-			pkt.sourceRef,
-			pkt.destRef,
-			&inKey,
+		var (
+			inKey = pkt.inKey()
+			err   error
 		)
+		if isRelaying {
+			err = l.channel.MalformedFailHTLC(
+				pkt.incomingHTLCID,
+				lnwire.CodeInvalidBlinding,
+				relaying.OnionSHA256,
+				pkt.sourceRef,
+				pkt.destRef,
+				&inKey,
+			)
+		} else {
+			err = l.channel.FailHTLC(
+				pkt.incomingHTLCID,
+				htlc.Reason,
+				pkt.sourceRef,
+				pkt.destRef,
+				&inKey,
+			)
+		}
 		if err != nil {
 			l.log.Errorf("unable to cancel incoming HTLC for "+
 				"circuit-key=%v: %v", inKey, err)
@@ -1553,7 +1753,17 @@ func (l *channelLink) handleDownstreamPkt(pkt *htlcPacket) {
 
 		// We send the HTLC message to the peer which initially created
 		// the HTLC.
-		l.cfg.Peer.SendMessage(false, htlc)
+		if isRelaying {
+			malformed := &lnwire.UpdateFailMalformedHTLC{
+				ChanID:       htlc.ChanID,
+				ID:           htlc.ID,
+				ShaOnionBlob: relaying.OnionSHA256,
+				FailureCode:  lnwire.CodeInvalidBlinding,
+			}
+			l.cfg.Peer.SendMessage(false, malformed)
+		} else {
+			l.cfg.Peer.SendMessage(false, htlc)
+		}
 
 		// If the packet does not have a link failure set, it failed
 		// further down the route so we notify a forwarding failure.
@@ -1656,7 +1866,28 @@ func (l *channelLink) cleanupSpuriousResponse(pkt *htlcPacket) {
 // updates from the upstream peer. The upstream peer is the peer whom we have a
 // direct channel with, updating our respective commitment chains.
 func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
+	// Once the remote party sent stfu, it must not send any further
+	// updates until the channel exits quiescence.
+	if l.quiescence.received {
+		switch msg.(type) {
+		case *lnwire.UpdateAddHTLC, *lnwire.UpdateFulfillHTLC,
+			*lnwire.UpdateFailHTLC, *lnwire.UpdateFailMalformedHTLC,
+			*lnwire.UpdateFee:
+
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received %T after stfu", msg)
+			return
+		}
+	}
+
+	// Messages of a splice are handled separately.
+	if l.handleSpliceMsg(msg) {
+		return
+	}
+
 	switch msg := msg.(type) {
+	case *lnwire.Stfu:
+		l.handleStfu(msg)
 
 	case *lnwire.UpdateAddHTLC:
 		// We just received an add request from an upstream peer, so we
@@ -1721,6 +1952,12 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			failure = &lnwire.FailInvalidOnionKey{
 				OnionSHA256: msg.ShaOnionBlob,
 			}
+
+		case lnwire.CodeInvalidBlinding:
+			failure = &lnwire.FailInvalidBlinding{
+				OnionSHA256: msg.ShaOnionBlob,
+			}
+
 		default:
 			l.log.Warnf("unexpected failure code received in "+
 				"UpdateFailMailformedHTLC: %v", msg.FailureCode)
@@ -2183,11 +2420,12 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 }
 
 // ChanID returns the channel ID for the channel link. The channel ID is a more
-// compact representation of a channel's full outpoint.
+// compact representation of a channel's full outpoint, or is derived from the
+// revocation base points of both parties for dual funded channels.
 //
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) ChanID() lnwire.ChannelID {
-	return lnwire.NewChanIDFromOutPoint(l.channel.ChannelPoint())
+	return l.channel.ChanID()
 }
 
 // Bandwidth returns the total amount that can flow through the channel link at
@@ -2312,7 +2550,7 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliBronees,
 	incomingTimeout, outgoingTimeout uint32,
-	heightNow uint32) *LinkError {
+	inboundFee channeldb.InboundFee, heightNow uint32) *LinkError {
 
 	l.RLock()
 	policy := l.cfg.FwrdingPolicy
@@ -2329,14 +2567,21 @@ func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
 	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	outboundFee := ExpectedFee(policy, amtToForward)
+
+	// The incoming link may charge an inbound fee on top, which is based
+	// on the outgoing amount plus the outbound fee. Both fees are rounded
+	// separately, like the sender does, to not fail htlcs due to rounding
+	// differences. A negative total fee is treated as zero.
+	expectedFee := int64(outboundFee) +
+		inboundFee.CalcFee(amtToForward+outboundFee)
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
 	// values have been tampered with, or the send used incorrect/dated
 	// information to construct the forwarding information for this hop. In
 	// any case, we'll cancel this HTLC.
-	actualFee := incomingHtlcAmt - amtToForward
+	actualFee := int64(incomingHtlcAmt) - int64(amtToForward)
 	if incomingHtlcAmt < amtToForward || actualFee < expectedFee {
 		l.log.Warnf("outgoing htlc(%x) has insufficient fee: "+
 			"expected %v, got %v",
@@ -2568,6 +2813,166 @@ func (l *channelLink) ShutdownIfChannelClean() error {
 	}
 }
 
+// Quiesce requests the channel to become quiescent by exchanging stfu messages
+// with the remote peer. The returned channel receives the result once the
+// channel is quiescent, or the link shuts down.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Quiesce() <-chan QuiescenceResult {
+	resp := make(chan QuiescenceResult, 1)
+
+	select {
+	case l.quiesceRequests <- resp:
+	case <-l.quit:
+		resp <- QuiescenceResult{Err: ErrLinkShuttingDown}
+	}
+
+	return resp
+}
+
+// ExitQuiescence resumes adding updates to the quiescent channel.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) ExitQuiescence() error {
+	errChan := make(chan error, 1)
+
+	select {
+	case l.exitQuiescenceRequests <- errChan:
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-l.quit:
+		return ErrLinkShuttingDown
+	}
+}
+
+// handleQuiesceRequest handles a local request to make the channel quiescent.
+// If the channel is quiescent already, the result is sent right away.
+// Otherwise, we stop sending updates and send stfu once all our updates are
+// irrevocably committed.
+func (l *channelLink) handleQuiesceRequest(resp chan QuiescenceResult) {
+	if l.channel.IsQuiescent() {
+		resp <- QuiescenceResult{Initiator: l.quiescence.initiator}
+		return
+	}
+
+	features := l.cfg.Peer.RemoteFeatures()
+	if !features.HasFeature(lnwire.QuiescenceOptional) {
+		resp <- QuiescenceResult{Err: ErrQuiescenceNotSupported}
+		return
+	}
+
+	l.quiescence.subscribers = append(l.quiescence.subscribers, resp)
+	l.quiescence.requested = true
+	l.setQuiescing(true)
+
+	l.updateQuiescence()
+}
+
+// handleStfu handles an stfu message of the remote party, after which it must
+// not send any further updates. If we haven't sent stfu ourselves, we stop
+// sending updates and send it once all our updates are irrevocably committed.
+func (l *channelLink) handleStfu(msg *lnwire.Stfu) {
+	if l.quiescence.received {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received duplicate stfu")
+		return
+	}
+
+	// The remote party may only send stfu once all of its updates are
+	// irrevocably committed.
+	if !l.channel.NoDanglingUpdates(false) {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received stfu with dangling updates")
+		return
+	}
+
+	l.log.Debugf("received stfu, initiator=%v", msg.Initiator)
+
+	l.quiescence.received = true
+	l.quiescence.receivedInitiator = msg.Initiator
+	l.setQuiescing(true)
+
+	l.updateQuiescence()
+}
+
+// updateQuiescence progresses the quiescence protocol. We send stfu once
+// either party requested quiescence and all our updates are irrevocably
+// committed, and the channel becomes quiescent once both parties sent stfu.
+func (l *channelLink) updateQuiescence() {
+	q := &l.quiescence
+
+	if (q.requested || q.received) && !q.sent &&
+		l.channel.NoDanglingUpdates(true) {
+
+		// We are the initiator if the remote party hasn't sent stfu
+		// before us.
+		stfu := &lnwire.Stfu{
+			ChanID:    l.ChanID(),
+			Initiator: !q.received,
+		}
+		if err := l.cfg.Peer.SendMessage(false, stfu); err != nil {
+			l.log.Errorf("unable to send stfu: %v", err)
+			return
+		}
+
+		l.log.Debugf("sent stfu, initiator=%v", stfu.Initiator)
+
+		q.sent = true
+		q.sentInitiator = stfu.Initiator
+	}
+
+	if !q.sent || !q.received || l.channel.IsQuiescent() {
+		return
+	}
+
+	if err := l.channel.EnterQuiescence(); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to enter quiescence: %v", err)
+		return
+	}
+
+	// If both parties requested quiescence at the same time, the channel
+	// initiator is the initiator of the quiescence.
+	if q.sentInitiator && q.receivedInitiator {
+		q.initiator = l.channel.IsInitiator()
+	} else {
+		q.initiator = q.sentInitiator
+	}
+
+	l.log.Infof("channel is quiescent, initiator=%v", q.initiator)
+
+	for _, sub := range q.subscribers {
+		sub <- QuiescenceResult{Initiator: q.initiator}
+	}
+	q.subscribers = nil
+
+	// If quiescence was requested to splice the channel, the splice can
+	// be started now.
+	if l.splice.request != nil && l.splice.session == nil {
+		l.startSplice()
+	}
+}
+
+// exitQuiescence resumes adding updates to the quiescent channel.
+func (l *channelLink) exitQuiescence() error {
+	if !l.channel.IsQuiescent() {
+		return ErrLinkNotQuiescent
+	}
+
+	l.log.Infof("channel exits quiescence")
+
+	l.channel.ExitQuiescence()
+	l.quiescence = quiescenceState{}
+	l.setQuiescing(false)
+
+	return nil
+}
+
 // updateChannelFee updates the commitment fee-per-kw on this channel by
 // committing to an update_fee message.
 func (l *channelLink) updateChannelFee(feePerKw chainfee.SatPerKWeight) error {
@@ -2735,9 +3140,11 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			onionReader := bytes.NewReader(pd.OnionBlob)
 
 			req := hop.DecodeHopIteratorRequest{
-				OnionReader:  onionReader,
-				RHash:        pd.RHash[:],
-				IncomingCltv: pd.Timeout,
+				OnionReader:    onionReader,
+				RHash:          pd.RHash[:],
+				IncomingCltv:   pd.Timeout,
+				IncomingAmount: pd.Amount,
+				BlindingPoint:  pd.BlindingPoint,
 			}
 
 			decodeReqs = append(decodeReqs, req)
@@ -2757,6 +3164,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		return
 	}
 
+	// The htlcs that we forward pay the inbound fee of this link, which is
+	// checked by the outgoing link.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	var switchPackets []*htlcPacket
 
 	for i, pd := range lockedInHtlcs {
@@ -2792,8 +3205,9 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// If we're unable to process the onion blob than we
 			// should send the malformed htlc error to payment
 			// sender.
-			l.sendMalformedHTLCError(pd.HtlcIndex, failureCode,
-				onionBlob[:], pd.SourceRef)
+			l.sendMalformedHTLCError(pd.HtlcIndex,
+				blindedFailCode(pd, failureCode), onionBlob[:],
+				pd.SourceRef)
 
 			l.log.Errorf("unable to decode onion hop "+
 				"iterator: %v", failureCode)
@@ -2810,7 +3224,8 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// should send the malformed htlc error to payment
 			// sender.
 			l.sendMalformedHTLCError(
-				pd.HtlcIndex, failureCode, onionBlob[:], pd.SourceRef,
+				pd.HtlcIndex, blindedFailCode(pd, failureCode),
+				onionBlob[:], pd.SourceRef,
 			)
 
 			l.log.Errorf("unable to decode onion "+
@@ -2888,9 +3303,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				// Otherwise, it was already processed, we can
 				// can collect it and continue.
 				addMsg := &lnwire.UpdateAddHTLC{
-					Expiry:      fwdInfo.OutgoingCTLV,
-					Amount:      fwdInfo.AmountToForward,
-					PaymentHash: pd.RHash,
+					Expiry:        fwdInfo.OutgoingCTLV,
+					Amount:        fwdInfo.AmountToForward,
+					PaymentHash:   pd.RHash,
+					BlindingPoint: fwdInfo.NextBlinding,
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -2914,6 +3330,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 					customRecords:   pld.CustomRecords(),
 				}
 				switchPackets = append(
@@ -2930,9 +3347,10 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// create the outgoing HTLC using the parameters as
 			// specified in the forwarding info.
 			addMsg := &lnwire.UpdateAddHTLC{
-				Expiry:      fwdInfo.OutgoingCTLV,
-				Amount:      fwdInfo.AmountToForward,
-				PaymentHash: pd.RHash,
+				Expiry:        fwdInfo.OutgoingCTLV,
+				Amount:        fwdInfo.AmountToForward,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: fwdInfo.NextBlinding,
 			}
 
 			// Finally, we'll encode the onion packet for the
@@ -2978,6 +3396,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 					customRecords:   pld.CustomRecords(),
 				}
 
@@ -3070,10 +3489,28 @@ func (l *channelLink) processExitHop(pd *lnwallet.PaymentDescriptor,
 		HtlcID: pd.HtlcIndex,
 	}
 
-	event, err := l.cfg.Registry.NotifyExitHopHtlc(
-		invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
-		circuitKey, l.hodlQueue.ChanIn(), payload,
+	var (
+		event invoices.HtlcResolution
+		err   error
 	)
+
+	// Htlcs that carry a trampoline onion aren't payments to us, but are
+	// to be forwarded by the trampoline handler, which resolves them
+	// once the outgoing payment completes.
+	trampolinePayload, isTrampoline := payload.(TrampolinePayload)
+	if isTrampoline && trampolinePayload.TrampolineOnion() != nil &&
+		l.cfg.TrampolineHandler != nil {
+
+		event, err = l.cfg.TrampolineHandler.NotifyTrampolineHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
+			circuitKey, l.hodlQueue.ChanIn(), trampolinePayload,
+		)
+	} else {
+		event, err = l.cfg.Registry.NotifyExitHopHtlc(
+			invoiceHash, pd.Amount, pd.Timeout, int32(heightNow),
+			circuitKey, l.hodlQueue.ChanIn(), payload,
+		)
+	}
 	if err != nil {
 		return err
 	}
@@ -3168,23 +3605,35 @@ func (l *channelLink) forwardBatch(packets ...*htlcPacket) {
 func (l *channelLink) sendHTLCError(pd *lnwallet.PaymentDescriptor,
 	failure *LinkError, e hop.ErrorEncrypter, isReceive bool) {
 
-	reason, err := e.EncryptFirstHop(failure.WireMessage())
-	if err != nil {
-		l.log.Errorf("unable to obfuscate error: %v", err)
-		return
-	}
+	// HTLCs that we received within a blinded route after its
+	// introduction node are failed with an invalid_onion_blinding
+	// malformed failure, which doesn't reveal the actual failure.
+	if e.Type() == hop.EncrypterTypeRelaying {
+		l.sendMalformedHTLCError(
+			pd.HtlcIndex, lnwire.CodeInvalidBlinding, pd.OnionBlob,
+			pd.SourceRef,
+		)
+	} else {
+		reason, err := e.EncryptFirstHop(failure.WireMessage())
+		if err != nil {
+			l.log.Errorf("unable to obfuscate error: %v", err)
+			return
+		}
 
-	err = l.channel.FailHTLC(pd.HtlcIndex, reason, pd.SourceRef, nil, nil)
-	if err != nil {
-		l.log.Errorf("unable cancel htlc: %v", err)
-		return
-	}
+		err = l.channel.FailHTLC(
+			pd.HtlcIndex, reason, pd.SourceRef, nil, nil,
+		)
+		if err != nil {
+			l.log.Errorf("unable cancel htlc: %v", err)
+			return
+		}
 
-	l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
-		ChanID: l.ChanID(),
-		ID:     pd.HtlcIndex,
-		Reason: reason,
-	})
+		l.cfg.Peer.SendMessage(false, &lnwire.UpdateFailHTLC{
+			ChanID: l.ChanID(),
+			ID:     pd.HtlcIndex,
+			Reason: reason,
+		})
+	}
 
 	// Notify a link failure on our incoming link. Outgoing htlc information
 	// is not available at this point, because we have not decrypted the
@@ -3219,7 +3668,9 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 	code lnwire.FailCode, onionBlob []byte, sourceRef *channeldb.AddRef) {
 
 	shaOnionBlob := sha256.Sum256(onionBlob)
-	err := l.channel.MalformedFailHTLC(htlcIndex, code, shaOnionBlob, sourceRef)
+	err := l.channel.MalformedFailHTLC(
+		htlcIndex, code, shaOnionBlob, sourceRef, nil, nil,
+	)
 	if err != nil {
 		l.log.Errorf("unable cancel htlc: %v", err)
 		return
@@ -3233,6 +3684,19 @@ func (l *channelLink) sendMalformedHTLCError(htlcIndex uint64,
 	})
 }
 
+// blindedFailCode returns the failure code that an incoming HTLC which we were
+// unable to decode should be failed with. HTLCs that carry a blinding point are
+// part of a blinded route and are always failed with invalid_onion_blinding.
+func blindedFailCode(pd *lnwallet.PaymentDescriptor,
+	code lnwire.FailCode) lnwire.FailCode {
+
+	if pd.BlindingPoint != nil {
+		return lnwire.CodeInvalidBlinding
+	}
+
+	return code
+}
+
 // fail is a function which is used to encapsulate the action necessary for
 // properly failing the link. It takes a LinkFailureError, which will be passed
 // to the OnChannelFailure closure, in order for it to determine if we should
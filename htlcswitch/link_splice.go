@@ -0,0 +1,629 @@
+package htlcswitch
+
+import (
+	"fmt"
+
+	"github.com/brsuite/broln/contractcourt"
+	"github.com/brsuite/broln/labels"
+	"github.com/brsuite/broln/lnwallet"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+	"github.com/brsuite/brond/wire"
+)
+
+// spliceRequest is a local request to splice the channel, together with the
+// channel that receives its result.
+type spliceRequest struct {
+	*SpliceRequest
+
+	resp chan SpliceResult
+}
+
+// spliceState tracks the progress of a splice of the channel.
+type spliceState struct {
+	// request is our request to splice the channel, if we initiate the
+	// splice.
+	request *spliceRequest
+
+	// session is the splice while it is negotiated with the remote party.
+	session *lnwallet.Splice
+
+	// persisted is true once the splice has been persisted before sending
+	// our tx_signatures, after which it can't be aborted anymore.
+	persisted bool
+
+	// remoteLocked is the splice transaction that the remote party sent
+	// splice_locked for, before it was confirmed for us.
+	remoteLocked *chainhash.Hash
+}
+
+// Splice splices funds into or out of the channel. The returned channel
+// receives the result once the splice transaction is broadcast, or the splice
+// failed.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) Splice(req *SpliceRequest) <-chan SpliceResult {
+	resp := make(chan SpliceResult, 1)
+
+	select {
+	case l.spliceRequests <- &spliceRequest{req, resp}:
+	case <-l.quit:
+		resp <- SpliceResult{Err: ErrLinkShuttingDown}
+	}
+
+	return resp
+}
+
+// handleSpliceRequest handles a local request to splice the channel. The
+// splice is started once the channel is quiescent, with us as the initiator.
+func (l *channelLink) handleSpliceRequest(req *spliceRequest) {
+	features := l.cfg.Peer.RemoteFeatures()
+	if !features.HasFeature(lnwire.SpliceOptional) {
+		req.resp <- SpliceResult{Err: ErrSpliceNotSupported}
+		return
+	}
+
+	if l.splice.request != nil || l.splice.session != nil {
+		req.resp <- SpliceResult{Err: ErrSpliceInProgress}
+		return
+	}
+
+	// Dangling updates are committed while the channel becomes quiescent,
+	// so we only check that the channel can be spliced otherwise.
+	err := l.channel.ValidateSplice()
+	if err != nil && err != lnwallet.ErrDanglingUpdates {
+		req.resp <- SpliceResult{Err: err}
+		return
+	}
+
+	l.splice.request = req
+
+	if l.channel.IsQuiescent() {
+		l.startSplice()
+		return
+	}
+
+	l.quiescence.requested = true
+	l.setQuiescing(true)
+
+	l.updateQuiescence()
+}
+
+// startSplice sends splice_init for our splice request once the channel is
+// quiescent.
+func (l *channelLink) startSplice() {
+	if !l.quiescence.initiator {
+		l.failSplice(ErrSpliceNotInitiator)
+		return
+	}
+
+	req := l.splice.request
+	msg := &lnwire.SpliceInit{
+		ChannelID:               l.ChanID(),
+		FundingContribution:     req.Intent.Contribution,
+		FundingFeePerKiloWeight: uint32(req.Intent.FeeRate),
+		LockTime:                req.LockTime,
+		FundingKey:              l.channel.LocalFundingKey,
+	}
+	if err := l.cfg.Peer.SendMessage(false, msg); err != nil {
+		l.abortSplice(fmt.Errorf("unable to send splice_init: %v", err))
+		return
+	}
+
+	l.log.Infof("initiated splice, contribution=%v",
+		req.Intent.Contribution)
+}
+
+// handleSpliceMsg handles the messages of a splice, and returns false if the
+// message isn't part of a splice.
+func (l *channelLink) handleSpliceMsg(msg lnwire.Message) bool {
+	switch msg := msg.(type) {
+	case *lnwire.SpliceInit:
+		l.handleSpliceInit(msg)
+
+	case *lnwire.SpliceAck:
+		l.handleSpliceAck(msg)
+
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete:
+
+		l.handleInteractiveTxMsg(msg)
+
+	// While a splice is negotiated, the commitment signatures are those
+	// for the new funding output.
+	case *lnwire.CommitSig:
+		if l.splice.session == nil {
+			return false
+		}
+		l.handleSpliceCommitSig(msg)
+
+	case *lnwire.TxSignatures:
+		l.handleSpliceTxSignatures(msg)
+
+	case *lnwire.TxAbort:
+		l.handleTxAbort(msg)
+
+	case *lnwire.SpliceLocked:
+		l.handleSpliceLocked(msg)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+// handleSpliceInit handles a splice initiated by the remote party. We accept
+// it without contributing to the splice ourselves.
+func (l *channelLink) handleSpliceInit(msg *lnwire.SpliceInit) {
+	if !l.channel.IsQuiescent() || l.quiescence.initiator ||
+		l.splice.session != nil {
+
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received unexpected splice_init")
+		return
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FundingFeePerKiloWeight)
+	switch {
+	case !msg.FundingKey.IsEqual(l.channel.RemoteFundingKey):
+		l.abortSplice(fmt.Errorf("funding key of splice doesn't " +
+			"match"))
+		return
+
+	case feeRate < chainfee.FeePerKwFloor:
+		l.abortSplice(fmt.Errorf("splice fee rate %v below minimum "+
+			"%v", feeRate, chainfee.FeePerKwFloor))
+		return
+	}
+
+	session, err := l.channel.NewSplice(
+		nil, false, 0, msg.FundingContribution, feeRate, msg.LockTime,
+		l.cfg.NodeKey,
+	)
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to accept splice: %v", err))
+		return
+	}
+	l.splice.session = session
+
+	ack := &lnwire.SpliceAck{
+		ChannelID:  l.ChanID(),
+		FundingKey: l.channel.LocalFundingKey,
+	}
+	if err := l.cfg.Peer.SendMessage(false, ack); err != nil {
+		l.abortSplice(fmt.Errorf("unable to send splice_ack: %v", err))
+		return
+	}
+
+	l.log.Infof("accepted splice, remote contribution=%v",
+		msg.FundingContribution)
+}
+
+// handleSpliceAck handles the remote party's acceptance of our splice, after
+// which we start constructing the splice transaction.
+func (l *channelLink) handleSpliceAck(msg *lnwire.SpliceAck) {
+	req := l.splice.request
+	if req == nil || l.splice.session != nil {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"received unexpected splice_ack")
+		return
+	}
+
+	if !msg.FundingKey.IsEqual(l.channel.RemoteFundingKey) {
+		l.abortSplice(fmt.Errorf("funding key of splice doesn't " +
+			"match"))
+		return
+	}
+
+	session, err := l.channel.NewSplice(
+		req.Intent, true, req.Intent.Contribution,
+		msg.FundingContribution, req.Intent.FeeRate, req.LockTime,
+		l.cfg.NodeKey,
+	)
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to start splice: %v", err))
+		return
+	}
+	l.splice.session = session
+
+	l.sendNextSpliceMsg()
+}
+
+// handleInteractiveTxMsg handles the remote party's message for the
+// construction of the splice transaction.
+func (l *channelLink) handleInteractiveTxMsg(msg lnwire.Message) {
+	session := l.splice.session
+	if session == nil {
+		// The message may have been sent before the remote party
+		// received our tx_abort.
+		l.log.Warnf("ignoring %T without splice", msg)
+		return
+	}
+
+	if err := session.ProcessMsg(msg); err != nil {
+		l.abortSplice(fmt.Errorf("invalid %T: %v", msg, err))
+		return
+	}
+
+	// If the message was the tx_complete that concluded the construction,
+	// we don't respond to it, but continue with the commitment signature
+	// right away.
+	if session.Done() {
+		l.sendSpliceCommitSig()
+		return
+	}
+
+	l.sendNextSpliceMsg()
+}
+
+// sendNextSpliceMsg sends our next message for the construction of the
+// splice transaction. If it concluded the construction, we continue with the
+// commitment signature.
+func (l *channelLink) sendNextSpliceMsg() {
+	session := l.splice.session
+
+	msg, err := session.NextMsg()
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to construct splice "+
+			"transaction: %v", err))
+		return
+	}
+	if err := l.cfg.Peer.SendMessage(false, msg); err != nil {
+		l.abortSplice(fmt.Errorf("unable to send %T: %v", msg, err))
+		return
+	}
+
+	if session.Done() {
+		l.sendSpliceCommitSig()
+	}
+}
+
+// sendSpliceCommitSig signs the remote party's commitment that spends the
+// new funding output once the splice transaction has been constructed.
+func (l *channelLink) sendSpliceCommitSig() {
+	commitSig, err := l.splice.session.SignCommitment()
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to sign splice commitment: "+
+			"%v", err))
+		return
+	}
+	if err := l.cfg.Peer.SendMessage(false, commitSig); err != nil {
+		l.abortSplice(fmt.Errorf("unable to send commit_sig: %v", err))
+	}
+}
+
+// handleSpliceCommitSig handles the remote party's signature for our
+// commitment that spends the new funding output. If we must send our
+// tx_signatures first, we do so right away.
+func (l *channelLink) handleSpliceCommitSig(msg *lnwire.CommitSig) {
+	session := l.splice.session
+	if err := session.ReceiveCommitSig(msg); err != nil {
+		l.abortSplice(fmt.Errorf("invalid splice commit_sig: %v", err))
+		return
+	}
+
+	if session.SendTxSignaturesFirst() {
+		l.sendSpliceTxSignatures()
+	}
+}
+
+// sendSpliceTxSignatures sends our tx_signatures for the splice transaction.
+// The splice is persisted first, as the remote party is able to broadcast
+// the splice transaction once it has received our signatures.
+func (l *channelLink) sendSpliceTxSignatures() {
+	session := l.splice.session
+
+	msg, err := session.TxSignatures()
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to sign splice transaction: "+
+			"%v", err))
+		return
+	}
+
+	err = l.channel.State().AddSplice(session.ChannelSplice())
+	if err != nil {
+		l.abortSplice(fmt.Errorf("unable to persist splice: %v", err))
+		return
+	}
+	l.splice.persisted = true
+
+	// If the message can't be sent, it is sent again once the link is
+	// restarted.
+	if err := l.cfg.Peer.SendMessage(false, msg); err != nil {
+		l.log.Errorf("unable to send tx_signatures: %v", err)
+	}
+}
+
+// handleSpliceTxSignatures handles the remote party's tx_signatures for the
+// splice transaction, after which the splice transaction is broadcast. The
+// message may also be received for a persisted splice after a reconnection.
+func (l *channelLink) handleSpliceTxSignatures(msg *lnwire.TxSignatures) {
+	session := l.splice.session
+	if session == nil {
+		l.completePersistedSplice(msg)
+		return
+	}
+
+	if err := session.ProcessTxSignatures(msg); err != nil {
+		l.abortSplice(fmt.Errorf("invalid tx_signatures: %v", err))
+		return
+	}
+
+	if !l.splice.persisted {
+		l.sendSpliceTxSignatures()
+		if !l.splice.persisted {
+			return
+		}
+	}
+
+	spliceTx, err := session.SignedTx()
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to complete splice transaction: %v", err)
+		return
+	}
+	if !l.publishSplice(spliceTx) {
+		return
+	}
+
+	if req := l.splice.request; req != nil {
+		req.resp <- SpliceResult{SpliceTx: spliceTx}
+	}
+	l.splice = spliceState{remoteLocked: l.splice.remoteLocked}
+}
+
+// completePersistedSplice completes the transaction of a persisted splice
+// with the remote party's tx_signatures, which are sent again after a
+// reconnection until the splice transaction is confirmed.
+func (l *channelLink) completePersistedSplice(msg *lnwire.TxSignatures) {
+	for _, splice := range l.channel.State().PendingSplices() {
+		if splice.FundingOutpoint.Hash != msg.TxID {
+			continue
+		}
+
+		// We already broadcast the splice transaction.
+		if splice.FullySigned() {
+			return
+		}
+
+		spliceTx, err := l.channel.CompleteSpliceTx(splice, msg)
+		if err != nil {
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"invalid tx_signatures: %v", err)
+			return
+		}
+		l.publishSplice(spliceTx)
+
+		return
+	}
+
+	l.log.Warnf("ignoring tx_signatures for unknown splice %v", msg.TxID)
+}
+
+// publishSplice persists the fully signed splice transaction and broadcasts
+// it. It returns false if the link failed.
+func (l *channelLink) publishSplice(spliceTx *wire.MsgTx) bool {
+	if err := l.channel.State().UpdateSpliceTx(spliceTx); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to persist splice transaction: %v", err)
+		return false
+	}
+
+	l.log.Infof("broadcasting splice transaction %v", spliceTx.TxHash())
+
+	shortChanID := l.ShortChanID()
+	label := labels.MakeLabel(labels.LabelTypeChannelSplice, &shortChanID)
+	if err := l.cfg.PublishTransaction(spliceTx, label); err != nil {
+		l.log.Errorf("unable to broadcast splice transaction: %v", err)
+	}
+
+	return true
+}
+
+// handleTxAbort handles the remote party's abort of the splice. We respond
+// with tx_abort ourselves and exit quiescence.
+func (l *channelLink) handleTxAbort(msg *lnwire.TxAbort) {
+	// The message may be the response to our own tx_abort.
+	if l.splice.request == nil && l.splice.session == nil {
+		return
+	}
+
+	if l.splice.persisted {
+		l.log.Warnf("ignoring tx_abort for signed splice: %v",
+			msg.Data)
+		return
+	}
+
+	l.log.Infof("splice aborted by remote party: %v", msg.Data)
+
+	l.abortSplice(ErrSpliceAborted)
+}
+
+// abortSplice aborts the negotiation of a splice, notifies the remote party
+// via tx_abort and exits quiescence. Once our tx_signatures were persisted,
+// the splice can't be aborted anymore, and the link fails instead.
+func (l *channelLink) abortSplice(err error) {
+	if l.splice.persisted {
+		l.fail(LinkFailureError{code: ErrInvalidUpdate},
+			"unable to complete splice: %v", err)
+		return
+	}
+
+	l.log.Warnf("aborting splice: %v", err)
+
+	msg := &lnwire.TxAbort{
+		ChannelID: l.ChanID(),
+		Data:      lnwire.ErrorData(err.Error()),
+	}
+	if err := l.cfg.Peer.SendMessage(false, msg); err != nil {
+		l.log.Errorf("unable to send tx_abort: %v", err)
+	}
+
+	l.failSplice(err)
+
+	if l.channel.IsQuiescent() {
+		if err := l.exitQuiescence(); err != nil {
+			l.log.Errorf("unable to exit quiescence: %v", err)
+		}
+	}
+}
+
+// failSplice notifies the requester of our splice that it failed, and resets
+// the splice state.
+func (l *channelLink) failSplice(err error) {
+	if req := l.splice.request; req != nil {
+		// Our inputs may still be spent by a splice transaction that
+		// was signed already.
+		if !l.splice.persisted {
+			req.Intent.Cancel()
+		}
+		req.resp <- SpliceResult{Err: err}
+	}
+
+	l.splice = spliceState{remoteLocked: l.splice.remoteLocked}
+}
+
+// resumeSplices resumes the splices of the channel once the link is
+// restarted. The tx_signatures of splices that aren't fully signed yet are
+// sent again, the transactions of fully signed splices are broadcast again,
+// and splice_locked is sent again until the remote party locked the splice.
+func (l *channelLink) resumeSplices() {
+	chanState := l.channel.State()
+	if !chanState.AwaitingSpliceLocked() &&
+		len(chanState.PendingSplices()) == 0 {
+
+		return
+	}
+
+	// The channel stays quiescent until the splice is locked.
+	l.setQuiescing(true)
+
+	for _, splice := range chanState.PendingSplices() {
+		if splice.FullySigned() {
+			l.publishSplice(splice.SpliceTx)
+			continue
+		}
+
+		err := l.cfg.Peer.SendMessage(false, splice.LocalSigs)
+		if err != nil {
+			l.log.Errorf("unable to send tx_signatures: %v", err)
+		}
+	}
+
+	if chanState.AwaitingSpliceLocked() {
+		l.sendSpliceLocked()
+	}
+}
+
+// handleSpliceConfirmed moves the link to the new funding output once a
+// splice of the channel has been confirmed, and sends splice_locked to the
+// remote party.
+func (l *channelLink) handleSpliceConfirmed(
+	info *contractcourt.SpliceConfirmedInfo) {
+
+	oldShortChanID := l.ShortChanID()
+
+	err := l.channel.PromoteSplice(info.FundingOutpoint, info.ShortChanID)
+	if err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to promote splice: %v", err)
+		return
+	}
+
+	l.log.Infof("splice confirmed, channel_point=%v, short_chan_id=%v",
+		info.FundingOutpoint, info.ShortChanID)
+
+	// The subscription of the chain watcher for the old funding output
+	// is replaced by that for the new one.
+	l.Lock()
+	l.shortChanID = info.ShortChanID
+	chainEvents := l.cfg.ChainEvents
+	l.cfg.ChainEvents = info.ChainEvents
+	l.Unlock()
+
+	if chainEvents.Cancel != nil {
+		chainEvents.Cancel()
+	}
+
+	go func() {
+		err := l.cfg.UpdateContractSignals(&contractcourt.ContractSignals{
+			HtlcUpdates: l.htlcUpdates,
+			ShortChanID: info.ShortChanID,
+		})
+		if err != nil {
+			l.log.Errorf("unable to update signals")
+		}
+	}()
+
+	err = l.cfg.SplicedShortChanID(l.ChanID(), oldShortChanID)
+	if err != nil {
+		l.log.Errorf("unable to update short_chan_id: %v", err)
+	}
+
+	l.sendSpliceLocked()
+
+	remoteLocked := l.splice.remoteLocked
+	if remoteLocked != nil && *remoteLocked == info.FundingOutpoint.Hash {
+		l.lockSplice()
+	}
+}
+
+// sendSpliceLocked sends splice_locked for the current funding transaction of
+// the channel.
+func (l *channelLink) sendSpliceLocked() {
+	msg := &lnwire.SpliceLocked{
+		ChannelID:  l.ChanID(),
+		SpliceTxID: l.channel.ChannelPoint().Hash,
+	}
+	if err := l.cfg.Peer.SendMessage(false, msg); err != nil {
+		l.log.Errorf("unable to send splice_locked: %v", err)
+	}
+}
+
+// handleSpliceLocked handles the remote party's splice_locked. Once both
+// parties locked the splice, the channel exits quiescence.
+func (l *channelLink) handleSpliceLocked(msg *lnwire.SpliceLocked) {
+	chanState := l.channel.State()
+	isFunding := msg.SpliceTxID == l.channel.ChannelPoint().Hash
+
+	switch {
+	case isFunding && chanState.AwaitingSpliceLocked():
+		l.lockSplice()
+
+	// We locked the splice already, but the remote party may not have
+	// received our splice_locked before a reconnection.
+	case isFunding:
+		l.sendSpliceLocked()
+
+	default:
+		for _, splice := range chanState.PendingSplices() {
+			if splice.FundingOutpoint.Hash == msg.SpliceTxID {
+				txid := msg.SpliceTxID
+				l.splice.remoteLocked = &txid
+				return
+			}
+		}
+
+		l.log.Warnf("ignoring splice_locked for unknown splice %v",
+			msg.SpliceTxID)
+	}
+}
+
+// lockSplice marks the splice as locked by both parties, after which the
+// channel exits quiescence.
+func (l *channelLink) lockSplice() {
+	if err := l.channel.State().MarkSpliceLocked(); err != nil {
+		l.fail(LinkFailureError{code: ErrInternalError},
+			"unable to lock splice: %v", err)
+		return
+	}
+	l.splice.remoteLocked = nil
+
+	l.log.Infof("splice locked by both parties")
+
+	if err := l.exitQuiescence(); err != nil {
+		l.log.Errorf("unable to exit quiescence: %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/contractcourt"
+	"github.com/brsuite/broln/input"
+	"github.com/brsuite/broln/lntest/wait"
+	"github.com/brsuite/broln/lnwallet"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwallet/chanfunding"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/txscript"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelLinkSplice tests that the link splices funds out of the channel
+// once it is quiescent, broadcasts the signed splice transaction, and moves
+// to the new funding output once the splice is confirmed and locked.
+func TestChannelLinkSplice(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = bronutil.SatoshiPerBrocoin * 5
+	aliceLink, bobChannel, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	var (
+		coreLink      = aliceLink.(*channelLink)
+		aliceMsgs     = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+		published     = make(chan *wire.MsgTx, 1)
+		spliceConfirm = make(chan *contractcourt.SpliceConfirmedInfo, 1)
+		splicedScid   = make(chan lnwire.ShortChannelID, 1)
+	)
+
+	// The identity key of each channel state is that of the remote party.
+	coreLink.cfg.NodeKey = bobChannel.State().IdentityPub
+	coreLink.cfg.PublishTransaction = func(tx *wire.MsgTx, _ string) error {
+		published <- tx
+		return nil
+	}
+	coreLink.cfg.SplicedShortChanID = func(_ lnwire.ChannelID,
+		oldScid lnwire.ShortChannelID) error {
+
+		splicedScid <- oldScid
+		return nil
+	}
+	coreLink.cfg.ChainEvents.SpliceConfirmed = spliceConfirm
+
+	require.NoError(t, start())
+
+	receiveMsg := func() lnwire.Message {
+		t.Helper()
+
+		select {
+		case msg := <-aliceMsgs:
+			return msg
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("did not receive message from Alice")
+			return nil
+		}
+	}
+
+	// Alice removes 1 BRON from the channel to a wallet output.
+	feeRate := chainfee.SatPerKWeight(1000)
+	spliceOut := wire.NewTxOut(bronutil.BroneesPerBrocoin, []byte{
+		txscript.OP_0, txscript.OP_DATA_20,
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+	})
+	sharedOut := &wire.TxOut{PkScript: make([]byte, input.P2WSHSize)}
+	weight := chanfunding.InteractiveTxWeight(
+		true, true, 0, []*wire.TxOut{spliceOut}, sharedOut,
+	)
+	intent := &lnwallet.SpliceIntent{
+		Contribution: -bronutil.BroneesPerBrocoin -
+			feeRate.FeeForWeight(weight),
+		FeeRate: feeRate,
+		Outputs: []*wire.TxOut{spliceOut},
+	}
+	resultChan := aliceLink.Splice(&SpliceRequest{Intent: intent})
+
+	// The channel is made quiescent first, with Alice as the initiator.
+	stfu, ok := receiveMsg().(*lnwire.Stfu)
+	require.True(t, ok)
+	require.True(t, stfu.Initiator)
+
+	require.NoError(t, bobChannel.EnterQuiescence())
+	aliceLink.HandleChannelUpdate(&lnwire.Stfu{
+		ChanID: coreLink.ChanID(),
+	})
+
+	spliceInit, ok := receiveMsg().(*lnwire.SpliceInit)
+	require.True(t, ok)
+	require.Equal(t, intent.Contribution, spliceInit.FundingContribution)
+	require.True(t, spliceInit.FundingKey.IsEqual(
+		bobChannel.RemoteFundingKey,
+	))
+
+	bobSplice, err := bobChannel.NewSplice(
+		nil, false, 0, spliceInit.FundingContribution, feeRate,
+		spliceInit.LockTime, coreLink.channel.State().IdentityPub,
+	)
+	require.NoError(t, err)
+	aliceLink.HandleChannelUpdate(&lnwire.SpliceAck{
+		ChannelID:  coreLink.ChanID(),
+		FundingKey: bobChannel.LocalFundingKey,
+	})
+
+	// Alice adds the shared input and outputs, to which Bob responds with
+	// tx_complete until both parties completed the splice transaction.
+	for !bobSplice.Done() {
+		require.NoError(t, bobSplice.ProcessMsg(receiveMsg()))
+		if bobSplice.Done() {
+			break
+		}
+
+		msg, err := bobSplice.NextMsg()
+		require.NoError(t, err)
+		aliceLink.HandleChannelUpdate(msg)
+	}
+
+	// Both parties sign the commitments that spend the new funding output.
+	aliceCommitSig, ok := receiveMsg().(*lnwire.CommitSig)
+	require.True(t, ok)
+	bobCommitSig, err := bobSplice.SignCommitment()
+	require.NoError(t, err)
+	require.NoError(t, bobSplice.ReceiveCommitSig(aliceCommitSig))
+	aliceLink.HandleChannelUpdate(bobCommitSig)
+
+	// The signatures for the splice transaction are exchanged in the
+	// order of the node keys.
+	if bobSplice.SendTxSignaturesFirst() {
+		bobSigs, err := bobSplice.TxSignatures()
+		require.NoError(t, err)
+		aliceLink.HandleChannelUpdate(bobSigs)
+
+		aliceSigs, ok := receiveMsg().(*lnwire.TxSignatures)
+		require.True(t, ok)
+		require.NoError(t, bobSplice.ProcessTxSignatures(aliceSigs))
+	} else {
+		aliceSigs, ok := receiveMsg().(*lnwire.TxSignatures)
+		require.True(t, ok)
+		require.NoError(t, bobSplice.ProcessTxSignatures(aliceSigs))
+
+		bobSigs, err := bobSplice.TxSignatures()
+		require.NoError(t, err)
+		aliceLink.HandleChannelUpdate(bobSigs)
+	}
+
+	// Alice broadcasts the splice transaction, which matches Bob's.
+	spliceTx, err := bobSplice.SignedTx()
+	require.NoError(t, err)
+
+	select {
+	case tx := <-published:
+		require.Equal(t, spliceTx.TxHash(), tx.TxHash())
+	case <-time.After(5 * time.Second):
+		t.Fatalf("splice transaction not broadcast")
+	}
+
+	select {
+	case result := <-resultChan:
+		require.NoError(t, result.Err)
+		require.Equal(t, spliceTx.TxHash(), result.SpliceTx.TxHash())
+	case <-time.After(5 * time.Second):
+		t.Fatalf("did not receive splice result")
+	}
+
+	// The channel stays quiescent until the splice is locked.
+	require.False(t, aliceLink.EligibleToForward())
+
+	// Once the splice is confirmed, Alice moves to the new funding output
+	// and sends splice_locked.
+	oldScid := aliceLink.ShortChanID()
+	newScid := lnwire.ShortChannelID{
+		BlockHeight: oldScid.BlockHeight + 10,
+	}
+	spliceOutpoint := bobSplice.ChannelSplice().FundingOutpoint
+	spliceConfirm <- &contractcourt.SpliceConfirmedInfo{
+		FundingOutpoint: spliceOutpoint,
+		ShortChanID:     newScid,
+		ChainEvents:     &contractcourt.ChainEventSubscription{},
+	}
+
+	spliceLocked, ok := receiveMsg().(*lnwire.SpliceLocked)
+	require.True(t, ok)
+	require.Equal(t, spliceOutpoint.Hash, spliceLocked.SpliceTxID)
+	require.Equal(t, newScid, aliceLink.ShortChanID())
+	require.Equal(t, spliceOutpoint, *aliceLink.ChannelPoint())
+
+	select {
+	case scid := <-splicedScid:
+		require.Equal(t, oldScid, scid)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("switch not notified of new short_chan_id")
+	}
+
+	// After Bob locked the splice as well, the channel exits quiescence.
+	aliceLink.HandleChannelUpdate(&lnwire.SpliceLocked{
+		ChannelID:  coreLink.ChanID(),
+		SpliceTxID: spliceOutpoint.Hash,
+	})
+	require.NoError(t, wait.Predicate(func() bool {
+		return aliceLink.EligibleToForward()
+	}, 5*time.Second))
+}
@@ -1897,7 +1897,12 @@ func (m *mockPeer) LocalFeatures() *lnwire.FeatureVector {
 	return nil
 }
 func (m *mockPeer) RemoteFeatures() *lnwire.FeatureVector {
-	return nil
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.QuiescenceOptional, lnwire.SpliceOptional,
+		),
+		lnwire.Features,
+	)
 }
 
 func newSingleLinkTestHarness(chanAmt, chanReserve bronutil.Amount) (
@@ -4701,6 +4706,109 @@ func TestChannelLinkNoMoreUpdates(t *testing.T) {
 	}
 }
 
+// TestChannelLinkQuiescence tests that the link exchanges stfu messages with
+// the remote party to make the channel quiescent, refuses to add new updates
+// while quiescing, and resumes once it exits quiescence.
+func TestChannelLinkQuiescence(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = bronutil.SatoshiPerBrocoin * 5
+	aliceLink, _, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, 0)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	require.NoError(t, start())
+
+	var (
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+	)
+
+	receiveStfu := func() *lnwire.Stfu {
+		t.Helper()
+
+		select {
+		case msg := <-aliceMsgs:
+			stfu, ok := msg.(*lnwire.Stfu)
+			require.Truef(t, ok, "expected stfu, got %T", msg)
+			require.Equal(t, coreLink.ChanID(), stfu.ChanID)
+
+			return stfu
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("did not receive stfu")
+			return nil
+		}
+	}
+
+	receiveResult := func(resultChan <-chan QuiescenceResult) QuiescenceResult {
+		t.Helper()
+
+		select {
+		case result := <-resultChan:
+			return result
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("channel did not become quiescent")
+			return QuiescenceResult{}
+		}
+	}
+
+	// Alice requests quiescence, and should send stfu as the initiator as
+	// she doesn't have any dangling updates.
+	resultChan := aliceLink.Quiesce()
+	require.True(t, receiveStfu().Initiator)
+
+	// Alice no longer forwards new htlcs.
+	require.False(t, aliceLink.EligibleToForward())
+
+	select {
+	case <-resultChan:
+		t.Fatalf("channel quiescent before receiving stfu")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once Bob responds with stfu, the channel is quiescent with Alice as
+	// the initiator.
+	aliceLink.HandleChannelUpdate(&lnwire.Stfu{
+		ChanID: coreLink.ChanID(),
+	})
+	result := receiveResult(resultChan)
+	require.NoError(t, result.Err)
+	require.True(t, result.Initiator)
+
+	// Requesting quiescence again returns right away.
+	result = receiveResult(aliceLink.Quiesce())
+	require.NoError(t, result.Err)
+	require.True(t, result.Initiator)
+
+	// Alice refuses to add htlcs to the quiescent channel.
+	htlc := generateHtlc(t, coreLink, 0)
+	pkt := &htlcPacket{
+		incomingChanID: hop.Source,
+		incomingHTLCID: 0,
+		htlc:           htlc,
+	}
+	require.ErrorIs(t, coreLink.handleLocalAddPacket(pkt), ErrLinkQuiescing)
+
+	// After exiting quiescence, the channel can become quiescent on Bob's
+	// request, in which case Alice responds with stfu.
+	require.NoError(t, aliceLink.ExitQuiescence())
+	require.ErrorIs(t, aliceLink.ExitQuiescence(), ErrLinkNotQuiescent)
+	require.True(t, aliceLink.EligibleToForward())
+
+	aliceLink.HandleChannelUpdate(&lnwire.Stfu{
+		ChanID:    coreLink.ChanID(),
+		Initiator: true,
+	})
+	require.False(t, receiveStfu().Initiator)
+
+	result = receiveResult(aliceLink.Quiesce())
+	require.NoError(t, result.Err)
+	require.False(t, result.Initiator)
+}
+
 // checkHasPreimages inspects Alice's preimage cache, and asserts whether the
 // preimages for the provided HTLCs are known and unknown, and that all of them
 // match the expected status of expOk.
@@ -5378,6 +5486,76 @@ func TestChannelLinkCleanupSpuriousResponses(t *testing.T) {
 	}
 }
 
+// TestChannelLinkFailBlindedRelay tests that HTLCs that we relayed within a
+// blinded route are failed with an invalid_onion_blinding malformed failure,
+// rather than the failure that was returned to us through the switch.
+func TestChannelLinkFailBlindedRelay(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = bronutil.SatoshiPerBrocoin * 5
+	const chanReserve = bronutil.SatoshiPerBrocoin * 1
+	aliceLink, bobChannel, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, chanReserve)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	require.NoError(t, start())
+
+	var (
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+	)
+
+	// Settle Alice in hodl ExitSettle mode so that she won't respond to
+	// the htlc herself, leaving us in control of the failure.
+	coreLink.cfg.HodlMask = hodl.ExitSettle.Mask()
+
+	ctx := linkTestContext{
+		t:          t,
+		aliceLink:  aliceLink,
+		aliceMsgs:  aliceMsgs,
+		bobChannel: bobChannel,
+	}
+
+	htlc := generateHtlc(t, coreLink, 0)
+	ctx.sendHtlcBobToAlice(htlc)
+	ctx.sendCommitSigBobToAlice(1)
+	ctx.receiveRevAndAckAliceToBob()
+	ctx.receiveCommitSigAliceToBob(1)
+	ctx.sendRevAndAckBobToAlice()
+
+	// Fail the htlc as if it had been relayed within a blinded route and
+	// failed further down the route.
+	encrypter := hop.NewRelayingErrorEncrypter()
+	encrypter.OnionSHA256 = [32]byte{1, 2, 3}
+	err = aliceLink.handleSwitchPacket(&htlcPacket{
+		incomingChanID: bobChannel.ShortChanID(),
+		incomingHTLCID: 0,
+		obfuscator:     encrypter,
+		htlc: &lnwire.UpdateFailHTLC{
+			Reason: []byte{0x01},
+		},
+	})
+	require.NoError(t, err)
+
+	var msg lnwire.Message
+	select {
+	case msg = <-aliceMsgs:
+	case <-time.After(15 * time.Second):
+		t.Fatalf("did not receive message")
+	}
+
+	malformed, ok := msg.(*lnwire.UpdateFailMalformedHTLC)
+	require.True(t, ok, "expected UpdateFailMalformedHTLC, got %T", msg)
+	require.Equal(t, lnwire.CodeInvalidBlinding, malformed.FailureCode)
+	require.Equal(t, encrypter.OnionSHA256, malformed.ShaOnionBlob)
+
+	// Bob converts the malformed failure into a regular one, after which
+	// Alice should commit to it as usual.
+	require.NoError(t, bobChannel.ReceiveFailHTLC(malformed.ID, nil))
+	ctx.receiveCommitSigAliceToBob(0)
+}
+
 type mockPackager struct {
 	failLoadFwdPkgs bool
 }
@@ -5568,6 +5746,45 @@ func TestChannelLinkFail(t *testing.T) {
 			true,
 			false,
 		},
+		{
+			// Test that we fail the link if we receive stfu while
+			// the remote party has dangling updates.
+			func(c *channelLink) {
+			},
+			func(t *testing.T, c *channelLink, remoteChannel *lnwallet.LightningChannel) {
+				htlc1 := generateHtlc(t, c, 0)
+				ctx := linkTestContext{
+					t:          t,
+					aliceLink:  c,
+					bobChannel: remoteChannel,
+				}
+				ctx.sendHtlcBobToAlice(htlc1)
+
+				c.HandleChannelUpdate(&lnwire.Stfu{
+					ChanID:    c.ChanID(),
+					Initiator: true,
+				})
+			},
+			false,
+			false,
+		},
+		{
+			// Test that we fail the link if we receive an update
+			// after the remote party sent stfu.
+			func(c *channelLink) {
+			},
+			func(t *testing.T, c *channelLink, remoteChannel *lnwallet.LightningChannel) {
+				c.HandleChannelUpdate(&lnwire.Stfu{
+					ChanID:    c.ChanID(),
+					Initiator: true,
+				})
+
+				htlc1 := generateHtlc(t, c, 0)
+				c.HandleChannelUpdate(htlc1)
+			},
+			false,
+			false,
+		},
 		{
 			// Test that we consider the failure permanent if we
 			// receive a link error from the remote.
@@ -5788,7 +6005,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("satisfied", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 0)
+			200, 150, channeldb.InboundFee{}, 0)
 		if result != nil {
 			t.Fatalf("expected policy to be satisfied")
 		}
@@ -5796,7 +6013,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("below minhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 100, 50,
-			200, 150, 0)
+			200, 150, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailAmountBelowMinimum); !ok {
 			t.Fatalf("expected FailAmountBelowMinimum failure code")
 		}
@@ -5804,7 +6021,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("above maxhtlc", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1200,
-			200, 150, 0)
+			200, 150, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailTemporaryChannelFailure); !ok {
 			t.Fatalf("expected FailTemporaryChannelFailure failure code")
 		}
@@ -5812,7 +6029,31 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("insufficient fee", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1005, 1000,
-			200, 150, 0)
+			200, 150, channeldb.InboundFee{}, 0)
+		if _, ok := result.WireMessage().(*lnwire.FailFeeInsufficient); !ok {
+			t.Fatalf("expected FailFeeInsufficient failure code")
+		}
+	})
+
+	t.Run("inbound discount", func(t *testing.T) {
+		// The discount of the incoming link lowers the fee that needs
+		// to be paid.
+		discount := channeldb.InboundFee{Base: -5}
+		result := link.CheckHtlcForward(hash, 1005, 1000,
+			200, 150, discount, 0)
+		require.Nil(t, result)
+
+		// The total fee is never negative.
+		discount = channeldb.InboundFee{Base: -50}
+		result = link.CheckHtlcForward(hash, 1000, 1000,
+			200, 150, discount, 0)
+		require.Nil(t, result)
+	})
+
+	t.Run("insufficient inbound fee", func(t *testing.T) {
+		inboundFee := channeldb.InboundFee{Base: 10}
+		result := link.CheckHtlcForward(hash, 1015, 1000,
+			200, 150, inboundFee, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailFeeInsufficient); !ok {
 			t.Fatalf("expected FailFeeInsufficient failure code")
 		}
@@ -5820,7 +6061,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("expiry too soon", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 150, 190)
+			200, 150, channeldb.InboundFee{}, 190)
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooSoon); !ok {
 			t.Fatalf("expected FailExpiryTooSoon failure code")
 		}
@@ -5828,7 +6069,7 @@ func TestCheckHtlcForward(t *testing.T) {
 
 	t.Run("incorrect cltv expiry", func(t *testing.T) {
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			200, 190, 0)
+			200, 190, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailIncorrectCltvExpiry); !ok {
 			t.Fatalf("expected FailIncorrectCltvExpiry failure code")
 		}
@@ -5838,7 +6079,7 @@ func TestCheckHtlcForward(t *testing.T) {
 	t.Run("cltv expiry too far in the future", func(t *testing.T) {
 		// Check that expiry isn't too far in the future.
 		result := link.CheckHtlcForward(hash, 1500, 1000,
-			10200, 10100, 0)
+			10200, 10100, channeldb.InboundFee{}, 0)
 		if _, ok := result.WireMessage().(*lnwire.FailExpiryTooFar); !ok {
 			t.Fatalf("expected FailExpiryTooFar failure code")
 		}
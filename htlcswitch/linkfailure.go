@@ -8,6 +8,38 @@ var (
 
 	// ErrLinkFailedShutdown signals that a requested shutdown failed.
 	ErrLinkFailedShutdown = errors.New("link failed to shutdown")
+
+	// ErrLinkQuiescing signals that the link refuses new updates, as the
+	// channel is becoming or is quiescent.
+	ErrLinkQuiescing = errors.New("link is quiescing")
+
+	// ErrLinkNotQuiescent signals that the link can't exit quiescence, as
+	// the channel isn't quiescent.
+	ErrLinkNotQuiescent = errors.New("link is not quiescent")
+
+	// ErrQuiescenceNotSupported signals that the channel can't become
+	// quiescent, as the remote peer doesn't support the quiescence
+	// protocol.
+	ErrQuiescenceNotSupported = errors.New("remote peer doesn't support " +
+		"quiescence")
+
+	// ErrSpliceNotSupported signals that the channel can't be spliced, as
+	// the remote peer doesn't support splicing.
+	ErrSpliceNotSupported = errors.New("remote peer doesn't support " +
+		"splicing")
+
+	// ErrSpliceNotInitiator signals that the channel can't be spliced, as
+	// the remote party is the initiator of the quiescence.
+	ErrSpliceNotInitiator = errors.New("remote party is the initiator " +
+		"of the quiescence")
+
+	// ErrSpliceInProgress signals that the channel can't be spliced, as
+	// another splice of the channel is being negotiated.
+	ErrSpliceInProgress = errors.New("splice already in progress")
+
+	// ErrSpliceAborted signals that the splice was aborted by the remote
+	// party.
+	ErrSpliceAborted = errors.New("splice aborted by remote party")
 )
 
 // errorCode encodes the possible types of errors that will make us fail the
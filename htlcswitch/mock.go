@@ -749,7 +749,8 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
 func (f *mockChannelLink) CheckHtlcForward([32]byte, lnwire.MilliBronees,
-	lnwire.MilliBronees, uint32, uint32, uint32) *LinkError {
+	lnwire.MilliBronees, uint32, uint32, channeldb.InboundFee,
+	uint32) *LinkError {
 
 	return f.checkHtlcForwardResult
 }
@@ -786,12 +787,25 @@ func (f *mockChannelLink) Stop()                                        {}
 func (f *mockChannelLink) EligibleToForward() bool                      { return f.eligible }
 func (f *mockChannelLink) MayAddOutgoingHtlc(lnwire.MilliBronees) error { return nil }
 func (f *mockChannelLink) ShutdownIfChannelClean() error                { return nil }
+func (f *mockChannelLink) ExitQuiescence() error                        { return nil }
 func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) Quiesce() <-chan QuiescenceResult {
+	resp := make(chan QuiescenceResult, 1)
+	resp <- QuiescenceResult{}
+	return resp
+}
+
+func (f *mockChannelLink) Splice(*SpliceRequest) <-chan SpliceResult {
+	resp := make(chan SpliceResult, 1)
+	resp <- SpliceResult{}
+	return resp
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 func newDB() (*channeldb.DB, func(), error) {
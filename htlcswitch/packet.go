@@ -43,6 +43,11 @@ type htlcPacket struct {
 	// amount is the value of the HTLC that is being created or modified.
 	amount lnwire.MilliBronees
 
+	// inboundFee is the inbound fee of the incoming link at the time the
+	// HTLC was forwarded. It is checked by the outgoing link together
+	// with its own fee.
+	inboundFee channeldb.InboundFee
+
 	// htlc lnwire message type of which depends on switch request type.
 	htlc lnwire.Message
 
@@ -106,6 +111,16 @@ func (p *htlcPacket) inKey() CircuitKey {
 	}
 }
 
+// errorEncrypter returns the error encrypter of the incoming htlc, which is
+// taken from the packet's circuit if one was committed.
+func (p *htlcPacket) errorEncrypter() hop.ErrorEncrypter {
+	if p.circuit != nil && p.circuit.ErrorEncrypter != nil {
+		return p.circuit.ErrorEncrypter
+	}
+
+	return p.obfuscator
+}
+
 // outKey returns the circuit key used to identify the outgoing, forwarded htlc.
 func (p *htlcPacket) outKey() CircuitKey {
 	return CircuitKey{
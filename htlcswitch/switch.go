@@ -200,6 +200,13 @@ type Config struct {
 	// DustThreshold is the threshold in milli-broneess after which we'll
 	// fail incoming or outgoing dust payments for a particular channel.
 	DustThreshold lnwire.MilliBronees
+
+	// FindBaseScid returns the base ShortChannelID of the channel the
+	// passed alias refers to. Links are indexed by their base
+	// ShortChannelID, so this allows HTLCs to be forwarded over channels
+	// that are referred to by an alias. An error is returned if the
+	// passed ShortChannelID isn't a known alias.
+	FindBaseScid func(lnwire.ShortChannelID) (lnwire.ShortChannelID, error)
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -561,18 +568,24 @@ func (s *Switch) UpdateForwardingPolicies(
 
 	s.indexMtx.RLock()
 
-	// Update each link in chanPolicies.
-	for targetLink, policy := range chanPolicies {
-		cid := lnwire.NewChanIDFromOutPoint(&targetLink)
-
-		link, ok := s.linkIndex[cid]
+	// Update each link in chanPolicies. As the channel ID of dual funded
+	// channels isn't derived from their channel point, we'll look up the
+	// policy of each link by its channel point instead.
+	var numUpdated int
+	for _, link := range s.linkIndex {
+		policy, ok := chanPolicies[*link.ChannelPoint()]
 		if !ok {
-			log.Debugf("Unable to find ChannelPoint(%v) to update "+
-				"link policy", targetLink)
 			continue
 		}
 
 		link.UpdateForwardingPolicy(policy)
+		numUpdated++
+	}
+
+	if numUpdated < len(chanPolicies) {
+		log.Debugf("Unable to find %v of %v channel points to update "+
+			"link policy", len(chanPolicies)-numUpdated,
+			len(chanPolicies))
 	}
 
 	s.indexMtx.RUnlock()
@@ -1019,6 +1032,11 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.failAddPacket(packet, failure)
 		}
 
+		// If the outgoing channel is referred to by one of its
+		// aliases, we'll use its base ShortChannelID from here on, as
+		// that's what our links are indexed by.
+		packet.outgoingChanID = s.baseScid(packet.outgoingChanID)
+
 		// Before we attempt to find a non-strict forwarding path for
 		// this htlc, check whether the htlc is being routed over the
 		// same incoming and outgoing channel. If our node does not
@@ -1081,7 +1099,8 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				failure = link.CheckHtlcForward(
 					htlc.PaymentHash, packet.incomingAmount,
 					packet.amount, packet.incomingTimeout,
-					packet.outgoingTimeout, currentHeight,
+					packet.outgoingTimeout,
+					packet.inboundFee, currentHeight,
 				)
 			}
 
@@ -1346,6 +1365,7 @@ func (s *Switch) failAddPacket(packet *htlcPacket, failure *LinkError) error {
 		incomingTimeout: packet.incomingTimeout,
 		outgoingTimeout: packet.outgoingTimeout,
 		circuit:         packet.circuit,
+		obfuscator:      packet.obfuscator,
 		linkFailure:     failure,
 		htlc: &lnwire.UpdateFailHTLC{
 			Reason: reason,
@@ -1658,19 +1678,19 @@ out:
 		// relevant link (if it exists) so the channel can be
 		// cooperatively closed (if possible).
 		case req := <-s.chanCloseRequests:
-			chanID := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
-
 			s.indexMtx.RLock()
-			link, ok := s.linkIndex[chanID]
+			link, ok := s.getLinkByChanPoint(*req.ChanPoint)
 			if !ok {
 				s.indexMtx.RUnlock()
 
 				req.Err <- fmt.Errorf("no peer for channel with "+
-					"chan_id=%x", chanID[:])
+					"chan_point=%v", req.ChanPoint)
 				continue
 			}
 			s.indexMtx.RUnlock()
 
+			chanID := link.ChanID()
+
 			peerPub := link.Peer().PubKey()
 			log.Debugf("Requesting local channel close: peer=%v, "+
 				"chan_id=%x", link.Peer(), chanID[:])
@@ -2133,6 +2153,45 @@ func (s *Switch) getLink(chanID lnwire.ChannelID) (ChannelLink, error) {
 	return link, nil
 }
 
+// GetLinkByChanPoint returns the link of the channel with the given funding
+// outpoint. Unlike the ID of other channels, the ID of dual funded channels
+// can't be derived from their funding outpoint, so callers that only know the
+// outpoint of a channel should use this method to look up its link.
+func (s *Switch) GetLinkByChanPoint(chanPoint wire.OutPoint) (
+	ChannelUpdateHandler, error) {
+
+	s.indexMtx.RLock()
+	defer s.indexMtx.RUnlock()
+
+	if link, ok := s.getLinkByChanPoint(chanPoint); ok {
+		return link, nil
+	}
+
+	for _, link := range s.pendingLinkIndex {
+		if *link.ChannelPoint() == chanPoint {
+			return link, nil
+		}
+	}
+
+	return nil, ErrChannelLinkNotFound
+}
+
+// getLinkByChanPoint returns the live link of the channel with the given
+// funding outpoint.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) getLinkByChanPoint(chanPoint wire.OutPoint) (ChannelLink,
+	bool) {
+
+	for _, link := range s.linkIndex {
+		if *link.ChannelPoint() == chanPoint {
+			return link, true
+		}
+	}
+
+	return nil, false
+}
+
 // GetLinkByShortID attempts to return the link which possesses the target short
 // channel ID.
 func (s *Switch) GetLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink,
@@ -2149,7 +2208,7 @@ func (s *Switch) GetLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink,
 //
 // NOTE: This MUST be called with the indexMtx held.
 func (s *Switch) getLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink, error) {
-	link, ok := s.forwardingIndex[chanID]
+	link, ok := s.forwardingIndex[s.baseScid(chanID)]
 	if !ok {
 		return nil, ErrChannelLinkNotFound
 	}
@@ -2157,6 +2216,22 @@ func (s *Switch) getLinkByShortID(chanID lnwire.ShortChannelID) (ChannelLink, er
 	return link, nil
 }
 
+// baseScid returns the base ShortChannelID of the channel identified by the
+// passed ShortChannelID, which may be one of the channel's aliases. If it
+// isn't a known alias, it is returned unchanged.
+func (s *Switch) baseScid(scid lnwire.ShortChannelID) lnwire.ShortChannelID {
+	if s.cfg.FindBaseScid == nil {
+		return scid
+	}
+
+	base, err := s.cfg.FindBaseScid(scid)
+	if err != nil {
+		return scid
+	}
+
+	return base
+}
+
 // HasActiveLink returns true if the given channel ID has a link in the link
 // index AND the link is eligible to forward.
 func (s *Switch) HasActiveLink(chanID lnwire.ChannelID) bool {
@@ -2263,6 +2338,38 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// UpdateSplicedShortChanID updates the short channel ID of a live link after
+// a splice of its channel has been confirmed, such that HTLCs are forwarded
+// via the new short channel ID. The link must already be using it.
+func (s *Switch) UpdateSplicedShortChanID(chanID lnwire.ChannelID,
+	oldShortChanID lnwire.ShortChannelID) error {
+
+	s.indexMtx.Lock()
+	defer s.indexMtx.Unlock()
+
+	link, ok := s.linkIndex[chanID]
+	if !ok {
+		return fmt.Errorf("link %v not found", chanID)
+	}
+
+	shortChanID := link.ShortChanID()
+
+	log.Infof("Updated short_chan_id for spliced ChannelLink(%v): "+
+		"old=%v, new=%v", chanID, oldShortChanID, shortChanID)
+
+	if s.forwardingIndex[oldShortChanID] == link {
+		delete(s.forwardingIndex, oldShortChanID)
+	}
+	s.forwardingIndex[shortChanID] = link
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID, shortChanID)
+	s.mailOrchestrator.BindLiveShortChanID(
+		mailbox, chanID, shortChanID,
+	)
+
+	return nil
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelUpdateHandler,
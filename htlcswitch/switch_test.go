@@ -1,8 +1,10 @@
 package htlcswitch
 
 import (
+	"bytes"
 	"crypto/rand"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,9 +12,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/brsuite/broln/chainntnfs"
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/htlcswitch/hodl"
 	"github.com/brsuite/broln/htlcswitch/hop"
+	"github.com/brsuite/broln/lntest/mock"
 	"github.com/brsuite/broln/lntypes"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/ticker"
@@ -162,6 +166,52 @@ func TestSwitchHasActiveLink(t *testing.T) {
 	}
 }
 
+// TestSwitchGetLinkByAlias tests that a live link can be looked up by any of
+// the aliases of its channel, as well as by its base short channel id.
+func TestSwitchGetLinkByAlias(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+	alias := lnwire.NewShortChanIDFromInt(1234)
+	unknownAlias := lnwire.NewShortChanIDFromInt(5678)
+
+	s.cfg.FindBaseScid = func(
+		scid lnwire.ShortChannelID) (lnwire.ShortChannelID, error) {
+
+		if scid == alias {
+			return aliceChanID, nil
+		}
+
+		return lnwire.ShortChannelID{}, errors.New("unknown alias")
+	}
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+
+	link, err := s.GetLinkByShortID(aliceChanID)
+	require.NoError(t, err)
+	require.Equal(t, aliceChannelLink, link)
+
+	link, err = s.GetLinkByShortID(alias)
+	require.NoError(t, err)
+	require.Equal(t, aliceChannelLink, link)
+
+	_, err = s.GetLinkByShortID(unknownAlias)
+	require.ErrorIs(t, err, ErrChannelLinkNotFound)
+}
+
 // TestSwitchSendPending checks the inability of htlc switch to forward adds
 // over pending links, and the UpdateShortChanID makes a pending link live.
 func TestSwitchSendPending(t *testing.T) {
@@ -3153,7 +3203,7 @@ func (m *mockForwardInterceptor) settle(preimage lntypes.Preimage) error {
 }
 
 func (m *mockForwardInterceptor) fail() error {
-	return m.intercepted.Fail()
+	return m.intercepted.FailWithCode(lnwire.CodeTemporaryChannelFailure)
 }
 
 func (m *mockForwardInterceptor) resume() error {
@@ -3248,10 +3298,11 @@ func TestSwitchHoldForward(t *testing.T) {
 	preimage := [sha256.Size]byte{1}
 	rhash := sha256.Sum256(preimage[:])
 	ogPacket := &htlcPacket{
-		incomingChanID: aliceChannelLink.ShortChanID(),
-		incomingHTLCID: 0,
-		outgoingChanID: bobChannelLink.ShortChanID(),
-		obfuscator:     NewMockObfuscator(),
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		incomingTimeout: testStartingHeight + 100,
+		obfuscator:      NewMockObfuscator(),
 		htlc: &lnwire.UpdateAddHTLC{
 			PaymentHash: rhash,
 			Amount:      1,
@@ -3259,8 +3310,12 @@ func TestSwitchHoldForward(t *testing.T) {
 	}
 
 	forwardInterceptor := &mockForwardInterceptor{}
-	switchForwardInterceptor := NewInterceptableSwitch(s)
-	switchForwardInterceptor.SetInterceptor(forwardInterceptor.InterceptForwardHtlc)
+	switchForwardInterceptor := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch: s,
+		},
+	)
+	switchForwardInterceptor.AddInterceptor(forwardInterceptor.InterceptForwardHtlc)
 	linkQuit := make(chan struct{})
 
 	// Test resume a hold forward
@@ -3321,6 +3376,557 @@ func TestSwitchHoldForward(t *testing.T) {
 	assertNumCircuits(t, s, 0, 0)
 }
 
+// TestSwitchHoldForwardMultipleInterceptors tests that forwards are offered to
+// multiple interceptors in the order of registration, that they can be resumed
+// with modifications and that they can be failed with custom failures.
+func TestSwitchHoldForwardMultipleInterceptors(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+	// Each packet uses a new incoming htlc id, because failed htlcs remain
+	// in alice's mailbox.
+	var nextHtlcID uint64
+	newPacket := func() *htlcPacket {
+		nextHtlcID++
+
+		return &htlcPacket{
+			incomingChanID:  aliceChannelLink.ShortChanID(),
+			incomingHTLCID:  nextHtlcID,
+			outgoingChanID:  bobChannelLink.ShortChanID(),
+			incomingAmount:  1000,
+			amount:          1000,
+			incomingTimeout: testStartingHeight + 100,
+			obfuscator:      NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1000,
+			},
+		}
+	}
+
+	// receiveFail asserts that alice receives a failure and returns its
+	// reason.
+	receiveFail := func() lnwire.OpaqueReason {
+		select {
+		case packet := <-aliceChannelLink.packets:
+			fail, ok := packet.htlc.(*lnwire.UpdateFailHTLC)
+			require.True(t, ok, "expected fail")
+
+			return fail.Reason
+
+		case <-time.After(time.Second):
+			t.Fatal("fail was not propagated to alice")
+		}
+
+		return nil
+	}
+
+	first := &mockForwardInterceptor{}
+	second := &mockForwardInterceptor{}
+	interceptableSwitch := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch: s,
+		},
+	)
+	removeFirst := interceptableSwitch.AddInterceptor(
+		first.InterceptForwardHtlc,
+	)
+	interceptableSwitch.AddInterceptor(second.InterceptForwardHtlc)
+	linkQuit := make(chan struct{})
+
+	// The forward is offered to the first interceptor only.
+	err = interceptableSwitch.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	require.NotNil(t, first.intercepted)
+	require.Nil(t, second.intercepted)
+
+	// An amount that exceeds the incoming amount is rejected.
+	tooMuch := lnwire.MilliBronees(1001)
+	err = first.intercepted.ResumeModified(nil, &tooMuch)
+	require.Error(t, err)
+	require.Nil(t, second.intercepted)
+
+	// When the first interceptor resumes with a lower amount, the modified
+	// forward is offered to the second interceptor.
+	modifiedAmt := lnwire.MilliBronees(900)
+	require.NoError(t, first.intercepted.ResumeModified(nil, &modifiedAmt))
+	require.NotNil(t, second.intercepted)
+	require.Equal(
+		t, modifiedAmt, second.intercepted.Packet().OutgoingAmount,
+	)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// After the second interceptor resumes, bob receives the modified
+	// htlc.
+	require.NoError(t, second.resume())
+	select {
+	case packet := <-bobChannelLink.packets:
+		require.Equal(t, modifiedAmt, packet.amount)
+		htlc, ok := packet.htlc.(*lnwire.UpdateAddHTLC)
+		require.True(t, ok, "expected add")
+		require.Equal(t, modifiedAmt, htlc.Amount)
+		require.NoError(t, bobChannelLink.completeCircuit(packet))
+
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+
+	// Settle the htlc to close the circuit.
+	settle := &htlcPacket{
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		outgoingHTLCID: 0,
+		amount:         modifiedAmt,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+	err = interceptableSwitch.ForwardPackets(linkQuit, settle)
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, aliceChannelLink, true)
+	assertNumCircuits(t, s, 0, 0)
+
+	// Fail a forward with a failure message that originates at this node.
+	first.intercepted = nil
+	second.intercepted = nil
+	err = interceptableSwitch.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	require.NoError(t, first.intercepted.FailWithMessage(
+		&lnwire.FailPermanentChannelFailure{},
+	))
+
+	reason := receiveFail()
+	failure, err := lnwire.DecodeFailure(bytes.NewReader(reason), 0)
+	require.NoError(t, err)
+	require.Equal(t, &lnwire.FailPermanentChannelFailure{}, failure)
+	require.Nil(t, second.intercepted)
+
+	// Fail a forward with an encrypted failure, which the mock obfuscator
+	// passes on unmodified.
+	err = interceptableSwitch.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+
+	encryptedFailure := []byte{1, 2, 3}
+	require.NoError(t, first.intercepted.Fail(encryptedFailure))
+	require.Equal(t, lnwire.OpaqueReason(encryptedFailure), receiveFail())
+
+	// Unsupported failure codes are rejected.
+	err = interceptableSwitch.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	require.Error(t, first.intercepted.FailWithCode(lnwire.CodeMPPTimeout))
+	require.NoError(t, first.intercepted.FailWithCode(
+		lnwire.CodeUnknownNextPeer,
+	))
+	receiveFail()
+
+	// After removing the first interceptor, forwards are offered to the
+	// second interceptor directly.
+	removeFirst()
+	first.intercepted = nil
+	err = interceptableSwitch.ForwardPackets(linkQuit, newPacket())
+	require.NoError(t, err)
+	require.Nil(t, first.intercepted)
+	require.NotNil(t, second.intercepted)
+	require.NoError(t, second.fail())
+	receiveFail()
+}
+
+// TestSwitchHoldForwardRequireInterceptor tests that forwards are held while
+// no interceptor is registered if an interceptor is required, that held
+// forwards are replayed to new interceptors and that held forwards are failed
+// back automatically before they expire.
+func TestSwitchHoldForwardRequireInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const cltvRejectDelta = 10
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+
+	var nextHtlcID uint64
+	newPacket := func(incomingTimeout uint32) *htlcPacket {
+		nextHtlcID++
+
+		return &htlcPacket{
+			incomingChanID:  aliceChannelLink.ShortChanID(),
+			incomingHTLCID:  nextHtlcID,
+			outgoingChanID:  bobChannelLink.ShortChanID(),
+			incomingAmount:  1000,
+			amount:          1000,
+			incomingTimeout: incomingTimeout,
+			obfuscator:      NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1000,
+			},
+		}
+	}
+
+	// newInterceptor returns an interceptor that holds all forwards and
+	// passes them on over the returned channel.
+	newInterceptor := func() (ForwardInterceptor, chan InterceptedForward) {
+		intercepted := make(chan InterceptedForward, 10)
+
+		return func(f InterceptedForward) bool {
+			intercepted <- f
+			return true
+		}, intercepted
+	}
+
+	receiveIntercepted := func(
+		intercepted chan InterceptedForward) InterceptedForward {
+
+		select {
+		case f := <-intercepted:
+			return f
+
+		case <-time.After(time.Second):
+			t.Fatal("forward was not intercepted")
+		}
+
+		return nil
+	}
+
+	assertFailed := func(expectFail bool) {
+		select {
+		case packet := <-aliceChannelLink.packets:
+			require.True(t, expectFail, "unexpected packet")
+			_, ok := packet.htlc.(*lnwire.UpdateFailHTLC)
+			require.True(t, ok, "expected fail")
+
+		case <-time.After(100 * time.Millisecond):
+			require.False(t, expectFail, "fail was not propagated")
+		}
+	}
+
+	assertNotIntercepted := func(intercepted chan InterceptedForward) {
+		select {
+		case <-intercepted:
+			t.Fatal("unexpected interception")
+
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	notifier := &mock.ChainNotifier{
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+	}
+	interceptableSwitch := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch:             s,
+			Notifier:           notifier,
+			RequireInterceptor: true,
+			CltvRejectDelta:    cltvRejectDelta,
+		},
+	)
+	require.NoError(t, interceptableSwitch.Start())
+	defer func() {
+		require.NoError(t, interceptableSwitch.Stop())
+	}()
+	linkQuit := make(chan struct{})
+
+	// Without an interceptor, the forward is held instead of forwarded.
+	packet := newPacket(testStartingHeight + 100)
+	err = interceptableSwitch.ForwardPackets(linkQuit, packet)
+	require.NoError(t, err)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// Once an interceptor is registered, the forward is replayed to it.
+	firstInterceptor, firstIntercepted := newInterceptor()
+	removeFirst := interceptableSwitch.AddInterceptor(firstInterceptor)
+	forward := receiveIntercepted(firstIntercepted)
+	require.Equal(
+		t, uint32(testStartingHeight+100-cltvRejectDelta),
+		forward.Packet().AutoFailHeight,
+	)
+
+	// A replay of the forward by the link isn't offered again.
+	err = interceptableSwitch.ForwardPackets(linkQuit, packet)
+	require.NoError(t, err)
+	assertNotIntercepted(firstIntercepted)
+
+	// When the interceptor is removed, the forward is held again and can't
+	// be resolved by the removed interceptor anymore.
+	removeFirst()
+	require.ErrorIs(t, forward.Resume(), ErrFwdNotExists)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// The forward is replayed to the next interceptor, which resumes it.
+	secondInterceptor, secondIntercepted := newInterceptor()
+	interceptableSwitch.AddInterceptor(secondInterceptor)
+	forward = receiveIntercepted(secondIntercepted)
+	require.NoError(t, forward.Resume())
+	assertOutgoingLinkReceive(t, bobChannelLink, true)
+
+	// A forward that expires soon is failed right away instead of being
+	// intercepted.
+	err = interceptableSwitch.ForwardPackets(
+		linkQuit, newPacket(testStartingHeight+cltvRejectDelta),
+	)
+	require.NoError(t, err)
+	assertNotIntercepted(secondIntercepted)
+	assertFailed(true)
+
+	// A held forward is failed back automatically once the auto fail
+	// height is reached.
+	err = interceptableSwitch.ForwardPackets(
+		linkQuit, newPacket(testStartingHeight+20),
+	)
+	require.NoError(t, err)
+	forward = receiveIntercepted(secondIntercepted)
+
+	notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: testStartingHeight + 9,
+	}
+	assertFailed(false)
+
+	notifier.EpochChan <- &chainntnfs.BlockEpoch{
+		Height: testStartingHeight + 10,
+	}
+	assertFailed(true)
+	require.ErrorIs(
+		t, forward.FailWithCode(lnwire.CodeTemporaryNodeFailure),
+		ErrFwdNotExists,
+	)
+}
+
+// TestSwitchHoldForwardRemoveInterceptor tests that the forwards that a removed
+// interceptor holds are forwarded if no interceptor is required.
+func TestSwitchHoldForwardRemoveInterceptor(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		incomingTimeout: testStartingHeight + 100,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+
+	forwardInterceptor := &mockForwardInterceptor{}
+	interceptableSwitch := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch: s,
+		},
+	)
+	removeInterceptor := interceptableSwitch.AddInterceptor(
+		forwardInterceptor.InterceptForwardHtlc,
+	)
+	linkQuit := make(chan struct{})
+
+	err = interceptableSwitch.ForwardPackets(linkQuit, packet)
+	require.NoError(t, err)
+	require.NotNil(t, forwardInterceptor.intercepted)
+	assertOutgoingLinkReceive(t, bobChannelLink, false)
+
+	// Removing the interceptor resumes the held forward.
+	removeInterceptor()
+	assertOutgoingLinkReceive(t, bobChannelLink, true)
+	require.ErrorIs(t, forwardInterceptor.fail(), ErrFwdNotExists)
+}
+
+// TestSwitchHoldForwardUnknownChannel tests that forwards to unknown outgoing
+// channels are offered to interceptors and can be resumed onto a link that is
+// added later, like a channel that is opened just in time.
+func TestSwitchHoldForwardUnknownChannel(t *testing.T) {
+	t.Parallel()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	require.NoError(t, err)
+
+	tempPath, err := ioutil.TempDir("", "circuitdb")
+	require.NoError(t, err)
+
+	cdb, err := channeldb.Open(tempPath)
+	require.NoError(t, err)
+
+	s, err := initSwitchWithDB(testStartingHeight, cdb)
+	require.NoError(t, err)
+	require.NoError(t, s.Start())
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	require.NoError(t, s.AddLink(aliceChannelLink))
+
+	// The forward uses a short channel id that no link is known for.
+	unknownChanID := lnwire.NewShortChanIDFromInt(999)
+	preimage := [sha256.Size]byte{1}
+	rhash := sha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  unknownChanID,
+		incomingAmount:  1000,
+		amount:          1000,
+		incomingTimeout: testStartingHeight + 100,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1000,
+		},
+	}
+
+	forwardInterceptor := &mockForwardInterceptor{}
+	interceptableSwitch := NewInterceptableSwitch(
+		&InterceptableSwitchConfig{
+			Switch: s,
+		},
+	)
+	interceptableSwitch.AddInterceptor(
+		forwardInterceptor.InterceptForwardHtlc,
+	)
+	linkQuit := make(chan struct{})
+
+	err = interceptableSwitch.ForwardPackets(linkQuit, packet)
+	require.NoError(t, err)
+	require.NotNil(t, forwardInterceptor.intercepted)
+	require.Equal(
+		t, unknownChanID,
+		forwardInterceptor.intercepted.Packet().OutgoingChanID,
+	)
+	assertOutgoingLinkReceive(t, aliceChannelLink, false)
+
+	// Now a channel to bob is opened and the forward is resumed onto it,
+	// with a part of the amount kept as a fee.
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	require.NoError(t, s.AddLink(bobChannelLink))
+
+	outgoingChanID := bobChannelLink.ShortChanID()
+	outgoingAmount := lnwire.MilliBronees(900)
+	err = forwardInterceptor.intercepted.ResumeModified(
+		&outgoingChanID, &outgoingAmount,
+	)
+	require.NoError(t, err)
+
+	select {
+	case packet := <-bobChannelLink.packets:
+		require.Equal(t, outgoingChanID, packet.outgoingChanID)
+		require.Equal(t, outgoingAmount, packet.amount)
+
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+}
+
 // TestSwitchDustForwarding tests that the switch properly fails HTLC's which
 // have incoming or outgoing links that breach their dust thresholds.
 func TestSwitchDustForwarding(t *testing.T) {
@@ -0,0 +1,147 @@
+package invoices
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+)
+
+var (
+	// ErrHtlcModifierAlreadyExists is returned when a new htlc modifier
+	// client is registered while another one is still connected.
+	ErrHtlcModifierAlreadyExists = errors.New("htlc modifier client " +
+		"already registered")
+)
+
+// HtlcModifyRequest is the request that is passed to the htlc modifier client
+// for every exit hop htlc that is about to be processed by the registry.
+type HtlcModifyRequest struct {
+	// Invoice is the invoice that the htlc pays to, as it was before the
+	// htlc arrived.
+	Invoice channeldb.Invoice
+
+	// ExitHtlcCircuitKey is the circuit key that identifies the htlc.
+	ExitHtlcCircuitKey channeldb.CircuitKey
+
+	// ExitHtlcAmt is the amount that the htlc carries.
+	ExitHtlcAmt lnwire.MilliBronees
+
+	// ExitHtlcExpiry is the absolute expiry height of the htlc.
+	ExitHtlcExpiry uint32
+
+	// CurrentHeight is the current block height.
+	CurrentHeight uint32
+
+	// CustomRecords are the custom tlv records that were parsed from the
+	// final hop payload of the htlc.
+	CustomRecords record.CustomSet
+}
+
+// HtlcModifyResponse is the response of the htlc modifier client to a
+// HtlcModifyRequest.
+type HtlcModifyResponse struct {
+	// AmountPaid is the amount that the registry credits to the invoice for
+	// the htlc. If nil, the amount of the htlc is used. For multi-part
+	// payments the overridden amounts of the parts are summed up when
+	// checking whether the full amount of the invoice was paid.
+	AmountPaid *lnwire.MilliBronees
+
+	// Reject indicates that the htlc must be failed back without updating
+	// the invoice.
+	Reject bool
+}
+
+// HtlcModifyCallback is the function that is called with each exit hop htlc.
+// It blocks until the client has decided on the htlc.
+type HtlcModifyCallback func(HtlcModifyRequest) (*HtlcModifyResponse, error)
+
+// HtlcModifier allows a single external client to inspect exit hop htlcs and
+// to reject them or to change the amount that is credited to the invoice
+// before the registry processes them.
+type HtlcModifier interface {
+	// RegisterInterceptor sets the client callback that is called for all
+	// subsequent exit hop htlcs. The returned function unregisters the
+	// callback again. Only a single callback can be registered at a time.
+	RegisterInterceptor(callback HtlcModifyCallback) (func(), error)
+
+	// Intercept passes the request to the registered client callback and
+	// returns its response. If no client is registered, nil is returned.
+	Intercept(req HtlcModifyRequest) (*HtlcModifyResponse, error)
+}
+
+// HtlcModificationInterceptor is the default implementation of the
+// HtlcModifier interface.
+type HtlcModificationInterceptor struct {
+	mu sync.Mutex
+
+	// callback is the client callback that is currently registered, or
+	// nil if there is no client.
+	callback HtlcModifyCallback
+
+	// nextID identifies the registrations, so that a stale unregister
+	// function can't remove a newer client.
+	nextID uint64
+	id     uint64
+}
+
+// A compile time check to ensure HtlcModificationInterceptor implements the
+// HtlcModifier interface.
+var _ HtlcModifier = (*HtlcModificationInterceptor)(nil)
+
+// NewHtlcModificationInterceptor returns a new htlc modifier without a
+// registered client.
+func NewHtlcModificationInterceptor() *HtlcModificationInterceptor {
+	return &HtlcModificationInterceptor{}
+}
+
+// RegisterInterceptor sets the client callback that is called for all
+// subsequent exit hop htlcs.
+//
+// NOTE: Part of the HtlcModifier interface.
+func (h *HtlcModificationInterceptor) RegisterInterceptor(
+	callback HtlcModifyCallback) (func(), error) {
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.callback != nil {
+		return nil, ErrHtlcModifierAlreadyExists
+	}
+
+	h.nextID++
+	id := h.nextID
+
+	h.callback = callback
+	h.id = id
+
+	return func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+
+		if h.id == id {
+			h.callback = nil
+		}
+	}, nil
+}
+
+// Intercept passes the request to the registered client callback and returns
+// its response. The callback is called without holding the lock, so that a
+// slow client doesn't block the registration of a new one.
+//
+// NOTE: Part of the HtlcModifier interface.
+func (h *HtlcModificationInterceptor) Intercept(
+	req HtlcModifyRequest) (*HtlcModifyResponse, error) {
+
+	h.mu.Lock()
+	callback := h.callback
+	h.mu.Unlock()
+
+	if callback == nil {
+		return nil, nil
+	}
+
+	return callback(req)
+}
@@ -0,0 +1,51 @@
+package invoices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcModificationInterceptor tests that only a single client can be
+// registered at a time and that htlcs are only passed to a registered client.
+func TestHtlcModificationInterceptor(t *testing.T) {
+	t.Parallel()
+
+	modifier := NewHtlcModificationInterceptor()
+
+	// Without a client, there is no response.
+	resp, err := modifier.Intercept(HtlcModifyRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	reject := func(HtlcModifyRequest) (*HtlcModifyResponse, error) {
+		return &HtlcModifyResponse{Reject: true}, nil
+	}
+	unregister, err := modifier.RegisterInterceptor(reject)
+	require.NoError(t, err)
+
+	resp, err = modifier.Intercept(HtlcModifyRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Reject)
+
+	// A second client is refused while the first one is registered.
+	_, err = modifier.RegisterInterceptor(reject)
+	require.ErrorIs(t, err, ErrHtlcModifierAlreadyExists)
+
+	unregister()
+
+	resp, err = modifier.Intercept(HtlcModifyRequest{})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+
+	// Once the first client is gone, a new one can register. Calling the
+	// stale unregister function again doesn't remove the new client.
+	_, err = modifier.RegisterInterceptor(reject)
+	require.NoError(t, err)
+
+	unregister()
+
+	resp, err = modifier.Intercept(HtlcModifyRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Reject)
+}
@@ -0,0 +1,153 @@
+package invoices
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lntypes"
+)
+
+// ArchivedInvoice is an invoice together with the payment hash that it was
+// stored under.
+type ArchivedInvoice struct {
+	// PayHash is the payment hash of the invoice.
+	PayHash lntypes.Hash
+
+	// Invoice is the archived invoice.
+	Invoice *channeldb.Invoice
+}
+
+// InvoiceArchive is an append-only file that settled invoices are exported to
+// before they are deleted from the database. Each record of the archive
+// consists of the 32 byte payment hash of the invoice, followed by the 4 byte
+// big endian length of the serialized invoice and the invoice itself in the
+// database serialization format.
+//
+// Invoices are always appended to the archive before they are deleted from the
+// database. If broln is interrupted in between, the same invoice may therefore
+// be archived more than once, in which case the last record is the relevant
+// one.
+type InvoiceArchive struct {
+	path string
+
+	mu sync.Mutex
+}
+
+// NewInvoiceArchive creates a new invoice archive that appends to the file at
+// the given path. The file is created once the first invoice is archived.
+func NewInvoiceArchive(path string) *InvoiceArchive {
+	return &InvoiceArchive{
+		path: path,
+	}
+}
+
+// Append appends the passed invoices to the archive. The archive is synced to
+// disk before Append returns, so the invoices can be deleted from the database
+// afterwards.
+func (a *InvoiceArchive) Append(invoices []ArchivedInvoice) error {
+	if len(invoices) == 0 {
+		return nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	file, err := os.OpenFile(
+		a.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600,
+	)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	for _, archived := range invoices {
+		if err := writeArchivedInvoice(w, archived); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// writeArchivedInvoice writes a single archive record to the writer.
+func writeArchivedInvoice(w io.Writer, archived ArchivedInvoice) error {
+	var b bytes.Buffer
+	err := channeldb.SerializeInvoice(&b, archived.Invoice)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(archived.PayHash[:]); err != nil {
+		return err
+	}
+
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(b.Len()))
+	if _, err := w.Write(length[:]); err != nil {
+		return err
+	}
+
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// ReadInvoiceArchive reads all records of an invoice archive from the reader
+// and calls the passed function for each archived invoice in the order in
+// which they were archived.
+func ReadInvoiceArchive(r io.Reader, cb func(ArchivedInvoice) error) error {
+	r = bufio.NewReader(r)
+
+	for {
+		var payHash lntypes.Hash
+		_, err := io.ReadFull(r, payHash[:])
+		switch {
+		// The archive ends after the last complete record.
+		case err == io.EOF:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		var length [4]byte
+		if _, err := io.ReadFull(r, length[:]); err != nil {
+			return err
+		}
+
+		invoiceBytes := make([]byte, binary.BigEndian.Uint32(length[:]))
+		if _, err := io.ReadFull(r, invoiceBytes); err != nil {
+			return err
+		}
+
+		invoice, err := channeldb.DeserializeInvoice(
+			bytes.NewReader(invoiceBytes),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = cb(ArchivedInvoice{
+			PayHash: payHash,
+			Invoice: &invoice,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package invoices
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/brsuite/broln/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInvoiceArchive tests that archived invoices can be read back in the order
+// in which they were appended.
+func TestInvoiceArchive(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "invoicearchive")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	archivePath := filepath.Join(tempDir, "invoices.archive")
+	archive := NewInvoiceArchive(archivePath)
+
+	var archived []ArchivedInvoice
+	for i := byte(1); i <= 3; i++ {
+		preimage := lntypes.Preimage{i}
+		archived = append(archived, ArchivedInvoice{
+			PayHash: preimage.Hash(),
+			Invoice: newTestInvoice(t, preimage, testTime, 0),
+		})
+	}
+
+	// Append the invoices in two calls, so that the second one appends to
+	// the existing file.
+	require.NoError(t, archive.Append(archived[:2]))
+	require.NoError(t, archive.Append(archived[2:]))
+
+	archiveBytes, err := ioutil.ReadFile(archivePath)
+	require.NoError(t, err)
+
+	var read []ArchivedInvoice
+	err = ReadInvoiceArchive(
+		bytes.NewReader(archiveBytes), func(a ArchivedInvoice) error {
+			read = append(read, a)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, read, len(archived))
+
+	for i, a := range archived {
+		require.Equal(t, a.PayHash, read[i].PayHash)
+		require.Equal(
+			t, a.Invoice.Terms.PaymentPreimage,
+			read[i].Invoice.Terms.PaymentPreimage,
+		)
+		require.Equal(
+			t, a.Invoice.PaymentRequest,
+			read[i].Invoice.PaymentRequest,
+		)
+		require.True(
+			t, a.Invoice.CreationDate.Equal(
+				read[i].Invoice.CreationDate,
+			),
+		)
+	}
+
+	// A truncated record at the end of the archive is reported after the
+	// complete records were read.
+	read = nil
+	err = ReadInvoiceArchive(
+		bytes.NewReader(archiveBytes[:len(archiveBytes)-1]),
+		func(a ArchivedInvoice) error {
+			read = append(read, a)
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	require.Len(t, read, len(archived)-1)
+}
@@ -0,0 +1,247 @@
+package invoices
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lntypes"
+)
+
+const (
+	// DefaultInvoiceGcInterval is the default interval at which invoices
+	// are deleted according to the configured retention.
+	DefaultInvoiceGcInterval = time.Hour
+
+	// invoiceGcBatchSize is the number of invoices that are scanned, and
+	// at most deleted, in a single database transaction.
+	invoiceGcBatchSize = 1000
+)
+
+var (
+	// ErrInvoiceNotCanceled is returned when an invoice is attempted to be
+	// deleted as a canceled invoice while it isn't canceled.
+	ErrInvoiceNotCanceled = errors.New("invoice not canceled")
+)
+
+// InvoiceDeleteFilter selects the invoices that are deleted by DeleteInvoices.
+// Only canceled and settled invoices can be deleted.
+type InvoiceDeleteFilter struct {
+	// Canceled selects canceled invoices.
+	Canceled bool
+
+	// CanceledCreatedBefore, if non-zero, restricts the selected canceled
+	// invoices to those that were created before this time.
+	CanceledCreatedBefore time.Time
+
+	// Settled selects settled invoices. If an invoice archive is
+	// configured, settled invoices are appended to it before they are
+	// deleted.
+	Settled bool
+
+	// SettledBefore, if non-zero, restricts the selected settled invoices
+	// to those that were settled before this time.
+	SettledBefore time.Time
+}
+
+// matches returns true if the invoice is selected by the filter.
+func (f *InvoiceDeleteFilter) matches(invoice *channeldb.Invoice) bool {
+	switch invoice.State {
+	case channeldb.ContractCanceled:
+		return f.Canceled && (f.CanceledCreatedBefore.IsZero() ||
+			invoice.CreationDate.Before(f.CanceledCreatedBefore))
+
+	case channeldb.ContractSettled:
+		return f.Settled && (f.SettledBefore.IsZero() ||
+			invoice.SettleDate.Before(f.SettledBefore))
+
+	default:
+		return false
+	}
+}
+
+// makeInvoiceDeleteRef returns the reference that is required to delete the
+// given invoice.
+func makeInvoiceDeleteRef(payHash lntypes.Hash,
+	invoice *channeldb.Invoice) channeldb.InvoiceDeleteRef {
+
+	ref := channeldb.InvoiceDeleteRef{
+		PayHash:     payHash,
+		AddIndex:    invoice.AddIndex,
+		SettleIndex: invoice.SettleIndex,
+	}
+	if invoice.Terms.PaymentAddr != channeldb.BlankPayAddr {
+		ref.PayAddr = &invoice.Terms.PaymentAddr
+	}
+
+	return ref
+}
+
+// DeleteInvoices deletes all invoices that are selected by the filter and
+// returns the number of deleted invoices. Settled invoices are appended to the
+// invoice archive first, if one is configured. The invoices are processed in
+// batches, so if an error is returned, a part of the selected invoices may
+// have been deleted already.
+func (i *InvoiceRegistry) DeleteInvoices(
+	filter InvoiceDeleteFilter) (int, error) {
+
+	i.gcMtx.Lock()
+	defer i.gcMtx.Unlock()
+
+	var (
+		startAfter *lntypes.Hash
+		numDeleted int
+	)
+	for {
+		var (
+			numScanned int
+			lastHash   lntypes.Hash
+			refs       []channeldb.InvoiceDeleteRef
+			archived   []ArchivedInvoice
+		)
+
+		reset := func() {
+			numScanned = 0
+			refs = nil
+			archived = nil
+		}
+
+		scanFunc := func(payHash lntypes.Hash,
+			invoice *channeldb.Invoice) error {
+
+			numScanned++
+			lastHash = payHash
+
+			if !filter.matches(invoice) {
+				return nil
+			}
+
+			ref := makeInvoiceDeleteRef(payHash, invoice)
+			refs = append(refs, ref)
+
+			if invoice.State == channeldb.ContractSettled &&
+				i.cfg.InvoiceArchive != nil {
+
+				archived = append(archived, ArchivedInvoice{
+					PayHash: payHash,
+					Invoice: invoice,
+				})
+			}
+
+			return nil
+		}
+
+		err := i.cdb.ScanInvoiceBatch(
+			startAfter, invoiceGcBatchSize, scanFunc, reset,
+		)
+		switch {
+		case err == channeldb.ErrNoInvoicesCreated:
+			return numDeleted, nil
+
+		case err != nil:
+			return numDeleted, err
+		}
+
+		// Make sure that the settled invoices are archived before they
+		// are deleted.
+		if len(archived) > 0 {
+			err := i.cfg.InvoiceArchive.Append(archived)
+			if err != nil {
+				return numDeleted, err
+			}
+		}
+
+		if len(refs) > 0 {
+			if err := i.cdb.DeleteInvoice(refs); err != nil {
+				return numDeleted, err
+			}
+			numDeleted += len(refs)
+		}
+
+		// A partial batch means that we've reached the last invoice.
+		if numScanned < invoiceGcBatchSize {
+			return numDeleted, nil
+		}
+		startAfter = &lastHash
+
+		select {
+		case <-i.quit:
+			return numDeleted, ErrShuttingDown
+		default:
+		}
+	}
+}
+
+// DeleteCanceledInvoice deletes the canceled invoice with the given payment
+// hash. If the invoice isn't canceled, ErrInvoiceNotCanceled is returned.
+func (i *InvoiceRegistry) DeleteCanceledInvoice(payHash lntypes.Hash) error {
+	i.gcMtx.Lock()
+	defer i.gcMtx.Unlock()
+
+	invoice, err := i.cdb.LookupInvoice(channeldb.InvoiceRefByHash(payHash))
+	if err != nil {
+		return err
+	}
+
+	if invoice.State != channeldb.ContractCanceled {
+		return ErrInvoiceNotCanceled
+	}
+
+	return i.cdb.DeleteInvoice([]channeldb.InvoiceDeleteRef{
+		makeInvoiceDeleteRef(payHash, &invoice),
+	})
+}
+
+// invoiceGcLoop periodically deletes canceled and settled invoices according
+// to the configured retention.
+//
+// NOTE: This MUST be run as a goroutine.
+func (i *InvoiceRegistry) invoiceGcLoop() {
+	defer i.wg.Done()
+
+	interval := i.cfg.InvoiceGcInterval
+	if interval == 0 {
+		interval = DefaultInvoiceGcInterval
+	}
+
+	for {
+		i.gcInvoices()
+
+		select {
+		case <-i.cfg.Clock.TickAfter(interval):
+
+		case <-i.quit:
+			return
+		}
+	}
+}
+
+// gcInvoices deletes all canceled and settled invoices that are older than the
+// configured retention.
+func (i *InvoiceRegistry) gcInvoices() {
+	var (
+		now    = i.cfg.Clock.Now()
+		filter InvoiceDeleteFilter
+	)
+
+	if i.cfg.CanceledInvoiceRetention > 0 {
+		filter.Canceled = true
+		filter.CanceledCreatedBefore = now.Add(
+			-i.cfg.CanceledInvoiceRetention,
+		)
+	}
+
+	if i.cfg.SettledInvoiceRetention > 0 {
+		filter.Settled = true
+		filter.SettledBefore = now.Add(-i.cfg.SettledInvoiceRetention)
+	}
+
+	numDeleted, err := i.DeleteInvoices(filter)
+	if err != nil && err != ErrShuttingDown {
+		log.Errorf("Unable to delete old invoices: %v", err)
+	}
+
+	if numDeleted > 0 {
+		log.Infof("Deleted %v old invoices", numDeleted)
+	}
+}
@@ -0,0 +1,131 @@
+package invoices
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteInvoices tests that canceled and settled invoices are deleted
+// according to the filters and the retention, and that settled invoices are
+// archived before they are deleted.
+func TestDeleteInvoices(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	tempDir, err := ioutil.TempDir("", "invoiceretention")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	archivePath := filepath.Join(tempDir, "invoices.archive")
+	ctx.registry.cfg.InvoiceArchive = NewInvoiceArchive(archivePath)
+
+	var nextPreimage byte
+	addInvoice := func() lntypes.Hash {
+		nextPreimage++
+		preimage := lntypes.Preimage{nextPreimage}
+
+		invoice := newTestInvoice(
+			t, preimage, ctx.clock.Now(), 24*time.Hour,
+		)
+		_, err := ctx.registry.AddInvoice(invoice, preimage.Hash())
+		require.NoError(t, err)
+
+		return preimage.Hash()
+	}
+
+	cancelInvoice := func() lntypes.Hash {
+		hash := addInvoice()
+		require.NoError(t, ctx.registry.CancelInvoice(hash))
+
+		return hash
+	}
+
+	var nextHtlcID uint64
+	settleInvoice := func() lntypes.Hash {
+		hash := addInvoice()
+
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			hash, testInvoiceAmount, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(nextHtlcID),
+			make(chan interface{}, 1), testPayload,
+		)
+		require.NoError(t, err)
+		nextHtlcID++
+
+		settleResolution, ok := resolution.(*HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, ResultSettled, settleResolution.Outcome)
+
+		return hash
+	}
+
+	assertDeleted := func(hash lntypes.Hash) {
+		_, err := ctx.registry.LookupInvoice(hash)
+		require.ErrorIs(t, err, channeldb.ErrInvoiceNotFound)
+	}
+
+	open := addInvoice()
+	canceledOld := cancelInvoice()
+	settledOld := settleInvoice()
+
+	ctx.clock.SetTime(testTime.Add(2 * time.Hour))
+
+	canceledNew := cancelInvoice()
+	settledNew := settleInvoice()
+
+	// Only canceled invoices can be deleted individually.
+	err = ctx.registry.DeleteCanceledInvoice(open)
+	require.ErrorIs(t, err, ErrInvoiceNotCanceled)
+
+	require.NoError(t, ctx.registry.DeleteCanceledInvoice(canceledNew))
+	assertDeleted(canceledNew)
+
+	// Delete the invoices that were canceled and settled before the clock
+	// was moved forward.
+	numDeleted, err := ctx.registry.DeleteInvoices(InvoiceDeleteFilter{
+		Canceled:              true,
+		CanceledCreatedBefore: testTime.Add(time.Hour),
+		Settled:               true,
+		SettledBefore:         testTime.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numDeleted)
+	assertDeleted(canceledOld)
+	assertDeleted(settledOld)
+
+	// The settled invoice that is older than the retention is deleted by
+	// the garbage collection.
+	ctx.registry.cfg.SettledInvoiceRetention = time.Hour
+	ctx.clock.SetTime(testTime.Add(4 * time.Hour))
+	ctx.registry.gcInvoices()
+	assertDeleted(settledNew)
+
+	// The open invoice is never deleted.
+	inv, err := ctx.registry.LookupInvoice(open)
+	require.NoError(t, err)
+	require.Equal(t, channeldb.ContractOpen, inv.State)
+
+	// Both settled invoices were archived.
+	archive, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer archive.Close()
+
+	var archived []lntypes.Hash
+	err = ReadInvoiceArchive(archive, func(a ArchivedInvoice) error {
+		require.Equal(t, channeldb.ContractSettled, a.Invoice.State)
+		archived = append(archived, a.PayHash)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []lntypes.Hash{settledOld, settledNew}, archived)
+}
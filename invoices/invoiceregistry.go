@@ -72,6 +72,29 @@ type RegistryConfig struct {
 	// KeysendHoldTime indicates for how long we want to accept and hold
 	// spontaneous keysend payments.
 	KeysendHoldTime time.Duration
+
+	// CanceledInvoiceRetention, if non-zero, is the duration after the
+	// creation of a canceled invoice after which it is deleted.
+	CanceledInvoiceRetention time.Duration
+
+	// SettledInvoiceRetention, if non-zero, is the duration after the
+	// settlement of an invoice after which it is deleted. The invoice is
+	// appended to the InvoiceArchive first, if one is set.
+	SettledInvoiceRetention time.Duration
+
+	// InvoiceArchive, if set, is the archive that settled invoices are
+	// appended to before they are deleted.
+	InvoiceArchive *InvoiceArchive
+
+	// InvoiceGcInterval is the interval at which invoices are deleted
+	// according to the retention settings above. If zero,
+	// DefaultInvoiceGcInterval is used.
+	InvoiceGcInterval time.Duration
+
+	// HtlcModifier is an optional modifier that allows an external client
+	// to reject exit hop htlcs or to change the amount that is credited
+	// to the invoice before the registry processes them.
+	HtlcModifier HtlcModifier
 }
 
 // htlcReleaseEvent describes an htlc auto-release event. It is used to release
@@ -132,6 +155,10 @@ type InvoiceRegistry struct {
 
 	expiryWatcher *InvoiceExpiryWatcher
 
+	// gcMtx serializes the deletion of invoices through DeleteInvoices
+	// and DeleteCanceledInvoice.
+	gcMtx sync.Mutex
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -251,6 +278,14 @@ func (i *InvoiceRegistry) Start() error {
 		return err
 	}
 
+	// Periodically delete old invoices if a retention is configured.
+	if i.cfg.CanceledInvoiceRetention > 0 ||
+		i.cfg.SettledInvoiceRetention > 0 {
+
+		i.wg.Add(1)
+		go i.invoiceGcLoop()
+	}
+
 	return nil
 }
 
@@ -970,6 +1005,16 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		}
 	}
 
+	// Give the htlc modifier client the chance to reject the htlc or to
+	// change the amount that it pays. This is done outside the lock,
+	// because the client may take a while to respond.
+	if i.cfg.HtlcModifier != nil {
+		failure := i.modifyHtlc(&ctx)
+		if failure != nil {
+			return failure, nil
+		}
+	}
+
 	// Execute locked notify exit hop logic.
 	i.Lock()
 	resolution, err := i.notifyExitHopHtlcLocked(&ctx, hodlChan)
@@ -1007,6 +1052,60 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 	}
 }
 
+// modifyHtlc passes the htlc of the given update context to the htlc modifier
+// client and applies its response to the context. If the htlc must be failed,
+// a fail resolution is returned. Htlcs that don't pay to a known open invoice
+// and replayed htlcs are left to the registry and not passed to the client.
+func (i *InvoiceRegistry) modifyHtlc(
+	ctx *invoiceUpdateCtx) *HtlcFailResolution {
+
+	invoice, err := i.cdb.LookupInvoice(ctx.invoiceRef())
+	if err != nil {
+		return nil
+	}
+
+	if _, ok := invoice.Htlcs[ctx.circuitKey]; ok {
+		return nil
+	}
+
+	if invoice.State != channeldb.ContractOpen {
+		return nil
+	}
+
+	resp, err := i.cfg.HtlcModifier.Intercept(HtlcModifyRequest{
+		Invoice:            invoice,
+		ExitHtlcCircuitKey: ctx.circuitKey,
+		ExitHtlcAmt:        ctx.amtPaid,
+		ExitHtlcExpiry:     ctx.expiry,
+		CurrentHeight:      uint32(ctx.currentHeight),
+		CustomRecords:      ctx.customRecords,
+	})
+	switch {
+	case err != nil:
+		ctx.log(fmt.Sprintf("htlc modifier error: %v", err))
+
+		return ctx.failRes(ResultHtlcModifierError)
+
+	// No client is registered.
+	case resp == nil:
+		return nil
+
+	case resp.Reject:
+		ctx.log("rejected by htlc modifier")
+
+		return ctx.failRes(ResultHtlcModifierRejected)
+	}
+
+	if resp.AmountPaid != nil {
+		ctx.log(fmt.Sprintf("htlc modifier changed amount to %v",
+			*resp.AmountPaid))
+
+		ctx.amtPaid = *resp.AmountPaid
+	}
+
+	return nil
+}
+
 // notifyExitHopHtlcLocked is the internal implementation of NotifyExitHopHtlc
 // that should be executed inside the registry lock.
 func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
@@ -2,6 +2,7 @@ package invoices
 
 import (
 	"crypto/rand"
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -1766,3 +1767,97 @@ func testSpontaneousAmpPayment(
 		}
 	}
 }
+
+// TestHtlcModifier tests that the htlc modifier client can reject exit hop
+// htlcs and change the amount that they pay to the invoice.
+func TestHtlcModifier(t *testing.T) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	modifier := NewHtlcModificationInterceptor()
+	ctx.registry.cfg.HtlcModifier = modifier
+
+	var (
+		requests []HtlcModifyRequest
+		response *HtlcModifyResponse
+		respErr  error
+	)
+	unregister, err := modifier.RegisterInterceptor(
+		func(req HtlcModifyRequest) (*HtlcModifyResponse, error) {
+			requests = append(requests, req)
+			return response, respErr
+		},
+	)
+	require.NoError(t, err)
+	defer unregister()
+
+	_, err = ctx.registry.AddInvoice(testInvoice, testInvoicePaymentHash)
+	require.NoError(t, err)
+
+	customRecords := record.CustomSet{record.CustomTypeStart: {1, 2, 3}}
+	payload := &mockPayload{customRecords: customRecords}
+	htlcAmt := lnwire.MilliBronees(1000)
+	hodlChan := make(chan interface{}, 1)
+
+	notifyHtlc := func(htlcID uint64) HtlcResolution {
+		resolution, err := ctx.registry.NotifyExitHopHtlc(
+			testInvoicePaymentHash, htlcAmt, testHtlcExpiry,
+			testCurrentHeight, getCircuitKey(htlcID), hodlChan,
+			payload,
+		)
+		require.NoError(t, err)
+
+		return resolution
+	}
+
+	// The client rejects the first htlc based on its custom records.
+	response = &HtlcModifyResponse{Reject: true}
+	resolution := notifyHtlc(0)
+	checkFailResolution(t, resolution, ResultHtlcModifierRejected)
+
+	require.Len(t, requests, 1)
+	require.Equal(t, getCircuitKey(0), requests[0].ExitHtlcCircuitKey)
+	require.Equal(t, htlcAmt, requests[0].ExitHtlcAmt)
+	require.Equal(t, testHtlcExpiry, requests[0].ExitHtlcExpiry)
+	require.Equal(
+		t, uint32(testCurrentHeight), requests[0].CurrentHeight,
+	)
+	require.Equal(t, customRecords, requests[0].CustomRecords)
+	require.Equal(t, channeldb.ContractOpen, requests[0].Invoice.State)
+
+	// A client error fails the htlc too.
+	response, respErr = nil, errors.New("client error")
+	resolution = notifyHtlc(1)
+	checkFailResolution(t, resolution, ResultHtlcModifierError)
+
+	// Neither htlc was added to the invoice.
+	inv, err := ctx.registry.LookupInvoice(testInvoicePaymentHash)
+	require.NoError(t, err)
+	require.Equal(t, channeldb.ContractOpen, inv.State)
+	require.Empty(t, inv.Htlcs)
+
+	// The htlc pays less than the invoice amount, but the client credits
+	// the full amount to the invoice, which settles it.
+	amtPaid := testInvoiceAmt
+	response, respErr = &HtlcModifyResponse{AmountPaid: &amtPaid}, nil
+	resolution = notifyHtlc(2)
+	settleResolution := checkSettleResolution(
+		t, resolution, testInvoicePreimage,
+	)
+	require.Equal(t, ResultSettled, settleResolution.Outcome)
+
+	inv, err = ctx.registry.LookupInvoice(testInvoicePaymentHash)
+	require.NoError(t, err)
+	require.Equal(t, channeldb.ContractSettled, inv.State)
+	require.Equal(t, amtPaid, inv.AmtPaid)
+
+	// A replay of the settled htlc isn't passed to the client again.
+	resolution = notifyHtlc(2)
+	settleResolution = checkSettleResolution(
+		t, resolution, testInvoicePreimage,
+	)
+	require.Equal(t, ResultReplayToSettled, settleResolution.Outcome)
+	require.Len(t, requests, 3)
+}
@@ -120,6 +120,26 @@ const (
 	// ResultAmpReconstruction is returned when the derived child
 	// hash/preimage pairs were invalid for at least one HTLC in the set.
 	ResultAmpReconstruction
+
+	// ResultTrampolineFeeInsufficient is returned when a trampoline
+	// payment doesn't pay the fee that we charge for forwarding it.
+	ResultTrampolineFeeInsufficient
+
+	// ResultTrampolineExpiryTooSoon is returned when a trampoline payment
+	// doesn't leave us enough time to forward it.
+	ResultTrampolineExpiryTooSoon
+
+	// ResultTrampolineFailed is returned when a trampoline payment is
+	// invalid, or we failed to forward it to the next node.
+	ResultTrampolineFailed
+
+	// ResultHtlcModifierRejected is returned when the htlc modifier client
+	// rejects the htlc.
+	ResultHtlcModifierRejected
+
+	// ResultHtlcModifierError is returned when the htlc modifier client
+	// failed to decide on the htlc.
+	ResultHtlcModifierError
 )
 
 // String returns a string representation of the result.
@@ -189,6 +209,21 @@ func (f FailResolutionResult) FailureString() string {
 	case ResultAmpReconstruction:
 		return "amp reconstruction failed"
 
+	case ResultTrampolineFeeInsufficient:
+		return "trampoline fee insufficient"
+
+	case ResultTrampolineExpiryTooSoon:
+		return "trampoline expiry too soon"
+
+	case ResultTrampolineFailed:
+		return "trampoline forward failed"
+
+	case ResultHtlcModifierRejected:
+		return "rejected by htlc modifier"
+
+	case ResultHtlcModifierError:
+		return "htlc modifier error"
+
 	default:
 		return "unknown failure resolution result"
 	}
@@ -60,6 +60,12 @@ const (
 	// peer and a block arriving during that round trip to trigger force
 	// closure.
 	DefaultOutgoingCltvRejectDelta = DefaultOutgoingBroadcastDelta + 3
+
+	// DefaultInterceptorCltvRejectDelta defines the number of blocks before
+	// the expiry of an incoming htlc held by an htlc interceptor at which
+	// we cancel it back automatically. Like for exit hop htlcs, holding on
+	// to it any longer may cause the channel to be force closed.
+	DefaultInterceptorCltvRejectDelta = DefaultFinalCltvRejectDelta
 )
 
 // CleanAndExpandPath expands environment variables and leading ~ in the
@@ -1,12 +1,55 @@
 package lncfg
 
-// DefaultHoldInvoiceExpiryDelta defines the number of blocks before the expiry
-// height of a hold invoice's htlc that broln will automatically cancel the
-// invoice to prevent the channel from force closing. This value *must* be
-// greater than DefaultIncomingBroadcastDelta to prevent force closes.
-const DefaultHoldInvoiceExpiryDelta = DefaultIncomingBroadcastDelta + 2
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultHoldInvoiceExpiryDelta defines the number of blocks before the
+	// expiry height of a hold invoice's htlc that broln will automatically
+	// cancel the invoice to prevent the channel from force closing. This
+	// value *must* be greater than DefaultIncomingBroadcastDelta to prevent
+	// force closes.
+	DefaultHoldInvoiceExpiryDelta = DefaultIncomingBroadcastDelta + 2
+
+	// DefaultInvoiceArchiveFilename is the default name of the file that
+	// settled invoices are archived to.
+	DefaultInvoiceArchiveFilename = "invoices.archive"
+)
 
 // Invoices holds the configuration options for invoices.
 type Invoices struct {
 	HoldExpiryDelta uint32 `long:"holdexpirydelta" description:"The number of blocks before a hold invoice's htlc expires that the invoice should be canceled to prevent a force close. Force closes will not be prevented if this value is not greater than DefaultIncomingBroadcastDelta."`
+
+	CanceledRetention time.Duration `long:"canceledretention" description:"If non-zero, canceled invoices are deleted once this duration has passed since their creation (e.g. 720h for 30 days)."`
+
+	SettledRetention time.Duration `long:"settledretention" description:"If non-zero, settled invoices are appended to the invoice archive file and deleted from the database once this duration has passed since their settlement."`
+
+	ArchiveFile string `long:"archivefile" description:"The append-only file that settled invoices are archived to before they are deleted. Defaults to invoices.archive in the graph database directory."`
+
+	GcInterval time.Duration `long:"gcinterval" description:"The interval at which invoices are deleted according to the retention options."`
 }
+
+// Validate checks the Invoices configuration to ensure that the input values
+// are sane.
+func (i *Invoices) Validate() error {
+	if i.CanceledRetention < 0 {
+		return fmt.Errorf("canceled invoice retention (%v) must "+
+			"not be negative", i.CanceledRetention)
+	}
+	if i.SettledRetention < 0 {
+		return fmt.Errorf("settled invoice retention (%v) must not be "+
+			"negative", i.SettledRetention)
+	}
+	if i.GcInterval <= 0 {
+		return fmt.Errorf("invoice gc interval (%v) must be positive",
+			i.GcInterval)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Invoices implements the Validator
+// interface.
+var _ Validator = (*Invoices)(nil)
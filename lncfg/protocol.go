@@ -29,6 +29,40 @@ type ProtocolOptions struct {
 	// opening or accepting channels having the script enforced commitment
 	// type for leased channel.
 	NoScriptEnforcedLease bool `long:"no-script-enforced-lease" description:"disable support for script enforced lease commitments"`
+
+	// OptionScidAlias should be set if we want to signal the
+	// option-scid-alias feature bit. This allows scid aliases and the
+	// option-scid-alias channel-type.
+	OptionScidAlias bool `long:"option-scid-alias" description:"enable support for option_scid_alias channels"`
+
+	// OptionZeroConf should be set if we want to signal the zero-conf
+	// feature bit.
+	OptionZeroConf bool `long:"zero-conf" description:"enable support for zero-conf channels, must have option-scid-alias set also"`
+
+	// OptionRouteBlinding should be set if we want to signal the
+	// route-blinding feature bit. This allows us to forward payments
+	// within blinded routes and to create invoices containing them.
+	OptionRouteBlinding bool `long:"route-blinding" description:"enable support for forwarding and receiving payments over blinded routes"`
+
+	// OptionOnionMessages should be set if we want to signal the
+	// onion-messages feature bit. This allows us to send, receive and
+	// forward onion messages, and requires route blinding to be enabled.
+	OptionOnionMessages bool `long:"onion-messages" description:"enable support for sending, receiving and forwarding onion messages, must have route-blinding set also"`
+
+	// OptionTrampolineRouting should be set if we want to signal the
+	// trampoline-routing feature bit. This allows us to forward
+	// trampoline payments on behalf of light clients.
+	OptionTrampolineRouting bool `long:"trampoline-routing" description:"enable support for forwarding trampoline payments"`
+
+	// OptionDualFund should be set if we want to signal the dual-fund
+	// feature bit. This allows us to open and accept channels that both
+	// parties contribute funds to.
+	OptionDualFund bool `long:"dual-fund" description:"enable support for opening and accepting dual funded channels"`
+
+	// OptionSplice should be set if we want to signal the splice feature
+	// bit. This allows us to add funds to and remove funds from active
+	// channels.
+	OptionSplice bool `long:"splice" description:"enable support for splicing funds into and out of active channels"`
 }
 
 // Wumbo returns true if broln should permit the creation and acceptance of wumbo
@@ -48,3 +82,41 @@ func (l *ProtocolOptions) NoAnchorCommitments() bool {
 func (l *ProtocolOptions) NoScriptEnforcementLease() bool {
 	return l.NoScriptEnforcedLease
 }
+
+// ScidAlias returns true if we have enabled the option-scid-alias feature bit.
+func (l *ProtocolOptions) ScidAlias() bool {
+	return l.OptionScidAlias
+}
+
+// ZeroConf returns true if we have enabled the zero-conf feature bit.
+func (l *ProtocolOptions) ZeroConf() bool {
+	return l.OptionZeroConf
+}
+
+// RouteBlinding returns true if we have enabled the route-blinding feature
+// bit.
+func (l *ProtocolOptions) RouteBlinding() bool {
+	return l.OptionRouteBlinding
+}
+
+// OnionMessages returns true if we have enabled the onion-messages feature
+// bit.
+func (l *ProtocolOptions) OnionMessages() bool {
+	return l.OptionOnionMessages
+}
+
+// TrampolineRouting returns true if we have enabled the trampoline-routing
+// feature bit.
+func (l *ProtocolOptions) TrampolineRouting() bool {
+	return l.OptionTrampolineRouting
+}
+
+// DualFund returns true if we have enabled the dual-fund feature bit.
+func (l *ProtocolOptions) DualFund() bool {
+	return l.OptionDualFund
+}
+
+// Splice returns true if we have enabled the splice feature bit.
+func (l *ProtocolOptions) Splice() bool {
+	return l.OptionSplice
+}
@@ -0,0 +1,42 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+// MinTrampolineCltvDelta is the minimum expiry delta that we accept for
+// forwarding trampoline payments. It has to leave room for the expiry deltas
+// of the route to the next node on top of our own safety margin.
+const MinTrampolineCltvDelta = 144
+
+// Trampoline holds the configuration options for forwarding trampoline
+// payments.
+type Trampoline struct {
+	BaseFee uint64 `long:"basefee" description:"The base fee in milli-bronees that we charge for forwarding a trampoline payment. It has to cover the routing fees to the next node."`
+
+	FeeRate uint64 `long:"feerate" description:"The proportional fee in millionths that we charge for forwarding a trampoline payment. It has to cover the routing fees to the next node."`
+
+	CltvDelta uint16 `long:"cltvdelta" description:"The expiry delta that we require for forwarding a trampoline payment. It has to cover the expiry deltas of the route to the next node."`
+
+	MppTimeout time.Duration `long:"mpptimeout" description:"The time to wait for all parts of an incoming trampoline payment to arrive before failing them back."`
+}
+
+// Validate checks the Trampoline configuration to ensure that the input
+// values are sane.
+func (t *Trampoline) Validate() error {
+	if t.CltvDelta < MinTrampolineCltvDelta {
+		return fmt.Errorf("trampoline cltv delta (%d) must be at "+
+			"least %d", t.CltvDelta, MinTrampolineCltvDelta)
+	}
+	if t.MppTimeout <= 0 {
+		return fmt.Errorf("trampoline mpp timeout (%v) must be "+
+			"positive", t.MppTimeout)
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Trampoline implements the Validator
+// interface.
+var _ Validator = (*Trampoline)(nil)
@@ -0,0 +1,18 @@
+//go:build dev
+// +build dev
+
+package devrpc
+
+import (
+	"github.com/brsuite/broln/htlcswitch"
+)
+
+// Config is the primary configuration struct for the dev RPC server. It
+// contains all items required for the RPC server to carry out its duties. The
+// fields with struct tags are meant to parsed as normal configuration options,
+// while if able to be populated, the latter fields MUST also be specified.
+type Config struct {
+	// Switch is used to look up the links of the channels that are
+	// requested to be quiescent.
+	Switch *htlcswitch.Switch
+}
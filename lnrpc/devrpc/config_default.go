@@ -0,0 +1,7 @@
+//go:build !dev
+// +build !dev
+
+package devrpc
+
+// Config is empty for non-dev builds.
+type Config struct{}
@@ -0,0 +1,222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.6.1
+// source: devrpc/dev.proto
+
+package devrpc
+
+import (
+	lnrpc "github.com/brsuite/broln/lnrpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type QuiescenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channel point of the channel to make quiescent.
+	ChanId *lnrpc.ChannelPoint `protobuf:"bytes,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+}
+
+func (x *QuiescenceRequest) Reset() {
+	*x = QuiescenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_devrpc_dev_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuiescenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuiescenceRequest) ProtoMessage() {}
+
+func (x *QuiescenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_devrpc_dev_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuiescenceRequest.ProtoReflect.Descriptor instead.
+func (*QuiescenceRequest) Descriptor() ([]byte, []int) {
+	return file_devrpc_dev_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuiescenceRequest) GetChanId() *lnrpc.ChannelPoint {
+	if x != nil {
+		return x.ChanId
+	}
+	return nil
+}
+
+type QuiescenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether we are the initiator of the quiescence, which is the party
+	// that may propose changes to the channel while it is quiescent.
+	Initiator bool `protobuf:"varint,1,opt,name=initiator,proto3" json:"initiator,omitempty"`
+}
+
+func (x *QuiescenceResponse) Reset() {
+	*x = QuiescenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_devrpc_dev_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuiescenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuiescenceResponse) ProtoMessage() {}
+
+func (x *QuiescenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_devrpc_dev_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuiescenceResponse.ProtoReflect.Descriptor instead.
+func (*QuiescenceResponse) Descriptor() ([]byte, []int) {
+	return file_devrpc_dev_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuiescenceResponse) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
+}
+
+var File_devrpc_dev_proto protoreflect.FileDescriptor
+
+var file_devrpc_dev_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65, 0x76, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x06, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x1a, 0x0f, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x41, 0x0a, 0x11, 0x51,
+	0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x32,
+	0x0a, 0x12, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x32, 0x47, 0x0a, 0x03, 0x44, 0x65, 0x76, 0x12, 0x40, 0x0a, 0x07, 0x51, 0x75, 0x69,
+	0x65, 0x73, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75,
+	0x69, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x64, 0x65, 0x76, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x72, 0x73, 0x75, 0x69, 0x74,
+	0x65, 0x2f, 0x62, 0x72, 0x6f, 0x6c, 0x6e, 0x2f, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2f, 0x64, 0x65,
+	0x76, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_devrpc_dev_proto_rawDescOnce sync.Once
+	file_devrpc_dev_proto_rawDescData = file_devrpc_dev_proto_rawDesc
+)
+
+func file_devrpc_dev_proto_rawDescGZIP() []byte {
+	file_devrpc_dev_proto_rawDescOnce.Do(func() {
+		file_devrpc_dev_proto_rawDescData = protoimpl.X.CompressGZIP(file_devrpc_dev_proto_rawDescData)
+	})
+	return file_devrpc_dev_proto_rawDescData
+}
+
+var file_devrpc_dev_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_devrpc_dev_proto_goTypes = []interface{}{
+	(*QuiescenceRequest)(nil),  // 0: devrpc.QuiescenceRequest
+	(*QuiescenceResponse)(nil), // 1: devrpc.QuiescenceResponse
+	(*lnrpc.ChannelPoint)(nil), // 2: lnrpc.ChannelPoint
+}
+var file_devrpc_dev_proto_depIdxs = []int32{
+	2, // 0: devrpc.QuiescenceRequest.chan_id:type_name -> lnrpc.ChannelPoint
+	0, // 1: devrpc.Dev.Quiesce:input_type -> devrpc.QuiescenceRequest
+	1, // 2: devrpc.Dev.Quiesce:output_type -> devrpc.QuiescenceResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_devrpc_dev_proto_init() }
+func file_devrpc_dev_proto_init() {
+	if File_devrpc_dev_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_devrpc_dev_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuiescenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_devrpc_dev_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuiescenceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_devrpc_dev_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_devrpc_dev_proto_goTypes,
+		DependencyIndexes: file_devrpc_dev_proto_depIdxs,
+		MessageInfos:      file_devrpc_dev_proto_msgTypes,
+	}.Build()
+	File_devrpc_dev_proto = out.File
+	file_devrpc_dev_proto_rawDesc = nil
+	file_devrpc_dev_proto_goTypes = nil
+	file_devrpc_dev_proto_depIdxs = nil
+}
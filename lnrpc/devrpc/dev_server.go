@@ -0,0 +1,177 @@
+//go:build dev
+// +build dev
+
+package devrpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/brsuite/brond/wire"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognizes it as the name of our
+	// RPC service.
+	subServerName = "DevRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/devrpc.Dev/Quiesce": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+	}
+)
+
+// ServerShell is a shell struct holding a reference to the actual sub-server.
+// It is used to register the gRPC sub-server with the root server before we
+// have the necessary dependencies to populate the actual sub-server.
+type ServerShell struct {
+	DevServer
+}
+
+// Server is a sub-server of the main RPC server that exposes functionality
+// which is only meant to be used for testing and development.
+type Server struct {
+	// Required by the grpc-gateway/v2 library for forward compatibility.
+	UnimplementedDevServer
+
+	cfg *Config
+}
+
+// A compile time check to ensure that Server fully implements the DevServer
+// gRPC service.
+var _ DevServer = (*Server)(nil)
+
+// New returns a new instance of the devrpc Dev sub-server. We also return the
+// set of permissions for the macaroons that we may create within this method.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	return &Server{cfg: cfg}, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a sub
+// RPC server to register itself with the main gRPC root server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterDevServer(grpcServer, r)
+
+	log.Debugf("Dev RPC server successfully register with root gRPC " +
+		"server")
+
+	return nil
+}
+
+// RegisterWithRestServer will be called by the root REST mux to direct a sub
+// RPC server to register itself with the main REST mux server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRestServer(ctx context.Context,
+	mux *runtime.ServeMux, dest string, opts []grpc.DialOption) error {
+
+	// We make sure that we register it with the main REST server to ensure
+	// all our methods are routed properly.
+	err := RegisterDevHandlerFromEndpoint(ctx, mux, dest, opts)
+	if err != nil {
+		log.Errorf("Could not register Dev REST server with root REST "+
+			"server: %v", err)
+		return err
+	}
+
+	log.Debugf("Dev REST server successfully registered with root REST " +
+		"server")
+	return nil
+}
+
+// CreateSubServer populates the subserver's dependencies using the passed
+// SubServerConfigDispatcher. This method should fully initialize the
+// sub-server instance, making it ready for action. It returns the macaroon
+// permissions that the sub-server wishes to pass on to the root server for all
+// methods routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) CreateSubServer(configRegistry lnrpc.SubServerConfigDispatcher) (
+	lnrpc.SubServer, lnrpc.MacaroonPerms, error) {
+
+	subServer, macPermissions, err := createNewSubServer(configRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.DevServer = subServer
+	return subServer, macPermissions, nil
+}
+
+// Quiesce makes the given channel quiescent by exchanging stfu messages with
+// the remote peer, and returns once the channel is quiescent.
+func (s *Server) Quiesce(ctx context.Context,
+	req *QuiescenceRequest) (*QuiescenceResponse, error) {
+
+	if req.ChanId == nil {
+		return nil, fmt.Errorf("chan_id must be set")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(req.ChanId)
+	if err != nil {
+		return nil, err
+	}
+
+	chanPoint := wire.NewOutPoint(txid, req.ChanId.OutputIndex)
+
+	link, err := s.cfg.Switch.GetLinkByChanPoint(*chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find link for channel %v: "+
+			"%v", chanPoint, err)
+	}
+
+	log.Debugf("Requesting quiescence of channel %v", chanPoint)
+
+	select {
+	case result := <-link.Quiesce():
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		return &QuiescenceResponse{
+			Initiator: result.Initiator,
+		}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
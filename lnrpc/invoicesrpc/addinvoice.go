@@ -9,7 +9,9 @@ import (
 	"math"
 	"time"
 
+	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg"
+	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/wire"
 	"github.com/brsuite/bronutil"
 	"github.com/davecgh/go-spew/spew"
@@ -67,6 +69,18 @@ type AddInvoiceConfig struct {
 	// GenAmpInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated AMP invoices.
 	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
+
+	// NodePubKey is the identity public key of the running broln node. It
+	// is required to create blinded invoices.
+	NodePubKey *bronec.PublicKey
+
+	// BestHeight returns the current height of the chain. It is required
+	// to create blinded invoices.
+	BestHeight func() (uint32, error)
+
+	// GetAlias returns the alias that our peer assigned to the channel
+	// identified by the passed ChannelID, if any.
+	GetAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -120,6 +134,11 @@ type AddInvoiceData struct {
 	// RouteHints are optional route hints that can each be individually used
 	// to assist in reaching the invoice's destination.
 	RouteHints [][]zpay32.HopHint
+
+	// Blind signals that the invoice should hide the identity of our node
+	// behind blinded paths, rather than including our public key and any
+	// route hints.
+	Blind bool
 }
 
 // paymentHashAndPreimage returns the payment hash and preimage for this invoice
@@ -252,6 +271,20 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 			maxInvoiceAmt)
 	}
 
+	// Blinded invoices must not reveal our channels, and AMP payments
+	// can't be received over blinded paths.
+	if invoice.Blind {
+		switch {
+		case invoice.Private || len(invoice.RouteHints) > 0:
+			return nil, nil, errors.New("blinded invoices cannot " +
+				"include route hints")
+
+		case invoice.Amp:
+			return nil, nil, errors.New("blinded invoices cannot " +
+				"be AMP invoices")
+		}
+	}
+
 	amtMSat := invoice.Value
 
 	// We also create an encoded payment request which allows the
@@ -279,6 +312,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		options = append(options, zpay32.FallbackAddr(addr))
 	}
 
+	var expiry time.Duration
 	switch {
 
 	// If expiry is set, specify it. If it is not provided, no expiry time
@@ -298,18 +332,17 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				float64(expSeconds), maxExpiry.Seconds())
 		}
 
-		expiry := time.Duration(invoice.Expiry) * time.Second
-		options = append(options, zpay32.Expiry(expiry))
+		expiry = time.Duration(invoice.Expiry) * time.Second
 
 	// If no custom expiry is provided, use the default MPP expiry.
 	case !invoice.Amp:
-		options = append(options, zpay32.Expiry(DefaultInvoiceExpiry))
+		expiry = DefaultInvoiceExpiry
 
 	// Otherwise, use the default AMP expiry.
 	default:
-		options = append(options, zpay32.Expiry(DefaultAMPInvoiceExpiry))
-
+		expiry = DefaultAMPInvoiceExpiry
 	}
+	options = append(options, zpay32.Expiry(expiry))
 
 	// If the description hash is set, then we add it do the list of options.
 	// If not, use the memo field as the payment request description.
@@ -325,6 +358,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	// We'll use our current default CLTV value unless one was specified as
 	// an option on the command line when creating an invoice.
+	var finalCltvDelta uint64
 	switch {
 	case invoice.CltvExpiry > math.MaxUint16:
 		return nil, nil, fmt.Errorf("CLTV delta of %v is too large, max "+
@@ -338,13 +372,12 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 				routing.MinCLTVDelta, invoice.CltvExpiry)
 		}
 
-		options = append(options,
-			zpay32.CLTVExpiry(invoice.CltvExpiry))
+		finalCltvDelta = invoice.CltvExpiry
 	default:
 		// TODO(roasbeef): assumes set delta between versions
-		defaultDelta := cfg.DefaultCLTVExpiry
-		options = append(options, zpay32.CLTVExpiry(uint64(defaultDelta)))
+		finalCltvDelta = uint64(cfg.DefaultCLTVExpiry)
 	}
+	options = append(options, zpay32.CLTVExpiry(finalCltvDelta))
 
 	// We make sure that the given invoice routing hints number is within the
 	// valid range
@@ -416,6 +449,22 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 	options = append(options, zpay32.PaymentAddr(paymentAddr))
 
+	// If a blinded invoice was requested, we'll add blinded paths to our
+	// node that use the payment address as their path ID.
+	if invoice.Blind {
+		blindedPaths, err := selectBlindedPaths(cfg, &blindedPathConfig{
+			amtMSat:        amtMSat,
+			paymentAddr:    paymentAddr,
+			finalCltvDelta: uint16(finalCltvDelta),
+			expiry:         expiry,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		options = append(options, blindedPaths...)
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -425,11 +474,7 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 		return nil, nil, err
 	}
 
-	payReqString, err := payReq.Encode(zpay32.MessageSigner{
-		SignCompact: func(msg []byte) ([]byte, error) {
-			return cfg.NodeSigner.SignMessageCompact(msg, false)
-		},
-	})
+	payReqString, err := payReq.Encode(invoiceSigner(cfg, invoice.Blind))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -464,6 +509,36 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	return &paymentHash, newInvoice, nil
 }
 
+// invoiceSigner returns the signer used to sign a new invoice. Regular
+// invoices are signed with our node key. Blinded invoices are signed with an
+// ephemeral key instead, as the payee recovered from the signature would
+// otherwise reveal our identity.
+func invoiceSigner(cfg *AddInvoiceConfig, blind bool) zpay32.MessageSigner {
+	if !blind {
+		return zpay32.MessageSigner{
+			SignCompact: func(msg []byte) ([]byte, error) {
+				return cfg.NodeSigner.SignMessageCompact(
+					msg, false,
+				)
+			},
+		}
+	}
+
+	return zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			ephemeralKey, err := bronec.NewPrivateKey(bronec.S256())
+			if err != nil {
+				return nil, err
+			}
+
+			return bronec.SignCompact(
+				bronec.S256(), ephemeralKey,
+				chainhash.HashB(msg), true,
+			)
+		},
+	}
+}
+
 // chanCanBeHopHint returns true if the target channel is eligible to be a hop
 // hint.
 func chanCanBeHopHint(channel *channeldb.OpenChannel, cfg *AddInvoiceConfig) (
@@ -477,9 +552,7 @@ func chanCanBeHopHint(channel *channeldb.OpenChannel, cfg *AddInvoiceConfig) (
 	}
 
 	// Make sure the channel is active.
-	chanPoint := lnwire.NewChanIDFromOutPoint(
-		&channel.FundingOutpoint,
-	)
+	chanPoint := channel.ChanID()
 	if !cfg.IsChannelActive(chanPoint) {
 		log.Debugf("Skipping channel %v due to not "+
 			"being eligible to forward payments",
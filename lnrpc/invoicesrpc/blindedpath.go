@@ -0,0 +1,263 @@
+package invoicesrpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/broln/zpay32"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// maxBlindedPaths is the maximum number of blinded paths that are
+	// included in a single invoice.
+	maxBlindedPaths = 3
+
+	// blindedPathCltvSlack is the number of blocks, on top of the invoice
+	// expiry and the expiry deltas of the path, that HTLCs sent over a
+	// blinded path may expire after. It leaves room for the shadow route
+	// and the route to the introduction node that payers add.
+	blindedPathCltvSlack = 2016
+
+	// blockInterval is the expected time between two blocks, used to
+	// convert the expiry of an invoice into a number of blocks.
+	blockInterval = 10 * time.Minute
+)
+
+var (
+	// ErrNoBlindedPaths is returned when a blinded invoice is requested,
+	// but none of our channels can be used as the introduction point of
+	// a blinded path.
+	ErrNoBlindedPaths = errors.New("no channel peers eligible as " +
+		"introduction node of a blinded path")
+)
+
+// blindedPathConfig contains the parameters of the invoice that the blinded
+// paths are created for.
+type blindedPathConfig struct {
+	// amtMSat is the amount of the invoice.
+	amtMSat lnwire.MilliBronees
+
+	// paymentAddr is the payment address of the invoice, which is used as
+	// the path ID of our own hop to match payments to the invoice.
+	paymentAddr [32]byte
+
+	// finalCltvDelta is the final expiry delta required by the invoice.
+	finalCltvDelta uint16
+
+	// expiry is the expiry of the invoice.
+	expiry time.Duration
+}
+
+// selectBlindedPaths creates blinded paths to our node for the invoice
+// described by the passed config. Each path consists of one of our channel
+// peers that supports route blinding, which acts as the introduction node, and
+// our own node. The paths are returned as functional options that add them to
+// the invoice.
+func selectBlindedPaths(cfg *AddInvoiceConfig,
+	pathCfg *blindedPathConfig) ([]func(*zpay32.Invoice), error) {
+
+	if cfg.NodePubKey == nil || cfg.BestHeight == nil {
+		return nil, errors.New("blinded paths not supported")
+	}
+
+	openChannels, err := cfg.ChanDB.FetchAllChannels()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch all channels: %v", err)
+	}
+
+	bestHeight, err := cfg.BestHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	// HTLCs sent to us over a blinded path must not expire later than
+	// the latest height at which the invoice may be paid, plus the
+	// expiry delta we require and some slack for the payer.
+	expiryBlocks := uint32(pathCfg.expiry / blockInterval)
+	maxCltvExpiry := bestHeight + expiryBlocks +
+		uint32(pathCfg.finalCltvDelta) + blindedPathCltvSlack
+
+	var (
+		options  []func(*zpay32.Invoice)
+		seenPeer = make(map[route.Vertex]struct{})
+	)
+	for _, channel := range openChannels {
+		if len(options) >= maxBlindedPaths {
+			break
+		}
+
+		// We'll only create a single path per peer, as multiple paths
+		// through the same introduction node don't add much.
+		peer := route.NewVertex(channel.IdentityPub)
+		if _, ok := seenPeer[peer]; ok {
+			continue
+		}
+
+		// Skip channels that on their own can't satisfy the payment.
+		if channel.LocalCommitment.RemoteBalance < pathCfg.amtMSat {
+			continue
+		}
+
+		policy, ok := chanCanBeIntroduction(channel, cfg)
+		if !ok {
+			continue
+		}
+
+		payment, err := buildBlindedPayment(
+			cfg, channel, policy, pathCfg, maxCltvExpiry,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		seenPeer[peer] = struct{}{}
+		options = append(options, zpay32.WithBlindedPaymentPath(payment))
+	}
+
+	if len(options) == 0 {
+		return nil, ErrNoBlindedPaths
+	}
+
+	return options, nil
+}
+
+// chanCanBeIntroduction returns the policy of the remote node of the channel if
+// the remote node can be used as the introduction node of a blinded path to
+// our node. This is the case if the channel is active and the remote node is a
+// public node that supports route blinding.
+func chanCanBeIntroduction(channel *channeldb.OpenChannel,
+	cfg *AddInvoiceConfig) (*channeldb.ChannelEdgePolicy, bool) {
+
+	chanPoint := channel.ChanID()
+	if !cfg.IsChannelActive(chanPoint) {
+		log.Debugf("Skipping channel %v due to not being eligible to "+
+			"forward payments", chanPoint)
+		return nil, false
+	}
+
+	// The introduction node is the only node of the path that payers
+	// route to in the clear, so it must be publicly advertised.
+	remotePub := route.NewVertex(channel.IdentityPub)
+	node, err := cfg.Graph.FetchLightningNode(remotePub)
+	if err != nil {
+		log.Debugf("Skipping channel %v due to counterparty %v "+
+			"being unadvertised: %v", chanPoint, remotePub, err)
+		return nil, false
+	}
+
+	if !node.Features.HasFeature(lnwire.RouteBlindingOptional) {
+		log.Debugf("Skipping channel %v due to counterparty %v not "+
+			"supporting route blinding", chanPoint, remotePub)
+		return nil, false
+	}
+
+	// Fetch the policies for each end of the channel.
+	chanID := channel.ShortChanID().ToUint64()
+	info, p1, p2, err := cfg.Graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		log.Errorf("Unable to fetch the routing policies for the "+
+			"edges of the channel %v: %v", chanPoint, err)
+		return nil, false
+	}
+
+	// Now, we'll need to determine which is the correct policy for HTLCs
+	// being sent from the remote node.
+	remotePolicy := p2
+	if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
+		remotePolicy = p1
+	}
+
+	return remotePolicy, remotePolicy != nil
+}
+
+// buildBlindedPayment creates a blinded path that leads from the remote node
+// of the passed channel to our node, along with its aggregated relay
+// parameters.
+func buildBlindedPayment(cfg *AddInvoiceConfig,
+	channel *channeldb.OpenChannel, policy *channeldb.ChannelEdgePolicy,
+	pathCfg *blindedPathConfig,
+	maxCltvExpiry uint32) (*blindedpath.BlindedPayment, error) {
+
+	// The introduction node needs to know which channel to forward the
+	// payment over. If our peer assigned an alias to the channel, we'll
+	// use it to not reveal the channel's funding outpoint.
+	scid := channel.ShortChanID()
+	if cfg.GetAlias != nil {
+		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+		if alias, err := cfg.GetAlias(chanID); err == nil {
+			scid = alias
+		}
+	}
+
+	introData, err := record.EncodeBlindedRouteData(&record.BlindedRouteData{
+		ShortChannelID: &scid,
+		RelayInfo: &record.PaymentRelayInfo{
+			CltvExpiryDelta: policy.TimeLockDelta,
+			FeeRate:         uint32(policy.FeeProportionalMillionths),
+			BaseFee:         uint32(policy.FeeBaseMSat),
+		},
+		Constraints: &record.PaymentConstraints{
+			MaxCltvExpiry: maxCltvExpiry +
+				uint32(policy.TimeLockDelta),
+			HtlcMinimumMsat: policy.MinHTLC,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ourData, err := record.EncodeBlindedRouteData(&record.BlindedRouteData{
+		PathID: pathCfg.paymentAddr[:],
+		Constraints: &record.PaymentConstraints{
+			MaxCltvExpiry:   maxCltvExpiry,
+			HtlcMinimumMsat: policy.MinHTLC,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := blindedpath.BuildBlindedPath(sessionKey, []*blindedpath.HopInfo{
+		{
+			NodePub:   channel.IdentityPub,
+			PlainText: introData,
+		},
+		{
+			NodePub:   cfg.NodePubKey,
+			PlainText: ourData,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a max HTLC in the policy, the capacity of the channel is the
+	// upper bound of what can be sent over it.
+	htlcMax := lnwire.NewMSatFromBroneess(channel.Capacity)
+	if policy.MessageFlags.HasMaxHtlc() {
+		htlcMax = policy.MaxHTLC
+	}
+
+	return &blindedpath.BlindedPayment{
+		BlindedPath:         path,
+		BaseFee:             uint32(policy.FeeBaseMSat),
+		ProportionalFeeRate: uint32(policy.FeeProportionalMillionths),
+		CltvExpiryDelta: policy.TimeLockDelta +
+			pathCfg.finalCltvDelta,
+		HtlcMinimum: uint64(policy.MinHTLC),
+		HtlcMaximum: uint64(htlcMax),
+	}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/macaroons"
 	"github.com/brsuite/broln/netann"
+	"github.com/brsuite/broln/offers"
 )
 
 // Config is the primary configuration struct for the invoices RPC server. It
@@ -30,6 +31,10 @@ type Config struct {
 	// created by the daemon.
 	InvoiceRegistry *invoices.InvoiceRegistry
 
+	// HtlcModifier is the htlc modifier of the invoice registry that the
+	// HtlcModifier rpc connects its client to.
+	HtlcModifier invoices.HtlcModifier
+
 	// IsChannelActive is used to generate valid hop hints.
 	IsChannelActive func(chanID lnwire.ChannelID) bool
 
@@ -60,4 +65,8 @@ type Config struct {
 	// GenAmpInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated AMP invoices.
 	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
+
+	// Offers is the manager of the BOLT12 offers that we've issued. It is
+	// nil if onion messages or route blinding aren't enabled.
+	Offers *offers.Manager
 }
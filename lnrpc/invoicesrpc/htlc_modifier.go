@@ -0,0 +1,232 @@
+//go:build invoicesrpc
+// +build invoicesrpc
+
+package invoicesrpc
+
+import (
+	"errors"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/invoices"
+	"github.com/brsuite/broln/lnwire"
+)
+
+var (
+	// errHtlcModifierDisconnected is returned to the invoice registry for
+	// htlcs that are still waiting for a response when the client
+	// disconnects.
+	errHtlcModifierDisconnected = errors.New("htlc modifier client " +
+		"disconnected")
+
+	// errModifyRequestNotExists is returned when the client responds to an
+	// htlc that isn't waiting for a response.
+	errModifyRequestNotExists = errors.New("htlc modify request does " +
+		"not exist")
+)
+
+// modifyRequest is an htlc of the invoice registry that waits for the response
+// of the client.
+type modifyRequest struct {
+	// req is the request that is sent to the client.
+	req invoices.HtlcModifyRequest
+
+	// respChan receives the response of the client.
+	respChan chan *invoices.HtlcModifyResponse
+}
+
+// htlcModifier is a helper struct that handles the lifecycle of an rpc htlc
+// modifier streaming session. It is created when the stream opens and
+// disconnects when the stream closes.
+type htlcModifier struct {
+	// server is the Server reference.
+	server *Server
+
+	// stream is the bidirectional RPC stream.
+	stream Invoices_HtlcModifierServer
+
+	// pending contains the htlcs that were sent to the client and wait for
+	// a response.
+	pending map[channeldb.CircuitKey]*modifyRequest
+
+	// requests is where we receive all htlcs coming from the invoice
+	// registry.
+	requests chan *modifyRequest
+
+	// quit is a channel that is closed when this htlcModifier is shutting
+	// down.
+	quit chan struct{}
+}
+
+// newHtlcModifier creates a new htlcModifier.
+func newHtlcModifier(server *Server,
+	stream Invoices_HtlcModifierServer) *htlcModifier {
+
+	return &htlcModifier{
+		server:   server,
+		stream:   stream,
+		pending:  make(map[channeldb.CircuitKey]*modifyRequest),
+		requests: make(chan *modifyRequest),
+		quit:     make(chan struct{}),
+	}
+}
+
+// run registers the htlc modifier with the invoice registry, sends the htlcs
+// to the client and passes the client responses back. All htlcs and responses
+// are handled in the main loop.
+func (r *htlcModifier) run() error {
+	// Make sure that htlcs that still wait for a response are failed once
+	// we are disconnected.
+	defer close(r.quit)
+
+	unregister, err := r.server.cfg.HtlcModifier.RegisterInterceptor(
+		r.onModify,
+	)
+	if err != nil {
+		return err
+	}
+	defer unregister()
+
+	// Start a go routine that reads client responses.
+	errChan := make(chan error, 1)
+	responses := make(chan *HtlcModifyResponse)
+	go r.readClientResponses(responses, errChan)
+
+	for {
+		select {
+		case request := <-r.requests:
+			err := r.sendToClient(request)
+			if err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			// An invalid response doesn't indicate a connection
+			// problem, so we only log it.
+			if err := r.resolveFromClient(resp); err != nil {
+				log.Warnf("Client response to htlc modify "+
+					"request failed: %v", err)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-r.server.quit:
+			return nil
+		}
+	}
+}
+
+// onModify is the callback that is called by the invoice registry for every
+// exit hop htlc. It blocks until the client responded.
+func (r *htlcModifier) onModify(
+	req invoices.HtlcModifyRequest) (*invoices.HtlcModifyResponse, error) {
+
+	request := &modifyRequest{
+		req:      req,
+		respChan: make(chan *invoices.HtlcModifyResponse, 1),
+	}
+
+	select {
+	case r.requests <- request:
+	case <-r.quit:
+		return nil, errHtlcModifierDisconnected
+	case <-r.server.quit:
+		return nil, errHtlcModifierDisconnected
+	}
+
+	select {
+	case resp := <-request.respChan:
+		return resp, nil
+	case <-r.quit:
+		return nil, errHtlcModifierDisconnected
+	case <-r.server.quit:
+		return nil, errHtlcModifierDisconnected
+	}
+}
+
+// readClientResponses reads the responses from the client stream and passes
+// them to the main loop.
+func (r *htlcModifier) readClientResponses(
+	responses chan *HtlcModifyResponse, errChan chan error) {
+
+	for {
+		resp, err := r.stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		select {
+		case responses <- resp:
+		case <-r.quit:
+			return
+		case <-r.server.quit:
+			return
+		}
+	}
+}
+
+// sendToClient remembers the htlc as pending and sends it to the client.
+func (r *htlcModifier) sendToClient(request *modifyRequest) error {
+	req := request.req
+
+	rpcInvoice, err := CreateRPCInvoice(
+		&req.Invoice, r.server.cfg.ChainParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	key := req.ExitHtlcCircuitKey
+	r.pending[key] = request
+
+	return r.stream.Send(&HtlcModifyRequest{
+		Invoice: rpcInvoice,
+		ExitHtlcCircuitKey: &CircuitKey{
+			ChanId: key.ChanID.ToUint64(),
+			HtlcId: key.HtlcID,
+		},
+		ExitHtlcAmt:           uint64(req.ExitHtlcAmt),
+		ExitHtlcExpiry:        req.ExitHtlcExpiry,
+		CurrentHeight:         req.CurrentHeight,
+		ExitHtlcCustomRecords: req.CustomRecords,
+	})
+}
+
+// resolveFromClient passes a client response to the htlc that waits for it.
+func (r *htlcModifier) resolveFromClient(in *HtlcModifyResponse) error {
+	if in.CircuitKey == nil {
+		return errors.New("missing circuit key")
+	}
+
+	key := channeldb.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(in.CircuitKey.ChanId),
+		HtlcID: in.CircuitKey.HtlcId,
+	}
+	request, ok := r.pending[key]
+	if !ok {
+		return errModifyRequestNotExists
+	}
+	delete(r.pending, key)
+
+	request.respChan <- unmarshallModifyResponse(in)
+
+	return nil
+}
+
+// unmarshallModifyResponse converts a client response to the response that
+// the invoice registry expects.
+func unmarshallModifyResponse(
+	in *HtlcModifyResponse) *invoices.HtlcModifyResponse {
+
+	resp := &invoices.HtlcModifyResponse{
+		Reject: in.Reject,
+	}
+
+	if in.OverrideAmt {
+		amtPaid := lnwire.MilliBronees(in.AmtPaid)
+		resp.AmountPaid = &amtPaid
+	}
+
+	return resp
+}
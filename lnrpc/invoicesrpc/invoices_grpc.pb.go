@@ -41,6 +41,38 @@ type InvoicesClient interface {
 	//LookupInvoiceV2 attempts to look up at invoice. An invoice can be refrenced
 	//using either its payment hash, payment address, or set ID.
 	LookupInvoiceV2(ctx context.Context, in *LookupInvoiceMsg, opts ...grpc.CallOption) (*lnrpc.Invoice, error)
+	//
+	//DeleteCanceledInvoice deletes a canceled invoice from the database. If the
+	//invoice isn't canceled, an error is returned.
+	DeleteCanceledInvoice(ctx context.Context, in *DeleteCanceledInvoiceMsg, opts ...grpc.CallOption) (*DeleteCanceledInvoiceResp, error)
+	//
+	//DeleteInvoices deletes all canceled and/or settled invoices that match the
+	//given filters from the database. Settled invoices are appended to the
+	//invoice archive file before they are deleted. Open and accepted invoices
+	//are never deleted.
+	DeleteInvoices(ctx context.Context, in *DeleteInvoicesMsg, opts ...grpc.CallOption) (*DeleteInvoicesResp, error)
+	//
+	//HtlcModifier is a bidirectional streaming RPC that allows a client to
+	//inspect every htlc that pays to one of our open invoices, including its
+	//custom records, before the invoice registry processes it. The client can
+	//reject the htlc or change the amount that is credited to the invoice.
+	//Only a single client can be connected at a time. While a client is
+	//connected, htlcs paying to invoices are held until the client responds,
+	//and htlcs that are pending when the client disconnects are failed.
+	HtlcModifier(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcModifierClient, error)
+	//
+	//AddOffer creates a BOLT12 offer with our node as issuer. Payers request
+	//invoices for the offer over onion messages, so the offer can only be paid
+	//if onion messages and route blinding are enabled.
+	AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error)
+	//
+	//ListOffers returns all offers that we've created.
+	ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error)
+	//
+	//DisableOffer disables an offer, after which we no longer create invoices
+	//for it. Invoices that were already created for the offer can still be
+	//paid.
+	DisableOffer(ctx context.Context, in *DisableOfferRequest, opts ...grpc.CallOption) (*DisableOfferResponse, error)
 }
 
 type invoicesClient struct {
@@ -119,6 +151,82 @@ func (c *invoicesClient) LookupInvoiceV2(ctx context.Context, in *LookupInvoiceM
 	return out, nil
 }
 
+func (c *invoicesClient) DeleteCanceledInvoice(ctx context.Context, in *DeleteCanceledInvoiceMsg, opts ...grpc.CallOption) (*DeleteCanceledInvoiceResp, error) {
+	out := new(DeleteCanceledInvoiceResp)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/DeleteCanceledInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) DeleteInvoices(ctx context.Context, in *DeleteInvoicesMsg, opts ...grpc.CallOption) (*DeleteInvoicesResp, error) {
+	out := new(DeleteInvoicesResp)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/DeleteInvoices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) HtlcModifier(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcModifierClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Invoices_ServiceDesc.Streams[1], "/invoicesrpc.Invoices/HtlcModifier", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &invoicesHtlcModifierClient{stream}
+	return x, nil
+}
+
+type Invoices_HtlcModifierClient interface {
+	Send(*HtlcModifyResponse) error
+	Recv() (*HtlcModifyRequest, error)
+	grpc.ClientStream
+}
+
+type invoicesHtlcModifierClient struct {
+	grpc.ClientStream
+}
+
+func (x *invoicesHtlcModifierClient) Send(m *HtlcModifyResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcModifierClient) Recv() (*HtlcModifyRequest, error) {
+	m := new(HtlcModifyRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *invoicesClient) AddOffer(ctx context.Context, in *AddOfferRequest, opts ...grpc.CallOption) (*AddOfferResponse, error) {
+	out := new(AddOfferResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/AddOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error) {
+	out := new(ListOffersResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/ListOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) DisableOffer(ctx context.Context, in *DisableOfferRequest, opts ...grpc.CallOption) (*DisableOfferResponse, error) {
+	out := new(DisableOfferResponse)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/DisableOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 // All implementations must embed UnimplementedInvoicesServer
 // for forward compatibility
@@ -145,6 +253,38 @@ type InvoicesServer interface {
 	//LookupInvoiceV2 attempts to look up at invoice. An invoice can be refrenced
 	//using either its payment hash, payment address, or set ID.
 	LookupInvoiceV2(context.Context, *LookupInvoiceMsg) (*lnrpc.Invoice, error)
+	//
+	//DeleteCanceledInvoice deletes a canceled invoice from the database. If the
+	//invoice isn't canceled, an error is returned.
+	DeleteCanceledInvoice(context.Context, *DeleteCanceledInvoiceMsg) (*DeleteCanceledInvoiceResp, error)
+	//
+	//DeleteInvoices deletes all canceled and/or settled invoices that match the
+	//given filters from the database. Settled invoices are appended to the
+	//invoice archive file before they are deleted. Open and accepted invoices
+	//are never deleted.
+	DeleteInvoices(context.Context, *DeleteInvoicesMsg) (*DeleteInvoicesResp, error)
+	//
+	//HtlcModifier is a bidirectional streaming RPC that allows a client to
+	//inspect every htlc that pays to one of our open invoices, including its
+	//custom records, before the invoice registry processes it. The client can
+	//reject the htlc or change the amount that is credited to the invoice.
+	//Only a single client can be connected at a time. While a client is
+	//connected, htlcs paying to invoices are held until the client responds,
+	//and htlcs that are pending when the client disconnects are failed.
+	HtlcModifier(Invoices_HtlcModifierServer) error
+	//
+	//AddOffer creates a BOLT12 offer with our node as issuer. Payers request
+	//invoices for the offer over onion messages, so the offer can only be paid
+	//if onion messages and route blinding are enabled.
+	AddOffer(context.Context, *AddOfferRequest) (*AddOfferResponse, error)
+	//
+	//ListOffers returns all offers that we've created.
+	ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error)
+	//
+	//DisableOffer disables an offer, after which we no longer create invoices
+	//for it. Invoices that were already created for the offer can still be
+	//paid.
+	DisableOffer(context.Context, *DisableOfferRequest) (*DisableOfferResponse, error)
 	mustEmbedUnimplementedInvoicesServer()
 }
 
@@ -167,6 +307,24 @@ func (UnimplementedInvoicesServer) SettleInvoice(context.Context, *SettleInvoice
 func (UnimplementedInvoicesServer) LookupInvoiceV2(context.Context, *LookupInvoiceMsg) (*lnrpc.Invoice, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoiceV2 not implemented")
 }
+func (UnimplementedInvoicesServer) DeleteCanceledInvoice(context.Context, *DeleteCanceledInvoiceMsg) (*DeleteCanceledInvoiceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCanceledInvoice not implemented")
+}
+func (UnimplementedInvoicesServer) DeleteInvoices(context.Context, *DeleteInvoicesMsg) (*DeleteInvoicesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvoices not implemented")
+}
+func (UnimplementedInvoicesServer) HtlcModifier(Invoices_HtlcModifierServer) error {
+	return status.Errorf(codes.Unimplemented, "method HtlcModifier not implemented")
+}
+func (UnimplementedInvoicesServer) AddOffer(context.Context, *AddOfferRequest) (*AddOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOffer not implemented")
+}
+func (UnimplementedInvoicesServer) ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffers not implemented")
+}
+func (UnimplementedInvoicesServer) DisableOffer(context.Context, *DisableOfferRequest) (*DisableOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableOffer not implemented")
+}
 func (UnimplementedInvoicesServer) mustEmbedUnimplementedInvoicesServer() {}
 
 // UnsafeInvoicesServer may be embedded to opt out of forward compatibility for this service.
@@ -273,6 +431,122 @@ func _Invoices_LookupInvoiceV2_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Invoices_DeleteCanceledInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCanceledInvoiceMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).DeleteCanceledInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/DeleteCanceledInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).DeleteCanceledInvoice(ctx, req.(*DeleteCanceledInvoiceMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_DeleteInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvoicesMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).DeleteInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/DeleteInvoices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).DeleteInvoices(ctx, req.(*DeleteInvoicesMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_HtlcModifier_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InvoicesServer).HtlcModifier(&invoicesHtlcModifierServer{stream})
+}
+
+type Invoices_HtlcModifierServer interface {
+	Send(*HtlcModifyRequest) error
+	Recv() (*HtlcModifyResponse, error)
+	grpc.ServerStream
+}
+
+type invoicesHtlcModifierServer struct {
+	grpc.ServerStream
+}
+
+func (x *invoicesHtlcModifierServer) Send(m *HtlcModifyRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *invoicesHtlcModifierServer) Recv() (*HtlcModifyResponse, error) {
+	m := new(HtlcModifyResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Invoices_AddOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).AddOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/AddOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).AddOffer(ctx, req.(*AddOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/ListOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).ListOffers(ctx, req.(*ListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_DisableOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).DisableOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/DisableOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).DisableOffer(ctx, req.(*DisableOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Invoices_ServiceDesc is the grpc.ServiceDesc for Invoices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +570,26 @@ var Invoices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoiceV2",
 			Handler:    _Invoices_LookupInvoiceV2_Handler,
 		},
+		{
+			MethodName: "DeleteCanceledInvoice",
+			Handler:    _Invoices_DeleteCanceledInvoice_Handler,
+		},
+		{
+			MethodName: "DeleteInvoices",
+			Handler:    _Invoices_DeleteInvoices_Handler,
+		},
+		{
+			MethodName: "AddOffer",
+			Handler:    _Invoices_AddOffer_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _Invoices_ListOffers_Handler,
+		},
+		{
+			MethodName: "DisableOffer",
+			Handler:    _Invoices_DisableOffer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -303,6 +597,12 @@ var Invoices_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Invoices_SubscribeSingleInvoice_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HtlcModifier",
+			Handler:       _Invoices_HtlcModifier_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "invoicesrpc/invoices.proto",
 }
@@ -5,10 +5,12 @@ package invoicesrpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -16,7 +18,9 @@ import (
 	"gopkg.in/macaroon-bakery.v2/bakery"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/brsuite/broln/bolt12"
 	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/invoices"
 	"github.com/brsuite/broln/lnrpc"
 	"github.com/brsuite/broln/lntypes"
 	"github.com/brsuite/broln/macaroons"
@@ -66,6 +70,30 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/HtlcModifier": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/DeleteCanceledInvoice": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/DeleteInvoices": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/AddOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/ListOffers": {{
+			Entity: "invoices",
+			Action: "read",
+		}},
+		"/invoicesrpc.Invoices/DisableOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -438,3 +466,198 @@ func (s *Server) LookupInvoiceV2(ctx context.Context,
 
 	return CreateRPCInvoice(&invoice, s.cfg.ChainParams)
 }
+
+// DeleteCanceledInvoice deletes a canceled invoice from the database. If the
+// invoice isn't canceled, an error is returned.
+func (s *Server) DeleteCanceledInvoice(ctx context.Context,
+	in *DeleteCanceledInvoiceMsg) (*DeleteCanceledInvoiceResp, error) {
+
+	paymentHash, err := lntypes.MakeHash(in.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.cfg.InvoiceRegistry.DeleteCanceledInvoice(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Deleted canceled invoice %v", paymentHash)
+
+	return &DeleteCanceledInvoiceResp{}, nil
+}
+
+// DeleteInvoices deletes all canceled and/or settled invoices that match the
+// given filters from the database. Settled invoices are archived before they
+// are deleted.
+func (s *Server) DeleteInvoices(ctx context.Context,
+	in *DeleteInvoicesMsg) (*DeleteInvoicesResp, error) {
+
+	if !in.Canceled && !in.Settled {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"canceled or settled invoices must be selected",
+		)
+	}
+
+	filter := invoices.InvoiceDeleteFilter{
+		Canceled: in.Canceled,
+		Settled:  in.Settled,
+	}
+	if in.CanceledCreatedBefore != 0 {
+		filter.CanceledCreatedBefore = time.Unix(
+			in.CanceledCreatedBefore, 0,
+		)
+	}
+	if in.SettledBefore != 0 {
+		filter.SettledBefore = time.Unix(in.SettledBefore, 0)
+	}
+
+	numDeleted, err := s.cfg.InvoiceRegistry.DeleteInvoices(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Deleted %v invoices", numDeleted)
+
+	return &DeleteInvoicesResp{
+		NumDeleted: uint64(numDeleted),
+	}, nil
+}
+
+// HtlcModifier is a bidirectional streaming RPC that allows a client to
+// inspect every htlc that pays to one of our open invoices before the invoice
+// registry processes it. The client can reject the htlc or change the amount
+// that is credited to the invoice.
+func (s *Server) HtlcModifier(stream Invoices_HtlcModifierServer) error {
+	return newHtlcModifier(s, stream).run()
+}
+
+// errOffersDisabled is returned by the offer RPCs if offers aren't supported
+// by the node.
+var errOffersDisabled = errors.New("offers require the " +
+	"protocol.onion-messages and protocol.route-blinding options")
+
+// AddOffer creates a BOLT12 offer with our node as issuer.
+func (s *Server) AddOffer(ctx context.Context,
+	in *AddOfferRequest) (*AddOfferResponse, error) {
+
+	if s.cfg.Offers == nil {
+		return nil, errOffersDisabled
+	}
+
+	if len(in.Description) > channeldb.MaxMemoSize {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"description too large: %v bytes (maxsize=%v)",
+			len(in.Description), channeldb.MaxMemoSize)
+	}
+
+	offer := &bolt12.Offer{
+		Amount:      in.AmountMsat,
+		Description: in.Description,
+		Issuer:      in.Issuer,
+	}
+
+	switch {
+	case in.QuantityMax != 0 && in.UnlimitedQuantity:
+		return nil, status.Error(codes.InvalidArgument,
+			"quantity_max and unlimited_quantity cannot appear "+
+				"together")
+
+	case in.QuantityMax != 0:
+		offer.QuantityMax = &in.QuantityMax
+
+	// A maximum quantity of zero allows any quantity.
+	case in.UnlimitedQuantity:
+		var unlimited uint64
+		offer.QuantityMax = &unlimited
+	}
+
+	if in.AbsoluteExpiry != 0 {
+		offer.AbsoluteExpiry = time.Unix(in.AbsoluteExpiry, 0)
+	}
+
+	id, err := s.cfg.Offers.AddOffer(offer)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddOfferResponse{
+		Offer:   encoded,
+		OfferId: id[:],
+	}, nil
+}
+
+// ListOffers returns all offers that we've created.
+func (s *Server) ListOffers(ctx context.Context,
+	_ *ListOffersRequest) (*ListOffersResponse, error) {
+
+	if s.cfg.Offers == nil {
+		return nil, errOffersDisabled
+	}
+
+	dbOffers, err := s.cfg.Offers.ListOffers()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListOffersResponse{
+		Offers: make([]*Offer, 0, len(dbOffers)),
+	}
+	for _, dbOffer := range dbOffers {
+		offer, err := bolt12.DeserializeOffer(dbOffer.Offer)
+		if err != nil {
+			return nil, err
+		}
+
+		encoded, err := offer.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Offers = append(resp.Offers, &Offer{
+			OfferId:      dbOffer.ID[:],
+			Offer:        encoded,
+			CreationDate: dbOffer.CreationDate.Unix(),
+			Disabled:     dbOffer.Disabled,
+			Description:  offer.Description,
+			AmountMsat:   offer.Amount,
+		})
+	}
+
+	return resp, nil
+}
+
+// DisableOffer disables an offer, after which we no longer create invoices
+// for it.
+func (s *Server) DisableOffer(ctx context.Context,
+	in *DisableOfferRequest) (*DisableOfferResponse, error) {
+
+	if s.cfg.Offers == nil {
+		return nil, errOffersDisabled
+	}
+
+	if len(in.OfferId) != 32 {
+		return nil, status.Error(codes.InvalidArgument,
+			"offer_id must be 32 bytes")
+	}
+
+	var id [32]byte
+	copy(id[:], in.OfferId)
+
+	err := s.cfg.Offers.DisableOffer(id)
+	switch {
+	case err == channeldb.ErrOfferNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+
+	case err != nil:
+		return nil, err
+	}
+
+	return &DisableOfferResponse{}, nil
+}
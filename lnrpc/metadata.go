@@ -13,5 +13,6 @@ var (
 		regexp.MustCompile("^/v1/channels/acceptor$"),
 		regexp.MustCompile("^/v1/channels/transaction-stream$"),
 		regexp.MustCompile("^/v2/router/htlcinterceptor$"),
+		regexp.MustCompile("^/v2/invoices/htlcmodifier$"),
 	}
 )
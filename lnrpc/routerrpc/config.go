@@ -3,6 +3,7 @@ package routerrpc
 import (
 	"github.com/brsuite/broln/macaroons"
 	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/prober"
 )
 
 // Config is the main configuration file for the router RPC server. It contains
@@ -41,6 +42,11 @@ type Config struct {
 
 // DefaultConfig defines the config defaults.
 func DefaultConfig() *Config {
+	var (
+		proberAmt         = prober.DefaultAmount.ToBroneess()
+		proberMaxInFlight = prober.DefaultMaxInFlight.ToBroneess()
+	)
+
 	defaultRoutingConfig := RoutingConfig{
 		AprioriHopProbability: routing.DefaultAprioriHopProbability,
 		AprioriWeight:         routing.DefaultAprioriWeight,
@@ -48,8 +54,26 @@ func DefaultConfig() *Config {
 		PenaltyHalfLife:       routing.DefaultPenaltyHalfLife,
 		AttemptCost:           routing.DefaultAttemptCost.ToBroneess(),
 		AttemptCostPPM:        routing.DefaultAttemptCostPPM,
+		CapacityFraction:      routing.DefaultCapacityFraction,
 		MaxMcHistory:          routing.DefaultMaxMcHistory,
 		McFlushInterval:       routing.DefaultMcFlushInterval,
+		MaxMcPairs:            routing.DefaultMaxMcPairs,
+		McPairMaxAge:          routing.DefaultMcPairMaxAge,
+
+		ProbabilityEstimatorType: routing.AprioriEstimatorName,
+		BimodalConfig: &BimodalConfig{
+			Scale:      int64(routing.DefaultBimodalScaleMsat),
+			NodeWeight: routing.DefaultBimodalNodeWeight,
+			DecayTime:  routing.DefaultBimodalDecayTime,
+		},
+		ProberConfig: &ProberConfig{
+			Interval:        prober.DefaultInterval,
+			Amt:             int64(proberAmt),
+			MaxInFlight:     int64(proberMaxInFlight),
+			Timeout:         prober.DefaultTimeout,
+			FeeLimitPPM:     prober.DefaultFeeLimitPPM,
+			NumCentralNodes: prober.DefaultNumCentralNodes,
+		},
 	}
 
 	return &Config{
@@ -65,8 +89,29 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		MinRouteProbability:   cfg.MinRouteProbability,
 		AttemptCost:           cfg.AttemptCost,
 		AttemptCostPPM:        cfg.AttemptCostPPM,
+		CapacityFraction:      cfg.CapacityFraction,
 		PenaltyHalfLife:       cfg.PenaltyHalfLife,
 		MaxMcHistory:          cfg.MaxMcHistory,
 		McFlushInterval:       cfg.McFlushInterval,
+		MaxMcPairs:            cfg.MaxMcPairs,
+		McPairMaxAge:          cfg.McPairMaxAge,
+		NodeTagsFile:          cfg.NodeTagsFile,
+
+		ProbabilityEstimatorType: cfg.ProbabilityEstimatorType,
+		BimodalConfig: &BimodalConfig{
+			Scale:      cfg.BimodalConfig.Scale,
+			NodeWeight: cfg.BimodalConfig.NodeWeight,
+			DecayTime:  cfg.BimodalConfig.DecayTime,
+		},
+		ProberConfig: &ProberConfig{
+			Active:          cfg.ProberConfig.Active,
+			Interval:        cfg.ProberConfig.Interval,
+			Amt:             cfg.ProberConfig.Amt,
+			MaxInFlight:     cfg.ProberConfig.MaxInFlight,
+			Timeout:         cfg.ProberConfig.Timeout,
+			FeeLimitPPM:     cfg.ProberConfig.FeeLimitPPM,
+			Destinations:    cfg.ProberConfig.Destinations,
+			NumCentralNodes: cfg.ProberConfig.NumCentralNodes,
+		},
 	}
 }
@@ -1,12 +1,14 @@
 package routerrpc
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/htlcswitch"
+	"github.com/brsuite/broln/lnrpc"
 	"github.com/brsuite/broln/lntypes"
 	"github.com/brsuite/broln/lnwire"
 )
@@ -19,6 +21,11 @@ var (
 	// ErrMissingPreimage is an error returned when the caller tries to settle
 	// a forward and doesn't provide a preimage.
 	ErrMissingPreimage = errors.New("missing preimage")
+
+	// ErrMultipleFailures is an error returned when the caller tries to
+	// fail a forward with more than one kind of failure.
+	ErrMultipleFailures = errors.New("only one of failure message, " +
+		"failure code and encrypted failure can be set")
 )
 
 // forwardInterceptor is a helper struct that handles the lifecycle of an rpc
@@ -68,10 +75,13 @@ func (r *forwardInterceptor) run() error {
 	// make sure we disconnect and resolves all remaining packets if any.
 	defer r.onDisconnect()
 
-	// Register our interceptor so we receive all forwarded packets.
+	// Register our interceptor so we receive all forwarded packets. The
+	// packets are offered to interceptors that connected earlier first.
 	interceptableForwarder := r.server.cfg.RouterBackend.InterceptableForwarder
-	interceptableForwarder.SetInterceptor(r.onIntercept)
-	defer interceptableForwarder.SetInterceptor(nil)
+	removeInterceptor := interceptableForwarder.AddInterceptor(
+		r.onIntercept,
+	)
+	defer removeInterceptor()
 
 	// start a go routine that reads client resolutions.
 	errChan := make(chan error)
@@ -150,12 +160,8 @@ func (r *forwardInterceptor) holdAndForwardToClient(
 	htlc := forward.Packet()
 	inKey := htlc.IncomingCircuit
 
-	// Ignore already held htlcs.
-	if _, ok := r.holdForwards[inKey]; ok {
-		return nil
-	}
-
-	// First hold the forward, then send to client.
+	// First hold the forward, then send to client. If the htlc was offered
+	// before, the previous forward isn't valid anymore and is replaced.
 	r.holdForwards[inKey] = forward
 	interceptionRequest := &ForwardHtlcInterceptRequest{
 		IncomingCircuitKey: &CircuitKey{
@@ -170,6 +176,7 @@ func (r *forwardInterceptor) holdAndForwardToClient(
 		IncomingExpiry:          htlc.IncomingExpiry,
 		CustomRecords:           htlc.CustomRecords,
 		OnionBlob:               htlc.OnionBlob[:],
+		AutoFailHeight:          htlc.AutoFailHeight,
 	}
 
 	return r.stream.Send(interceptionRequest)
@@ -188,13 +195,45 @@ func (r *forwardInterceptor) resolveFromClient(
 	if !ok {
 		return ErrFwdNotExists
 	}
-	delete(r.holdForwards, circuitKey)
+
+	// Only release the forward if it was resolved or doesn't exist in the
+	// switch anymore. Otherwise, the client can try again.
+	err := resolveForward(interceptedForward, in)
+	if err == nil || err == htlcswitch.ErrFwdNotExists {
+		delete(r.holdForwards, circuitKey)
+	}
+
+	return err
+}
+
+// resolveForward resolves an intercepted forward with the action that the
+// client requested.
+func resolveForward(interceptedForward htlcswitch.InterceptedForward,
+	in *ForwardHtlcInterceptResponse) error {
 
 	switch in.Action {
 	case ResolveHoldForwardAction_RESUME:
 		return interceptedForward.Resume()
+	case ResolveHoldForwardAction_RESUME_MODIFIED:
+		var outgoingChanID *lnwire.ShortChannelID
+		if in.OutgoingChanId != 0 {
+			chanID := lnwire.NewShortChanIDFromInt(
+				in.OutgoingChanId,
+			)
+			outgoingChanID = &chanID
+		}
+
+		var outgoingAmount *lnwire.MilliBronees
+		if in.OutgoingAmountMsat != 0 {
+			amt := lnwire.MilliBronees(in.OutgoingAmountMsat)
+			outgoingAmount = &amt
+		}
+
+		return interceptedForward.ResumeModified(
+			outgoingChanID, outgoingAmount,
+		)
 	case ResolveHoldForwardAction_FAIL:
-		return interceptedForward.Fail()
+		return failForward(interceptedForward, in)
 	case ResolveHoldForwardAction_SETTLE:
 		if in.Preimage == nil {
 			return ErrMissingPreimage
@@ -209,18 +248,96 @@ func (r *forwardInterceptor) resolveFromClient(
 	}
 }
 
-// onDisconnect removes all previousely held forwards from
-// the store. Before they are removed it ensure to resume as the default
-// behavior.
+// failForward fails an intercepted forward with the failure that the client
+// specified. If no failure is specified, a temporary channel failure is
+// returned.
+func failForward(interceptedForward htlcswitch.InterceptedForward,
+	in *ForwardHtlcInterceptResponse) error {
+
+	var numFailures int
+	if len(in.FailureMessage) > 0 {
+		numFailures++
+	}
+	if in.FailureCode != lnrpc.Failure_RESERVED {
+		numFailures++
+	}
+	if len(in.EncryptedFailure) > 0 {
+		numFailures++
+	}
+	if numFailures > 1 {
+		return ErrMultipleFailures
+	}
+
+	switch {
+	case len(in.FailureMessage) > 0:
+		failure, err := lnwire.DecodeFailureMessage(
+			bytes.NewReader(in.FailureMessage), 0,
+		)
+		if err != nil {
+			return err
+		}
+
+		return interceptedForward.FailWithMessage(failure)
+
+	case in.FailureCode != lnrpc.Failure_RESERVED:
+		code, err := unmarshallFailureCode(in.FailureCode)
+		if err != nil {
+			return err
+		}
+
+		return interceptedForward.FailWithCode(code)
+
+	case len(in.EncryptedFailure) > 0:
+		return interceptedForward.Fail(in.EncryptedFailure)
+
+	default:
+		return interceptedForward.FailWithCode(
+			lnwire.CodeTemporaryChannelFailure,
+		)
+	}
+}
+
+// unmarshallFailureCode converts an rpc failure code into the corresponding
+// wire failure code. Only the codes that an interceptor can fail forwards with
+// are supported.
+func unmarshallFailureCode(code lnrpc.Failure_FailureCode) (lnwire.FailCode,
+	error) {
+
+	switch code {
+	case lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:
+		return lnwire.CodeTemporaryChannelFailure, nil
+
+	case lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS:
+		return lnwire.CodeIncorrectOrUnknownPaymentDetails, nil
+
+	case lnrpc.Failure_TEMPORARY_NODE_FAILURE:
+		return lnwire.CodeTemporaryNodeFailure, nil
+
+	case lnrpc.Failure_PERMANENT_NODE_FAILURE:
+		return lnwire.CodePermanentNodeFailure, nil
+
+	case lnrpc.Failure_UNKNOWN_NEXT_PEER:
+		return lnwire.CodeUnknownNextPeer, nil
+
+	case lnrpc.Failure_PERMANENT_CHANNEL_FAILURE:
+		return lnwire.CodePermanentChannelFailure, nil
+
+	default:
+		return 0, fmt.Errorf("unsupported failure code %v", code)
+	}
+}
+
+// onDisconnect removes all previousely held forwards from the store. The
+// switch takes care of the forwards that are still held, once the interceptor
+// is removed.
 func (r *forwardInterceptor) onDisconnect() {
 	// Then close the channel so all go routine will exit.
 	close(r.quit)
 
-	log.Infof("RPC interceptor disconnected, resolving held packets")
-	for key, forward := range r.holdForwards {
-		if err := forward.Resume(); err != nil {
-			log.Errorf("failed to resume hold forward %v", err)
-		}
+	log.Infof("RPC interceptor disconnected, releasing %v held packets",
+		len(r.holdForwards))
+
+	for key := range r.holdForwards {
 		delete(r.holdForwards, key)
 	}
 	r.wg.Wait()
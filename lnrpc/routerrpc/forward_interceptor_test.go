@@ -0,0 +1,178 @@
+package routerrpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/brsuite/broln/htlcswitch"
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// mockInterceptedForward records how an intercepted forward was resolved.
+type mockInterceptedForward struct {
+	resumed         bool
+	outgoingChanID  *lnwire.ShortChannelID
+	outgoingAmount  *lnwire.MilliBronees
+	failReason      []byte
+	failureMessage  lnwire.FailureMessage
+	failCode        lnwire.FailCode
+	settledPreimage *lntypes.Preimage
+}
+
+func (m *mockInterceptedForward) Packet() htlcswitch.InterceptedPacket {
+	return htlcswitch.InterceptedPacket{}
+}
+
+func (m *mockInterceptedForward) Resume() error {
+	m.resumed = true
+	return nil
+}
+
+func (m *mockInterceptedForward) ResumeModified(
+	outgoingChanID *lnwire.ShortChannelID,
+	outgoingAmount *lnwire.MilliBronees) error {
+
+	m.outgoingChanID = outgoingChanID
+	m.outgoingAmount = outgoingAmount
+
+	return m.Resume()
+}
+
+func (m *mockInterceptedForward) Settle(preimage lntypes.Preimage) error {
+	m.settledPreimage = &preimage
+	return nil
+}
+
+func (m *mockInterceptedForward) Fail(reason []byte) error {
+	m.failReason = reason
+	return nil
+}
+
+func (m *mockInterceptedForward) FailWithMessage(
+	failure lnwire.FailureMessage) error {
+
+	m.failureMessage = failure
+	return nil
+}
+
+func (m *mockInterceptedForward) FailWithCode(code lnwire.FailCode) error {
+	m.failCode = code
+	return nil
+}
+
+// TestResolveForward tests that interceptor responses are mapped onto the
+// intercepted forward correctly.
+func TestResolveForward(t *testing.T) {
+	t.Parallel()
+
+	var failureMessage bytes.Buffer
+	err := lnwire.EncodeFailureMessage(
+		&failureMessage, &lnwire.FailPermanentNodeFailure{}, 0,
+	)
+	require.NoError(t, err)
+
+	chanID := lnwire.NewShortChanIDFromInt(123)
+	amount := lnwire.MilliBronees(1000)
+
+	testCases := []struct {
+		name      string
+		response  *ForwardHtlcInterceptResponse
+		expectErr bool
+		expected  *mockInterceptedForward
+	}{{
+		name: "resume",
+		response: &ForwardHtlcInterceptResponse{
+			Action: ResolveHoldForwardAction_RESUME,
+		},
+		expected: &mockInterceptedForward{resumed: true},
+	}, {
+		name: "resume modified",
+		response: &ForwardHtlcInterceptResponse{
+			Action:             ResolveHoldForwardAction_RESUME_MODIFIED,
+			OutgoingChanId:     chanID.ToUint64(),
+			OutgoingAmountMsat: uint64(amount),
+		},
+		expected: &mockInterceptedForward{
+			resumed:        true,
+			outgoingChanID: &chanID,
+			outgoingAmount: &amount,
+		},
+	}, {
+		name: "resume modified without changes",
+		response: &ForwardHtlcInterceptResponse{
+			Action: ResolveHoldForwardAction_RESUME_MODIFIED,
+		},
+		expected: &mockInterceptedForward{resumed: true},
+	}, {
+		name: "fail default",
+		response: &ForwardHtlcInterceptResponse{
+			Action: ResolveHoldForwardAction_FAIL,
+		},
+		expected: &mockInterceptedForward{
+			failCode: lnwire.CodeTemporaryChannelFailure,
+		},
+	}, {
+		name: "fail with code",
+		response: &ForwardHtlcInterceptResponse{
+			Action:      ResolveHoldForwardAction_FAIL,
+			FailureCode: lnrpc.Failure_UNKNOWN_NEXT_PEER,
+		},
+		expected: &mockInterceptedForward{
+			failCode: lnwire.CodeUnknownNextPeer,
+		},
+	}, {
+		name: "fail with unsupported code",
+		response: &ForwardHtlcInterceptResponse{
+			Action:      ResolveHoldForwardAction_FAIL,
+			FailureCode: lnrpc.Failure_MPP_TIMEOUT,
+		},
+		expectErr: true,
+		expected:  &mockInterceptedForward{},
+	}, {
+		name: "fail with message",
+		response: &ForwardHtlcInterceptResponse{
+			Action:         ResolveHoldForwardAction_FAIL,
+			FailureMessage: failureMessage.Bytes(),
+		},
+		expected: &mockInterceptedForward{
+			failureMessage: &lnwire.FailPermanentNodeFailure{},
+		},
+	}, {
+		name: "fail with encrypted failure",
+		response: &ForwardHtlcInterceptResponse{
+			Action:           ResolveHoldForwardAction_FAIL,
+			EncryptedFailure: []byte{1, 2, 3},
+		},
+		expected: &mockInterceptedForward{
+			failReason: []byte{1, 2, 3},
+		},
+	}, {
+		name: "multiple failures",
+		response: &ForwardHtlcInterceptResponse{
+			Action:           ResolveHoldForwardAction_FAIL,
+			FailureCode:      lnrpc.Failure_UNKNOWN_NEXT_PEER,
+			EncryptedFailure: []byte{1, 2, 3},
+		},
+		expectErr: true,
+		expected:  &mockInterceptedForward{},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			forward := &mockInterceptedForward{}
+			err := resolveForward(forward, tc.response)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expected, forward)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	math "math"
 	"time"
 
+	"github.com/brsuite/broln/bolt12"
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/feature"
 	"github.com/brsuite/broln/htlcswitch"
@@ -17,8 +18,11 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
 	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/routing/prober"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/broln/subscribe"
+	"github.com/brsuite/broln/trampoline"
 	"github.com/brsuite/broln/zpay32"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg"
@@ -33,6 +37,10 @@ const (
 	// TODO(roasbeef): make this value dynamic based on expected number of
 	// attempts for given amount
 	DefaultMaxParts = 16
+
+	// MaxNumRoutes is the maximum number of alternative routes that can be
+	// requested from QueryRoutes.
+	MaxNumRoutes = 20
 )
 
 // RouterBackend contains the backend implementation of the router rpc sub
@@ -45,6 +53,11 @@ type RouterBackend struct {
 	// capacity of a channel to populate in responses.
 	FetchChannelCapacity func(chanID uint64) (bronutil.Amount, error)
 
+	// FetchAmountPairCapacity determines the maximal channel capacity
+	// between two nodes given a certain amount.
+	FetchAmountPairCapacity func(nodeFrom, nodeTo route.Vertex,
+		amount lnwire.MilliBronees) (bronutil.Amount, error)
+
 	// FetchChannelEndpoints returns the pubkeys of both endpoints of the
 	// given channel id.
 	FetchChannelEndpoints func(chanID uint64) (route.Vertex,
@@ -56,8 +69,18 @@ type RouterBackend struct {
 		amt lnwire.MilliBronees, restrictions *routing.RestrictParams,
 		destCustomRecords record.CustomSet,
 		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
+		blindedPayments []*blindedpath.BlindedPayment,
 		finalExpiry uint16) (*route.Route, error)
 
+	// FindRoutes is like FindRoute, but returns up to numRoutes
+	// alternative routes in order of increasing cost.
+	FindRoutes func(source, target route.Vertex,
+		amt lnwire.MilliBronees, restrictions *routing.RestrictParams,
+		destCustomRecords record.CustomSet,
+		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
+		blindedPayments []*blindedpath.BlindedPayment,
+		finalExpiry uint16, numRoutes int) ([]*route.Route, error)
+
 	MissionControl MissionControl
 
 	// ActiveNetParams are the network parameters of the primary network
@@ -95,6 +118,39 @@ type RouterBackend struct {
 	// SetChannelAuto exposes the ability to restore automatic channel state
 	// management after manually setting channel status.
 	SetChannelAuto func(wire.OutPoint) error
+
+	// BestHeight returns the current best block height, which is needed
+	// to compute the expiries of trampoline payments.
+	BestHeight func() uint32
+
+	// NodeTags holds the tags of nodes that requests may ask path finding
+	// to avoid. It is nil if no node tags file is configured.
+	NodeTags routing.NodeTags
+
+	// Prober sends probe payments to discover the liquidity of channels.
+	Prober Prober
+
+	// DeletePayment removes a completed payment from the database. It is
+	// used to remove the probe payments of fee estimations.
+	DeletePayment func(lntypes.Hash) error
+
+	// FetchOfferInvoice requests an invoice for a BOLT12 offer from its
+	// issuer and verifies it. It is nil if offers aren't supported.
+	FetchOfferInvoice func(ctx context.Context, offer *bolt12.Offer,
+		amt lnwire.MilliBronees, quantity uint64,
+		payerNote string) (*bolt12.Invoice, error)
+}
+
+// Prober defines the prober dependencies of routerrpc.
+type Prober interface {
+	// Status returns a snapshot of the state of the prober.
+	Status() *prober.Status
+
+	// SetActive activates or deactivates the prober.
+	SetActive(active bool)
+
+	// SetBudget replaces the budget of the prober.
+	SetBudget(budget prober.Budget) error
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
@@ -102,7 +158,7 @@ type MissionControl interface {
 	// GetProbability is expected to return the success probability of a
 	// payment from fromNode to toNode.
 	GetProbability(fromNode, toNode route.Vertex,
-		amt lnwire.MilliBronees) float64
+		amt lnwire.MilliBronees, capacity bronutil.Amount) float64
 
 	// ResetHistory resets the history of MissionControl returning it to a
 	// state as if no payment attempts have been made.
@@ -113,10 +169,18 @@ type MissionControl interface {
 	GetHistorySnapshot() *routing.MissionControlSnapshot
 
 	// ImportHistory imports the mission control snapshot to our internal
-	// state. This import will only be applied in-memory, and will not be
-	// persisted across restarts.
+	// state. The resulting state is persisted.
 	ImportHistory(snapshot *routing.MissionControlSnapshot, force bool) error
 
+	// Export returns a copy of the mission control state, optionally
+	// including the raw payment results.
+	Export(includeResults bool) (*routing.MissionControlExport, error)
+
+	// Import merges an export into the mission control state and returns
+	// the number of imported pair results and payment results.
+	Import(export *routing.MissionControlExport, force bool) (int, int,
+		error)
+
 	// GetPairHistorySnapshot returns the stored history for a given node
 	// pair.
 	GetPairHistorySnapshot(fromNode,
@@ -142,6 +206,18 @@ type MissionControl interface {
 func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	in *lnrpc.QueryRoutesRequest) (*lnrpc.QueryRoutesResponse, error) {
 
+	// Limit the number of routes, because every additional route requires
+	// multiple path finding runs.
+	numRoutes := 1
+	switch {
+	case in.NumRoutes > MaxNumRoutes:
+		return nil, fmt.Errorf("number of routes %v exceeds maximum "+
+			"of %v", in.NumRoutes, MaxNumRoutes)
+
+	case in.NumRoutes > 0:
+		numRoutes = int(in.NumRoutes)
+	}
+
 	parsePubKey := func(key string) (route.Vertex, error) {
 		pubKeyBytes, err := hex.DecodeString(key)
 		if err != nil {
@@ -151,9 +227,23 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		return route.NewVertexFromBytes(pubKeyBytes)
 	}
 
+	blindedPayments, err := UnmarshalBlindedPayments(
+		in.BlindedPaymentPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Parse the hex-encoded source and target public keys into full public
-	// key objects we can properly manipulate.
-	targetPubKey, err := parsePubKey(in.PubKey)
+	// key objects we can properly manipulate. Routes to blinded paths
+	// don't require a target, as the recipient is hidden behind the
+	// paths.
+	var targetPubKey route.Vertex
+	if in.PubKey == "" && len(blindedPayments) > 0 {
+		targetPubKey, err = blindedPathTarget()
+	} else {
+		targetPubKey, err = parsePubKey(in.PubKey)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -181,16 +271,15 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// Unmarshall restrictions from request.
 	feeLimit := lnrpc.CalculateFeeLimit(in.FeeLimit, amt)
 
-	ignoredNodes := make(map[route.Vertex]struct{})
-	for _, ignorePubKey := range in.IgnoredNodes {
-		ignoreVertex, err := route.NewVertexFromBytes(ignorePubKey)
-		if err != nil {
-			return nil, err
-		}
-		ignoredNodes[ignoreVertex] = struct{}{}
+	exclusions, err := r.unmarshallExclusions(
+		in.IgnoredNodes, in.IgnoredPairs, in.IgnoredChannels,
+		in.AvoidNodeTags,
+	)
+	if err != nil {
+		return nil, err
 	}
-
-	ignoredPairs := make(map[routing.DirectedNodePair]struct{})
+	ignoredNodes := exclusions.nodes
+	ignoredPairs := exclusions.pairs
 
 	// Convert deprecated ignoredEdges to pairs.
 	for _, ignoredEdge := range in.IgnoredEdges {
@@ -204,22 +293,6 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		ignoredPairs[pair] = struct{}{}
 	}
 
-	// Add ignored pairs to set.
-	for _, ignorePair := range in.IgnoredPairs {
-		from, err := route.NewVertexFromBytes(ignorePair.From)
-		if err != nil {
-			return nil, err
-		}
-
-		to, err := route.NewVertexFromBytes(ignorePair.To)
-		if err != nil {
-			return nil, err
-		}
-
-		pair := routing.NewDirectedNodePair(from, to)
-		ignoredPairs[pair] = struct{}{}
-	}
-
 	// Since QueryRoutes allows having a different source other than
 	// ourselves, we'll only apply our max time lock if we are the source.
 	maxTotalTimelock := r.MaxTotalTimelock
@@ -254,10 +327,17 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		return nil, err
 	}
 
+	// The recipient of a blinded path isn't part of the graph, but must
+	// support TLV payloads to be able to receive over the path.
+	if features == nil && len(blindedPayments) > 0 {
+		features = blindedPathFeatures()
+	}
+
 	restrictions := &routing.RestrictParams{
 		FeeLimit: feeLimit,
 		ProbabilitySource: func(fromNode, toNode route.Vertex,
-			amt lnwire.MilliBronees) float64 {
+			amt lnwire.MilliBronees,
+			capacity bronutil.Amount) float64 {
 
 			if _, ok := ignoredNodes[fromNode]; ok {
 				return 0
@@ -276,9 +356,10 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 			}
 
 			return r.MissionControl.GetProbability(
-				fromNode, toNode, amt,
+				fromNode, toNode, amt, capacity,
 			)
 		},
+		IgnoredChannels:   exclusions.channels,
 		DestCustomRecords: record.CustomSet(in.DestCustomRecords),
 		CltvLimit:         cltvLimit,
 		DestFeatures:      features,
@@ -320,33 +401,39 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 		return nil, err
 	}
 
-	// Query the channel router for a possible path to the destination that
+	// Query the channel router for possible paths to the destination that
 	// can carry `in.Amt` broneess _including_ the total fee required on
 	// the route.
-	route, err := r.FindRoute(
+	routes, err := r.FindRoutes(
 		sourcePubKey, targetPubKey, amt, restrictions,
-		customRecords, routeHintEdges, finalCLTVDelta,
+		customRecords, routeHintEdges, blindedPayments, finalCLTVDelta,
+		numRoutes,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// For each valid route, we'll convert the result into the format
-	// required by the RPC system.
-	rpcRoute, err := r.MarshallRoute(route)
-	if err != nil {
-		return nil, err
-	}
+	routeResp := &lnrpc.QueryRoutesResponse{}
+	for _, route := range routes {
+		// For each valid route, we'll convert the result into the
+		// format required by the RPC system.
+		rpcRoute, err := r.MarshallRoute(route)
+		if err != nil {
+			return nil, err
+		}
 
-	// Calculate route success probability. Do not rely on a probability
-	// that could have been returned from path finding, because mission
-	// control may have been disabled in the provided ProbabilitySource.
-	successProb := r.getSuccessProbability(route)
+		// Calculate route success probability. Do not rely on a
+		// probability that could have been returned from path finding,
+		// because mission control may have been disabled in the
+		// provided ProbabilitySource.
+		successProb := r.getSuccessProbability(route)
 
-	routeResp := &lnrpc.QueryRoutesResponse{
-		Routes:      []*lnrpc.Route{rpcRoute},
-		SuccessProb: successProb,
+		routeResp.Routes = append(routeResp.Routes, rpcRoute)
+		routeResp.SuccessProbs = append(
+			routeResp.SuccessProbs, successProb,
+		)
 	}
+	routeResp.SuccessProb = routeResp.SuccessProbs[0]
 
 	return routeResp, nil
 }
@@ -360,8 +447,15 @@ func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
 	for _, hop := range rt.Hops {
 		toNode := hop.PubKeyBytes
 
+		// Obtain the capacity of the channel used by this hop. If it
+		// is unknown, a zero capacity is passed on to mission control.
+		capacity, err := r.FetchChannelCapacity(hop.ChannelID)
+		if err != nil {
+			capacity = 0
+		}
+
 		probability := r.MissionControl.GetProbability(
-			fromNode, toNode, amtToFwd,
+			fromNode, toNode, amtToFwd, capacity,
 		)
 
 		successProb *= probability
@@ -373,6 +467,78 @@ func (r *RouterBackend) getSuccessProbability(rt *route.Route) float64 {
 	return successProb
 }
 
+// pathExclusions holds the nodes, node pairs and channel directions that path
+// finding must avoid for a single request.
+type pathExclusions struct {
+	nodes    map[route.Vertex]struct{}
+	pairs    map[routing.DirectedNodePair]struct{}
+	channels map[routing.EdgeLocator]struct{}
+}
+
+// unmarshallExclusions parses the nodes, node pairs and channel directions
+// that a request asks path finding to avoid. Nodes that have one of the given
+// tags are added to the ignored nodes.
+func (r *RouterBackend) unmarshallExclusions(rpcNodes [][]byte,
+	rpcPairs []*lnrpc.NodePair, rpcChannels []*lnrpc.EdgeLocator,
+	avoidNodeTags []string) (*pathExclusions, error) {
+
+	exclusions := &pathExclusions{
+		nodes:    make(map[route.Vertex]struct{}),
+		pairs:    make(map[routing.DirectedNodePair]struct{}),
+		channels: make(map[routing.EdgeLocator]struct{}),
+	}
+
+	for _, ignorePubKey := range rpcNodes {
+		ignoreVertex, err := route.NewVertexFromBytes(ignorePubKey)
+		if err != nil {
+			return nil, err
+		}
+		exclusions.nodes[ignoreVertex] = struct{}{}
+	}
+
+	for _, ignorePair := range rpcPairs {
+		from, err := route.NewVertexFromBytes(ignorePair.From)
+		if err != nil {
+			return nil, err
+		}
+
+		to, err := route.NewVertexFromBytes(ignorePair.To)
+		if err != nil {
+			return nil, err
+		}
+
+		pair := routing.NewDirectedNodePair(from, to)
+		exclusions.pairs[pair] = struct{}{}
+	}
+
+	for _, ignoreChannel := range rpcChannels {
+		locator := routing.EdgeLocator{
+			ChannelID: ignoreChannel.ChannelId,
+		}
+		if ignoreChannel.DirectionReverse {
+			locator.Direction = 1
+		}
+		exclusions.channels[locator] = struct{}{}
+	}
+
+	if len(avoidNodeTags) == 0 {
+		return exclusions, nil
+	}
+
+	// Silently ignoring the tags would route through the very nodes the
+	// caller wants to avoid, so we fail if we don't know any tags.
+	if r.NodeTags == nil {
+		return nil, errors.New("avoiding node tags requires a node " +
+			"tags file to be configured")
+	}
+
+	for node := range r.NodeTags.NodesWithTags(avoidNodeTags) {
+		exclusions.nodes[node] = struct{}{}
+	}
+
+	return exclusions, nil
+}
+
 // rpcEdgeToPair looks up the provided channel and returns the channel endpoints
 // as a directed pair.
 func (r *RouterBackend) rpcEdgeToPair(e *lnrpc.EdgeLocator) (
@@ -429,6 +595,13 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			}
 		}
 
+		// Extract the blinding point if this is the introduction
+		// node of a blinded route.
+		var blindingPoint []byte
+		if hop.BlindingPoint != nil {
+			blindingPoint = hop.BlindingPoint.SerializeCompressed()
+		}
+
 		resp.Hops[i] = &lnrpc.Hop{
 			ChanId:           hop.ChannelID,
 			ChanCapacity:     int64(chanCapacity),
@@ -443,6 +616,9 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			CustomRecords: hop.CustomRecords,
 			TlvPayload:    !hop.LegacyPayload,
 			MppRecord:     mpp,
+			EncryptedData: hop.EncryptedData,
+			BlindingPoint: blindingPoint,
+			TotalAmtMsat:  uint64(hop.TotalAmtMsat),
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -470,6 +646,16 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		return nil, err
 	}
 
+	var blindingPoint *bronec.PublicKey
+	if len(rpcHop.BlindingPoint) > 0 {
+		blindingPoint, err = bronec.ParsePubKey(
+			rpcHop.BlindingPoint, bronec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinding point: %v", err)
+		}
+	}
+
 	return &route.Hop{
 		OutgoingTimeLock: rpcHop.Expiry,
 		AmtToForward:     lnwire.MilliBronees(rpcHop.AmtToForwardMsat),
@@ -479,6 +665,9 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		LegacyPayload:    !rpcHop.TlvPayload,
 		MPP:              mpp,
 		AMP:              amp,
+		EncryptedData:    rpcHop.EncryptedData,
+		BlindingPoint:    blindingPoint,
+		TotalAmtMsat:     lnwire.MilliBronees(rpcHop.TotalAmtMsat),
 	}, nil
 }
 
@@ -554,7 +743,7 @@ func (r *RouterBackend) UnmarshallRoute(rpcroute *lnrpc.Route) (
 // extractIntentFromSendRequest attempts to parse the SendRequest details
 // required to dispatch a client from the information presented by an RPC
 // client.
-func (r *RouterBackend) extractIntentFromSendRequest(
+func (r *RouterBackend) extractIntentFromSendRequest(ctx context.Context,
 	rpcPayReq *SendPaymentRequest) (*routing.LightningPayment, error) {
 
 	payIntent := &routing.LightningPayment{}
@@ -585,6 +774,18 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.LastHop = &lastHop
 	}
 
+	// Pass along the nodes, node pairs and channels to avoid.
+	exclusions, err := r.unmarshallExclusions(
+		rpcPayReq.IgnoredNodes, rpcPayReq.IgnoredPairs,
+		rpcPayReq.IgnoredChannels, rpcPayReq.AvoidNodeTags,
+	)
+	if err != nil {
+		return nil, err
+	}
+	payIntent.IgnoredNodes = exclusions.nodes
+	payIntent.IgnoredPairs = exclusions.pairs
+	payIntent.IgnoredChannels = exclusions.channels
+
 	// Take the CLTV limit from the request if set, otherwise use the max.
 	cltvLimit, err := ValidateCLTVLimit(
 		uint32(rpcPayReq.CltvLimit), r.MaxTotalTimelock,
@@ -602,6 +803,7 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		maxParts = DefaultMaxParts
 	}
 	payIntent.MaxParts = maxParts
+	payIntent.MaxParallelShards = rpcPayReq.MaxParallelShards
 
 	// If this payment had a max shard amount specified, then we'll apply
 	// that now, which'll force us to always make payment splits smaller
@@ -650,12 +852,38 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
-	// If the payment request field isn't blank, then the details of the
-	// invoice are encoded entirely within the encoded payReq.  So we'll
-	// attempt to decode it, populating the payment accordingly.
-	if rpcPayReq.PaymentRequest != "" {
+	// Blinded paths to the destination.
+	blindedPayments, err := UnmarshalBlindedPayments(
+		rpcPayReq.BlindedPaymentPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// If an offer is set, we'll fetch an invoice for it from its issuer
+	// and pay that. Otherwise, if the payment request field isn't blank,
+	// then the details of the invoice are encoded entirely within the
+	// encoded payReq.  So we'll attempt to decode it, populating the
+	// payment accordingly.
+	if rpcPayReq.Offer != "" {
+		if len(blindedPayments) > 0 {
+			return nil, errors.New("blinded_payment_paths and " +
+				"offer cannot appear together")
+		}
+
+		err := r.extractIntentFromOffer(
+			ctx, rpcPayReq, reqAmt, payIntent,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else if rpcPayReq.PaymentRequest != "" {
 		switch {
 
+		case len(blindedPayments) > 0:
+			return nil, errors.New("blinded_payment_paths and " +
+				"payment_request cannot appear together")
+
 		case len(rpcPayReq.Dest) > 0:
 			return nil, errors.New("dest and payment_request " +
 				"cannot appear together")
@@ -759,23 +987,48 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = payReq.Features
 		payIntent.PaymentAddr = payAddr
 		payIntent.PaymentRequest = []byte(rpcPayReq.PaymentRequest)
+
+		// If the invoice contains blinded paths, we'll pay over those
+		// instead. Their aggregated expiry delta already includes the
+		// final expiry delta of the recipient, and the payment address
+		// is replaced by the path ID within the encrypted data.
+		if len(payReq.BlindedPaymentPaths) > 0 {
+			if payReq.Features.HasFeature(lnwire.AMPOptional) {
+				return nil, errors.New("AMP payments to " +
+					"blinded paths are not supported")
+			}
+
+			payIntent.BlindedPayments = payReq.BlindedPaymentPaths
+			payIntent.FinalCLTVDelta = 0
+			payIntent.PaymentAddr = nil
+		}
 	} else {
 		// Otherwise, If the payment request field was not specified
 		// (and a custom route wasn't specified), construct the payment
 		// from the other fields.
 
-		// Payment destination.
-		target, err := route.NewVertexFromBytes(rpcPayReq.Dest)
+		// Payment destination. Payments to blinded paths don't require
+		// one, as the recipient is hidden behind the paths.
+		var target route.Vertex
+		if len(rpcPayReq.Dest) == 0 && len(blindedPayments) > 0 {
+			target, err = blindedPathTarget()
+		} else {
+			target, err = route.NewVertexFromBytes(rpcPayReq.Dest)
+		}
 		if err != nil {
 			return nil, err
 		}
 		payIntent.Target = target
 
-		// Final payment CLTV delta.
-		if rpcPayReq.FinalCltvDelta != 0 {
+		// Final payment CLTV delta. The aggregated expiry delta of
+		// blinded paths already includes the final expiry delta of the
+		// recipient.
+		switch {
+		case rpcPayReq.FinalCltvDelta != 0:
 			payIntent.FinalCLTVDelta =
 				uint16(rpcPayReq.FinalCltvDelta)
-		} else {
+
+		case len(blindedPayments) == 0:
 			payIntent.FinalCLTVDelta = r.DefaultFinalCltvDelta
 		}
 
@@ -792,6 +1045,12 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			return nil, err
 		}
 
+		// Payments to blinded paths can only be made to recipients
+		// that support TLV payloads.
+		if features == nil && len(blindedPayments) > 0 {
+			features = blindedPathFeatures()
+		}
+
 		// Validate the features if any was specified.
 		if features != nil {
 			err = feature.ValidateDeps(features)
@@ -878,6 +1137,48 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		}
 
 		payIntent.DestFeatures = features
+
+		if len(blindedPayments) > 0 {
+			switch {
+			case rpcPayReq.Amp:
+				return nil, errors.New("AMP payments to " +
+					"blinded paths are not supported")
+
+			case len(rpcPayReq.PaymentAddr) > 0:
+				return nil, errors.New("payment_addr cannot " +
+					"be used with blinded paths")
+			}
+
+			payIntent.BlindedPayments = blindedPayments
+		}
+	}
+
+	// Route hints can't be combined with blinded paths, as the latter
+	// already describe how to reach the destination.
+	if len(payIntent.BlindedPayments) > 0 && len(payIntent.RouteHints) > 0 {
+		return nil, errors.New("route hints cannot be used when " +
+			"paying to blinded paths")
+	}
+
+	// If trampoline nodes are given, the payment is wrapped into a
+	// trampoline onion and sent to the first of them instead.
+	if len(rpcPayReq.TrampolineNodes) > 0 {
+		nodes := make([]route.Vertex, 0, len(rpcPayReq.TrampolineNodes))
+		for _, node := range rpcPayReq.TrampolineNodes {
+			vertex, err := route.NewVertexFromBytes(node)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, vertex)
+		}
+
+		err := trampoline.PreparePayment(
+			payIntent, nodes, trampoline.DefaultHopPolicy,
+			r.BestHeight(),
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Do bounds checking with the block padding so the router isn't
@@ -897,6 +1198,97 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// extractIntentFromOffer fetches an invoice for the offer of the request from
+// its issuer, and populates the payment intent to pay the invoice over its
+// blinded paths.
+func (r *RouterBackend) extractIntentFromOffer(ctx context.Context,
+	rpcPayReq *SendPaymentRequest, reqAmt lnwire.MilliBronees,
+	payIntent *routing.LightningPayment) error {
+
+	switch {
+	case r.FetchOfferInvoice == nil:
+		return errors.New("paying offers requires onion messages " +
+			"and route blinding to be enabled")
+
+	case rpcPayReq.PaymentRequest != "":
+		return errors.New("payment_request and offer cannot appear " +
+			"together")
+
+	case len(rpcPayReq.Dest) > 0:
+		return errors.New("dest and offer cannot appear together")
+
+	case len(rpcPayReq.PaymentHash) > 0:
+		return errors.New("payment_hash and offer cannot appear " +
+			"together")
+
+	case rpcPayReq.FinalCltvDelta != 0:
+		return errors.New("final_cltv_delta and offer cannot appear " +
+			"together")
+
+	case len(rpcPayReq.PaymentAddr) > 0:
+		return errors.New("payment_addr and offer cannot appear " +
+			"together")
+
+	case rpcPayReq.Amp:
+		return errors.New("AMP payments to offers are not supported")
+	}
+
+	offer, err := bolt12.DecodeOffer(rpcPayReq.Offer)
+	if err != nil {
+		return err
+	}
+
+	invoice, err := r.FetchOfferInvoice(
+		ctx, offer, reqAmt, rpcPayReq.OfferQuantity,
+		rpcPayReq.PayerNote,
+	)
+	if err != nil {
+		return err
+	}
+
+	payReq, err := invoice.Encode()
+	if err != nil {
+		return err
+	}
+
+	target, err := blindedPathTarget()
+	if err != nil {
+		return err
+	}
+	payIntent.Target = target
+
+	if err := payIntent.SetPaymentHash(invoice.PaymentHash); err != nil {
+		return err
+	}
+
+	// The recipient is hidden behind the blinded paths of the invoice,
+	// and their aggregated expiry delta already includes its final expiry
+	// delta. Multi-part payments are matched to the invoice by the path
+	// ID within the encrypted data, so they don't need a payment address.
+	features := blindedPathFeatures()
+	if invoice.Features != nil &&
+		invoice.Features.IsSet(lnwire.MPPOptional) {
+
+		features = lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+			), lnwire.Features,
+		)
+	} else {
+		payIntent.MaxParts = 1
+	}
+
+	payIntent.Amount = invoice.Amount
+	payIntent.DestFeatures = features
+	payIntent.BlindedPayments = invoice.Paths
+	payIntent.FinalCLTVDelta = 0
+	payIntent.PaymentRequest = []byte(payReq)
+
+	return nil
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
@@ -941,6 +1333,152 @@ func unmarshallHopHint(rpcHint *lnrpc.HopHint) (zpay32.HopHint, error) {
 	}, nil
 }
 
+// UnmarshalBlindedPayments unmarshalls a list of blinded payment paths.
+func UnmarshalBlindedPayments(rpcPayments []*lnrpc.BlindedPaymentPath) (
+	[]*blindedpath.BlindedPayment, error) {
+
+	payments := make([]*blindedpath.BlindedPayment, 0, len(rpcPayments))
+	for _, rpcPayment := range rpcPayments {
+		payment, err := UnmarshalBlindedPayment(rpcPayment)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+// UnmarshalBlindedPayment unmarshalls a single blinded payment path.
+func UnmarshalBlindedPayment(rpcPayment *lnrpc.BlindedPaymentPath) (
+	*blindedpath.BlindedPayment, error) {
+
+	if rpcPayment.TotalCltvDelta > math.MaxUint16 {
+		return nil, fmt.Errorf("total cltv delta of %v exceeds "+
+			"maximum of %v", rpcPayment.TotalCltvDelta,
+			math.MaxUint16)
+	}
+
+	path, err := UnmarshalBlindedPath(rpcPayment.BlindedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if rpcPayment.HtlcMaxMsat != 0 &&
+		rpcPayment.HtlcMaxMsat < rpcPayment.HtlcMinMsat {
+
+		return nil, fmt.Errorf("htlc max of %v below htlc min of %v",
+			rpcPayment.HtlcMaxMsat, rpcPayment.HtlcMinMsat)
+	}
+
+	if rpcPayment.BaseFeeMsat > math.MaxUint32 {
+		return nil, fmt.Errorf("base fee of %v exceeds maximum of %v",
+			rpcPayment.BaseFeeMsat, uint32(math.MaxUint32))
+	}
+
+	return &blindedpath.BlindedPayment{
+		BlindedPath:         path,
+		BaseFee:             uint32(rpcPayment.BaseFeeMsat),
+		ProportionalFeeRate: rpcPayment.ProportionalFeeRate,
+		CltvExpiryDelta:     uint16(rpcPayment.TotalCltvDelta),
+		HtlcMinimum:         rpcPayment.HtlcMinMsat,
+		HtlcMaximum:         rpcPayment.HtlcMaxMsat,
+	}, nil
+}
+
+// UnmarshalBlindedPath unmarshalls a blinded path.
+func UnmarshalBlindedPath(rpcPath *lnrpc.BlindedPath) (
+	*blindedpath.BlindedPath, error) {
+
+	if rpcPath == nil {
+		return nil, errors.New("blinded path required")
+	}
+
+	if len(rpcPath.BlindedHops) == 0 {
+		return nil, blindedpath.ErrNoHops
+	}
+
+	introductionPoint, err := bronec.ParsePubKey(
+		rpcPath.IntroductionNode, bronec.S256(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid introduction node: %v", err)
+	}
+
+	blindingPoint, err := bronec.ParsePubKey(
+		rpcPath.BlindingPoint, bronec.S256(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blinding point: %v", err)
+	}
+
+	hops := make([]*blindedpath.BlindedHop, 0, len(rpcPath.BlindedHops))
+	for _, rpcHop := range rpcPath.BlindedHops {
+		blindedNodePub, err := bronec.ParsePubKey(
+			rpcHop.BlindedNode, bronec.S256(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded node: %v", err)
+		}
+
+		if len(rpcHop.EncryptedData) == 0 {
+			return nil, errors.New("blinded hop encrypted data " +
+				"required")
+		}
+
+		hops = append(hops, &blindedpath.BlindedHop{
+			BlindedNodePub: blindedNodePub,
+			CipherText:     rpcHop.EncryptedData,
+		})
+	}
+
+	return &blindedpath.BlindedPath{
+		IntroductionPoint: introductionPoint,
+		BlindingPoint:     blindingPoint,
+		BlindedHops:       hops,
+	}, nil
+}
+
+// MarshalBlindedPath marshalls a blinded path into its rpc representation.
+func MarshalBlindedPath(path *blindedpath.BlindedPath) *lnrpc.BlindedPath {
+	hops := make([]*lnrpc.BlindedHop, 0, len(path.BlindedHops))
+	for _, hop := range path.BlindedHops {
+		hops = append(hops, &lnrpc.BlindedHop{
+			BlindedNode:   hop.BlindedNodePub.SerializeCompressed(),
+			EncryptedData: hop.CipherText,
+		})
+	}
+
+	return &lnrpc.BlindedPath{
+		IntroductionNode: path.IntroductionPoint.SerializeCompressed(),
+		BlindingPoint:    path.BlindingPoint.SerializeCompressed(),
+		BlindedHops:      hops,
+	}
+}
+
+// blindedPathTarget returns a random vertex that is used as the target of
+// path finding for payments to blinded paths that don't specify one. The
+// vertex doesn't exist in the graph, and is only reached over the virtual
+// edges of the blinded paths.
+func blindedPathTarget() (route.Vertex, error) {
+	privKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	return route.NewVertex(privKey.PubKey()), nil
+}
+
+// blindedPathFeatures returns the features that are assumed for the recipient
+// of a blinded path if none are provided.
+func blindedPathFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.TLVOnionPayloadOptional),
+		lnwire.Features,
+	)
+}
+
 // UnmarshalFeatures converts a list of uint32's into a valid feature vector.
 // This method checks that feature bit pairs aren't assigned toegether, and
 // validates transitive dependencies.
@@ -1280,6 +1818,10 @@ func marshallWireError(msg lnwire.FailureMessage,
 	case *lnwire.InvalidOnionPayload:
 		response.Code = lnrpc.Failure_INVALID_ONION_PAYLOAD
 
+	case *lnwire.FailInvalidBlinding:
+		response.Code = lnrpc.Failure_INVALID_ONION_BLINDING
+		response.OnionSha_256 = onionErr.OnionSHA256[:]
+
 	case nil:
 		response.Code = lnrpc.Failure_UNKNOWN_FAILURE
 
@@ -10,6 +10,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
 	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/bronutil"
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,8 @@ var (
 	node1 = route.Vertex{10}
 
 	node2 = route.Vertex{11}
+
+	taggedNode = route.Vertex{12}
 )
 
 // TestQueryRoutes asserts that query routes rpc parameters are properly parsed
@@ -99,11 +102,17 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 			From: node1[:],
 			To:   node2[:],
 		}},
+		IgnoredChannels: []*lnrpc.EdgeLocator{{
+			ChannelId:        777,
+			DirectionReverse: true,
+		}},
+		AvoidNodeTags:     []string{"Country:XX"},
 		UseMissionControl: useMissionControl,
 		LastHopPubkey:     lastHop[:],
 		OutgoingChanId:    outgoingChan,
 		DestFeatures:      []lnrpc.FeatureBit{lnrpc.FeatureBit_MPP_OPT},
 		RouteHints:        rpcRouteHints,
+		NumRoutes:         2,
 	}
 
 	amtSat := int64(100000)
@@ -123,11 +132,12 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 		}
 	}
 
-	findRoute := func(source, target route.Vertex,
+	findRoutes := func(source, target route.Vertex,
 		amt lnwire.MilliBronees, restrictions *routing.RestrictParams,
 		_ record.CustomSet,
 		routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
-		finalExpiry uint16) (*route.Route, error) {
+		blindedPayments []*blindedpath.BlindedPayment,
+		finalExpiry uint16, numRoutes int) ([]*route.Route, error) {
 
 		if int64(amt) != amtSat*1000 {
 			t.Fatal("unexpected amount")
@@ -146,21 +156,35 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 		}
 
 		if restrictions.ProbabilitySource(route.Vertex{2},
-			route.Vertex{1}, 0,
+			route.Vertex{1}, 0, 0,
 		) != 0 {
 			t.Fatal("expecting 0% probability for ignored edge")
 		}
 
 		if restrictions.ProbabilitySource(ignoreNodeVertex,
-			route.Vertex{6}, 0,
+			route.Vertex{6}, 0, 0,
 		) != 0 {
 			t.Fatal("expecting 0% probability for ignored node")
 		}
 
-		if restrictions.ProbabilitySource(node1, node2, 0) != 0 {
+		if restrictions.ProbabilitySource(node1, node2, 0, 0) != 0 {
 			t.Fatal("expecting 0% probability for ignored pair")
 		}
 
+		if restrictions.ProbabilitySource(taggedNode,
+			route.Vertex{6}, 0, 0,
+		) != 0 {
+			t.Fatal("expecting 0% probability for tagged node")
+		}
+
+		ignoredChannel := routing.EdgeLocator{
+			ChannelID: 777,
+			Direction: 1,
+		}
+		if _, ok := restrictions.IgnoredChannels[ignoredChannel]; !ok {
+			t.Fatal("expected ignored channel")
+		}
+
 		if *restrictions.LastHop != lastHop {
 			t.Fatal("unexpected last hop")
 		}
@@ -182,18 +206,33 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 			expectedProb = testMissionControlProb
 		}
 		if restrictions.ProbabilitySource(route.Vertex{4},
-			route.Vertex{5}, 0,
+			route.Vertex{5}, 0, 0,
 		) != expectedProb {
 			t.Fatal("expecting 100% probability")
 		}
 
-		hops := []*route.Hop{{}}
-		return route.NewRouteFromHops(amt, 144, source, hops)
+		if numRoutes != 2 {
+			t.Fatal("unexpected number of routes")
+		}
+
+		var routes []*route.Route
+		for i := 0; i < numRoutes; i++ {
+			hops := []*route.Hop{{}}
+			rt, err := route.NewRouteFromHops(
+				amt, 144, source, hops,
+			)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, rt)
+		}
+
+		return routes, nil
 	}
 
 	backend := &RouterBackend{
-		FindRoute: findRoute,
-		SelfNode:  route.Vertex{1, 2, 3},
+		FindRoutes: findRoutes,
+		SelfNode:   route.Vertex{1, 2, 3},
 		FetchChannelCapacity: func(chanID uint64) (
 			bronutil.Amount, error) {
 
@@ -210,6 +249,9 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 			}
 			return route.Vertex{1}, route.Vertex{2}, nil
 		},
+		NodeTags: routing.NodeTags{
+			taggedNode: {"country:xx": {}},
+		},
 	}
 
 	// If this is set, we'll populate MaxTotalTimelock. If this is not set,
@@ -230,17 +272,38 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(resp.Routes) != 1 {
-		t.Fatal("expected a single route response")
+	if len(resp.Routes) != 2 {
+		t.Fatal("expected two routes in response")
+	}
+	if len(resp.SuccessProbs) != 2 {
+		t.Fatal("expected a success probability for every route")
+	}
+	if resp.SuccessProb != resp.SuccessProbs[0] {
+		t.Fatal("expected success probability of first route")
 	}
 }
 
+// TestQueryRoutesNumRoutesLimit asserts that requests for too many
+// alternative routes are rejected.
+func TestQueryRoutesNumRoutesLimit(t *testing.T) {
+	backend := &RouterBackend{}
+
+	_, err := backend.QueryRoutes(
+		context.Background(), &lnrpc.QueryRoutesRequest{
+			PubKey:    destKey,
+			Amt:       100000,
+			NumRoutes: MaxNumRoutes + 1,
+		},
+	)
+	require.Error(t, err)
+}
+
 type mockMissionControl struct {
 	MissionControl
 }
 
 func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliBronees) float64 {
+	amt lnwire.MilliBronees, capacity bronutil.Amount) float64 {
 
 	return testMissionControlProb
 }
@@ -259,6 +322,53 @@ func (m *mockMissionControl) GetPairHistorySnapshot(fromNode,
 	return routing.TimedPairResult{}
 }
 
+// TestUnmarshallExclusions asserts that the nodes, node pairs, channel
+// directions and node tags to avoid are parsed from a request.
+func TestUnmarshallExclusions(t *testing.T) {
+	backend := &RouterBackend{
+		NodeTags: routing.NodeTags{
+			taggedNode: {"country:xx": {}},
+			node2:      {"asn:64496": {}},
+		},
+	}
+
+	exclusions, err := backend.unmarshallExclusions(
+		[][]byte{node1[:]},
+		[]*lnrpc.NodePair{{From: node1[:], To: node2[:]}},
+		[]*lnrpc.EdgeLocator{
+			{ChannelId: 1},
+			{ChannelId: 2, DirectionReverse: true},
+		},
+		[]string{"country:xx"},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, map[route.Vertex]struct{}{
+		node1:      {},
+		taggedNode: {},
+	}, exclusions.nodes)
+	require.Equal(t, map[routing.DirectedNodePair]struct{}{
+		routing.NewDirectedNodePair(node1, node2): {},
+	}, exclusions.pairs)
+	require.Equal(t, map[routing.EdgeLocator]struct{}{
+		{ChannelID: 1, Direction: 0}: {},
+		{ChannelID: 2, Direction: 1}: {},
+	}, exclusions.channels)
+
+	// Invalid node keys are rejected.
+	_, err = backend.unmarshallExclusions(
+		[][]byte{{1, 2, 3}}, nil, nil, nil,
+	)
+	require.Error(t, err)
+
+	// Node tags can't be avoided without a node tags file.
+	backend.NodeTags = nil
+	_, err = backend.unmarshallExclusions(
+		nil, nil, nil, []string{"country:xx"},
+	)
+	require.Error(t, err)
+}
+
 type recordParseOutcome byte
 
 const (
@@ -470,3 +580,124 @@ func testUnmarshalAMP(t *testing.T, test unmarshalAMPTest) {
 		t.Fatalf("test case has non-standard outcome")
 	}
 }
+
+// TestUnmarshalBlindedPayment asserts that blinded payment paths are properly
+// parsed, and that paths with missing or malformed fields are rejected.
+func TestUnmarshalBlindedPayment(t *testing.T) {
+	t.Parallel()
+
+	introNode, err := hex.DecodeString(destKey)
+	require.NoError(t, err)
+	blindingPoint, err := hex.DecodeString(ignoreNodeKey)
+	require.NoError(t, err)
+	blindedNode, err := hex.DecodeString(hintNodeKey)
+	require.NoError(t, err)
+
+	validPath := func() *lnrpc.BlindedPaymentPath {
+		return &lnrpc.BlindedPaymentPath{
+			BlindedPath: &lnrpc.BlindedPath{
+				IntroductionNode: introNode,
+				BlindingPoint:    blindingPoint,
+				BlindedHops: []*lnrpc.BlindedHop{
+					{
+						BlindedNode:   introNode,
+						EncryptedData: []byte{1, 2, 3},
+					},
+					{
+						BlindedNode:   blindedNode,
+						EncryptedData: []byte{4, 5, 6},
+					},
+				},
+			},
+			BaseFeeMsat:         1000,
+			ProportionalFeeRate: 10,
+			TotalCltvDelta:      184,
+			HtlcMinMsat:         1,
+			HtlcMaxMsat:         100000,
+		}
+	}
+
+	payment, err := UnmarshalBlindedPayment(validPath())
+	require.NoError(t, err)
+	require.Equal(t, uint32(1000), payment.BaseFee)
+	require.Equal(t, uint32(10), payment.ProportionalFeeRate)
+	require.Equal(t, uint16(184), payment.CltvExpiryDelta)
+	require.Equal(t, uint64(1), payment.HtlcMinimum)
+	require.Equal(t, uint64(100000), payment.HtlcMaximum)
+	require.Equal(
+		t, introNode,
+		payment.BlindedPath.IntroductionPoint.SerializeCompressed(),
+	)
+	require.Equal(
+		t, blindingPoint,
+		payment.BlindedPath.BlindingPoint.SerializeCompressed(),
+	)
+	require.Len(t, payment.BlindedPath.BlindedHops, 2)
+	require.Equal(
+		t, blindedNode,
+		payment.BlindedPath.BlindedHops[1].BlindedNodePub.SerializeCompressed(),
+	)
+	require.Equal(
+		t, []byte{4, 5, 6}, payment.BlindedPath.BlindedHops[1].CipherText,
+	)
+
+	invalidTests := []struct {
+		name   string
+		mutate func(*lnrpc.BlindedPaymentPath)
+	}{
+		{
+			name: "no path",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.BlindedPath = nil
+			},
+		},
+		{
+			name: "no hops",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.BlindedPath.BlindedHops = nil
+			},
+		},
+		{
+			name: "invalid introduction node",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.BlindedPath.IntroductionNode = []byte{1, 2, 3}
+			},
+		},
+		{
+			name: "invalid blinding point",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.BlindedPath.BlindingPoint = nil
+			},
+		},
+		{
+			name: "missing encrypted data",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.BlindedPath.BlindedHops[1].EncryptedData = nil
+			},
+		},
+		{
+			name: "cltv delta too large",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.TotalCltvDelta = 1 << 16
+			},
+		},
+		{
+			name: "htlc max below min",
+			mutate: func(p *lnrpc.BlindedPaymentPath) {
+				p.HtlcMinMsat = 10
+				p.HtlcMaxMsat = 5
+			},
+		},
+	}
+
+	for _, test := range invalidTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := validPath()
+			test.mutate(path)
+
+			_, err := UnmarshalBlindedPayment(path)
+			require.Error(t, err)
+		})
+	}
+}
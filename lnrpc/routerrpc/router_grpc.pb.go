@@ -30,7 +30,9 @@ type RouterClient interface {
 	TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error)
 	//
 	//EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	//may cost to send an HTLC to the target end destination.
+	//may cost to send an HTLC to the target end destination. If a payment
+	//request is given, the estimate is obtained by probing the destination with
+	//a payment that can't be settled.
 	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
 	// Deprecated: Do not use.
 	//
@@ -57,10 +59,20 @@ type RouterClient interface {
 	//
 	//XImportMissionControl is an experimental API that imports the state provided
 	//to the internal mission control's state, using all results which are more
-	//recent than our existing values. These values will only be imported
-	//in-memory, and will not be persisted across restarts.
+	//recent than our existing values. The resulting state is persisted.
 	XImportMissionControl(ctx context.Context, in *XImportMissionControlRequest, opts ...grpc.CallOption) (*XImportMissionControlResponse, error)
 	//
+	//ExportMissionControl exports the mission control state in a versioned
+	//binary format that can be written to a file and imported into another node
+	//with ImportMissionControl.
+	ExportMissionControl(ctx context.Context, in *ExportMissionControlRequest, opts ...grpc.CallOption) (*ExportMissionControlResponse, error)
+	//
+	//ImportMissionControl imports mission control state that was exported with
+	//ExportMissionControl. Pair results are only used where they are more recent
+	//than our existing values, unless force is set. The resulting state is
+	//persisted.
+	ImportMissionControl(ctx context.Context, in *ImportMissionControlRequest, opts ...grpc.CallOption) (*ImportMissionControlResponse, error)
+	//
 	//GetMissionControlConfig returns mission control's current config.
 	GetMissionControlConfig(ctx context.Context, in *GetMissionControlConfigRequest, opts ...grpc.CallOption) (*GetMissionControlConfigResponse, error)
 	//
@@ -97,6 +109,23 @@ type RouterClient interface {
 	//a boolean that tells broln if this htlc should be intercepted.
 	//In case of interception, the htlc can be either settled, cancelled or
 	//resumed later by using the ResolveHoldForward endpoint.
+	//
+	//Multiple clients can intercept htlcs at the same time. Htlcs are offered to
+	//the clients in the order in which they connected. An htlc that a client
+	//resumes is offered to the next client, before it is forwarded.
+	//
+	//Htlcs are intercepted before the outgoing channel is looked up. Htlcs to
+	//unknown short channel ids, for example the fake ones of route hints for
+	//just-in-time channels, are offered to the clients as well. A client can
+	//open a (zero-conf) channel and resume the htlc onto it with
+	//RESUME_MODIFIED, setting outgoing_chan_id to an alias of the new channel.
+	//
+	//When a client disconnects, the htlcs that it holds are offered to the
+	//clients that connected after it. If broln runs with --requireinterceptor,
+	//htlcs that no client holds are not forwarded, but held until a client
+	//connects and then replayed to it. This also applies to htlcs that were held
+	//before a restart. Held htlcs are failed back automatically at
+	//auto_fail_height to prevent the incoming channel from being force closed.
 	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error)
 	//
 	//UpdateChanStatus attempts to manually set the state of a channel
@@ -104,6 +133,14 @@ type RouterClient interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+	//
+	//ProberStatus returns the state and the budget of the prober, which sends
+	//probe payments to discover the liquidity of channels, along with the
+	//results of the most recent probes.
+	ProberStatus(ctx context.Context, in *ProberStatusRequest, opts ...grpc.CallOption) (*ProberStatusResponse, error)
+	//
+	//UpdateProber starts or stops the prober and updates its budget.
+	UpdateProber(ctx context.Context, in *UpdateProberRequest, opts ...grpc.CallOption) (*UpdateProberResponse, error)
 }
 
 type routerClient struct {
@@ -233,6 +270,24 @@ func (c *routerClient) XImportMissionControl(ctx context.Context, in *XImportMis
 	return out, nil
 }
 
+func (c *routerClient) ExportMissionControl(ctx context.Context, in *ExportMissionControlRequest, opts ...grpc.CallOption) (*ExportMissionControlResponse, error) {
+	out := new(ExportMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ExportMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ImportMissionControl(ctx context.Context, in *ImportMissionControlRequest, opts ...grpc.CallOption) (*ImportMissionControlResponse, error) {
+	out := new(ImportMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ImportMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routerClient) GetMissionControlConfig(ctx context.Context, in *GetMissionControlConfigRequest, opts ...grpc.CallOption) (*GetMissionControlConfigResponse, error) {
 	out := new(GetMissionControlConfigResponse)
 	err := c.cc.Invoke(ctx, "/routerrpc.Router/GetMissionControlConfig", in, out, opts...)
@@ -407,6 +462,24 @@ func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatu
 	return out, nil
 }
 
+func (c *routerClient) ProberStatus(ctx context.Context, in *ProberStatusRequest, opts ...grpc.CallOption) (*ProberStatusResponse, error) {
+	out := new(ProberStatusResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProberStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) UpdateProber(ctx context.Context, in *UpdateProberRequest, opts ...grpc.CallOption) (*UpdateProberResponse, error) {
+	out := new(UpdateProberResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/UpdateProber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -422,7 +495,9 @@ type RouterServer interface {
 	TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error
 	//
 	//EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	//may cost to send an HTLC to the target end destination.
+	//may cost to send an HTLC to the target end destination. If a payment
+	//request is given, the estimate is obtained by probing the destination with
+	//a payment that can't be settled.
 	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
 	// Deprecated: Do not use.
 	//
@@ -449,10 +524,20 @@ type RouterServer interface {
 	//
 	//XImportMissionControl is an experimental API that imports the state provided
 	//to the internal mission control's state, using all results which are more
-	//recent than our existing values. These values will only be imported
-	//in-memory, and will not be persisted across restarts.
+	//recent than our existing values. The resulting state is persisted.
 	XImportMissionControl(context.Context, *XImportMissionControlRequest) (*XImportMissionControlResponse, error)
 	//
+	//ExportMissionControl exports the mission control state in a versioned
+	//binary format that can be written to a file and imported into another node
+	//with ImportMissionControl.
+	ExportMissionControl(context.Context, *ExportMissionControlRequest) (*ExportMissionControlResponse, error)
+	//
+	//ImportMissionControl imports mission control state that was exported with
+	//ExportMissionControl. Pair results are only used where they are more recent
+	//than our existing values, unless force is set. The resulting state is
+	//persisted.
+	ImportMissionControl(context.Context, *ImportMissionControlRequest) (*ImportMissionControlResponse, error)
+	//
 	//GetMissionControlConfig returns mission control's current config.
 	GetMissionControlConfig(context.Context, *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse, error)
 	//
@@ -489,6 +574,23 @@ type RouterServer interface {
 	//a boolean that tells broln if this htlc should be intercepted.
 	//In case of interception, the htlc can be either settled, cancelled or
 	//resumed later by using the ResolveHoldForward endpoint.
+	//
+	//Multiple clients can intercept htlcs at the same time. Htlcs are offered to
+	//the clients in the order in which they connected. An htlc that a client
+	//resumes is offered to the next client, before it is forwarded.
+	//
+	//Htlcs are intercepted before the outgoing channel is looked up. Htlcs to
+	//unknown short channel ids, for example the fake ones of route hints for
+	//just-in-time channels, are offered to the clients as well. A client can
+	//open a (zero-conf) channel and resume the htlc onto it with
+	//RESUME_MODIFIED, setting outgoing_chan_id to an alias of the new channel.
+	//
+	//When a client disconnects, the htlcs that it holds are offered to the
+	//clients that connected after it. If broln runs with --requireinterceptor,
+	//htlcs that no client holds are not forwarded, but held until a client
+	//connects and then replayed to it. This also applies to htlcs that were held
+	//before a restart. Held htlcs are failed back automatically at
+	//auto_fail_height to prevent the incoming channel from being force closed.
 	HtlcInterceptor(Router_HtlcInterceptorServer) error
 	//
 	//UpdateChanStatus attempts to manually set the state of a channel
@@ -496,6 +598,14 @@ type RouterServer interface {
 	//channel to stay disabled until a subsequent manual request of either
 	//"enable" or "auto".
 	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+	//
+	//ProberStatus returns the state and the budget of the prober, which sends
+	//probe payments to discover the liquidity of channels, along with the
+	//results of the most recent probes.
+	ProberStatus(context.Context, *ProberStatusRequest) (*ProberStatusResponse, error)
+	//
+	//UpdateProber starts or stops the prober and updates its budget.
+	UpdateProber(context.Context, *UpdateProberRequest) (*UpdateProberResponse, error)
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -527,6 +637,12 @@ func (UnimplementedRouterServer) QueryMissionControl(context.Context, *QueryMiss
 func (UnimplementedRouterServer) XImportMissionControl(context.Context, *XImportMissionControlRequest) (*XImportMissionControlResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method XImportMissionControl not implemented")
 }
+func (UnimplementedRouterServer) ExportMissionControl(context.Context, *ExportMissionControlRequest) (*ExportMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMissionControl not implemented")
+}
+func (UnimplementedRouterServer) ImportMissionControl(context.Context, *ImportMissionControlRequest) (*ImportMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMissionControl not implemented")
+}
 func (UnimplementedRouterServer) GetMissionControlConfig(context.Context, *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissionControlConfig not implemented")
 }
@@ -554,6 +670,12 @@ func (UnimplementedRouterServer) HtlcInterceptor(Router_HtlcInterceptorServer) e
 func (UnimplementedRouterServer) UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateChanStatus not implemented")
 }
+func (UnimplementedRouterServer) ProberStatus(context.Context, *ProberStatusRequest) (*ProberStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProberStatus not implemented")
+}
+func (UnimplementedRouterServer) UpdateProber(context.Context, *UpdateProberRequest) (*UpdateProberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProber not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -717,6 +839,42 @@ func _Router_XImportMissionControl_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_ExportMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ExportMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ExportMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ExportMissionControl(ctx, req.(*ExportMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ImportMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ImportMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ImportMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ImportMissionControl(ctx, req.(*ImportMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Router_GetMissionControlConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMissionControlConfigRequest)
 	if err := dec(in); err != nil {
@@ -896,6 +1054,42 @@ func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_ProberStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProberStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProberStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProberStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProberStatus(ctx, req.(*ProberStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_UpdateProber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).UpdateProber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/UpdateProber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).UpdateProber(ctx, req.(*UpdateProberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -927,6 +1121,14 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "XImportMissionControl",
 			Handler:    _Router_XImportMissionControl_Handler,
 		},
+		{
+			MethodName: "ExportMissionControl",
+			Handler:    _Router_ExportMissionControl_Handler,
+		},
+		{
+			MethodName: "ImportMissionControl",
+			Handler:    _Router_ImportMissionControl_Handler,
+		},
 		{
 			MethodName: "GetMissionControlConfig",
 			Handler:    _Router_GetMissionControlConfig_Handler,
@@ -947,6 +1149,14 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChanStatus",
 			Handler:    _Router_UpdateChanStatus_Handler,
 		},
+		{
+			MethodName: "ProberStatus",
+			Handler:    _Router_ProberStatus_Handler,
+		},
+		{
+			MethodName: "UpdateProber",
+			Handler:    _Router_UpdateProber_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1,7 +1,9 @@
 package routerrpc
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -17,6 +19,7 @@ import (
 	"github.com/brsuite/broln/macaroons"
 	"github.com/brsuite/broln/routing"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/broln/zpay32"
 	"github.com/brsuite/brond/wire"
 	"github.com/brsuite/bronutil"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -32,6 +35,10 @@ const (
 	// to register ourselves, and we also require that the main
 	// SubServerConfigDispatcher instance recognize as the name of our
 	subServerName = "RouterRPC"
+
+	// DefaultPaymentProbeTimeout is the default maximum time that a probe
+	// payment sent by EstimateRouteFee may take.
+	DefaultPaymentProbeTimeout = time.Minute
 )
 
 var (
@@ -85,6 +92,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/ExportMissionControl": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ImportMissionControl": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/GetMissionControlConfig": {{
 			Entity: "offchain",
 			Action: "read",
@@ -125,6 +140,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/ProberStatus": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/UpdateProber": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -310,7 +333,9 @@ func (r *ServerShell) CreateSubServer(configRegistry lnrpc.SubServerConfigDispat
 func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 	stream Router_SendPaymentV2Server) error {
 
-	payment, err := s.cfg.RouterBackend.extractIntentFromSendRequest(req)
+	payment, err := s.cfg.RouterBackend.extractIntentFromSendRequest(
+		stream.Context(), req,
+	)
 	if err != nil {
 		return err
 	}
@@ -343,6 +368,22 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 func (s *Server) EstimateRouteFee(ctx context.Context,
 	req *RouteFeeRequest) (*RouteFeeResponse, error) {
 
+	// If a payment request is given, we'll probe the destination instead
+	// of only running local pathfinding.
+	if req.PaymentRequest != "" {
+		if len(req.Dest) != 0 || req.AmtSat != 0 {
+			return nil, errors.New("dest and amt_sat must not be " +
+				"set together with payment_request")
+		}
+
+		return s.probePaymentRequest(ctx, req)
+	}
+
+	if req.Timeout != 0 {
+		return nil, errors.New("timeout can only be used together " +
+			"with payment_request")
+	}
+
 	if len(req.Dest) != 33 {
 		return nil, errors.New("invalid length destination key")
 	}
@@ -369,7 +410,7 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 			FeeLimit:          feeLimit,
 			CltvLimit:         s.cfg.RouterBackend.MaxTotalTimelock,
 			ProbabilitySource: mc.GetProbability,
-		}, nil, nil, s.cfg.RouterBackend.DefaultFinalCltvDelta,
+		}, nil, nil, nil, s.cfg.RouterBackend.DefaultFinalCltvDelta,
 	)
 	if err != nil {
 		return nil, err
@@ -381,6 +422,292 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 	}, nil
 }
 
+// probePaymentRequest estimates the fee and time lock of a payment to the
+// destination of the given payment request by sending it a probe payment with
+// a random payment hash. The destination fails the probe with
+// IncorrectOrUnknownPaymentDetails, and the route that reached it gives us the
+// fee and time lock. If the route hints of the payment request indicate that
+// the destination is a private node behind an LSP, we probe the LSP instead
+// and add the fee and time lock of its hop hint.
+func (s *Server) probePaymentRequest(ctx context.Context,
+	req *RouteFeeRequest) (*RouteFeeResponse, error) {
+
+	payReq, err := zpay32.Decode(
+		req.PaymentRequest, s.cfg.RouterBackend.ActiveNetParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if payReq.MilliSat == nil || *payReq.MilliSat == 0 {
+		return nil, errors.New("payment request must specify an " +
+			"amount to probe")
+	}
+	if len(payReq.BlindedPaymentPaths) > 0 {
+		return nil, errors.New("probing payment requests with " +
+			"blinded paths is not supported")
+	}
+
+	probeTimeout := DefaultPaymentProbeTimeout
+	if req.Timeout != 0 {
+		probeTimeout = time.Duration(req.Timeout) * time.Second
+	}
+
+	var paymentHash lntypes.Hash
+	if _, err := rand.Read(paymentHash[:]); err != nil {
+		return nil, err
+	}
+
+	// Like the pathfinding estimate, we don't restrict the fee of the
+	// probe, as we're interested in the fee of the route it takes.
+	var (
+		amt      = *payReq.MilliSat
+		feeLimit = lnwire.NewMSatFromBroneess(
+			bronutil.BroneesPerBrocoin,
+		)
+	)
+	payment := &routing.LightningPayment{
+		Target:            route.NewVertex(payReq.Destination),
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		CltvLimit:         s.cfg.RouterBackend.MaxTotalTimelock,
+		FinalCLTVDelta:    uint16(payReq.MinFinalCLTVExpiry()),
+		RouteHints:        payReq.RouteHints,
+		PaymentAddr:       payReq.PaymentAddr,
+		DestFeatures:      payReq.Features,
+		PayAttemptTimeout: probeTimeout,
+		MaxParts:          1,
+	}
+
+	// If all route hints lead through the same node, the destination is
+	// likely a private node behind an LSP, which may not forward probes to
+	// it. We'll probe the LSP instead, with an amount that includes the
+	// fee of its hop hint, and account for the last hop ourselves.
+	lsp := lspHopHint(payReq.RouteHints, amt)
+	if lsp != nil {
+		payment.Target = route.NewVertex(lsp.NodeID)
+		payment.Amount = amt + lsp.fee
+		payment.FinalCLTVDelta = lsp.CLTVExpiryDelta
+		payment.RouteHints = stripLastHopHints(payReq.RouteHints)
+		payment.PaymentAddr = nil
+		payment.DestFeatures = nil
+	}
+
+	if err := payment.SetPaymentHash(paymentHash); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Probing route fee to %v for payment request of %v",
+		payment.Target, amt)
+
+	if err := s.cfg.Router.SendPaymentAsync(payment); err != nil {
+		return nil, err
+	}
+
+	probe, err := s.waitForProbe(ctx, paymentHash)
+	if err != nil {
+		// The probe may still be in flight, so we'll remove it from
+		// the database in the background once it resolved.
+		go s.deleteProbeWhenResolved(paymentHash)
+
+		return nil, err
+	}
+
+	s.deleteProbe(probe)
+
+	if probe.Status == channeldb.StatusSucceeded {
+		return nil, fmt.Errorf("probe %v unexpectedly succeeded",
+			paymentHash)
+	}
+
+	resp, err := s.probeResult(probe)
+	if err != nil {
+		return nil, err
+	}
+
+	// If we probed an LSP, we still need to add the fee and the final CLTV
+	// delta of the last hop to the destination. The expiry delta of the
+	// LSP's hop hint was already used as final CLTV delta of the probe.
+	reached := resp.FailureReason ==
+		lnrpc.PaymentFailureReason_FAILURE_REASON_NONE
+	if lsp != nil && reached {
+		resp.RoutingFeeMsat += int64(lsp.fee)
+		resp.TimeLockDelay += int64(payReq.MinFinalCLTVExpiry())
+	}
+
+	return resp, nil
+}
+
+// waitForProbe waits for the probe payment with the given hash to resolve and
+// returns the resolved payment.
+func (s *Server) waitForProbe(ctx context.Context,
+	paymentHash lntypes.Hash) (*channeldb.MPPayment, error) {
+
+	subscription, err := s.cfg.RouterBackend.Tower.SubscribePayment(
+		paymentHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case item, ok := <-subscription.Updates:
+			if !ok {
+				return nil, fmt.Errorf("probe %v subscription "+
+					"closed", paymentHash)
+			}
+
+			payment := item.(*channeldb.MPPayment)
+			switch payment.Status {
+			case channeldb.StatusFailed, channeldb.StatusSucceeded:
+				return payment, nil
+			}
+
+		case <-s.quit:
+			return nil, errServerShuttingDown
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// deleteProbeWhenResolved waits for the probe payment with the given hash to
+// resolve and removes it from the database.
+func (s *Server) deleteProbeWhenResolved(paymentHash lntypes.Hash) {
+	probe, err := s.waitForProbe(context.Background(), paymentHash)
+	if err != nil {
+		log.Debugf("Unable to wait for probe %v: %v", paymentHash, err)
+		return
+	}
+
+	s.deleteProbe(probe)
+}
+
+// deleteProbe removes a resolved probe payment from the database, so that
+// fee estimations don't fill up the payment history. Probes that unexpectedly
+// succeeded are kept, as they paid the destination.
+func (s *Server) deleteProbe(payment *channeldb.MPPayment) {
+	if payment.Status != channeldb.StatusFailed {
+		return
+	}
+
+	paymentHash := payment.Info.PaymentIdentifier
+	if err := s.cfg.RouterBackend.DeletePayment(paymentHash); err != nil {
+		log.Errorf("Unable to delete probe %v: %v", paymentHash, err)
+	}
+}
+
+// probeResult extracts the fee and time lock of a failed probe payment from
+// the htlc attempt that was failed by the destination with
+// IncorrectOrUnknownPaymentDetails.
+func (s *Server) probeResult(payment *channeldb.MPPayment) (*RouteFeeResponse,
+	error) {
+
+	reason := payment.FailureReason
+	if reason == nil || *reason != channeldb.FailureReasonPaymentDetails {
+		rpcReason, err := marshallPaymentFailureReason(reason)
+		if err != nil {
+			return nil, err
+		}
+
+		return &RouteFeeResponse{
+			FailureReason: rpcReason,
+		}, nil
+	}
+
+	for _, htlc := range payment.HTLCs {
+		// Only the destination, which is the last hop of the route,
+		// tells us that the probe reached it.
+		failure := htlc.Failure
+		finalIdx := uint32(len(htlc.Route.Hops))
+		if failure == nil || failure.FailureSourceIndex != finalIdx {
+			continue
+		}
+
+		_, ok := failure.Message.(*lnwire.FailIncorrectDetails)
+		if !ok {
+			continue
+		}
+
+		return &RouteFeeResponse{
+			RoutingFeeMsat: int64(htlc.Route.TotalFees()),
+			TimeLockDelay:  int64(htlc.Route.TotalTimeLock),
+		}, nil
+	}
+
+	return nil, errors.New("probe failed with incorrect payment details " +
+		"without a matching htlc attempt")
+}
+
+// lspHint is the worst case hop hint from an LSP to a private destination,
+// along with its fee for the amount that is sent to the destination.
+type lspHint struct {
+	zpay32.HopHint
+
+	// fee is the fee that the LSP charges for the last hop.
+	fee lnwire.MilliBronees
+}
+
+// lspHopHint returns the hop hint of the LSP that all of the given route
+// hints lead through, or nil if they don't lead through a single node. As the
+// destination may be reached over any of the hints, the returned hint carries
+// the highest fee and expiry delta among them.
+func lspHopHint(routeHints [][]zpay32.HopHint,
+	amt lnwire.MilliBronees) *lspHint {
+
+	var lsp *lspHint
+	for _, routeHint := range routeHints {
+		if len(routeHint) == 0 {
+			continue
+		}
+
+		hopHint := routeHint[len(routeHint)-1]
+		fee := lnwire.MilliBronees(hopHint.FeeBaseMSat) +
+			amt*lnwire.MilliBronees(
+				hopHint.FeeProportionalMillionths,
+			)/1_000_000
+
+		if lsp == nil {
+			lsp = &lspHint{
+				HopHint: hopHint,
+				fee:     fee,
+			}
+			continue
+		}
+
+		if !lsp.NodeID.IsEqual(hopHint.NodeID) {
+			return nil
+		}
+
+		if fee > lsp.fee {
+			lsp.fee = fee
+		}
+		if hopHint.CLTVExpiryDelta > lsp.CLTVExpiryDelta {
+			lsp.CLTVExpiryDelta = hopHint.CLTVExpiryDelta
+		}
+	}
+
+	return lsp
+}
+
+// stripLastHopHints removes the hop hints from the LSP to the destination,
+// leaving the route hints that lead to the LSP itself.
+func stripLastHopHints(routeHints [][]zpay32.HopHint) [][]zpay32.HopHint {
+	var stripped [][]zpay32.HopHint
+	for _, routeHint := range routeHints {
+		if len(routeHint) > 1 {
+			stripped = append(
+				stripped, routeHint[:len(routeHint)-1],
+			)
+		}
+	}
+
+	return stripped
+}
+
 // SendToRouteV2 sends a payment through a predefined route. The response of this
 // call contains structured error information.
 func (s *Server) SendToRouteV2(ctx context.Context,
@@ -445,39 +772,144 @@ func (s *Server) GetMissionControlConfig(ctx context.Context,
 	req *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse,
 	error) {
 
+	// Query the current mission control config.
 	cfg := s.cfg.RouterBackend.MissionControl.GetConfig()
-	return &GetMissionControlConfigResponse{
+	resp := &GetMissionControlConfigResponse{
 		Config: &MissionControlConfig{
-			HalfLifeSeconds:             uint64(cfg.PenaltyHalfLife.Seconds()),
-			HopProbability:              float32(cfg.AprioriHopProbability),
-			Weight:                      float32(cfg.AprioriWeight),
 			MaximumPaymentResults:       uint32(cfg.MaxMcHistory),
 			MinimumFailureRelaxInterval: uint64(cfg.MinFailureRelaxInterval.Seconds()),
 		},
-	}, nil
+	}
+
+	// We only populate fields based on the current estimator.
+	switch v := cfg.Estimator.Config().(type) {
+	case routing.AprioriConfig:
+		resp.Config.Model = MissionControlConfig_APRIORI
+		aCfg := AprioriParameters{
+			HalfLifeSeconds:  uint64(v.PenaltyHalfLife.Seconds()),
+			HopProbability:   v.AprioriHopProbability,
+			Weight:           v.AprioriWeight,
+			CapacityFraction: v.CapacityFraction,
+		}
+
+		// Populate deprecated fields.
+		resp.Config.HalfLifeSeconds = uint64(
+			v.PenaltyHalfLife.Seconds(),
+		)
+		resp.Config.HopProbability = float32(v.AprioriHopProbability)
+		resp.Config.Weight = float32(v.AprioriWeight)
+
+		resp.Config.EstimatorConfig = &MissionControlConfig_Apriori{
+			Apriori: &aCfg,
+		}
+
+	case routing.BimodalConfig:
+		resp.Config.Model = MissionControlConfig_BIMODAL
+		bCfg := BimodalParameters{
+			NodeWeight: v.BimodalNodeWeight,
+			ScaleMsat:  uint64(v.BimodalScaleMsat),
+			DecayTime:  uint64(v.BimodalDecayTime.Seconds()),
+		}
+
+		resp.Config.EstimatorConfig = &MissionControlConfig_Bimodal{
+			Bimodal: &bCfg,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown estimator config type %T", v)
+	}
+
+	return resp, nil
 }
 
-// SetMissionControlConfig returns our current mission control config.
+// SetMissionControlConfig sets parameters in the mission control config.
 func (s *Server) SetMissionControlConfig(ctx context.Context,
 	req *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse,
 	error) {
 
-	cfg := &routing.MissionControlConfig{
-		ProbabilityEstimatorCfg: routing.ProbabilityEstimatorCfg{
-			PenaltyHalfLife: time.Duration(
-				req.Config.HalfLifeSeconds,
-			) * time.Second,
-			AprioriHopProbability: float64(req.Config.HopProbability),
-			AprioriWeight:         float64(req.Config.Weight),
-		},
+	if req.Config == nil {
+		return nil, fmt.Errorf("config must be set")
+	}
+
+	mcCfg := &routing.MissionControlConfig{
 		MaxMcHistory: int(req.Config.MaximumPaymentResults),
 		MinFailureRelaxInterval: time.Duration(
 			req.Config.MinimumFailureRelaxInterval,
 		) * time.Second,
 	}
 
+	switch req.Config.Model {
+	case MissionControlConfig_APRIORI:
+		var aprioriConfig routing.AprioriConfig
+
+		// Determine the apriori config with backward compatibility
+		// should the api use deprecated fields.
+		switch v := req.Config.EstimatorConfig.(type) {
+		case *MissionControlConfig_Bimodal:
+			return nil, fmt.Errorf("bimodal config provided, but " +
+				"apriori model requested")
+
+		case *MissionControlConfig_Apriori:
+			aprioriConfig = routing.AprioriConfig{
+				PenaltyHalfLife: time.Duration(
+					v.Apriori.HalfLifeSeconds,
+				) * time.Second,
+				AprioriHopProbability: v.Apriori.HopProbability,
+				AprioriWeight:         v.Apriori.Weight,
+				CapacityFraction: v.Apriori.
+					CapacityFraction,
+			}
+
+		default:
+			aprioriConfig = routing.AprioriConfig{
+				PenaltyHalfLife: time.Duration(
+					req.Config.HalfLifeSeconds,
+				) * time.Second,
+				AprioriHopProbability: float64(
+					req.Config.HopProbability,
+				),
+				AprioriWeight: float64(req.Config.Weight),
+				CapacityFraction: routing.
+					DefaultCapacityFraction,
+			}
+		}
+
+		estimator, err := routing.NewAprioriEstimator(aprioriConfig)
+		if err != nil {
+			return nil, err
+		}
+		mcCfg.Estimator = estimator
+
+	case MissionControlConfig_BIMODAL:
+		cfg, ok := req.Config.
+			EstimatorConfig.(*MissionControlConfig_Bimodal)
+		if !ok {
+			return nil, fmt.Errorf("bimodal estimator requested " +
+				"but corresponding config not set")
+		}
+		bCfg := cfg.Bimodal
+
+		bimodalConfig := routing.BimodalConfig{
+			BimodalDecayTime: time.Duration(
+				bCfg.DecayTime,
+			) * time.Second,
+			BimodalScaleMsat:  lnwire.MilliBronees(bCfg.ScaleMsat),
+			BimodalNodeWeight: bCfg.NodeWeight,
+		}
+
+		estimator, err := routing.NewBimodalEstimator(bimodalConfig)
+		if err != nil {
+			return nil, err
+		}
+		mcCfg.Estimator = estimator
+
+	default:
+		return nil, fmt.Errorf("unknown estimator type %v",
+			req.Config.Model)
+	}
+
 	return &SetMissionControlConfigResponse{},
-		s.cfg.RouterBackend.MissionControl.SetConfig(cfg)
+		s.cfg.RouterBackend.MissionControl.SetConfig(mcCfg)
 }
 
 // QueryMissionControl exposes the internal mission control state to callers. It
@@ -487,8 +919,20 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 
 	snapshot := s.cfg.RouterBackend.MissionControl.GetHistorySnapshot()
 
-	rpcPairs := make([]*PairHistory, 0, len(snapshot.Pairs))
-	for _, p := range snapshot.Pairs {
+	response := QueryMissionControlResponse{
+		Pairs: MarshalPairHistory(snapshot.Pairs),
+	}
+
+	return &response, nil
+}
+
+// MarshalPairHistory marshalls mission control pair snapshots to the rpc
+// struct.
+func MarshalPairHistory(
+	pairs []routing.MissionControlPairSnapshot) []*PairHistory {
+
+	rpcPairs := make([]*PairHistory, 0, len(pairs))
+	for _, p := range pairs {
 		// Prevent binding to loop variable.
 		pair := p
 
@@ -501,11 +945,7 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 		rpcPairs = append(rpcPairs, &rpcPair)
 	}
 
-	response := QueryMissionControlResponse{
-		Pairs: rpcPairs,
-	}
-
-	return &response, nil
+	return rpcPairs
 }
 
 // toRPCPairData marshalls mission control pair data to the rpc struct.
@@ -563,6 +1003,55 @@ func (s *Server) XImportMissionControl(ctx context.Context,
 	return &XImportMissionControlResponse{}, nil
 }
 
+// ExportMissionControl exports the mission control state in its binary export
+// format.
+func (s *Server) ExportMissionControl(ctx context.Context,
+	req *ExportMissionControlRequest) (*ExportMissionControlResponse,
+	error) {
+
+	export, err := s.cfg.RouterBackend.MissionControl.Export(
+		req.IncludeResults,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := export.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return &ExportMissionControlResponse{
+		Data: b.Bytes(),
+	}, nil
+}
+
+// ImportMissionControl imports mission control state that was exported with
+// ExportMissionControl.
+func (s *Server) ImportMissionControl(ctx context.Context,
+	req *ImportMissionControlRequest) (*ImportMissionControlResponse,
+	error) {
+
+	export, err := routing.DecodeMissionControlExport(
+		bytes.NewReader(req.Data),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid export: %v", err)
+	}
+
+	pairs, results, err := s.cfg.RouterBackend.MissionControl.Import(
+		export, req.Force,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportMissionControlResponse{
+		ImportedPairs:   uint32(pairs),
+		ImportedResults: uint32(results),
+	}, nil
+}
+
 func toPairSnapshot(pairResult *PairHistory) (*routing.MissionControlPairSnapshot,
 	error) {
 
@@ -700,8 +1189,18 @@ func (s *Server) QueryProbability(ctx context.Context,
 
 	amt := lnwire.MilliBronees(req.AmtMsat)
 
+	// Compute the probability using the largest capacity of a channel
+	// between the two nodes that can carry the amount. If the pair isn't
+	// known to us, we use a zero capacity.
+	capacity, err := s.cfg.RouterBackend.FetchAmountPairCapacity(
+		fromNode, toNode, amt,
+	)
+	if err != nil {
+		capacity = 0
+	}
+
 	mc := s.cfg.RouterBackend.MissionControl
-	prob := mc.GetProbability(fromNode, toNode, amt)
+	prob := mc.GetProbability(fromNode, toNode, amt, capacity)
 	history := mc.GetPairHistorySnapshot(fromNode, toNode)
 
 	return &QueryProbabilityResponse{
@@ -934,3 +1433,88 @@ func (s *Server) UpdateChanStatus(ctx context.Context,
 	}
 	return &UpdateChanStatusResponse{}, nil
 }
+
+// ProberStatus returns the state and the budget of the prober along with the
+// results of the most recent probes.
+func (s *Server) ProberStatus(ctx context.Context,
+	req *ProberStatusRequest) (*ProberStatusResponse, error) {
+
+	status := s.cfg.RouterBackend.Prober.Status()
+
+	resp := &ProberStatusResponse{
+		Active:          status.Active,
+		IntervalSeconds: uint32(status.Budget.Interval / time.Second),
+		AmtMsat:         uint64(status.Budget.Amount),
+		MaxInFlightMsat: uint64(status.Budget.MaxInFlight),
+		InFlightMsat:    uint64(status.InFlight),
+		ProbesSent:      status.Sent,
+		ProbesReached:   status.Reached,
+		ProbesFailed:    status.Failed,
+	}
+
+	for _, dest := range status.Destinations {
+		dest := dest
+		resp.Destinations = append(resp.Destinations, dest[:])
+	}
+
+	for _, result := range status.Recent {
+		rpcResult := &ProbeResult{
+			Destination: result.Destination[:],
+			AmtMsat:     uint64(result.Amount),
+			Reached:     result.Reached,
+			Timestamp:   result.Time.Unix(),
+		}
+		if result.Err != nil {
+			rpcResult.Error = result.Err.Error()
+		}
+
+		resp.RecentProbes = append(resp.RecentProbes, rpcResult)
+	}
+
+	return resp, nil
+}
+
+// UpdateProber starts or stops the prober and updates its budget. Budget
+// values that aren't set keep their current value.
+func (s *Server) UpdateProber(ctx context.Context,
+	req *UpdateProberRequest) (*UpdateProberResponse, error) {
+
+	if _, ok := ProberAction_name[int32(req.Action)]; !ok {
+		return nil, fmt.Errorf("unrecognized ProberAction %v",
+			req.Action)
+	}
+
+	p := s.cfg.RouterBackend.Prober
+
+	budget := p.Status().Budget
+	updateBudget := false
+	if req.IntervalSeconds != 0 {
+		budget.Interval = time.Duration(req.IntervalSeconds) *
+			time.Second
+		updateBudget = true
+	}
+	if req.AmtMsat != 0 {
+		budget.Amount = lnwire.MilliBronees(req.AmtMsat)
+		updateBudget = true
+	}
+	if req.MaxInFlightMsat != 0 {
+		budget.MaxInFlight = lnwire.MilliBronees(req.MaxInFlightMsat)
+		updateBudget = true
+	}
+
+	if updateBudget {
+		if err := p.SetBudget(budget); err != nil {
+			return nil, err
+		}
+	}
+
+	switch req.Action {
+	case ProberAction_START:
+		p.SetActive(true)
+
+	case ProberAction_STOP:
+		p.SetActive(false)
+	}
+
+	return &UpdateProberResponse{}, nil
+}
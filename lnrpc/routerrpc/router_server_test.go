@@ -0,0 +1,332 @@
+package routerrpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnrpc"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/prober"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/broln/zpay32"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLspHopHint asserts that route hints which all lead through the same
+// node are detected as an LSP, and that the worst case fee and expiry delta
+// of its hop hints are used.
+func TestLspHopHint(t *testing.T) {
+	t.Parallel()
+
+	newKey := func() *bronec.PublicKey {
+		key, err := bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		return key.PubKey()
+	}
+
+	var (
+		lspKey   = newKey()
+		otherKey = newKey()
+		amt      = lnwire.MilliBronees(1_000_000)
+	)
+
+	lspHint := func(feeBase, feeRate uint32,
+		cltvDelta uint16) zpay32.HopHint {
+
+		return zpay32.HopHint{
+			NodeID:                    lspKey,
+			ChannelID:                 uint64(feeBase),
+			FeeBaseMSat:               feeBase,
+			FeeProportionalMillionths: feeRate,
+			CLTVExpiryDelta:           cltvDelta,
+		}
+	}
+	otherHint := zpay32.HopHint{
+		NodeID:    otherKey,
+		ChannelID: 100,
+	}
+
+	// Without route hints, there's no LSP to probe.
+	require.Nil(t, lspHopHint(nil, amt))
+
+	// Route hints through different nodes don't indicate an LSP.
+	require.Nil(t, lspHopHint([][]zpay32.HopHint{
+		{lspHint(1000, 1, 40)},
+		{otherHint},
+	}, amt))
+
+	// If all route hints lead through the same node, the highest fee
+	// and expiry delta among them are used.
+	routeHints := [][]zpay32.HopHint{
+		{lspHint(1000, 100, 40)},
+		{otherHint, lspHint(2000, 10, 20)},
+		{},
+	}
+	lsp := lspHopHint(routeHints, amt)
+	require.NotNil(t, lsp)
+	require.True(t, lsp.NodeID.IsEqual(lspKey))
+	require.EqualValues(t, 2010, lsp.fee)
+	require.EqualValues(t, 40, lsp.CLTVExpiryDelta)
+
+	// Only the route hints that lead to the LSP itself remain.
+	require.Equal(
+		t, [][]zpay32.HopHint{{otherHint}},
+		stripLastHopHints(routeHints),
+	)
+}
+
+// TestProbeResult asserts that the fee and time lock of a probe are taken
+// from the attempt that was failed by the destination, and that probes which
+// didn't reach the destination only report their failure reason.
+func TestProbeResult(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+
+	rt, err := route.NewRouteFromHops(
+		1100, 250, route.Vertex{1}, []*route.Hop{
+			{
+				PubKeyBytes:      route.Vertex{2},
+				AmtToForward:     1000,
+				OutgoingTimeLock: 210,
+			},
+			{
+				PubKeyBytes:      route.Vertex{3},
+				AmtToForward:     1000,
+				OutgoingTimeLock: 210,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	attempt := func(sourceIdx uint32,
+		msg lnwire.FailureMessage) channeldb.HTLCAttempt {
+
+		return channeldb.HTLCAttempt{
+			HTLCAttemptInfo: channeldb.HTLCAttemptInfo{
+				Route: *rt,
+			},
+			Failure: &channeldb.HTLCFailInfo{
+				Reason:             channeldb.HTLCFailMessage,
+				Message:            msg,
+				FailureSourceIndex: sourceIdx,
+			},
+		}
+	}
+
+	reason := channeldb.FailureReasonPaymentDetails
+	resp, err := s.probeResult(&channeldb.MPPayment{
+		HTLCs: []channeldb.HTLCAttempt{
+			attempt(1, &lnwire.FailTemporaryNodeFailure{}),
+			attempt(2, &lnwire.FailIncorrectDetails{}),
+		},
+		FailureReason: &reason,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 100, resp.RoutingFeeMsat)
+	require.EqualValues(t, 250, resp.TimeLockDelay)
+	require.Equal(
+		t, lnrpc.PaymentFailureReason_FAILURE_REASON_NONE,
+		resp.FailureReason,
+	)
+
+	reason = channeldb.FailureReasonNoRoute
+	resp, err = s.probeResult(&channeldb.MPPayment{
+		FailureReason: &reason,
+	})
+	require.NoError(t, err)
+	require.Zero(t, resp.RoutingFeeMsat)
+	require.Equal(
+		t, lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+		resp.FailureReason,
+	)
+}
+
+// TestDeleteProbe asserts that probe payments are removed from the database
+// once they failed, also if the fee estimation stopped waiting for them while
+// they were still in flight.
+func TestDeleteProbe(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := channeldb.MakeTestDB()
+	require.NoError(t, err)
+	defer cleanup()
+
+	tower := routing.NewControlTower(channeldb.NewPaymentControl(db))
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				Tower: tower,
+				DeletePayment: func(hash lntypes.Hash) error {
+					return db.DeletePayment(hash, false)
+				},
+			},
+		},
+		quit: make(chan struct{}),
+	}
+
+	initProbe := func(hash lntypes.Hash) {
+		err := tower.InitPayment(hash, &channeldb.PaymentCreationInfo{
+			PaymentIdentifier: hash,
+			Value:             1000,
+			CreationTime:      time.Unix(1000, 0),
+			PaymentRequest:    []byte{},
+		})
+		require.NoError(t, err)
+	}
+
+	// The fee estimation gives up while the probe is still in flight.
+	hash := lntypes.Hash{1}
+	initProbe(hash)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = s.waitForProbe(ctx, hash)
+	require.ErrorIs(t, err, context.Canceled)
+
+	done := make(chan struct{})
+	go func() {
+		s.deleteProbeWhenResolved(hash)
+		close(done)
+	}()
+
+	err = tower.Fail(hash, channeldb.FailureReasonPaymentDetails)
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("probe not deleted")
+	}
+
+	_, err = tower.FetchPayment(hash)
+	require.ErrorIs(t, err, channeldb.ErrPaymentNotInitiated)
+
+	// A probe that already resolved is deleted right away.
+	hash = lntypes.Hash{2}
+	initProbe(hash)
+	err = tower.Fail(hash, channeldb.FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	probe, err := s.waitForProbe(context.Background(), hash)
+	require.NoError(t, err)
+	s.deleteProbe(probe)
+
+	_, err = tower.FetchPayment(hash)
+	require.ErrorIs(t, err, channeldb.ErrPaymentNotInitiated)
+}
+
+// mockProber is a mock implementation of the Prober interface.
+type mockProber struct {
+	status prober.Status
+}
+
+func (m *mockProber) Status() *prober.Status {
+	status := m.status
+	return &status
+}
+
+func (m *mockProber) SetActive(active bool) {
+	m.status.Active = active
+}
+
+func (m *mockProber) SetBudget(budget prober.Budget) error {
+	if budget.MaxInFlight < budget.Amount {
+		return prober.ErrInvalidMaxInFlight
+	}
+
+	m.status.Budget = budget
+	return nil
+}
+
+// TestUpdateProber asserts that the prober can be controlled and that its
+// status is reported.
+func TestUpdateProber(t *testing.T) {
+	t.Parallel()
+
+	errNoRoute := channeldb.FailureReasonNoRoute
+	p := &mockProber{
+		status: prober.Status{
+			Budget: prober.Budget{
+				Interval:    time.Minute,
+				Amount:      1000,
+				MaxInFlight: 2000,
+			},
+			Destinations: []route.Vertex{{1}},
+			Sent:         2,
+			Reached:      1,
+			Failed:       1,
+			Recent: []prober.Result{
+				{
+					Destination: route.Vertex{1},
+					Amount:      1000,
+					Reached:     true,
+					Time:        time.Unix(100, 0),
+				},
+				{
+					Destination: route.Vertex{1},
+					Amount:      1000,
+					Err:         errNoRoute,
+					Time:        time.Unix(200, 0),
+				},
+			},
+		},
+	}
+	s := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				Prober: p,
+			},
+		},
+	}
+	ctx := context.Background()
+
+	// Start the prober and raise its amount budget, keeping the interval.
+	_, err := s.UpdateProber(ctx, &UpdateProberRequest{
+		Action:          ProberAction_START,
+		AmtMsat:         1500,
+		MaxInFlightMsat: 3000,
+	})
+	require.NoError(t, err)
+
+	resp, err := s.ProberStatus(ctx, &ProberStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Active)
+	require.EqualValues(t, 60, resp.IntervalSeconds)
+	require.EqualValues(t, 1500, resp.AmtMsat)
+	require.EqualValues(t, 3000, resp.MaxInFlightMsat)
+	dest := route.Vertex{1}
+	require.Equal(t, [][]byte{dest[:]}, resp.Destinations)
+	require.EqualValues(t, 2, resp.ProbesSent)
+	require.Len(t, resp.RecentProbes, 2)
+	require.True(t, resp.RecentProbes[0].Reached)
+	require.Empty(t, resp.RecentProbes[0].Error)
+	require.EqualValues(t, 200, resp.RecentProbes[1].Timestamp)
+	require.Equal(
+		t, channeldb.FailureReasonNoRoute.Error(),
+		resp.RecentProbes[1].Error,
+	)
+
+	// An invalid budget is rejected and doesn't stop the prober.
+	_, err = s.UpdateProber(ctx, &UpdateProberRequest{
+		Action:  ProberAction_STOP,
+		AmtMsat: 5000,
+	})
+	require.ErrorIs(t, err, prober.ErrInvalidMaxInFlight)
+	require.True(t, p.status.Active)
+
+	// Stop the prober without changing its budget.
+	_, err = s.UpdateProber(ctx, &UpdateProberRequest{
+		Action: ProberAction_STOP,
+	})
+	require.NoError(t, err)
+	require.False(t, p.status.Active)
+	require.EqualValues(t, 1500, p.status.Budget.Amount)
+}
@@ -12,6 +12,9 @@ type RoutingConfig struct {
 	// to attempt the payment.
 	MinRouteProbability float64 `long:"minrtprob" description:"Minimum required route success probability to attempt the payment"`
 
+	// ProbabilityEstimatorType sets the estimator to use.
+	ProbabilityEstimatorType string `long:"estimator" choice:"apriori" choice:"bimodal" description:"Probability estimator used for pathfinding. The apriori estimator is configured by the apriorihopprob, aprioriweight, penaltyhalflife and capacityfraction options."`
+
 	// AprioriHopProbability is the assumed success probability of a hop in
 	// a route when no other information is available.
 	AprioriHopProbability float64 `long:"apriorihopprob" description:"Assumed success probability of a hop in a route when no other information is available."`
@@ -29,6 +32,14 @@ type RoutingConfig struct {
 	// channel is back at 50% probability.
 	PenaltyHalfLife time.Duration `long:"penaltyhalflife" description:"Defines the duration after which a penalized node or channel is back at 50% probability"`
 
+	// CapacityFraction is the fraction of a channel's capacity around
+	// which the apriori estimator reduces the success probability of the
+	// channel. Larger amounts are penalized increasingly, which favors
+	// larger channels for larger payments. Setting it to zero disables the
+	// penalty. The bimodal estimator already takes the capacity into
+	// account and ignores this option.
+	CapacityFraction float64 `long:"capacityfraction" description:"The fraction of a channel's capacity around which the apriori estimator starts to reduce the channel's success probability. Valid values are in [0, 1). Setting it to zero disables the capacity penalty."`
+
 	// AttemptCost is the fixed virtual cost in path finding of a failed
 	// payment attempt. It is used to trade off potentially better routes
 	// against their probability of succeeding.
@@ -47,4 +58,72 @@ type RoutingConfig struct {
 	// McFlushInterval defines the timer interval to use to flush mission
 	// control state to the DB.
 	McFlushInterval time.Duration `long:"mcflushinterval" description:"the timer interval to use to flush mission control state to the DB"`
+
+	// MaxMcPairs defines the maximum number of node pairs whose state is
+	// kept by mission control.
+	MaxMcPairs int `long:"maxmcpairs" description:"the maximum number of node pairs whose state is kept by mission control. If the limit is exceeded, the least recently updated pairs are forgotten. Set to 0 to disable the limit."`
+
+	// McPairMaxAge defines the age after which mission control forgets
+	// the results of a node pair.
+	McPairMaxAge time.Duration `long:"mcpairmaxage" description:"the age after which mission control forgets the failures and successes of a node pair. Set to 0 to keep results until the pair limit is reached."`
+
+	// NodeTagsFile is the path to a file that assigns tags to nodes, such
+	// as the country or the autonomous system they are hosted in. Payments
+	// and route queries may ask path finding to avoid nodes by their tags.
+	NodeTagsFile string `long:"nodetagsfile" description:"Path to a file that assigns tags such as country:xx or asn:64496 to nodes. Each line holds the hex-encoded public key of a node followed by its whitespace separated tags. Payments and route queries can avoid nodes by their tags."`
+
+	// BimodalConfig defines parameters for the bimodal probability
+	// estimator.
+	BimodalConfig *BimodalConfig `group:"bimodal" namespace:"bimodal" description:"configuration for the bimodal pathfinding probability estimator"`
+
+	// ProberConfig defines parameters for the prober that keeps mission
+	// control informed by probing destinations.
+	ProberConfig *ProberConfig `group:"prober" namespace:"prober" description:"configuration for the prober that sends probe payments to discover channel liquidity"`
+}
+
+// ProberConfig defines configuration for the prober.
+type ProberConfig struct {
+	// Active defines whether probes are sent from startup on. The prober
+	// can also be activated through the rpc interface.
+	Active bool `long:"active" description:"Send probe payments to discover the liquidity of channels from startup on."`
+
+	// Interval is the time between two probes.
+	Interval time.Duration `long:"interval" description:"The time between two probe payments."`
+
+	// Amt is the amount of a probe in sat.
+	Amt int64 `long:"amt" description:"The amount of a probe payment in sat."`
+
+	// MaxInFlight is the maximum total amount of the probes that are in
+	// flight at the same time in sat.
+	MaxInFlight int64 `long:"maxinflight" description:"The maximum total amount in sat of the probe payments that are in flight at the same time."`
+
+	// Timeout is the time after which no new attempts are made for a
+	// probe.
+	Timeout time.Duration `long:"timeout" description:"The time after which no new attempts are made for a probe payment."`
+
+	// FeeLimitPPM is the fee limit of a probe in parts per million of its
+	// amount.
+	FeeLimitPPM uint64 `long:"feelimitppm" description:"The fee limit of a probe payment in parts per million of its amount. Probes never pay fees, but the limit keeps them on routes that real payments would take."`
+
+	// Destinations are the hex-encoded public keys of the nodes to probe.
+	Destinations []string `long:"destination" description:"The hex-encoded public key of a node to probe. Can be specified multiple times. If no destination is given, the nodes with the highest betweenness centrality are probed."`
+
+	// NumCentralNodes is the number of nodes with the highest betweenness
+	// centrality that are probed if no destinations are configured.
+	NumCentralNodes int `long:"numcentralnodes" description:"The number of nodes with the highest betweenness centrality that are probed if no destination is configured."`
+}
+
+// BimodalConfig defines configuration for the bimodal probability estimator.
+type BimodalConfig struct {
+	// NodeWeight defines how strongly non-routed channels should be taken
+	// into account for probability estimation. Valid values are in [0, 1].
+	NodeWeight float64 `long:"nodeweight" description:"Defines how strongly non-routed channels should be taken into account for probability estimation. Valid values are in [0, 1]."`
+
+	// Scale is a parameter that describes how liquidity is distributed in
+	// the network on each channel.
+	Scale int64 `long:"scale" description:"Defines the unit on the channel capacity scale over which liquidity is assumed to be distributed, in msat."`
+
+	// DecayTime is the time after which learned information about channel
+	// liquidity is forgotten.
+	DecayTime time.Duration `long:"decaytime" description:"Describes the information decay of knowledge about previous successes and failures in channels."`
 }
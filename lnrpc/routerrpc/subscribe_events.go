@@ -196,6 +196,21 @@ func rpcFailureResolution(invoiceFailure invoices.FailResolutionResult) (
 	case invoices.ResultMppInProgress:
 		return FailureDetail_MPP_IN_PROGRESS, nil
 
+	case invoices.ResultTrampolineFeeInsufficient:
+		return FailureDetail_TRAMPOLINE_FEE_INSUFFICIENT, nil
+
+	case invoices.ResultTrampolineExpiryTooSoon:
+		return FailureDetail_TRAMPOLINE_EXPIRY_TOO_SOON, nil
+
+	case invoices.ResultTrampolineFailed:
+		return FailureDetail_TRAMPOLINE_FAILED, nil
+
+	case invoices.ResultHtlcModifierRejected:
+		return FailureDetail_HTLC_MODIFIER_REJECTED, nil
+
+	case invoices.ResultHtlcModifierError:
+		return FailureDetail_HTLC_MODIFIER_ERROR, nil
+
 	default:
 		return 0, fmt.Errorf("unknown fail resolution: %v",
 			invoiceFailure.FailureString())
@@ -6,16 +6,20 @@ package signrpc
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/brsuite/broln/input"
 	"github.com/brsuite/broln/keychain"
 	"github.com/brsuite/broln/lnrpc"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/macaroons"
+	"github.com/brsuite/broln/schnorr"
+	"github.com/brsuite/broln/schnorr/musig2"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/txscript"
@@ -69,6 +73,26 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		}},
+		"/signrpc.Signer/MuSig2CombineKeys": {{
+			Entity: "signer",
+			Action: "read",
+		}},
+		"/signrpc.Signer/MuSig2CreateSession": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/signrpc.Signer/MuSig2RegisterNonces": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/signrpc.Signer/MuSig2Sign": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
+		"/signrpc.Signer/MuSig2CombineSig": {{
+			Entity: "signer",
+			Action: "generate",
+		}},
 	}
 
 	// DefaultSignerMacFilename is the default name of the signer macaroon
@@ -93,6 +117,12 @@ type Server struct {
 	UnimplementedSignerServer
 
 	cfg *Config
+
+	// muSig2Sessions are the active MuSig2 signing sessions, keyed by
+	// their session ID. The sessions only live in memory, so they don't
+	// survive a restart.
+	muSig2Sessions    map[[32]byte]*muSig2Session
+	muSig2SessionsMtx sync.Mutex
 }
 
 // A compile time check to ensure that Server fully implements the SignerServer
@@ -145,7 +175,8 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
 	}
 
 	signerServer := &Server{
-		cfg: cfg,
+		cfg:            cfg,
+		muSig2Sessions: make(map[[32]byte]*muSig2Session),
 	}
 
 	return signerServer, macPermissions, nil
@@ -627,6 +658,439 @@ func (s *Server) DeriveSharedKey(_ context.Context, in *SharedKeyRequest) (
 	return &SharedKeyResponse{SharedKey: sharedKeyHash[:]}, nil
 }
 
+// MuSig2CombineKeys combines the given set of public keys into a single
+// combined MuSig2 public key, applying the optional tweaks.
+func (s *Server) MuSig2CombineKeys(_ context.Context,
+	in *MuSig2CombineKeysRequest) (*MuSig2CombineKeysResponse, error) {
+
+	pubKeys, err := parseMuSig2Keys(in.AllSignerPubkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	aggKey, internalKey, err := combineMuSig2Keys(
+		pubKeys, in.Tweaks, in.TaprootTweak,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to combine keys: %v", err)
+	}
+
+	combinedKey := schnorr.XOnly(aggKey.FinalKey)
+	resp := &MuSig2CombineKeysResponse{
+		CombinedKey: combinedKey[:],
+	}
+	if internalKey != nil {
+		internalKeyX := schnorr.XOnly(internalKey)
+		resp.TaprootInternalKey = internalKeyX[:]
+	}
+
+	return resp, nil
+}
+
+// MuSig2CreateSession creates a new MuSig2 signing session for the local key
+// identified by the key locator. The secret nonces of the session are created
+// from the private key, which is derived from the key ring and never leaves
+// the signer.
+func (s *Server) MuSig2CreateSession(_ context.Context,
+	in *MuSig2SessionRequest) (*MuSig2SessionResponse, error) {
+
+	if in.KeyLoc == nil {
+		return nil, fmt.Errorf("a key locator MUST be passed in")
+	}
+
+	pubKeys, err := parseMuSig2Keys(in.AllSignerPubkeys)
+	if err != nil {
+		return nil, err
+	}
+
+	aggKey, internalKey, err := combineMuSig2Keys(
+		pubKeys, in.Tweaks, in.TaprootTweak,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to combine keys: %v", err)
+	}
+
+	keyLoc := keychain.KeyLocator{
+		Family: keychain.KeyFamily(in.KeyLoc.KeyFamily),
+		Index:  uint32(in.KeyLoc.KeyIndex),
+	}
+	privKey, err := s.cfg.KeyRing.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: keyLoc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive private key: %v", err)
+	}
+
+	// The local key must be one of the signers, otherwise we won't be
+	// able to create a partial signature later on.
+	localKey := privKey.PubKey().SerializeCompressed()
+	var isSigner bool
+	for _, pubKey := range pubKeys {
+		if bytes.Equal(pubKey.SerializeCompressed(), localKey) {
+			isSigner = true
+			break
+		}
+	}
+	if !isSigner {
+		return nil, fmt.Errorf("local key %x is not part of the "+
+			"signer public keys", localKey)
+	}
+
+	nonces, err := musig2.GenNonces(privKey, aggKey.FinalKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate nonces: %v", err)
+	}
+
+	session := &muSig2Session{
+		keyLoc:      keyLoc,
+		aggKey:      aggKey,
+		numSigners:  len(pubKeys),
+		localNonces: nonces,
+	}
+	err = session.registerNonces(in.OtherSignerPublicNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	// The public nonces are unique for every session, so they also serve
+	// as the session ID.
+	sessionID := sha256.Sum256(nonces.PubNonce[:])
+
+	s.muSig2SessionsMtx.Lock()
+	s.muSig2Sessions[sessionID] = session
+	s.muSig2SessionsMtx.Unlock()
+
+	log.Debugf("Created MuSig2 session %x for %d signers", sessionID[:],
+		len(pubKeys))
+
+	combinedKey := schnorr.XOnly(aggKey.FinalKey)
+	resp := &MuSig2SessionResponse{
+		SessionId:         sessionID[:],
+		CombinedKey:       combinedKey[:],
+		LocalPublicNonces: nonces.PubNonce[:],
+		HaveAllNonces:     session.haveAllNonces(),
+	}
+	if internalKey != nil {
+		internalKeyX := schnorr.XOnly(internalKey)
+		resp.TaprootInternalKey = internalKeyX[:]
+	}
+
+	return resp, nil
+}
+
+// MuSig2RegisterNonces registers one or more public nonces of other signing
+// participants for a session identified by its ID.
+func (s *Server) MuSig2RegisterNonces(_ context.Context,
+	in *MuSig2RegisterNoncesRequest) (*MuSig2RegisterNoncesResponse,
+	error) {
+
+	s.muSig2SessionsMtx.Lock()
+	defer s.muSig2SessionsMtx.Unlock()
+
+	session, err := s.fetchMuSig2Session(in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = session.registerNonces(in.OtherSignerPublicNonces)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MuSig2RegisterNoncesResponse{
+		HaveAllNonces: session.haveAllNonces(),
+	}, nil
+}
+
+// MuSig2Sign creates a partial signature of the message digest using the local
+// key of a session. The secret nonce of the session is discarded afterwards,
+// so every session can only sign once.
+func (s *Server) MuSig2Sign(_ context.Context,
+	in *MuSig2SignRequest) (*MuSig2SignResponse, error) {
+
+	if len(in.MessageDigest) != 32 {
+		return nil, fmt.Errorf("message digest must be 32 bytes")
+	}
+
+	s.muSig2SessionsMtx.Lock()
+	defer s.muSig2SessionsMtx.Unlock()
+
+	session, err := s.fetchMuSig2Session(in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !session.haveAllNonces():
+		return nil, fmt.Errorf("not all nonces are known yet")
+
+	case session.signed:
+		return nil, fmt.Errorf("session was already used to sign")
+	}
+
+	privKey, err := s.cfg.KeyRing.DerivePrivKey(keychain.KeyDescriptor{
+		KeyLocator: session.keyLoc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive private key: %v", err)
+	}
+
+	var msg [32]byte
+	copy(msg[:], in.MessageDigest)
+
+	partialSig, err := musig2.Sign(
+		session.localNonces.SecNonce, privKey, session.aggNonce,
+		session.aggKey, msg,
+	)
+
+	// Reusing the secret nonce for another message would leak the private
+	// key, so we wipe it regardless of the outcome.
+	session.localNonces.SecNonce = [musig2.SecNonceSize]byte{}
+	session.signed = true
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign: %v", err)
+	}
+
+	session.msg = msg
+	session.partialSigs = append(session.partialSigs, partialSig)
+
+	return &MuSig2SignResponse{
+		LocalPartialSignature: partialSig[:],
+	}, nil
+}
+
+// MuSig2CombineSig combines the partial signatures of the other participants
+// with the local partial signature of a session. Once all partial signatures
+// are known, the final signature is returned and the session is removed.
+func (s *Server) MuSig2CombineSig(_ context.Context,
+	in *MuSig2CombineSigRequest) (*MuSig2CombineSigResponse, error) {
+
+	s.muSig2SessionsMtx.Lock()
+	defer s.muSig2SessionsMtx.Unlock()
+
+	session, err := s.fetchMuSig2Session(in.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !session.signed {
+		return nil, fmt.Errorf("session didn't sign yet")
+	}
+
+	numSigs := len(session.partialSigs) + len(in.OtherPartialSignatures)
+	if numSigs > session.numSigners {
+		return nil, fmt.Errorf("expected %d partial signatures, got "+
+			"%d", session.numSigners, numSigs)
+	}
+
+	for _, rawSig := range in.OtherPartialSignatures {
+		if len(rawSig) != musig2.PartialSigSize {
+			return nil, fmt.Errorf("partial signature must be %d "+
+				"bytes", musig2.PartialSigSize)
+		}
+
+		var partialSig [musig2.PartialSigSize]byte
+		copy(partialSig[:], rawSig)
+		session.partialSigs = append(session.partialSigs, partialSig)
+	}
+
+	if len(session.partialSigs) < session.numSigners {
+		return &MuSig2CombineSigResponse{}, nil
+	}
+
+	// All partial signatures are known, so the session is done, whether
+	// the final signature is valid or not.
+	var sessionID [32]byte
+	copy(sessionID[:], in.SessionId)
+	delete(s.muSig2Sessions, sessionID)
+
+	sig, err := musig2.CombineSigs(
+		session.aggNonce, session.aggKey, session.msg,
+		session.partialSigs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to combine signatures: %v", err)
+	}
+
+	return &MuSig2CombineSigResponse{
+		HaveAllSignatures: true,
+		FinalSignature:    sig[:],
+	}, nil
+}
+
+// fetchMuSig2Session returns the MuSig2 session with the given ID.
+//
+// NOTE: The caller must hold the muSig2SessionsMtx.
+func (s *Server) fetchMuSig2Session(rawID []byte) (*muSig2Session, error) {
+	if len(rawID) != 32 {
+		return nil, fmt.Errorf("session ID must be 32 bytes")
+	}
+
+	var sessionID [32]byte
+	copy(sessionID[:], rawID)
+
+	session, ok := s.muSig2Sessions[sessionID]
+	if !ok {
+		return nil, fmt.Errorf("unknown MuSig2 session %x", rawID)
+	}
+
+	return session, nil
+}
+
+// muSig2Session is the in-memory state of a MuSig2 signing session.
+type muSig2Session struct {
+	// keyLoc identifies the local signing key. The private key is only
+	// derived from the key ring when needed.
+	keyLoc keychain.KeyLocator
+
+	// aggKey is the combined key of all signers.
+	aggKey *musig2.AggregateKey
+
+	// numSigners is the number of signers of the session, including the
+	// local signer.
+	numSigners int
+
+	// localNonces are the nonces of the local signer. The secret nonce is
+	// wiped once it was used to sign.
+	localNonces *musig2.Nonces
+
+	// otherNonces are the public nonces of the other signers.
+	otherNonces [][musig2.PubNonceSize]byte
+
+	// aggNonce is the combined nonce of all signers. It is set once the
+	// nonces of all signers are known.
+	aggNonce [musig2.PubNonceSize]byte
+
+	// signed indicates whether the local signer created its partial
+	// signature already.
+	signed bool
+
+	// msg is the message digest that was signed.
+	msg [32]byte
+
+	// partialSigs are the partial signatures of the session, starting
+	// with the local one.
+	partialSigs [][musig2.PartialSigSize]byte
+}
+
+// haveAllNonces returns true if the public nonces of all signers are known.
+func (m *muSig2Session) haveAllNonces() bool {
+	return len(m.otherNonces) == m.numSigners-1
+}
+
+// registerNonces adds the public nonces of other signers to the session. Once
+// the nonces of all signers are known, the combined nonce is computed.
+func (m *muSig2Session) registerNonces(rawNonces [][]byte) error {
+	if len(rawNonces) == 0 {
+		return nil
+	}
+
+	if len(m.otherNonces)+len(rawNonces) > m.numSigners-1 {
+		return fmt.Errorf("expected %d nonces of other signers, got "+
+			"%d", m.numSigners-1, len(m.otherNonces)+len(rawNonces))
+	}
+
+	for _, rawNonce := range rawNonces {
+		if len(rawNonce) != musig2.PubNonceSize {
+			return fmt.Errorf("public nonce must be %d bytes",
+				musig2.PubNonceSize)
+		}
+
+		var nonce [musig2.PubNonceSize]byte
+		copy(nonce[:], rawNonce)
+		m.otherNonces = append(m.otherNonces, nonce)
+	}
+
+	if !m.haveAllNonces() {
+		return nil
+	}
+
+	pubNonces := append(
+		[][musig2.PubNonceSize]byte{m.localNonces.PubNonce},
+		m.otherNonces...,
+	)
+	aggNonce, err := musig2.AggregateNonces(pubNonces)
+	if err != nil {
+		return fmt.Errorf("unable to combine nonces: %v", err)
+	}
+	m.aggNonce = aggNonce
+
+	return nil
+}
+
+// parseMuSig2Keys parses the public keys of all signers of a MuSig2 session.
+func parseMuSig2Keys(rawKeys [][]byte) ([]*bronec.PublicKey, error) {
+	if len(rawKeys) == 0 {
+		return nil, fmt.Errorf("the public keys of all signers MUST " +
+			"be passed in")
+	}
+
+	pubKeys := make([]*bronec.PublicKey, len(rawKeys))
+	for i, rawKey := range rawKeys {
+		if len(rawKey) == 0 {
+			return nil, fmt.Errorf("signer public key must not " +
+				"be empty")
+		}
+
+		pubKey, err := parseRawKeyBytes(rawKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing signer public "+
+				"key %d: %v", i, err)
+		}
+		pubKeys[i] = pubKey
+	}
+
+	return pubKeys, nil
+}
+
+// combineMuSig2Keys combines the public keys of all signers and applies the
+// generic tweaks, followed by the taproot tweak. If a taproot tweak is
+// applied, the internal key of the taproot output is returned as well.
+func combineMuSig2Keys(pubKeys []*bronec.PublicKey, tweaks []*TweakDesc,
+	taprootTweak *TaprootTweakDesc) (*musig2.AggregateKey,
+	*bronec.PublicKey, error) {
+
+	aggKey, err := musig2.AggregateKeys(pubKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tweakDesc := range tweaks {
+		if len(tweakDesc.Tweak) != 32 {
+			return nil, nil, fmt.Errorf("tweak must be 32 bytes")
+		}
+
+		var tweak [32]byte
+		copy(tweak[:], tweakDesc.Tweak)
+		err := aggKey.ApplyTweak(tweak, tweakDesc.IsXOnly)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if taprootTweak == nil {
+		return aggKey, nil, nil
+	}
+
+	switch {
+	case taprootTweak.KeySpendOnly && len(taprootTweak.ScriptRoot) != 0:
+		return nil, nil, fmt.Errorf("script root must be empty for " +
+			"key spend only taproot tweak")
+
+	case !taprootTweak.KeySpendOnly && len(taprootTweak.ScriptRoot) != 32:
+		return nil, nil, fmt.Errorf("script root must be 32 bytes")
+	}
+
+	internalKey := aggKey.FinalKey
+	err = aggKey.ApplyTaprootTweak(taprootTweak.ScriptRoot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aggKey, internalKey, nil
+}
+
 // parseRawKeyBytes checks that the provided raw public key is valid and returns
 // the public key. A nil public key is returned if the length of the rawKeyBytes
 // is zero.
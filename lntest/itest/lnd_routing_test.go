@@ -1613,6 +1613,26 @@ func testMissionControlCfg(t *testing.T, node *lntest.HarnessNode) {
 	)
 	require.NoError(t, err)
 
+	// assertConfig sets the given mission control config and asserts that
+	// it is returned when querying the config.
+	assertConfig := func(setCfg,
+		expectedCfg *routerrpc.MissionControlConfig) {
+
+		_, err := node.RouterClient.SetMissionControlConfig(
+			ctxb, &routerrpc.SetMissionControlConfigRequest{
+				Config: setCfg,
+			},
+		)
+		require.NoError(t, err)
+
+		resp, err := node.RouterClient.GetMissionControlConfig(
+			ctxb, &routerrpc.GetMissionControlConfigRequest{},
+		)
+		require.NoError(t, err)
+		require.True(t, proto.Equal(expectedCfg, resp.Config))
+	}
+
+	// Setting the deprecated fields configures the apriori estimator.
 	cfg := &routerrpc.MissionControlConfig{
 		HalfLifeSeconds:             8000,
 		HopProbability:              0.8,
@@ -1620,19 +1640,37 @@ func testMissionControlCfg(t *testing.T, node *lntest.HarnessNode) {
 		MaximumPaymentResults:       30,
 		MinimumFailureRelaxInterval: 60,
 	}
-
-	_, err = node.RouterClient.SetMissionControlConfig(
-		ctxb, &routerrpc.SetMissionControlConfigRequest{
-			Config: cfg,
+	expectedCfg := &routerrpc.MissionControlConfig{
+		HalfLifeSeconds:             8000,
+		HopProbability:              0.8,
+		Weight:                      0.3,
+		MaximumPaymentResults:       30,
+		MinimumFailureRelaxInterval: 60,
+		Model:                       routerrpc.MissionControlConfig_APRIORI,
+		EstimatorConfig: &routerrpc.MissionControlConfig_Apriori{
+			Apriori: &routerrpc.AprioriParameters{
+				HalfLifeSeconds: 8000,
+				HopProbability:  float64(float32(0.8)),
+				Weight:          float64(float32(0.3)),
+			},
 		},
-	)
-	require.NoError(t, err)
+	}
+	assertConfig(cfg, expectedCfg)
 
-	resp, err := node.RouterClient.GetMissionControlConfig(
-		ctxb, &routerrpc.GetMissionControlConfigRequest{},
-	)
-	require.NoError(t, err)
-	require.True(t, proto.Equal(cfg, resp.Config))
+	// Switch to the bimodal estimator.
+	cfg = &routerrpc.MissionControlConfig{
+		MaximumPaymentResults:       30,
+		MinimumFailureRelaxInterval: 60,
+		Model:                       routerrpc.MissionControlConfig_BIMODAL,
+		EstimatorConfig: &routerrpc.MissionControlConfig_Bimodal{
+			Bimodal: &routerrpc.BimodalParameters{
+				NodeWeight: 0.3,
+				ScaleMsat:  1000,
+				DecayTime:  500,
+			},
+		},
+	}
+	assertConfig(cfg, cfg)
 
 	_, err = node.RouterClient.SetMissionControlConfig(
 		ctxb, &routerrpc.SetMissionControlConfigRequest{
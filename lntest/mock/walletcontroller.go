@@ -43,6 +43,13 @@ func (w *WalletController) BackEnd() string {
 func (w *WalletController) FetchInputInfo(
 	prevOut *wire.OutPoint) (*lnwallet.Utxo, error) {
 
+	// If the mock has a list of utxos, return the matching one.
+	for _, utxo := range w.Utxos {
+		if utxo.OutPoint == *prevOut {
+			return utxo, nil
+		}
+	}
+
 	utxo := &lnwallet.Utxo{
 		AddressType:   lnwallet.WitnessPubKey,
 		Value:         10 * bronutil.BroneesPerBrocoin,
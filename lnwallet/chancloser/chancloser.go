@@ -184,7 +184,7 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
 		cfg.Channel.ChannelPoint(), int64(idealFeeSat))
 
-	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
+	cid := cfg.Channel.ChanID()
 	return &ChanCloser{
 		closeReq:            closeReq,
 		state:               closeIdle,
@@ -0,0 +1,1038 @@
+package chanfunding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/brsuite/broln/input"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/txscript"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+)
+
+const (
+	// MaxInteractiveTxMessages is the maximum number of tx_add_input and
+	// tx_add_output messages each that we accept from the remote party
+	// during the construction of a transaction.
+	MaxInteractiveTxMessages = 4096
+
+	// MaxInteractiveTxInputs is the maximum number of inputs of an
+	// interactively constructed transaction.
+	MaxInteractiveTxInputs = 252
+
+	// MaxInteractiveTxOutputs is the maximum number of outputs of an
+	// interactively constructed transaction.
+	MaxInteractiveTxOutputs = 252
+
+	// MaxInteractiveTxSequence is the maximum sequence number of the inputs
+	// of an interactively constructed transaction, which ensures that the
+	// transaction signals replaceability.
+	MaxInteractiveTxSequence = 0xfffffffd
+
+	// maxStandardTxWeight is the maximum weight of a transaction that is
+	// relayed by the network.
+	maxStandardTxWeight = 400000
+
+	// commonFieldsWeight is the weight of the fields of a transaction that
+	// the initiator pays for: version, locktime, input and output count,
+	// and the segwit marker and flag.
+	commonFieldsWeight = (4+4+1+1)*4 + 2
+
+	// estimatedInputWeight is the estimated weight of an input, assuming
+	// that it spends a P2WKH output.
+	estimatedInputWeight = input.InputSize*4 + input.P2WKHWitnessSize
+
+	// SharedInputWeight is the weight of the shared input of a splice,
+	// which spends the 2-of-2 multi-sig funding output of the channel.
+	SharedInputWeight = input.InputSize*4 + input.MultiSigWitnessSize
+)
+
+var (
+	// ErrInvalidSerialID is returned when a serial ID doesn't have the
+	// parity of the party that added the input or output, is already in
+	// use or doesn't exist.
+	ErrInvalidSerialID = errors.New("invalid serial id")
+
+	// ErrInvalidInput is returned when the remote party adds an input
+	// that can't be part of the transaction.
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrInvalidOutput is returned when the remote party adds an output
+	// that can't be part of the transaction.
+	ErrInvalidOutput = errors.New("invalid output")
+
+	// ErrTooManyMessages is returned when the remote party sends more than
+	// MaxInteractiveTxMessages tx_add_input or tx_add_output messages.
+	ErrTooManyMessages = errors.New("too many messages")
+
+	// ErrInvalidTx is returned when the transaction is invalid once both
+	// parties have completed its construction.
+	ErrInvalidTx = errors.New("invalid transaction")
+
+	// ErrInsufficientFee is returned when a party's inputs don't pay for
+	// its contribution to the transaction at the agreed fee rate.
+	ErrInsufficientFee = errors.New("insufficient fee")
+
+	// ErrTxNotComplete is returned when the transaction is accessed before
+	// both parties have completed its construction.
+	ErrTxNotComplete = errors.New("transaction not complete")
+
+	// ErrTxComplete is returned when the transaction is modified after both
+	// parties have completed its construction.
+	ErrTxComplete = errors.New("transaction already complete")
+)
+
+// InteractiveTxConfig contains the parameters of an interactive transaction
+// construction that both parties agreed upon beforehand.
+type InteractiveTxConfig struct {
+	// IsInitiator is true if we initiated the construction, in which case
+	// we use even serial IDs and pay for the common fields and the shared
+	// output.
+	IsInitiator bool
+
+	// ChannelID is the ID of the channel that the transaction is
+	// constructed for.
+	ChannelID lnwire.ChannelID
+
+	// LocalNodeKey and RemoteNodeKey are the node keys of both parties,
+	// which break the tie if both parties contribute the same amount of
+	// inputs when deciding who sends its signatures first.
+	LocalNodeKey  *bronec.PublicKey
+	RemoteNodeKey *bronec.PublicKey
+
+	// FeeRate is the fee rate that each party must pay for its
+	// contribution.
+	FeeRate chainfee.SatPerKWeight
+
+	// LockTime is the lock time of the transaction.
+	LockTime uint32
+
+	// SharedOutputScript is the script of the shared output, which the
+	// initiator adds to the transaction.
+	SharedOutputScript []byte
+
+	// LocalAmt and RemoteAmt are the amounts that each party contributes
+	// to the shared output.
+	LocalAmt  bronutil.Amount
+	RemoteAmt bronutil.Amount
+
+	// DustLimit is the minimum value of the outputs of the transaction.
+	DustLimit bronutil.Amount
+
+	// SharedInput is set if the transaction splices a channel, in which
+	// case it spends the current funding output of the channel, and the
+	// shared output is the new funding output. The initiator adds the
+	// shared input and pays for it.
+	SharedInput *SharedInput
+}
+
+// SharedInput is the current funding output of a channel that is spent by a
+// splice transaction. Both parties already know the output, and its witness
+// requires the signatures of both of them.
+type SharedInput struct {
+	// OutPoint is the funding outpoint of the channel.
+	OutPoint wire.OutPoint
+
+	// TxOut is the funding output of the channel.
+	TxOut *wire.TxOut
+}
+
+// InteractiveTxWeight returns the weight that a party pays for when
+// constructing a transaction interactively, given the number of inputs that
+// it adds and the outputs other than the shared output. The initiator
+// additionally pays for the common fields, the shared output and, if the
+// channel is spliced, the shared input. All added inputs are assumed to spend
+// P2WKH outputs.
+func InteractiveTxWeight(isInitiator, splice bool, numInputs int,
+	outputs []*wire.TxOut, sharedOutput *wire.TxOut) int64 {
+
+	weight := int64(numInputs) * estimatedInputWeight
+	for _, txOut := range outputs {
+		weight += outputWeight(txOut)
+	}
+
+	if !isInitiator {
+		return weight
+	}
+
+	weight += commonFieldsWeight + outputWeight(sharedOutput)
+	if splice {
+		weight += SharedInputWeight
+	}
+
+	return weight
+}
+
+// interactiveInput is an input of an interactively constructed transaction.
+type interactiveInput struct {
+	serialID uint64
+	outPoint wire.OutPoint
+	txOut    *wire.TxOut
+	sequence uint32
+	local    bool
+
+	// shared is true for the shared input of a splice, which isn't
+	// contributed by either party.
+	shared bool
+}
+
+// value returns the value of the output that the input spends.
+func (i *interactiveInput) value() bronutil.Amount {
+	return bronutil.Amount(i.txOut.Value)
+}
+
+// interactiveOutput is an output of an interactively constructed transaction.
+type interactiveOutput struct {
+	serialID uint64
+	txOut    *wire.TxOut
+	local    bool
+}
+
+// InteractiveTxBuilder constructs a transaction together with a remote party,
+// as it is done for the funding transaction of dual funded channels. Both
+// parties take turns to add and remove inputs and outputs, until both of them
+// send a tx_complete message consecutively. The builder returns the messages
+// for our changes, and validates the changes of the remote party.
+type InteractiveTxBuilder struct {
+	cfg InteractiveTxConfig
+
+	inputs  map[uint64]*interactiveInput
+	outputs map[uint64]*interactiveOutput
+
+	nextSerialID uint64
+
+	numRemoteInputMsgs  int
+	numRemoteOutputMsgs int
+
+	localComplete  bool
+	remoteComplete bool
+
+	tx              *wire.MsgTx
+	localWitnesses  []wire.TxWitness
+	remoteWitnesses []wire.TxWitness
+	sharedWitness   wire.TxWitness
+	localSigned     bool
+	remoteSigned    bool
+}
+
+// NewInteractiveTxBuilder creates a builder for a new interactive transaction
+// construction.
+func NewInteractiveTxBuilder(cfg InteractiveTxConfig) *InteractiveTxBuilder {
+	// The initiator uses even serial IDs, the other party odd ones.
+	var nextSerialID uint64
+	if !cfg.IsInitiator {
+		nextSerialID = 1
+	}
+
+	return &InteractiveTxBuilder{
+		cfg:          cfg,
+		inputs:       make(map[uint64]*interactiveInput),
+		outputs:      make(map[uint64]*interactiveOutput),
+		nextSerialID: nextSerialID,
+	}
+}
+
+// isRemoteSerialID returns true if the serial ID has the parity of the remote
+// party.
+func (b *InteractiveTxBuilder) isRemoteSerialID(serialID uint64) bool {
+	isEven := serialID%2 == 0
+	return isEven != b.cfg.IsInitiator
+}
+
+// newSerialID returns an unused serial ID for a local input or output.
+func (b *InteractiveTxBuilder) newSerialID() uint64 {
+	serialID := b.nextSerialID
+	b.nextSerialID += 2
+
+	return serialID
+}
+
+// modify is called before the transaction is modified by either party. As
+// the construction is only complete once both parties send tx_complete
+// consecutively, it resets their previous tx_complete.
+func (b *InteractiveTxBuilder) modify() error {
+	if b.tx != nil {
+		return ErrTxComplete
+	}
+
+	b.localComplete = false
+	b.remoteComplete = false
+
+	return nil
+}
+
+// hasPrevOut returns true if an input of the transaction spends the outpoint.
+func (b *InteractiveTxBuilder) hasPrevOut(prevOut wire.OutPoint) bool {
+	for _, in := range b.inputs {
+		if in.outPoint == prevOut {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddInput adds a local input to the transaction, which spends the output of
+// prevTx at index vout, and returns the message to send to the remote party.
+func (b *InteractiveTxBuilder) AddInput(prevTx *wire.MsgTx, vout,
+	sequence uint32) (*lnwire.TxAddInput, error) {
+
+	if int(vout) >= len(prevTx.TxOut) {
+		return nil, fmt.Errorf("%w: output %d doesn't exist",
+			ErrInvalidInput, vout)
+	}
+	prevOut := wire.OutPoint{Hash: prevTx.TxHash(), Index: vout}
+	if b.hasPrevOut(prevOut) {
+		return nil, fmt.Errorf("%w: %v already spent", ErrInvalidInput,
+			prevOut)
+	}
+
+	if err := b.modify(); err != nil {
+		return nil, err
+	}
+
+	in := &interactiveInput{
+		serialID: b.newSerialID(),
+		outPoint: prevOut,
+		txOut:    prevTx.TxOut[vout],
+		sequence: sequence,
+		local:    true,
+	}
+	b.inputs[in.serialID] = in
+
+	return &lnwire.TxAddInput{
+		ChannelID:  b.cfg.ChannelID,
+		SerialID:   in.serialID,
+		PrevTx:     prevTx,
+		PrevTxVout: vout,
+		Sequence:   sequence,
+	}, nil
+}
+
+// AddSharedInput adds the shared input of a splice to the transaction, and
+// returns the message to send to the remote party. Only the initiator adds
+// the shared input.
+func (b *InteractiveTxBuilder) AddSharedInput(
+	sequence uint32) (*lnwire.TxAddInput, error) {
+
+	sharedInput := b.cfg.SharedInput
+	switch {
+	case sharedInput == nil:
+		return nil, fmt.Errorf("%w: no shared input", ErrInvalidInput)
+
+	case !b.cfg.IsInitiator:
+		return nil, fmt.Errorf("%w: shared input added by "+
+			"non-initiator", ErrInvalidInput)
+
+	case b.hasPrevOut(sharedInput.OutPoint):
+		return nil, fmt.Errorf("%w: %v already spent", ErrInvalidInput,
+			sharedInput.OutPoint)
+	}
+
+	if err := b.modify(); err != nil {
+		return nil, err
+	}
+
+	in := &interactiveInput{
+		serialID: b.newSerialID(),
+		outPoint: sharedInput.OutPoint,
+		txOut:    sharedInput.TxOut,
+		sequence: sequence,
+		local:    true,
+		shared:   true,
+	}
+	b.inputs[in.serialID] = in
+
+	txid := sharedInput.OutPoint.Hash
+
+	return &lnwire.TxAddInput{
+		ChannelID:       b.cfg.ChannelID,
+		SerialID:        in.serialID,
+		PrevTxVout:      sharedInput.OutPoint.Index,
+		Sequence:        sequence,
+		SharedInputTxID: &txid,
+	}, nil
+}
+
+// AddOutput adds a local output to the transaction, and returns the message
+// to send to the remote party.
+func (b *InteractiveTxBuilder) AddOutput(
+	txOut *wire.TxOut) (*lnwire.TxAddOutput, error) {
+
+	if err := b.modify(); err != nil {
+		return nil, err
+	}
+
+	out := &interactiveOutput{
+		serialID: b.newSerialID(),
+		txOut:    txOut,
+		local:    true,
+	}
+	b.outputs[out.serialID] = out
+
+	return &lnwire.TxAddOutput{
+		ChannelID: b.cfg.ChannelID,
+		SerialID:  out.serialID,
+		Amount:    bronutil.Amount(txOut.Value),
+		PkScript:  txOut.PkScript,
+	}, nil
+}
+
+// RemoveInput removes a local input from the transaction, and returns the
+// message to send to the remote party.
+func (b *InteractiveTxBuilder) RemoveInput(
+	serialID uint64) (*lnwire.TxRemoveInput, error) {
+
+	in, ok := b.inputs[serialID]
+	if !ok || !in.local {
+		return nil, fmt.Errorf("%w: no local input %d",
+			ErrInvalidSerialID, serialID)
+	}
+
+	if err := b.modify(); err != nil {
+		return nil, err
+	}
+	delete(b.inputs, serialID)
+
+	return &lnwire.TxRemoveInput{
+		ChannelID: b.cfg.ChannelID,
+		SerialID:  serialID,
+	}, nil
+}
+
+// RemoveOutput removes a local output from the transaction, and returns the
+// message to send to the remote party.
+func (b *InteractiveTxBuilder) RemoveOutput(
+	serialID uint64) (*lnwire.TxRemoveOutput, error) {
+
+	out, ok := b.outputs[serialID]
+	if !ok || !out.local {
+		return nil, fmt.Errorf("%w: no local output %d",
+			ErrInvalidSerialID, serialID)
+	}
+
+	if err := b.modify(); err != nil {
+		return nil, err
+	}
+	delete(b.outputs, serialID)
+
+	return &lnwire.TxRemoveOutput{
+		ChannelID: b.cfg.ChannelID,
+		SerialID:  serialID,
+	}, nil
+}
+
+// Complete signals that we have no further changes to the transaction, and
+// returns the message to send to the remote party. If the remote party has
+// completed the construction as well, the transaction is validated and
+// finalized.
+func (b *InteractiveTxBuilder) Complete() (*lnwire.TxComplete, error) {
+	if b.tx != nil {
+		return nil, ErrTxComplete
+	}
+
+	b.localComplete = true
+	if b.remoteComplete {
+		if err := b.finalize(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &lnwire.TxComplete{
+		ChannelID: b.cfg.ChannelID,
+	}, nil
+}
+
+// ProcessMsg applies a tx_add_input, tx_add_output, tx_remove_input,
+// tx_remove_output or tx_complete message of the remote party to the
+// transaction. If the message is invalid, the construction must be aborted.
+func (b *InteractiveTxBuilder) ProcessMsg(msg lnwire.Message) error {
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		return b.processAddInput(msg)
+
+	case *lnwire.TxAddOutput:
+		return b.processAddOutput(msg)
+
+	case *lnwire.TxRemoveInput:
+		in, ok := b.inputs[msg.SerialID]
+		if !ok || in.local {
+			return fmt.Errorf("%w: no remote input %d",
+				ErrInvalidSerialID, msg.SerialID)
+		}
+
+		if err := b.modify(); err != nil {
+			return err
+		}
+		delete(b.inputs, msg.SerialID)
+
+		return nil
+
+	case *lnwire.TxRemoveOutput:
+		out, ok := b.outputs[msg.SerialID]
+		if !ok || out.local {
+			return fmt.Errorf("%w: no remote output %d",
+				ErrInvalidSerialID, msg.SerialID)
+		}
+
+		if err := b.modify(); err != nil {
+			return err
+		}
+		delete(b.outputs, msg.SerialID)
+
+		return nil
+
+	case *lnwire.TxComplete:
+		if b.tx != nil {
+			return ErrTxComplete
+		}
+
+		b.remoteComplete = true
+		if b.localComplete {
+			return b.finalize()
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected message %T", msg)
+	}
+}
+
+// processAddInput validates and adds an input of the remote party.
+func (b *InteractiveTxBuilder) processAddInput(msg *lnwire.TxAddInput) error {
+	b.numRemoteInputMsgs++
+	if b.numRemoteInputMsgs > MaxInteractiveTxMessages {
+		return fmt.Errorf("%w: received more than %d tx_add_input",
+			ErrTooManyMessages, MaxInteractiveTxMessages)
+	}
+
+	if !b.isRemoteSerialID(msg.SerialID) {
+		return fmt.Errorf("%w: %d has wrong parity", ErrInvalidSerialID,
+			msg.SerialID)
+	}
+	if _, ok := b.inputs[msg.SerialID]; ok {
+		return fmt.Errorf("%w: %d already used", ErrInvalidSerialID,
+			msg.SerialID)
+	}
+
+	if msg.SharedInputTxID != nil {
+		return b.processAddSharedInput(msg)
+	}
+
+	if msg.PrevTx == nil || int(msg.PrevTxVout) >= len(msg.PrevTx.TxOut) {
+		return fmt.Errorf("%w: output %d doesn't exist",
+			ErrInvalidInput, msg.PrevTxVout)
+	}
+
+	// Only segwit outputs may be spent, as the txid of the transaction
+	// would otherwise change once it is signed.
+	txOut := msg.PrevTx.TxOut[msg.PrevTxVout]
+	if !txscript.IsWitnessProgram(txOut.PkScript) {
+		return fmt.Errorf("%w: output %d isn't segwit",
+			ErrInvalidInput, msg.PrevTxVout)
+	}
+
+	prevOut := wire.OutPoint{
+		Hash:  msg.PrevTx.TxHash(),
+		Index: msg.PrevTxVout,
+	}
+	if b.hasPrevOut(prevOut) {
+		return fmt.Errorf("%w: %v already spent", ErrInvalidInput,
+			prevOut)
+	}
+
+	if msg.Sequence > MaxInteractiveTxSequence {
+		return fmt.Errorf("%w: sequence %d not replaceable",
+			ErrInvalidInput, msg.Sequence)
+	}
+
+	if err := b.modify(); err != nil {
+		return err
+	}
+
+	b.inputs[msg.SerialID] = &interactiveInput{
+		serialID: msg.SerialID,
+		outPoint: prevOut,
+		txOut:    txOut,
+		sequence: msg.Sequence,
+	}
+
+	return nil
+}
+
+// processAddSharedInput validates and adds the shared input of a splice that
+// the remote party added as the initiator.
+func (b *InteractiveTxBuilder) processAddSharedInput(
+	msg *lnwire.TxAddInput) error {
+
+	sharedInput := b.cfg.SharedInput
+	prevOut := wire.OutPoint{
+		Hash:  *msg.SharedInputTxID,
+		Index: msg.PrevTxVout,
+	}
+
+	switch {
+	case sharedInput == nil:
+		return fmt.Errorf("%w: unexpected shared input",
+			ErrInvalidInput)
+
+	case prevOut != sharedInput.OutPoint:
+		return fmt.Errorf("%w: shared input %v doesn't spend funding "+
+			"output %v", ErrInvalidInput, prevOut,
+			sharedInput.OutPoint)
+
+	case b.hasPrevOut(prevOut):
+		return fmt.Errorf("%w: %v already spent", ErrInvalidInput,
+			prevOut)
+
+	case msg.Sequence > MaxInteractiveTxSequence:
+		return fmt.Errorf("%w: sequence %d not replaceable",
+			ErrInvalidInput, msg.Sequence)
+	}
+
+	if err := b.modify(); err != nil {
+		return err
+	}
+
+	b.inputs[msg.SerialID] = &interactiveInput{
+		serialID: msg.SerialID,
+		outPoint: prevOut,
+		txOut:    sharedInput.TxOut,
+		sequence: msg.Sequence,
+		shared:   true,
+	}
+
+	return nil
+}
+
+// processAddOutput validates and adds an output of the remote party.
+func (b *InteractiveTxBuilder) processAddOutput(msg *lnwire.TxAddOutput) error {
+	b.numRemoteOutputMsgs++
+	if b.numRemoteOutputMsgs > MaxInteractiveTxMessages {
+		return fmt.Errorf("%w: received more than %d tx_add_output",
+			ErrTooManyMessages, MaxInteractiveTxMessages)
+	}
+
+	if !b.isRemoteSerialID(msg.SerialID) {
+		return fmt.Errorf("%w: %d has wrong parity", ErrInvalidSerialID,
+			msg.SerialID)
+	}
+	if _, ok := b.outputs[msg.SerialID]; ok {
+		return fmt.Errorf("%w: %d already used", ErrInvalidSerialID,
+			msg.SerialID)
+	}
+
+	switch {
+	case msg.Amount < b.cfg.DustLimit:
+		return fmt.Errorf("%w: amount %v below dust limit %v",
+			ErrInvalidOutput, msg.Amount, b.cfg.DustLimit)
+
+	case msg.Amount > bronutil.MaxBronees:
+		return fmt.Errorf("%w: amount %v above maximum",
+			ErrInvalidOutput, msg.Amount)
+
+	case txscript.GetScriptClass(msg.PkScript) == txscript.NonStandardTy:
+		return fmt.Errorf("%w: non-standard script", ErrInvalidOutput)
+	}
+
+	if err := b.modify(); err != nil {
+		return err
+	}
+
+	b.outputs[msg.SerialID] = &interactiveOutput{
+		serialID: msg.SerialID,
+		txOut:    wire.NewTxOut(int64(msg.Amount), msg.PkScript),
+	}
+
+	return nil
+}
+
+// outputWeight returns the weight of an output.
+func outputWeight(txOut *wire.TxOut) int64 {
+	return int64(txOut.SerializeSize()) * 4
+}
+
+// finalize validates the transaction once both parties have completed its
+// construction, and assembles it.
+func (b *InteractiveTxBuilder) finalize() error {
+	if len(b.inputs) > MaxInteractiveTxInputs {
+		return fmt.Errorf("%w: %d inputs", ErrInvalidTx, len(b.inputs))
+	}
+	if len(b.outputs) > MaxInteractiveTxOutputs {
+		return fmt.Errorf("%w: %d outputs", ErrInvalidTx,
+			len(b.outputs))
+	}
+
+	// Each party pays for the inputs and outputs it added, and the
+	// initiator additionally for the common fields, the shared input and
+	// the shared output. The value of the shared input isn't contributed
+	// by either party, but carried over to the shared output.
+	var (
+		localIn, remoteIn         bronutil.Amount
+		localOut, remoteOut       bronutil.Amount
+		localWeight, remoteWeight int64
+		sharedIn                  *interactiveInput
+		sharedOutput              *interactiveOutput
+	)
+	if b.cfg.IsInitiator {
+		localWeight = commonFieldsWeight
+	} else {
+		remoteWeight = commonFieldsWeight
+	}
+
+	for _, in := range b.inputs {
+		switch {
+		case in.shared:
+			sharedIn = in
+			if b.cfg.IsInitiator {
+				localWeight += SharedInputWeight
+			} else {
+				remoteWeight += SharedInputWeight
+			}
+
+		case in.local:
+			localIn += in.value()
+			localWeight += estimatedInputWeight
+
+		default:
+			remoteIn += in.value()
+			remoteWeight += estimatedInputWeight
+		}
+	}
+
+	if b.cfg.SharedInput != nil && sharedIn == nil {
+		return fmt.Errorf("%w: no shared input", ErrInvalidTx)
+	}
+
+	for _, out := range b.outputs {
+		if bytes.Equal(out.txOut.PkScript, b.cfg.SharedOutputScript) {
+			if sharedOutput != nil {
+				return fmt.Errorf("%w: multiple shared outputs",
+					ErrInvalidTx)
+			}
+			sharedOutput = out
+
+			// The initiator pays for the shared output, and each
+			// party funds its part of it.
+			localOut += b.cfg.LocalAmt
+			remoteOut += b.cfg.RemoteAmt
+			if b.cfg.IsInitiator {
+				localWeight += outputWeight(out.txOut)
+			} else {
+				remoteWeight += outputWeight(out.txOut)
+			}
+
+			continue
+		}
+
+		if out.local {
+			localOut += bronutil.Amount(out.txOut.Value)
+			localWeight += outputWeight(out.txOut)
+		} else {
+			remoteOut += bronutil.Amount(out.txOut.Value)
+			remoteWeight += outputWeight(out.txOut)
+		}
+	}
+
+	if sharedOutput == nil {
+		return fmt.Errorf("%w: no shared output", ErrInvalidTx)
+	}
+	sharedAmt := b.cfg.LocalAmt + b.cfg.RemoteAmt
+	if sharedIn != nil {
+		sharedAmt += sharedIn.value()
+	}
+	if bronutil.Amount(sharedOutput.txOut.Value) != sharedAmt {
+		return fmt.Errorf("%w: shared output has amount %v, expected "+
+			"%v", ErrInvalidTx, sharedOutput.txOut.Value, sharedAmt)
+	}
+
+	if weight := localWeight + remoteWeight; weight > maxStandardTxWeight {
+		return fmt.Errorf("%w: weight %d exceeds maximum", ErrInvalidTx,
+			weight)
+	}
+
+	remoteFee := b.cfg.FeeRate.FeeForWeight(remoteWeight)
+	if remoteIn < remoteOut+remoteFee {
+		return fmt.Errorf("%w: remote inputs %v don't cover outputs "+
+			"%v and fee %v", ErrInsufficientFee, remoteIn,
+			remoteOut, remoteFee)
+	}
+	localFee := b.cfg.FeeRate.FeeForWeight(localWeight)
+	if localIn < localOut+localFee {
+		return fmt.Errorf("%w: local inputs %v don't cover outputs "+
+			"%v and fee %v", ErrInsufficientFee, localIn,
+			localOut, localFee)
+	}
+
+	// The inputs and outputs are ordered by their serial IDs.
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = b.cfg.LockTime
+	for _, in := range b.sortedInputs() {
+		prevOut := in.outPoint
+		txIn := wire.NewTxIn(&prevOut, nil, nil)
+		txIn.Sequence = in.sequence
+		tx.AddTxIn(txIn)
+	}
+	for _, out := range b.sortedOutputs() {
+		tx.AddTxOut(out.txOut)
+	}
+	b.tx = tx
+
+	return nil
+}
+
+// sortedInputs returns the inputs of the transaction ordered by serial ID.
+func (b *InteractiveTxBuilder) sortedInputs() []*interactiveInput {
+	inputs := make([]*interactiveInput, 0, len(b.inputs))
+	for _, in := range b.inputs {
+		inputs = append(inputs, in)
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].serialID < inputs[j].serialID
+	})
+
+	return inputs
+}
+
+// sortedOutputs returns the outputs of the transaction ordered by serial ID.
+func (b *InteractiveTxBuilder) sortedOutputs() []*interactiveOutput {
+	outputs := make([]*interactiveOutput, 0, len(b.outputs))
+	for _, out := range b.outputs {
+		outputs = append(outputs, out)
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].serialID < outputs[j].serialID
+	})
+
+	return outputs
+}
+
+// Done returns true once both parties have completed the construction of the
+// transaction.
+func (b *InteractiveTxBuilder) Done() bool {
+	return b.tx != nil
+}
+
+// Tx returns the unsigned transaction once both parties have completed its
+// construction.
+func (b *InteractiveTxBuilder) Tx() (*wire.MsgTx, error) {
+	if b.tx == nil {
+		return nil, ErrTxNotComplete
+	}
+
+	return b.tx.Copy(), nil
+}
+
+// SharedOutPoint returns the outpoint of the shared output once both parties
+// have completed the construction of the transaction.
+func (b *InteractiveTxBuilder) SharedOutPoint() (*wire.OutPoint, error) {
+	if b.tx == nil {
+		return nil, ErrTxNotComplete
+	}
+
+	for i, txOut := range b.tx.TxOut {
+		if bytes.Equal(txOut.PkScript, b.cfg.SharedOutputScript) {
+			return &wire.OutPoint{
+				Hash:  b.tx.TxHash(),
+				Index: uint32(i),
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no shared output", ErrInvalidTx)
+}
+
+// LocalInputs returns the indexes of our inputs within the transaction, which
+// we must sign, and the outputs that they spend. The shared input of a splice
+// isn't included.
+func (b *InteractiveTxBuilder) LocalInputs() ([]int, []*wire.TxOut, error) {
+	if b.tx == nil {
+		return nil, nil, ErrTxNotComplete
+	}
+
+	var (
+		indexes  []int
+		prevOuts []*wire.TxOut
+	)
+	for i, in := range b.sortedInputs() {
+		if !in.local || in.shared {
+			continue
+		}
+
+		indexes = append(indexes, i)
+		prevOuts = append(prevOuts, in.txOut)
+	}
+
+	return indexes, prevOuts, nil
+}
+
+// SharedInputIndex returns the index of the shared input of a splice within
+// the transaction.
+func (b *InteractiveTxBuilder) SharedInputIndex() (int, error) {
+	if b.tx == nil {
+		return 0, ErrTxNotComplete
+	}
+
+	for i, in := range b.sortedInputs() {
+		if in.shared {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: no shared input", ErrInvalidTx)
+}
+
+// SetSharedWitness sets the witness of the shared input of a splice, which
+// is assembled from the signatures of both parties.
+func (b *InteractiveTxBuilder) SetSharedWitness(witness wire.TxWitness) {
+	b.sharedWitness = witness
+}
+
+// SendSignaturesFirst returns true if we must send our tx_signatures before
+// the remote party, which is the case if we contributed the lower amount of
+// inputs. If both parties contributed the same amount, the party with the
+// lower node key sends first.
+func (b *InteractiveTxBuilder) SendSignaturesFirst() bool {
+	var localIn, remoteIn bronutil.Amount
+	for _, in := range b.inputs {
+		switch {
+		case in.shared:
+			continue
+
+		case in.local:
+			localIn += in.value()
+
+		default:
+			remoteIn += in.value()
+		}
+	}
+
+	if localIn != remoteIn {
+		return localIn < remoteIn
+	}
+
+	return bytes.Compare(
+		b.cfg.LocalNodeKey.SerializeCompressed(),
+		b.cfg.RemoteNodeKey.SerializeCompressed(),
+	) < 0
+}
+
+// LocalSignatures stores the witnesses of our inputs, ordered as returned by
+// LocalInputs, and returns the message to send to the remote party.
+func (b *InteractiveTxBuilder) LocalSignatures(
+	witnesses []wire.TxWitness) (*lnwire.TxSignatures, error) {
+
+	indexes, _, err := b.LocalInputs()
+	if err != nil {
+		return nil, err
+	}
+	if len(witnesses) != len(indexes) {
+		return nil, fmt.Errorf("expected %d witnesses, got %d",
+			len(indexes), len(witnesses))
+	}
+	b.localWitnesses = witnesses
+	b.localSigned = true
+
+	return &lnwire.TxSignatures{
+		ChannelID: b.cfg.ChannelID,
+		TxID:      b.tx.TxHash(),
+		Witnesses: witnesses,
+	}, nil
+}
+
+// ProcessSignatures stores the witnesses of the inputs of the remote party.
+func (b *InteractiveTxBuilder) ProcessSignatures(
+	msg *lnwire.TxSignatures) error {
+
+	if b.tx == nil {
+		return ErrTxNotComplete
+	}
+
+	if msg.TxID != b.tx.TxHash() {
+		return fmt.Errorf("%w: signatures for %v, expected %v",
+			ErrInvalidTx, msg.TxID, b.tx.TxHash())
+	}
+
+	var numRemoteInputs int
+	for _, in := range b.inputs {
+		if !in.local && !in.shared {
+			numRemoteInputs++
+		}
+	}
+	if len(msg.Witnesses) != numRemoteInputs {
+		return fmt.Errorf("expected %d witnesses, got %d",
+			numRemoteInputs, len(msg.Witnesses))
+	}
+
+	// Make sure that the witnesses are valid before accepting them, as we
+	// would otherwise be unable to broadcast the transaction.
+	tx := b.tx.Copy()
+	hashCache := txscript.NewTxSigHashes(tx)
+	witnesses := msg.Witnesses
+	for i, in := range b.sortedInputs() {
+		if in.local || in.shared {
+			continue
+		}
+
+		tx.TxIn[i].Witness = witnesses[0]
+		witnesses = witnesses[1:]
+
+		prevOut := in.txOut
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, i, txscript.StandardVerifyFlags,
+			nil, hashCache, prevOut.Value,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("%w: invalid witness for input "+
+				"%d: %v", ErrInvalidTx, i, err)
+		}
+	}
+
+	b.remoteWitnesses = msg.Witnesses
+	b.remoteSigned = true
+
+	return nil
+}
+
+// SignedTx returns the transaction with the witnesses of both parties, once
+// the signatures of both parties are known.
+func (b *InteractiveTxBuilder) SignedTx() (*wire.MsgTx, error) {
+	if b.tx == nil {
+		return nil, ErrTxNotComplete
+	}
+	if !b.localSigned || !b.remoteSigned {
+		return nil, errors.New("signatures not complete")
+	}
+
+	tx := b.tx.Copy()
+	localWitnesses, remoteWitnesses := b.localWitnesses, b.remoteWitnesses
+	for i, in := range b.sortedInputs() {
+		switch {
+		case in.shared:
+			if b.sharedWitness == nil {
+				return nil, errors.New("shared input not " +
+					"signed")
+			}
+			tx.TxIn[i].Witness = b.sharedWitness
+
+		case in.local:
+			tx.TxIn[i].Witness = localWitnesses[0]
+			localWitnesses = localWitnesses[1:]
+
+		default:
+			tx.TxIn[i].Witness = remoteWitnesses[0]
+			remoteWitnesses = remoteWitnesses[1:]
+		}
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,525 @@
+package chanfunding
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/txscript"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// sharedScript is the P2WSH script of the shared output in the tests.
+	sharedScript = append([]byte{0x00, 0x20}, make([]byte, 32)...)
+
+	testFeeRate = chainfee.SatPerKWeight(1000)
+)
+
+// newPrevTx returns a transaction with a single P2WKH output of the given
+// value, which is unique due to the lock time.
+func newPrevTx(value int64, lockTime uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.LockTime = lockTime
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(value, p2wkhScript))
+
+	return tx
+}
+
+// newSpendablePrevTx returns a transaction with a single P2WKH output of the
+// given value that can be spent with the key.
+func newSpendablePrevTx(t *testing.T, key *bronec.PrivateKey, value int64,
+	lockTime uint32) *wire.MsgTx {
+
+	pkHash := bronutil.Hash160(key.PubKey().SerializeCompressed())
+	pkScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_0).AddData(pkHash).Script()
+	require.NoError(t, err)
+
+	tx := newPrevTx(value, lockTime)
+	tx.TxOut[0].PkScript = pkScript
+
+	return tx
+}
+
+// signInput returns the witness that spends the P2WKH output prevOut with
+// the input at index idx of the transaction.
+func signInput(t *testing.T, tx *wire.MsgTx, idx int, prevOut *wire.TxOut,
+	key *bronec.PrivateKey) wire.TxWitness {
+
+	witness, err := txscript.WitnessSignature(
+		tx, txscript.NewTxSigHashes(tx), idx, prevOut.Value,
+		prevOut.PkScript, txscript.SigHashAll, key, true,
+	)
+	require.NoError(t, err)
+
+	return witness
+}
+
+// newTestBuilders returns the builders of the initiator and the other party of
+// an interactive transaction construction.
+func newTestBuilders(t *testing.T, localAmt,
+	remoteAmt bronutil.Amount) (*InteractiveTxBuilder,
+	*InteractiveTxBuilder) {
+
+	aliceKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	bobKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	cfg := InteractiveTxConfig{
+		IsInitiator:        true,
+		ChannelID:          lnwire.ChannelID{1},
+		LocalNodeKey:       aliceKey.PubKey(),
+		RemoteNodeKey:      bobKey.PubKey(),
+		FeeRate:            testFeeRate,
+		LockTime:           100,
+		SharedOutputScript: sharedScript,
+		LocalAmt:           localAmt,
+		RemoteAmt:          remoteAmt,
+		DustLimit:          354,
+	}
+	alice := NewInteractiveTxBuilder(cfg)
+
+	cfg.IsInitiator = false
+	cfg.LocalNodeKey, cfg.RemoteNodeKey = cfg.RemoteNodeKey, cfg.LocalNodeKey
+	cfg.LocalAmt, cfg.RemoteAmt = cfg.RemoteAmt, cfg.LocalAmt
+	bob := NewInteractiveTxBuilder(cfg)
+
+	return alice, bob
+}
+
+// TestInteractiveTx asserts that both parties construct and sign the same
+// transaction.
+func TestInteractiveTx(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestBuilders(t, 100_000, 50_000)
+
+	aliceInputKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	bobInputKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	// Alice adds the shared output, an input and a change output.
+	addShared, err := alice.AddOutput(
+		wire.NewTxOut(150_000, sharedScript),
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, addShared.SerialID)
+	require.NoError(t, bob.ProcessMsg(addShared))
+
+	addInput, err := alice.AddInput(
+		newSpendablePrevTx(t, aliceInputKey, 200_000, 1), 0, 0,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, addInput.SerialID)
+	require.NoError(t, bob.ProcessMsg(addInput))
+
+	// Bob adds two inputs, but removes one of them again.
+	addInput, err = bob.AddInput(
+		newSpendablePrevTx(t, bobInputKey, 60_000, 2), 0, 0,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, addInput.SerialID)
+	require.NoError(t, alice.ProcessMsg(addInput))
+
+	addInput, err = bob.AddInput(newPrevTx(70_000, 3), 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(addInput))
+
+	removeInput, err := bob.RemoveInput(addInput.SerialID)
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(removeInput))
+
+	// Alice completes, but Bob adds another output afterwards, so Alice
+	// must send tx_complete again.
+	complete, err := alice.Complete()
+	require.NoError(t, err)
+	require.NoError(t, bob.ProcessMsg(complete))
+
+	addOutput, err := bob.AddOutput(wire.NewTxOut(9_000, p2wkhScript))
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(addOutput))
+
+	complete, err = bob.Complete()
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(complete))
+	require.False(t, alice.Done())
+	require.False(t, bob.Done())
+
+	addOutput, err = alice.AddOutput(wire.NewTxOut(99_000, p2wkhScript))
+	require.NoError(t, err)
+	require.NoError(t, bob.ProcessMsg(addOutput))
+
+	complete, err = alice.Complete()
+	require.NoError(t, err)
+	require.NoError(t, bob.ProcessMsg(complete))
+
+	complete, err = bob.Complete()
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(complete))
+	require.True(t, alice.Done())
+	require.True(t, bob.Done())
+
+	// Both parties must have constructed the same transaction, with the
+	// inputs and outputs ordered by serial ID.
+	aliceTx, err := alice.Tx()
+	require.NoError(t, err)
+	bobTx, err := bob.Tx()
+	require.NoError(t, err)
+	require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+
+	require.EqualValues(t, 2, aliceTx.Version)
+	require.EqualValues(t, 100, aliceTx.LockTime)
+	require.Len(t, aliceTx.TxIn, 2)
+	require.Len(t, aliceTx.TxOut, 3)
+	require.EqualValues(t, 150_000, aliceTx.TxOut[0].Value)
+	require.EqualValues(t, 99_000, aliceTx.TxOut[1].Value)
+	require.EqualValues(t, 9_000, aliceTx.TxOut[2].Value)
+
+	sharedOutPoint, err := alice.SharedOutPoint()
+	require.NoError(t, err)
+	require.Equal(t, aliceTx.TxHash(), sharedOutPoint.Hash)
+	require.EqualValues(t, 0, sharedOutPoint.Index)
+
+	// The transaction can't be modified anymore.
+	_, err = alice.AddOutput(wire.NewTxOut(1_000, p2wkhScript))
+	require.ErrorIs(t, err, ErrTxComplete)
+
+	// Bob contributed fewer inputs, so Bob sends signatures first.
+	require.True(t, bob.SendSignaturesFirst())
+	require.False(t, alice.SendSignaturesFirst())
+
+	indexes, prevOuts, err := bob.LocalInputs()
+	require.NoError(t, err)
+	require.Equal(t, []int{0}, indexes)
+	require.EqualValues(t, 60_000, prevOuts[0].Value)
+
+	// Invalid witnesses are rejected.
+	bobSigs, err := bob.LocalSignatures(
+		[]wire.TxWitness{{{1}, {2}}},
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, alice.ProcessSignatures(bobSigs), ErrInvalidTx)
+
+	bobWitness := signInput(t, bobTx, indexes[0], prevOuts[0], bobInputKey)
+	bobSigs, err = bob.LocalSignatures([]wire.TxWitness{bobWitness})
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessSignatures(bobSigs))
+
+	_, err = alice.SignedTx()
+	require.Error(t, err)
+
+	indexes, prevOuts, err = alice.LocalInputs()
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, indexes)
+
+	aliceWitness := signInput(
+		t, aliceTx, indexes[0], prevOuts[0], aliceInputKey,
+	)
+	aliceSigs, err := alice.LocalSignatures(
+		[]wire.TxWitness{aliceWitness},
+	)
+	require.NoError(t, err)
+	require.NoError(t, bob.ProcessSignatures(aliceSigs))
+
+	aliceSigned, err := alice.SignedTx()
+	require.NoError(t, err)
+	bobSigned, err := bob.SignedTx()
+	require.NoError(t, err)
+	require.Equal(t, aliceSigned, bobSigned)
+	require.Equal(t, bobWitness, aliceSigned.TxIn[0].Witness)
+	require.Equal(t, aliceWitness, aliceSigned.TxIn[1].Witness)
+
+	// Signatures for another transaction are rejected.
+	bobSigs.TxID = [32]byte{1}
+	require.ErrorIs(t, alice.ProcessSignatures(bobSigs), ErrInvalidTx)
+}
+
+// TestInteractiveTxSplice asserts that the initiator of a splice adds the
+// shared input, and that its value is carried over to the shared output.
+func TestInteractiveTxSplice(t *testing.T) {
+	t.Parallel()
+
+	sharedInput := &SharedInput{
+		OutPoint: wire.OutPoint{Hash: [32]byte{1}, Index: 1},
+		TxOut:    wire.NewTxOut(1_000_000, sharedScript),
+	}
+
+	// Alice splices 100k out of the channel into a wallet output, and pays
+	// the fee from her channel balance.
+	walletOut := wire.NewTxOut(100_000, p2wkhScript)
+	sharedOut := wire.NewTxOut(0, sharedScript)
+	weight := InteractiveTxWeight(
+		true, true, 0, []*wire.TxOut{walletOut}, sharedOut,
+	)
+	fee := testFeeRate.FeeForWeight(weight)
+	contribution := -(100_000 + fee)
+	sharedOut.Value = 1_000_000 + int64(contribution)
+
+	alice, bob := newTestBuilders(t, contribution, 0)
+	alice.cfg.SharedInput = sharedInput
+	bob.cfg.SharedInput = sharedInput
+
+	// Only the initiator adds the shared input.
+	_, err := bob.AddSharedInput(0)
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	addInput, err := alice.AddSharedInput(0)
+	require.NoError(t, err)
+	require.Nil(t, addInput.PrevTx)
+	require.Equal(t, sharedInput.OutPoint.Hash, *addInput.SharedInputTxID)
+	require.NoError(t, bob.ProcessMsg(addInput))
+
+	for _, txOut := range []*wire.TxOut{sharedOut, walletOut} {
+		addOutput, err := alice.AddOutput(txOut)
+		require.NoError(t, err)
+		require.NoError(t, bob.ProcessMsg(addOutput))
+	}
+
+	complete, err := alice.Complete()
+	require.NoError(t, err)
+	require.NoError(t, bob.ProcessMsg(complete))
+	complete, err = bob.Complete()
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessMsg(complete))
+
+	aliceTx, err := alice.Tx()
+	require.NoError(t, err)
+	bobTx, err := bob.Tx()
+	require.NoError(t, err)
+	require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+	require.Equal(t, sharedInput.OutPoint, aliceTx.TxIn[0].PreviousOutPoint)
+
+	// The shared input isn't signed like the inputs of either party.
+	indexes, _, err := alice.LocalInputs()
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	idx, err := bob.SharedInputIndex()
+	require.NoError(t, err)
+	require.Equal(t, 0, idx)
+
+	bobSigs, err := bob.LocalSignatures(nil)
+	require.NoError(t, err)
+	require.NoError(t, alice.ProcessSignatures(bobSigs))
+	_, err = alice.LocalSignatures(nil)
+	require.NoError(t, err)
+
+	_, err = alice.SignedTx()
+	require.Error(t, err)
+
+	witness := wire.TxWitness{{1}, {2}}
+	alice.SetSharedWitness(witness)
+	signedTx, err := alice.SignedTx()
+	require.NoError(t, err)
+	require.Equal(t, witness, signedTx.TxIn[0].Witness)
+}
+
+// TestInteractiveTxInvalidSplice asserts that a splice is rejected if the
+// shared input doesn't spend the funding output, or is missing.
+func TestInteractiveTxInvalidSplice(t *testing.T) {
+	t.Parallel()
+
+	sharedInput := &SharedInput{
+		OutPoint: wire.OutPoint{Hash: [32]byte{1}, Index: 1},
+		TxOut:    wire.NewTxOut(1_000_000, sharedScript),
+	}
+
+	_, bob := newTestBuilders(t, 0, 0)
+	err := bob.ProcessMsg(&lnwire.TxAddInput{
+		SerialID:        0,
+		SharedInputTxID: &sharedInput.OutPoint.Hash,
+	})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	bob.cfg.SharedInput = sharedInput
+	err = bob.ProcessMsg(&lnwire.TxAddInput{
+		SerialID:        0,
+		SharedInputTxID: &sharedInput.OutPoint.Hash,
+	})
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	// Without the shared input, the transaction is invalid.
+	addOutput := &lnwire.TxAddOutput{
+		SerialID: 0,
+		Amount:   1_000_000,
+		PkScript: sharedScript,
+	}
+	require.NoError(t, bob.ProcessMsg(addOutput))
+
+	_, err = bob.Complete()
+	require.NoError(t, err)
+	err = bob.ProcessMsg(&lnwire.TxComplete{})
+	require.ErrorIs(t, err, ErrInvalidTx)
+}
+
+// TestInteractiveTxInvalidMsgs asserts that invalid changes of the remote party
+// are rejected.
+func TestInteractiveTxInvalidMsgs(t *testing.T) {
+	t.Parallel()
+
+	nonSegwitTx := newPrevTx(10_000, 4)
+	nonSegwitTx.TxOut[0].PkScript = p2khScript
+
+	testCases := []struct {
+		name string
+		msgs []lnwire.Message
+		err  error
+	}{
+		{
+			name: "input with wrong parity",
+			msgs: []lnwire.Message{&lnwire.TxAddInput{
+				SerialID: 2,
+				PrevTx:   newPrevTx(10_000, 1),
+			}},
+			err: ErrInvalidSerialID,
+		},
+		{
+			name: "duplicate serial id",
+			msgs: []lnwire.Message{
+				&lnwire.TxAddInput{
+					SerialID: 1,
+					PrevTx:   newPrevTx(10_000, 1),
+				},
+				&lnwire.TxAddInput{
+					SerialID: 1,
+					PrevTx:   newPrevTx(10_000, 2),
+				},
+			},
+			err: ErrInvalidSerialID,
+		},
+		{
+			name: "duplicate prevout",
+			msgs: []lnwire.Message{
+				&lnwire.TxAddInput{
+					SerialID: 1,
+					PrevTx:   newPrevTx(10_000, 1),
+				},
+				&lnwire.TxAddInput{
+					SerialID: 3,
+					PrevTx:   newPrevTx(10_000, 1),
+				},
+			},
+			err: ErrInvalidInput,
+		},
+		{
+			name: "unknown prevout",
+			msgs: []lnwire.Message{&lnwire.TxAddInput{
+				SerialID:   1,
+				PrevTx:     newPrevTx(10_000, 1),
+				PrevTxVout: 1,
+			}},
+			err: ErrInvalidInput,
+		},
+		{
+			name: "non-segwit prevout",
+			msgs: []lnwire.Message{&lnwire.TxAddInput{
+				SerialID: 1,
+				PrevTx:   nonSegwitTx,
+			}},
+			err: ErrInvalidInput,
+		},
+		{
+			name: "non-replaceable sequence",
+			msgs: []lnwire.Message{&lnwire.TxAddInput{
+				SerialID: 1,
+				PrevTx:   newPrevTx(10_000, 1),
+				Sequence: wire.MaxTxInSequenceNum,
+			}},
+			err: ErrInvalidInput,
+		},
+		{
+			name: "dust output",
+			msgs: []lnwire.Message{&lnwire.TxAddOutput{
+				SerialID: 1,
+				Amount:   353,
+				PkScript: p2wkhScript,
+			}},
+			err: ErrInvalidOutput,
+		},
+		{
+			name: "non-standard output",
+			msgs: []lnwire.Message{&lnwire.TxAddOutput{
+				SerialID: 1,
+				Amount:   1_000,
+				PkScript: []byte{0xff},
+			}},
+			err: ErrInvalidOutput,
+		},
+		{
+			name: "remove local input",
+			msgs: []lnwire.Message{&lnwire.TxRemoveInput{
+				SerialID: 0,
+			}},
+			err: ErrInvalidSerialID,
+		},
+		{
+			name: "remove unknown output",
+			msgs: []lnwire.Message{&lnwire.TxRemoveOutput{
+				SerialID: 1,
+			}},
+			err: ErrInvalidSerialID,
+		},
+		{
+			name: "insufficient fee",
+			msgs: []lnwire.Message{
+				&lnwire.TxAddInput{
+					SerialID: 1,
+					PrevTx:   newPrevTx(50_000, 1),
+				},
+				&lnwire.TxComplete{},
+			},
+			err: ErrInsufficientFee,
+		},
+		{
+			name: "wrong shared output amount",
+			msgs: []lnwire.Message{
+				&lnwire.TxAddInput{
+					SerialID: 1,
+					PrevTx:   newPrevTx(60_000, 1),
+				},
+				&lnwire.TxAddOutput{
+					SerialID: 1,
+					Amount:   1_000,
+					PkScript: sharedScript,
+				},
+				&lnwire.TxComplete{},
+			},
+			err: ErrInvalidTx,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			alice, _ := newTestBuilders(t, 100_000, 50_000)
+
+			_, err := alice.AddOutput(
+				wire.NewTxOut(150_000, sharedScript),
+			)
+			require.NoError(t, err)
+			_, err = alice.AddInput(newPrevTx(200_000, 0), 0, 0)
+			require.NoError(t, err)
+
+			msgs := testCase.msgs
+			for _, msg := range msgs[:len(msgs)-1] {
+				require.NoError(t, alice.ProcessMsg(msg))
+			}
+
+			// Alice completes before the last message, such that
+			// the transaction is validated if it is a tx_complete.
+			_, err = alice.Complete()
+			require.NoError(t, err)
+
+			err = alice.ProcessMsg(msgs[len(msgs)-1])
+			require.ErrorIs(t, err, testCase.err)
+		})
+	}
+}
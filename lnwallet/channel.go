@@ -95,6 +95,15 @@ var (
 	// both parties can retrieve their funds.
 	ErrCommitSyncRemoteDataLoss = fmt.Errorf("possible remote commitment " +
 		"state data loss")
+
+	// ErrChannelQuiescent is returned when an HTLC or fee update is added
+	// to a channel that is quiescent.
+	ErrChannelQuiescent = fmt.Errorf("channel is quiescent, updates " +
+		"disallowed")
+
+	// ErrDanglingUpdates is returned when a channel can't become quiescent
+	// as not all updates are irrevocably committed on both commitments.
+	ErrDanglingUpdates = fmt.Errorf("channel has dangling updates")
 )
 
 // ErrCommitSyncLocalDataLoss is returned in the case that we receive a valid
@@ -326,6 +335,12 @@ type PaymentDescriptor struct {
 	// NOTE: Populated only on add payment descriptor entry types.
 	OnionBlob []byte
 
+	// BlindingPoint is the route blinding point that was sent along with
+	// the HTLC, if it is part of a blinded route.
+	//
+	// NOTE: Populated only on add payment descriptor entry types.
+	BlindingPoint *bronec.PublicKey
+
 	// ShaOnionBlob is a sha of the onion blob.
 	//
 	// NOTE: Populated only in payment descriptor with MalformedFail type.
@@ -394,12 +409,13 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 
 		case *lnwire.UpdateAddHTLC:
 			pd = PaymentDescriptor{
-				RHash:     wireMsg.PaymentHash,
-				Timeout:   wireMsg.Expiry,
-				Amount:    wireMsg.Amount,
-				EntryType: Add,
-				HtlcIndex: wireMsg.ID,
-				LogIndex:  logUpdate.LogIndex,
+				RHash:         wireMsg.PaymentHash,
+				Timeout:       wireMsg.Expiry,
+				Amount:        wireMsg.Amount,
+				EntryType:     Add,
+				HtlcIndex:     wireMsg.ID,
+				LogIndex:      logUpdate.LogIndex,
+				BlindingPoint: wireMsg.BlindingPoint,
 				SourceRef: &channeldb.AddRef{
 					Height: height,
 					Index:  uint16(i),
@@ -1297,6 +1313,10 @@ type LightningChannel struct {
 	// log is a channel-specific logging instance.
 	log bronlog.Logger
 
+	// quiescent is true if both parties agreed to stop updating the
+	// channel, during which no HTLCs or fee updates can be added.
+	quiescent bool
+
 	sync.RWMutex
 }
 
@@ -1354,6 +1374,11 @@ func NewLightningChannel(signer input.Signer,
 		return nil, err
 	}
 
+	// The channel stays quiescent until a splice has been locked by both
+	// parties, as the commitments of the splice can't be updated.
+	lc.quiescent = len(state.PendingSplices()) > 0 ||
+		state.AwaitingSpliceLocked()
+
 	return lc, nil
 }
 
@@ -1431,6 +1456,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 			EntryType:             Add,
 			HtlcIndex:             wireMsg.ID,
 			LogIndex:              logUpdate.LogIndex,
+			BlindingPoint:         wireMsg.BlindingPoint,
 			addCommitHeightRemote: commitHeight,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
@@ -1630,6 +1656,7 @@ func (lc *LightningChannel) remoteLogUpdateToPayDesc(logUpdate *channeldb.LogUpd
 			EntryType:            Add,
 			HtlcIndex:            wireMsg.ID,
 			LogIndex:             logUpdate.LogIndex,
+			BlindingPoint:        wireMsg.BlindingPoint,
 			addCommitHeightLocal: commitHeight,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
@@ -3123,7 +3150,7 @@ func (lc *LightningChannel) createCommitDiff(
 	// used on the wire to identify this channel. We'll use this shortly
 	// when recording the exact CommitSig message that we'll be sending
 	// out.
-	chanID := lnwire.NewChanIDFromOutPoint(&lc.channelState.FundingOutpoint)
+	chanID := lc.channelState.ChanID()
 
 	var (
 		logUpdates        []channeldb.LogUpdate
@@ -3163,11 +3190,12 @@ func (lc *LightningChannel) createCommitDiff(
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3242,9 +3270,7 @@ func (lc *LightningChannel) createCommitDiff(
 	return &channeldb.CommitDiff{
 		Commitment: *diskCommit,
 		CommitSig: &lnwire.CommitSig{
-			ChanID: lnwire.NewChanIDFromOutPoint(
-				&lc.channelState.FundingOutpoint,
-			),
+			ChanID:    lc.channelState.ChanID(),
 			CommitSig: commitSig,
 			HtlcSigs:  htlcSigs,
 		},
@@ -3259,9 +3285,9 @@ func (lc *LightningChannel) createCommitDiff(
 // getUnsignedAckedUpdates returns all remote log updates that we haven't
 // signed for yet ourselves.
 func (lc *LightningChannel) getUnsignedAckedUpdates() []channeldb.LogUpdate {
-	// First, we need to convert the funding outpoint into the ID that's
-	// used on the wire to identify this channel.
-	chanID := lnwire.NewChanIDFromOutPoint(&lc.channelState.FundingOutpoint)
+	// First, we need to obtain the ID that's used on the wire to identify
+	// this channel.
+	chanID := lc.channelState.ChanID()
 
 	// Fetch the last remote update that we have signed for.
 	lastRemoteCommitted := lc.remoteCommitChain.tail().theirMessageIndex
@@ -3300,11 +3326,12 @@ func (lc *LightningChannel) getUnsignedAckedUpdates() []channeldb.LogUpdate {
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3828,9 +3855,7 @@ func (lc *LightningChannel) ProcessChanSyncMsg(
 			// another update to send over.
 			case err == nil:
 				updates = append(updates, &lnwire.CommitSig{
-					ChanID: lnwire.NewChanIDFromOutPoint(
-						&lc.channelState.FundingOutpoint,
-					),
+					ChanID:    lc.channelState.ChanID(),
 					CommitSig: commitSig,
 					HtlcSigs:  htlcSigs,
 				})
@@ -4596,6 +4621,69 @@ func (lc *LightningChannel) oweCommitment(local bool) bool {
 	return oweCommitment
 }
 
+// NoDanglingUpdates returns true if all updates of the local or remote party
+// are irrevocably committed on both commitments. As required by the quiescence
+// protocol, a party may only send stfu once this is the case for its updates.
+func (lc *LightningChannel) NoDanglingUpdates(local bool) bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.noDanglingUpdates(local)
+}
+
+// noDanglingUpdates is the internal version of NoDanglingUpdates. This
+// function expects to be executed with a lock held.
+func (lc *LightningChannel) noDanglingUpdates(local bool) bool {
+	// The tails of both commitment chains are the commitments for which
+	// all prior commitments have been revoked.
+	localTail := lc.localCommitChain.tail()
+	remoteTail := lc.remoteCommitChain.tail()
+
+	if local {
+		logIndex := lc.localUpdateLog.logIndex
+		return localTail.ourMessageIndex == logIndex &&
+			remoteTail.ourMessageIndex == logIndex
+	}
+
+	logIndex := lc.remoteUpdateLog.logIndex
+	return localTail.theirMessageIndex == logIndex &&
+		remoteTail.theirMessageIndex == logIndex
+}
+
+// EnterQuiescence marks the channel as quiescent once both parties have sent
+// stfu. While the channel is quiescent, no HTLCs or fee updates can be added.
+// An error is returned if any update of either party is not yet irrevocably
+// committed on both commitments.
+func (lc *LightningChannel) EnterQuiescence() error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	if !lc.noDanglingUpdates(true) || !lc.noDanglingUpdates(false) {
+		return ErrDanglingUpdates
+	}
+
+	lc.quiescent = true
+
+	return nil
+}
+
+// ExitQuiescence allows updates to be added to the channel again, once the
+// protocol that required the channel to be quiescent has completed.
+func (lc *LightningChannel) ExitQuiescence() {
+	lc.Lock()
+	defer lc.Unlock()
+
+	lc.quiescent = false
+}
+
+// IsQuiescent returns true if the channel is quiescent.
+func (lc *LightningChannel) IsQuiescent() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.quiescent
+}
+
 // PendingLocalUpdateCount returns the number of local updates that still need
 // to be applied to the remote commitment tx.
 func (lc *LightningChannel) PendingLocalUpdateCount() uint64 {
@@ -4655,9 +4743,7 @@ func (lc *LightningChannel) RevokeCurrentCommitment() (*lnwire.RevokeAndAck, []c
 		chainTail.theirBalance,
 		len(unsignedAckedUpdates))
 
-	revocationMsg.ChanID = lnwire.NewChanIDFromOutPoint(
-		&lc.channelState.FundingOutpoint,
-	)
+	revocationMsg.ChanID = lc.channelState.ChanID()
 
 	return revocationMsg, newCommitment.Htlcs, nil
 }
@@ -4723,7 +4809,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	localChainTail := lc.localCommitChain.tail().height
 
 	source := lc.ShortChanID()
-	chanID := lnwire.NewChanIDFromOutPoint(&lc.channelState.FundingOutpoint)
+	chanID := lc.channelState.ChanID()
 
 	// Determine the set of htlcs that can be forwarded as a result of
 	// having received the revocation. We will simultaneously construct the
@@ -4821,11 +4907,12 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 		switch pd.EntryType {
 		case Add:
 			htlc := &lnwire.UpdateAddHTLC{
-				ChanID:      chanID,
-				ID:          pd.HtlcIndex,
-				Amount:      pd.Amount,
-				Expiry:      pd.Timeout,
-				PaymentHash: pd.RHash,
+				ChanID:        chanID,
+				ID:            pd.HtlcIndex,
+				Amount:        pd.Amount,
+				Expiry:        pd.Timeout,
+				PaymentHash:   pd.RHash,
+				BlindingPoint: pd.BlindingPoint,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -4983,6 +5070,10 @@ func (lc *LightningChannel) AddHTLC(htlc *lnwire.UpdateAddHTLC,
 	lc.Lock()
 	defer lc.Unlock()
 
+	if lc.quiescent {
+		return 0, ErrChannelQuiescent
+	}
+
 	pd := lc.htlcAddDescriptor(htlc, openKey)
 	if err := lc.validateAddHtlc(pd); err != nil {
 		return 0, err
@@ -5064,6 +5155,10 @@ func (lc *LightningChannel) MayAddOutgoingHtlc(amt lnwire.MilliBronees) error {
 	lc.Lock()
 	defer lc.Unlock()
 
+	if lc.quiescent {
+		return ErrChannelQuiescent
+	}
+
 	var mockHtlcAmt lnwire.MilliBronees
 	switch {
 	// If the caller specifically set an amount, we use it.
@@ -5113,6 +5208,7 @@ func (lc *LightningChannel) htlcAddDescriptor(htlc *lnwire.UpdateAddHTLC,
 		LogIndex:       lc.localUpdateLog.logIndex,
 		HtlcIndex:      lc.localUpdateLog.htlcCounter,
 		OnionBlob:      htlc.OnionBlob[:],
+		BlindingPoint:  htlc.BlindingPoint,
 		OpenCircuitKey: openKey,
 	}
 }
@@ -5156,19 +5252,24 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 	lc.Lock()
 	defer lc.Unlock()
 
+	if lc.quiescent {
+		return 0, ErrChannelQuiescent
+	}
+
 	if htlc.ID != lc.remoteUpdateLog.htlcCounter {
 		return 0, fmt.Errorf("ID %d on HTLC add does not match expected next "+
 			"ID %d", htlc.ID, lc.remoteUpdateLog.htlcCounter)
 	}
 
 	pd := &PaymentDescriptor{
-		EntryType: Add,
-		RHash:     PaymentHash(htlc.PaymentHash),
-		Timeout:   htlc.Expiry,
-		Amount:    htlc.Amount,
-		LogIndex:  lc.remoteUpdateLog.logIndex,
-		HtlcIndex: lc.remoteUpdateLog.htlcCounter,
-		OnionBlob: htlc.OnionBlob[:],
+		EntryType:     Add,
+		RHash:         PaymentHash(htlc.PaymentHash),
+		Timeout:       htlc.Expiry,
+		Amount:        htlc.Amount,
+		LogIndex:      lc.remoteUpdateLog.logIndex,
+		HtlcIndex:     lc.remoteUpdateLog.htlcCounter,
+		OnionBlob:     htlc.OnionBlob[:],
+		BlindingPoint: htlc.BlindingPoint,
 	}
 
 	localACKedIndex := lc.remoteCommitChain.tail().ourMessageIndex
@@ -5365,14 +5466,25 @@ func (lc *LightningChannel) FailHTLC(htlcIndex uint64, reason []byte,
 // commitment update. This method is intended to be called in order to cancel
 // in _incoming_ HTLC.
 //
-// The additional sourceRef specifies the location of the Add HTLC within a
-// forwarding package that this HTLC is failing. This value should never be
-// empty.
+// The additional arguments correspond to:
+//  * sourceRef: specifies the location of the Add HTLC within a forwarding
+//      package that this HTLC is failing. This value should never be empty.
 //
-// NOTE: It is okay for sourceRef to be nil when unit testing the wallet.
+//  * destRef: specifies the location of the Fail HTLC within another channel's
+//      forwarding package. This is only set if the HTLC was failed further
+//      down a blinded route, see FailHTLC.
+//
+//  * closeKey: identifies the circuit that should be deleted after this Fail
+//      HTLC is included in a commitment txn. This value should only be nil if
+//      the HTLC was failed locally before committing a circuit to the circuit
+//      map.
+//
+// NOTE: It is okay for sourceRef, destRef, and closeKey to be nil when unit
+// testing the wallet.
 func (lc *LightningChannel) MalformedFailHTLC(htlcIndex uint64,
 	failCode lnwire.FailCode, shaOnionBlob [sha256.Size]byte,
-	sourceRef *channeldb.AddRef) error {
+	sourceRef *channeldb.AddRef, destRef *channeldb.SettleFailRef,
+	closeKey *channeldb.CircuitKey) error {
 
 	lc.Lock()
 	defer lc.Unlock()
@@ -5393,10 +5505,12 @@ func (lc *LightningChannel) MalformedFailHTLC(htlcIndex uint64,
 		RHash:        htlc.RHash,
 		ParentIndex:  htlcIndex,
 		LogIndex:     lc.localUpdateLog.logIndex,
-		EntryType:    MalformedFail,
-		FailCode:     failCode,
-		ShaOnionBlob: shaOnionBlob,
-		SourceRef:    sourceRef,
+		EntryType:        MalformedFail,
+		FailCode:         failCode,
+		ShaOnionBlob:     shaOnionBlob,
+		SourceRef:        sourceRef,
+		DestRef:          destRef,
+		ClosedCircuitKey: closeKey,
 	}
 
 	lc.localUpdateLog.appendUpdate(pd)
@@ -5457,6 +5571,11 @@ func (lc *LightningChannel) ChannelPoint() *wire.OutPoint {
 	return &lc.channelState.FundingOutpoint
 }
 
+// ChanID returns the ID that identifies the channel within the wire protocol.
+func (lc *LightningChannel) ChanID() lnwire.ChannelID {
+	return lc.channelState.ChanID()
+}
+
 // ShortChanID returns the short channel ID for the channel. The short channel
 // ID encodes the exact location in the main chain that the original
 // funding output can be found.
@@ -6900,6 +7019,10 @@ func (lc *LightningChannel) UpdateFee(feePerKw chainfee.SatPerKWeight) error {
 		return fmt.Errorf("local fee update as non-initiator")
 	}
 
+	if lc.quiescent {
+		return ErrChannelQuiescent
+	}
+
 	// Ensure that the passed fee rate meets our current requirements.
 	if err := lc.validateFeeRate(feePerKw); err != nil {
 		return err
@@ -6928,6 +7051,10 @@ func (lc *LightningChannel) ReceiveUpdateFee(feePerKw chainfee.SatPerKWeight) er
 		return fmt.Errorf("received fee update as initiator")
 	}
 
+	if lc.quiescent {
+		return ErrChannelQuiescent
+	}
+
 	// TODO(roasbeef): or just modify to use the other balance?
 	pd := &PaymentDescriptor{
 		LogIndex:  lc.remoteUpdateLog.logIndex,
@@ -6974,8 +7101,7 @@ func (lc *LightningChannel) generateRevocation(height uint64) (*lnwire.RevokeAnd
 	}
 
 	revocationMsg.NextRevocationKey = input.ComputeCommitmentPoint(nextCommitSecret[:])
-	revocationMsg.ChanID = lnwire.NewChanIDFromOutPoint(
-		&lc.channelState.FundingOutpoint)
+	revocationMsg.ChanID = lc.channelState.ChanID()
 
 	return revocationMsg, nil
 }
@@ -10202,3 +10202,88 @@ func testGetDustSum(t *testing.T, chantype channeldb.ChannelType) {
 		checkDust(bobChannel, htlc2Amt+htlc3Amt, htlc2Amt+htlc3Amt)
 	}
 }
+
+// TestChannelQuiescence tests that a channel can only become quiescent once
+// all updates are irrevocably committed, and that no HTLCs or fee updates can
+// be added while it is quiescent.
+func TestChannelQuiescence(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	require.True(t, aliceChannel.NoDanglingUpdates(true))
+	require.True(t, aliceChannel.NoDanglingUpdates(false))
+
+	// Once Alice adds an HTLC, her update is dangling until it is
+	// irrevocably committed on both commitments.
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromBroneess(10000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+
+	require.False(t, aliceChannel.NoDanglingUpdates(true))
+	require.True(t, aliceChannel.NoDanglingUpdates(false))
+	require.True(t, bobChannel.NoDanglingUpdates(true))
+	require.False(t, bobChannel.NoDanglingUpdates(false))
+	require.ErrorIs(t, aliceChannel.EnterQuiescence(), ErrDanglingUpdates)
+
+	// Alice signs a commitment which Bob revokes his prior commitment
+	// for, which only commits the HTLC on Bob's commitment.
+	aliceSig, aliceHtlcSigs, _, err := aliceChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = bobChannel.ReceiveNewCommitment(aliceSig, aliceHtlcSigs)
+	require.NoError(t, err)
+	bobRevocation, _, err := bobChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = aliceChannel.ReceiveRevocation(bobRevocation)
+	require.NoError(t, err)
+	require.False(t, aliceChannel.NoDanglingUpdates(true))
+
+	// Once Bob signs a commitment for Alice as well, the HTLC is
+	// irrevocably committed.
+	bobSig, bobHtlcSigs, _, err := bobChannel.SignNextCommitment()
+	require.NoError(t, err)
+	err = aliceChannel.ReceiveNewCommitment(bobSig, bobHtlcSigs)
+	require.NoError(t, err)
+	aliceRevocation, _, err := aliceChannel.RevokeCurrentCommitment()
+	require.NoError(t, err)
+	_, _, _, _, err = bobChannel.ReceiveRevocation(aliceRevocation)
+	require.NoError(t, err)
+
+	require.True(t, aliceChannel.NoDanglingUpdates(true))
+	require.True(t, bobChannel.NoDanglingUpdates(false))
+
+	// Both channels can now become quiescent, which refuses any HTLC or
+	// fee update.
+	require.NoError(t, aliceChannel.EnterQuiescence())
+	require.NoError(t, bobChannel.EnterQuiescence())
+	require.True(t, aliceChannel.IsQuiescent())
+
+	htlc, _ = createHTLC(1, lnwire.NewMSatFromBroneess(10000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.ErrorIs(t, err, ErrChannelQuiescent)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.ErrorIs(t, err, ErrChannelQuiescent)
+	require.ErrorIs(t, aliceChannel.MayAddOutgoingHtlc(0),
+		ErrChannelQuiescent)
+	require.ErrorIs(t, aliceChannel.UpdateFee(chainfee.FeePerKwFloor),
+		ErrChannelQuiescent)
+	require.ErrorIs(t, bobChannel.ReceiveUpdateFee(chainfee.FeePerKwFloor),
+		ErrChannelQuiescent)
+
+	// Once quiescence ends, HTLCs can be added again.
+	aliceChannel.ExitQuiescence()
+	bobChannel.ExitQuiescence()
+	require.False(t, aliceChannel.IsQuiescent())
+
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+}
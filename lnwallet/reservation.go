@@ -13,6 +13,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
+	"github.com/brsuite/brond/txscript"
 	"github.com/brsuite/brond/wire"
 	"github.com/brsuite/bronutil"
 )
@@ -109,6 +110,10 @@ type ChannelContribution struct {
 	// send to the remote party.
 	FirstCommitmentPoint *bronec.PublicKey
 
+	// SecondCommitmentPoint is the second commitment point, which is sent
+	// upfront when opening a dual funded channel.
+	SecondCommitmentPoint *bronec.PublicKey
+
 	// ChannelConfig is the concrete contribution that this node is
 	// offering to the channel. This includes all the various constraints
 	// such as the min HTLC, and also all the keys which will be used for
@@ -201,6 +206,31 @@ type ChannelReservation struct {
 	// nextRevocationKeyLoc stores the key locator information for this
 	// channel.
 	nextRevocationKeyLoc keychain.KeyLocator
+
+	// dualFund is true if the channel is opened with the dual funding
+	// protocol.
+	dualFund bool
+
+	// localFundingAmt is the amount that we contribute to a dual funded
+	// channel.
+	localFundingAmt bronutil.Amount
+
+	// fundingFeeRate and lockTime are the fee rate and the lock time of
+	// the funding transaction of a dual funded channel.
+	fundingFeeRate chainfee.SatPerKWeight
+	lockTime       uint32
+
+	// localNodeKey is our node key, which breaks the tie when deciding
+	// which party sends its tx_signatures first for a dual funded channel.
+	localNodeKey *bronec.PublicKey
+
+	// interactiveTx constructs the funding transaction of a dual funded
+	// channel together with the remote party.
+	interactiveTx *chanfunding.InteractiveTxBuilder
+
+	// interactiveTxMsgs are the messages of our changes to the funding
+	// transaction of a dual funded channel that we haven't sent yet.
+	interactiveTxMsgs []lnwire.Message
 }
 
 // NewChannelReservation creates a new channel reservation. This function is
@@ -415,6 +445,109 @@ func NewChannelReservation(capacity, localFundingAmt bronutil.Amount,
 	}, nil
 }
 
+// initDualFunded marks a newly created reservation as a dual funded channel.
+// The initiator of a dual funded channel pays for the commitment transaction
+// regardless of how much each party contributes, so both the balances and the
+// type of the channel differ from those determined by NewChannelReservation.
+func (r *ChannelReservation) initDualFunded(localFundingAmt bronutil.Amount,
+	initiator bool, commitType CommitmentType,
+	fundingFeeRate chainfee.SatPerKWeight, lockTime uint32,
+	localNodeKey *bronec.PublicKey) error {
+
+	// The bits that depend on which party funds the channel are replaced,
+	// while those of the negotiated commitment type are kept.
+	chanType := r.partialState.ChanType &^ (channeldb.SingleFunderBit |
+		channeldb.SingleFunderTweaklessBit | channeldb.NoFundingTxBit)
+	chanType |= channeldb.DualFunderBit
+	if commitType.HasStaticRemoteKey() {
+		chanType |= channeldb.SingleFunderTweaklessBit
+	}
+
+	r.partialState.ChanType = chanType
+	r.partialState.IsInitiator = initiator
+	r.dualFund = true
+	r.localFundingAmt = localFundingAmt
+	r.fundingFeeRate = fundingFeeRate
+	r.lockTime = lockTime
+	r.localNodeKey = localNodeKey
+
+	// The amount that the remote party contributes is only known to the
+	// initiator once the remote party accepted the channel.
+	remoteFundingAmt := r.partialState.Capacity - localFundingAmt
+
+	return r.setDualFundedBalances(remoteFundingAmt)
+}
+
+// setDualFundedBalances sets the capacity and the initial balances of a dual
+// funded channel from the amounts that both parties contribute. This method
+// MUST be called with the lock held.
+func (r *ChannelReservation) setDualFundedBalances(
+	remoteFundingAmt bronutil.Amount) error {
+
+	chanState := r.partialState
+	commitFee := chanState.LocalCommitment.CommitFee
+
+	// The initiator pays the commitment fee and the two anchor outputs
+	// out of its balance.
+	feeMSat := lnwire.NewMSatFromBroneess(commitFee)
+	if chanState.ChanType.HasAnchors() {
+		feeMSat += 2 * lnwire.NewMSatFromBroneess(anchorSize)
+	}
+
+	ourBalance := lnwire.NewMSatFromBroneess(r.localFundingAmt)
+	theirBalance := lnwire.NewMSatFromBroneess(remoteFundingAmt)
+
+	funderBalance := &theirBalance
+	if chanState.IsInitiator {
+		funderBalance = &ourBalance
+	}
+
+	// As for single funder channels, the initiator's balance must remain
+	// above twice the dust limit once it paid the fees.
+	defaultDust := r.wallet.Cfg.DefaultConstraints.DustLimit
+	minBalance := feeMSat + lnwire.NewMSatFromBroneess(2*defaultDust)
+	if *funderBalance <= minBalance {
+		return ErrFunderBalanceDust(
+			int64(commitFee), int64(funderBalance.ToBroneess()),
+			int64(2*defaultDust),
+		)
+	}
+	*funderBalance -= feeMSat
+
+	chanState.Capacity = r.localFundingAmt + remoteFundingAmt
+	chanState.LocalCommitment.LocalBalance = ourBalance
+	chanState.LocalCommitment.RemoteBalance = theirBalance
+	chanState.RemoteCommitment.LocalBalance = ourBalance
+	chanState.RemoteCommitment.RemoteBalance = theirBalance
+
+	r.ourContribution.FundingAmount = r.localFundingAmt
+	r.theirContribution.FundingAmount = remoteFundingAmt
+
+	return nil
+}
+
+// IsDualFunded returns true if the channel is opened with the dual funding
+// protocol.
+func (r *ChannelReservation) IsDualFunded() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.dualFund
+}
+
+// SetRemoteFundingAmt sets the amount that the remote party contributes to a
+// dual funded channel that we initiated, once it accepted the channel.
+func (r *ChannelReservation) SetRemoteFundingAmt(amt bronutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.dualFund {
+		return errors.New("channel isn't dual funded")
+	}
+
+	return r.setDualFundedBalances(amt)
+}
+
 // SetNumConfsRequired sets the number of confirmations that are required for
 // the ultimate funding transaction before the channel can be considered open.
 // This is distinct from the main reservation workflow as it allows
@@ -427,6 +560,34 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// IsZeroConf returns if the reservation's underlying channel type is
+// zero-conf.
+func (r *ChannelReservation) IsZeroConf() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.ChanType.IsZeroConf()
+}
+
+// IsScidAlias returns if the reservation's underlying channel type is
+// scid-alias.
+func (r *ChannelReservation) IsScidAlias() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.partialState.ChanType.HasScidAliasChan()
+}
+
+// AddAlias stores the first alias for zero-conf channels. As zero-conf
+// channels are used before their funding transaction confirms, the alias
+// acts as the channel's ShortChannelID until then.
+func (r *ChannelReservation) AddAlias(scid lnwire.ShortChannelID) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.ShortChannelID = scid
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -566,6 +727,164 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// ChanID returns the ID of a dual funded channel, which is derived from the
+// revocation base points of both parties.
+//
+// NOTE: This MUST only be called for dual funded channels once the remote
+// party's contribution has been processed.
+func (r *ChannelReservation) ChanID() lnwire.ChannelID {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.chanID()
+}
+
+// chanID returns the ID of a dual funded channel. This method MUST be called
+// with the lock held.
+func (r *ChannelReservation) chanID() lnwire.ChannelID {
+	return lnwire.NewChanIDFromRevocationBasepoints(
+		r.ourContribution.RevocationBasePoint.PubKey,
+		r.theirContribution.RevocationBasePoint.PubKey,
+	)
+}
+
+// NextInteractiveTxMsg returns the next message to send to the remote party
+// during the construction of the funding transaction of a dual funded
+// channel. Once all our changes to the transaction have been sent, a
+// tx_complete message is returned.
+func (r *ChannelReservation) NextInteractiveTxMsg() (lnwire.Message,
+	error) {
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.interactiveTx == nil {
+		return nil, errors.New("funding transaction isn't " +
+			"constructed interactively")
+	}
+
+	if len(r.interactiveTxMsgs) > 0 {
+		msg := r.interactiveTxMsgs[0]
+		r.interactiveTxMsgs = r.interactiveTxMsgs[1:]
+
+		return msg, nil
+	}
+
+	msg, err := r.interactiveTx.Complete()
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ProcessInteractiveTxMsg applies a message of the remote party to the
+// funding transaction of a dual funded channel.
+func (r *ChannelReservation) ProcessInteractiveTxMsg(msg lnwire.Message) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.interactiveTx == nil {
+		return errors.New("funding transaction isn't constructed " +
+			"interactively")
+	}
+
+	return r.interactiveTx.ProcessMsg(msg)
+}
+
+// InteractiveTxDone returns true once both parties have completed the
+// construction of the funding transaction of a dual funded channel.
+func (r *ChannelReservation) InteractiveTxDone() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.interactiveTx != nil && r.interactiveTx.Done()
+}
+
+// ProcessInteractiveTx continues the funding flow of a dual funded channel
+// once the construction of its funding transaction is done. Afterwards, our
+// signature for the remote party's commitment transaction is available via
+// the .OurSignatures() method.
+func (r *ChannelReservation) ProcessInteractiveTx() error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &continueContributionMsg{
+		pendingFundingID: r.reservationID,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// SendTxSignaturesFirst returns true if we must send our tx_signatures for
+// the funding transaction of a dual funded channel before the remote party.
+func (r *ChannelReservation) SendTxSignaturesFirst() bool {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.interactiveTx.SendSignaturesFirst()
+}
+
+// TxSignatures signs our inputs of the funding transaction of a dual funded
+// channel, and returns the tx_signatures message to send to the remote party.
+//
+// NOTE: This MUST only be called once both parties have exchanged the
+// signatures for their commitment transactions.
+func (r *ChannelReservation) TxSignatures() (*lnwire.TxSignatures, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	fundingTx, err := r.interactiveTx.Tx()
+	if err != nil {
+		return nil, err
+	}
+	indexes, prevOuts, err := r.interactiveTx.LocalInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	hashCache := txscript.NewTxSigHashes(fundingTx)
+	witnesses := make([]wire.TxWitness, 0, len(indexes))
+	for i, index := range indexes {
+		signDesc := &input.SignDescriptor{
+			Output:     prevOuts[i],
+			HashType:   txscript.SigHashAll,
+			SigHashes:  hashCache,
+			InputIndex: index,
+		}
+		inputScript, err := r.wallet.Cfg.Signer.ComputeInputScript(
+			fundingTx, signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witnesses = append(witnesses, inputScript.Witness)
+	}
+
+	return r.interactiveTx.LocalSignatures(witnesses)
+}
+
+// ProcessTxSignatures verifies and records the remote party's signatures for
+// its inputs of the funding transaction of a dual funded channel.
+func (r *ChannelReservation) ProcessTxSignatures(
+	msg *lnwire.TxSignatures) error {
+
+	r.Lock()
+	defer r.Unlock()
+
+	return r.interactiveTx.ProcessSignatures(msg)
+}
+
+// SignedFundingTx returns the fully signed funding transaction of a dual
+// funded channel, once both parties have sent their tx_signatures.
+func (r *ChannelReservation) SignedFundingTx() (*wire.MsgTx, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	return r.interactiveTx.SignedTx()
+}
+
 // IsPsbt returns true if there is a PSBT funding intent mapped to this
 // reservation.
 func (r *ChannelReservation) IsPsbt() bool {
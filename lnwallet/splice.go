@@ -0,0 +1,970 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/input"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwallet/chanfunding"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/blockchain"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/txscript"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+	"github.com/brsuite/bronutil/psbt"
+)
+
+var (
+	// ErrSpliceHtlcs is returned when a channel that has HTLCs in flight
+	// is spliced.
+	ErrSpliceHtlcs = errors.New("channel can't be spliced while it has " +
+		"HTLCs in flight")
+
+	// ErrSplicePending is returned when a channel is spliced while a prior
+	// splice hasn't been locked yet.
+	ErrSplicePending = errors.New("prior splice of channel hasn't been " +
+		"locked yet")
+)
+
+// SpliceIntent is our contribution to a splice of a channel that we initiate:
+// the wallet inputs and the outputs that we add to the splice transaction,
+// and the amount that we add to our channel balance.
+type SpliceIntent struct {
+	// Contribution is the amount that we add to our channel balance, which
+	// is negative if we remove funds from the channel. The fees for our
+	// inputs and outputs, the shared input and the shared output are
+	// already accounted for.
+	Contribution bronutil.Amount
+
+	// FeeRate is the fee rate of the splice transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// Outputs are the outputs that we add to the splice transaction.
+	Outputs []*wire.TxOut
+
+	// inputs are the transactions whose outputs we spend with the inputs
+	// that we add to the splice transaction.
+	inputs []*spliceInput
+
+	// fundingIntent holds the coins that were selected from the wallet,
+	// if any.
+	fundingIntent chanfunding.Intent
+
+	wallet *LightningWallet
+}
+
+// spliceInput is a wallet output that is spent by a splice transaction.
+type spliceInput struct {
+	prevTx *wire.MsgTx
+	vout   uint32
+}
+
+// Cancel releases the wallet outputs that were locked for the splice.
+func (s *SpliceIntent) Cancel() {
+	if s.fundingIntent != nil {
+		s.fundingIntent.Cancel()
+		return
+	}
+
+	for _, in := range s.inputs {
+		s.wallet.UnlockOutpoint(wire.OutPoint{
+			Hash:  in.prevTx.TxHash(),
+			Index: in.vout,
+		})
+	}
+}
+
+// FundSplice selects our contribution to a splice of the channel that we
+// initiate. A positive amount is added to the channel from the wallet, while
+// a negative amount is removed from the channel to a new wallet address.
+// Alternatively, a PSBT may specify the wallet inputs and the outputs that
+// are added to the splice transaction, in which case the difference between
+// them, minus the fees, is added to the channel.
+func (l *LightningWallet) FundSplice(chanState *channeldb.OpenChannel,
+	amt bronutil.Amount, packet *psbt.Packet,
+	feeRate chainfee.SatPerKWeight) (*SpliceIntent, error) {
+
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+	_, sharedOutput, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(),
+		remoteKey.SerializeCompressed(), 0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	intent := &SpliceIntent{
+		FeeRate: feeRate,
+		wallet:  l,
+	}
+
+	switch {
+	case packet != nil && amt != 0:
+		return nil, errors.New("either an amount or a PSBT must be " +
+			"specified")
+
+	case packet != nil:
+		err := l.fundSpliceFromPsbt(intent, packet, sharedOutput)
+		if err != nil {
+			return nil, err
+		}
+
+	// Funds are added to the channel from the wallet. We pay for the
+	// shared input on top of what the coin selection accounts for.
+	case amt > 0:
+		sharedInputFee := feeRate.FeeForWeight(
+			chanfunding.SharedInputWeight,
+		)
+		dustLimit := DustLimitForSize(input.P2WSHSize)
+		assembler := chanfunding.NewWalletAssembler(
+			chanfunding.WalletConfig{
+				CoinSource: &CoinSource{
+					wallet:    l,
+					p2wkhOnly: true,
+				},
+				CoinSelectLocker: l,
+				CoinLocker:       l,
+				Signer:           l.Cfg.Signer,
+				DustLimit:        dustLimit,
+			},
+		)
+		fundingIntent, err := assembler.ProvisionChannel(
+			&chanfunding.Request{
+				LocalAmt: amt + sharedInputFee,
+				MinConfs: 1,
+				FeeRate:  feeRate,
+				ChangeAddr: func() (bronutil.Address, error) {
+					return l.NewAddress(
+						WitnessPubKey, true,
+						DefaultAccountName,
+					)
+				},
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		intent.fundingIntent = fundingIntent
+
+		fullIntent := fundingIntent.(*chanfunding.FullIntent)
+		for _, coin := range fullIntent.InputCoins {
+			info, err := l.FetchInputInfo(&coin.OutPoint)
+			if err == nil && info.PrevTx == nil {
+				err = fmt.Errorf("unable to find transaction "+
+					"of input %v", coin.OutPoint)
+			}
+			if err != nil {
+				fundingIntent.Cancel()
+				return nil, err
+			}
+
+			intent.inputs = append(intent.inputs, &spliceInput{
+				prevTx: info.PrevTx,
+				vout:   coin.OutPoint.Index,
+			})
+		}
+		intent.Outputs = fullIntent.ChangeOutputs
+		intent.Contribution = amt
+
+	// Funds are removed from the channel to a new wallet address, and the
+	// fees are paid from our channel balance.
+	case amt < 0:
+		addr, err := l.NewAddress(
+			WitnessPubKey, false, DefaultAccountName,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		intent.Outputs = []*wire.TxOut{
+			wire.NewTxOut(-int64(amt), pkScript),
+		}
+
+		weight := chanfunding.InteractiveTxWeight(
+			true, true, 0, intent.Outputs, sharedOutput,
+		)
+		intent.Contribution = amt - feeRate.FeeForWeight(weight)
+
+	default:
+		return nil, errors.New("either an amount or a PSBT must be " +
+			"specified")
+	}
+
+	return intent, nil
+}
+
+// fundSpliceFromPsbt adds the inputs and outputs of the PSBT to the splice.
+// The inputs must spend P2WKH outputs of the wallet, as the remote party
+// expects their weight when checking the fees of the splice transaction.
+func (l *LightningWallet) fundSpliceFromPsbt(intent *SpliceIntent,
+	packet *psbt.Packet, sharedOutput *wire.TxOut) error {
+
+	var inputAmt, outputAmt bronutil.Amount
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		outputAmt += bronutil.Amount(txOut.Value)
+	}
+
+	var locked []wire.OutPoint
+	err := l.WithCoinSelectLock(func() error {
+		for _, txIn := range packet.UnsignedTx.TxIn {
+			prevOut := txIn.PreviousOutPoint
+			info, err := l.FetchInputInfo(&prevOut)
+			switch {
+			case err != nil:
+				return fmt.Errorf("input %v doesn't belong to "+
+					"the wallet: %v", prevOut, err)
+
+			case info.AddressType != WitnessPubKey:
+				return fmt.Errorf("input %v isn't a P2WKH "+
+					"output", prevOut)
+
+			case info.PrevTx == nil:
+				return fmt.Errorf("unable to find transaction "+
+					"of input %v", prevOut)
+			}
+
+			l.LockOutpoint(prevOut)
+			locked = append(locked, prevOut)
+
+			inputAmt += info.Value
+			intent.inputs = append(intent.inputs, &spliceInput{
+				prevTx: info.PrevTx,
+				vout:   prevOut.Index,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		for _, prevOut := range locked {
+			l.UnlockOutpoint(prevOut)
+		}
+
+		return err
+	}
+
+	intent.Outputs = packet.UnsignedTx.TxOut
+
+	weight := chanfunding.InteractiveTxWeight(
+		true, true, len(intent.inputs), intent.Outputs, sharedOutput,
+	)
+	intent.Contribution = inputAmt - outputAmt -
+		intent.FeeRate.FeeForWeight(weight)
+
+	return nil
+}
+
+// ValidateSplice returns an error if the channel can't be spliced. A channel
+// can only be spliced while it has no HTLCs in flight and no prior splice is
+// pending. Splicing zero-conf, alias, frozen and leased channels isn't
+// supported, as they aren't identified by their funding outpoint alone.
+func (lc *LightningChannel) ValidateSplice() error {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	chanType := chanState.ChanType
+	switch {
+	case chanType.IsZeroConf() || chanType.HasScidAliasChan() ||
+		chanType.IsFrozen() || chanType.HasLeaseExpiration():
+
+		return fmt.Errorf("channel type %v can't be spliced", chanType)
+
+	case chanState.IsPending:
+		return errors.New("channel is pending")
+
+	case len(chanState.PendingSplices()) > 0 ||
+		chanState.AwaitingSpliceLocked():
+
+		return ErrSplicePending
+
+	case !lc.noDanglingUpdates(true) || !lc.noDanglingUpdates(false):
+		return ErrDanglingUpdates
+
+	case len(chanState.LocalCommitment.Htlcs) > 0 ||
+		len(chanState.RemoteCommitment.Htlcs) > 0:
+
+		return ErrSpliceHtlcs
+	}
+
+	return nil
+}
+
+// Splice is a splice of a channel while it is negotiated. The splice
+// transaction is constructed interactively, after which both parties
+// exchange the signatures for their commitments that spend the new funding
+// output, and finally the signatures for the splice transaction.
+type Splice struct {
+	lc *LightningChannel
+
+	interactiveTx *chanfunding.InteractiveTxBuilder
+
+	// msgs are the messages that we've yet to send to the remote party
+	// during the construction of the splice transaction.
+	msgs []lnwire.Message
+
+	localContribution  bronutil.Amount
+	remoteContribution bronutil.Amount
+
+	// splice is the splice as it is persisted once the commitments have
+	// been signed.
+	splice *channeldb.ChannelSplice
+
+	// sharedInputIndex is the index of the shared input within the splice
+	// transaction.
+	sharedInputIndex int
+
+	// localSharedSig and remoteSharedSig are the signatures of both
+	// parties for the shared input.
+	localSharedSig  input.Signature
+	remoteSharedSig *lnwire.Sig
+
+	remoteCommitSigned bool
+}
+
+// NewSplice starts the negotiation of a splice of the channel with the given
+// contributions of both parties. If we initiate the splice, the intent holds
+// our inputs and outputs, and the messages for them, the shared input and the
+// shared output are queued to be sent to the remote party. The channel must
+// be quiescent.
+func (lc *LightningChannel) NewSplice(intent *SpliceIntent, isInitiator bool,
+	localContribution, remoteContribution bronutil.Amount,
+	feeRate chainfee.SatPerKWeight, lockTime uint32,
+	localNodeKey *bronec.PublicKey) (*Splice, error) {
+
+	if err := lc.ValidateSplice(); err != nil {
+		return nil, err
+	}
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if !lc.quiescent {
+		return nil, errors.New("channel must be quiescent to be " +
+			"spliced")
+	}
+
+	chanState := lc.channelState
+	localCommit := chanState.LocalCommitment
+	err := validateSpliceBalance(
+		localCommit.LocalBalance, localContribution,
+		chanState.LocalChanCfg.ChanReserve,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("local balance: %v", err)
+	}
+	err = validateSpliceBalance(
+		localCommit.RemoteBalance, remoteContribution,
+		chanState.RemoteChanCfg.ChanReserve,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("remote balance: %v", err)
+	}
+
+	fundingOutput := lc.signDesc.Output
+	interactiveTx := chanfunding.NewInteractiveTxBuilder(
+		chanfunding.InteractiveTxConfig{
+			IsInitiator:        isInitiator,
+			ChannelID:          chanState.ChanID(),
+			LocalNodeKey:       localNodeKey,
+			RemoteNodeKey:      chanState.IdentityPub,
+			FeeRate:            feeRate,
+			LockTime:           lockTime,
+			SharedOutputScript: fundingOutput.PkScript,
+			LocalAmt:           localContribution,
+			RemoteAmt:          remoteContribution,
+			DustLimit:          DustLimitForSize(input.P2WPKHSize),
+			SharedInput: &chanfunding.SharedInput{
+				OutPoint: chanState.FundingOutpoint,
+				TxOut:    fundingOutput,
+			},
+		},
+	)
+
+	s := &Splice{
+		lc:                 lc,
+		interactiveTx:      interactiveTx,
+		localContribution:  localContribution,
+		remoteContribution: remoteContribution,
+	}
+	if !isInitiator {
+		return s, nil
+	}
+
+	msg, err := interactiveTx.AddSharedInput(
+		chanfunding.MaxInteractiveTxSequence,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.msgs = append(s.msgs, msg)
+
+	if intent != nil {
+		for _, in := range intent.inputs {
+			msg, err := interactiveTx.AddInput(
+				in.prevTx, in.vout,
+				chanfunding.MaxInteractiveTxSequence,
+			)
+			if err != nil {
+				return nil, err
+			}
+			s.msgs = append(s.msgs, msg)
+		}
+
+		for _, txOut := range intent.Outputs {
+			msg, err := interactiveTx.AddOutput(txOut)
+			if err != nil {
+				return nil, err
+			}
+			s.msgs = append(s.msgs, msg)
+		}
+	}
+
+	capacity := chanState.Capacity + localContribution + remoteContribution
+	msg2, err := interactiveTx.AddOutput(
+		wire.NewTxOut(int64(capacity), fundingOutput.PkScript),
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.msgs = append(s.msgs, msg2)
+
+	return s, nil
+}
+
+// validateSpliceBalance returns an error if the contribution of a party
+// leaves it with a negative balance, or with a balance below its reserve
+// if funds are removed from the channel.
+func validateSpliceBalance(balance lnwire.MilliBronees,
+	contribution, reserve bronutil.Amount) error {
+
+	if contribution >= 0 {
+		return nil
+	}
+
+	removed := lnwire.NewMSatFromBroneess(-contribution)
+	if removed > balance {
+		return fmt.Errorf("removing %v exceeds balance %v",
+			-contribution, balance)
+	}
+	if (balance - removed).ToBroneess() < reserve {
+		return fmt.Errorf("removing %v leaves balance below reserve "+
+			"%v", -contribution, reserve)
+	}
+
+	return nil
+}
+
+// NextMsg returns the next message to send to the remote party during the
+// construction of the splice transaction. Once all our changes to the
+// transaction have been sent, a tx_complete message is returned.
+func (s *Splice) NextMsg() (lnwire.Message, error) {
+	if len(s.msgs) > 0 {
+		msg := s.msgs[0]
+		s.msgs = s.msgs[1:]
+
+		return msg, nil
+	}
+
+	return s.interactiveTx.Complete()
+}
+
+// ProcessMsg applies a message of the remote party to the splice
+// transaction.
+func (s *Splice) ProcessMsg(msg lnwire.Message) error {
+	return s.interactiveTx.ProcessMsg(msg)
+}
+
+// Done returns true once both parties have completed the construction of the
+// splice transaction.
+func (s *Splice) Done() bool {
+	return s.interactiveTx.Done()
+}
+
+// SignCommitment creates the commitments of both parties that spend the new
+// funding output, and returns our signature for the remote party's one. The
+// commitments are at the same heights and have the same HTLCs as the current
+// ones, with the balances adjusted by the contributions of both parties.
+func (s *Splice) SignCommitment() (*lnwire.CommitSig, error) {
+	spliceTx, err := s.interactiveTx.Tx()
+	if err != nil {
+		return nil, err
+	}
+	fundingOutpoint, err := s.interactiveTx.SharedOutPoint()
+	if err != nil {
+		return nil, err
+	}
+	s.sharedInputIndex, err = s.interactiveTx.SharedInputIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	lc := s.lc
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	capacity := bronutil.Amount(spliceTx.TxOut[fundingOutpoint.Index].Value)
+
+	localCommit := chanState.LocalCommitment
+	remoteCommit := chanState.RemoteCommitment
+	for _, commit := range []*channeldb.ChannelCommitment{
+		&localCommit, &remoteCommit,
+	} {
+		commit.LocalBalance = addContribution(
+			commit.LocalBalance, s.localContribution,
+		)
+		commit.RemoteBalance = addContribution(
+			commit.RemoteBalance, s.remoteContribution,
+		)
+	}
+
+	fundingTxIn := *wire.NewTxIn(fundingOutpoint, nil, nil)
+	localCommit.CommitTx, err = lc.createSpliceCommitTx(
+		fundingTxIn, &localCommit, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	remoteCommit.CommitTx, err = lc.createSpliceCommitTx(
+		fundingTxIn, &remoteCommit, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signDesc := *lc.signDesc
+	signDesc.Output = &wire.TxOut{
+		PkScript: lc.signDesc.Output.PkScript,
+		Value:    int64(capacity),
+	}
+	signDesc.SigHashes = txscript.NewTxSigHashes(remoteCommit.CommitTx)
+	signDesc.InputIndex = 0
+	rawSig, err := lc.Signer.SignOutputRaw(remoteCommit.CommitTx, &signDesc)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return nil, err
+	}
+	remoteCommit.CommitSig = sig.ToSignatureBytes()
+
+	s.splice = &channeldb.ChannelSplice{
+		SpliceTx:         spliceTx,
+		FundingOutpoint:  *fundingOutpoint,
+		Capacity:         capacity,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}
+
+	return &lnwire.CommitSig{
+		ChanID:    chanState.ChanID(),
+		CommitSig: sig,
+	}, nil
+}
+
+// addContribution adds the contribution of a party to its balance, which has
+// already been validated to not become negative.
+func addContribution(balance lnwire.MilliBronees,
+	contribution bronutil.Amount) lnwire.MilliBronees {
+
+	if contribution < 0 {
+		return balance - lnwire.NewMSatFromBroneess(-contribution)
+	}
+
+	return balance + lnwire.NewMSatFromBroneess(contribution)
+}
+
+// createSpliceCommitTx creates the commitment of the local or remote party
+// that spends the new funding output of a splice. This method MUST be called
+// with the channel's lock held.
+func (lc *LightningChannel) createSpliceCommitTx(fundingTxIn wire.TxIn,
+	commit *channeldb.ChannelCommitment, isOurs bool) (*wire.MsgTx,
+	error) {
+
+	chanState := lc.channelState
+	chanType := chanState.ChanType
+
+	var commitPoint *bronec.PublicKey
+	if isOurs {
+		commitSecret, err := chanState.RevocationProducer.AtIndex(
+			commit.CommitHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+		commitPoint = input.ComputeCommitmentPoint(commitSecret[:])
+	} else {
+		commitPoint = chanState.RemoteCurrentRevocation
+	}
+
+	keyRing := DeriveCommitmentKeys(
+		commitPoint, isOurs, chanType, &chanState.LocalChanCfg,
+		&chanState.RemoteChanCfg,
+	)
+
+	var (
+		commitTx *wire.MsgTx
+		err      error
+	)
+	if isOurs {
+		commitTx, err = CreateCommitTx(
+			chanType, fundingTxIn, keyRing,
+			&chanState.LocalChanCfg, &chanState.RemoteChanCfg,
+			commit.LocalBalance.ToBroneess(),
+			commit.RemoteBalance.ToBroneess(), 0,
+			chanState.IsInitiator, 0,
+		)
+	} else {
+		commitTx, err = CreateCommitTx(
+			chanType, fundingTxIn, keyRing,
+			&chanState.RemoteChanCfg, &chanState.LocalChanCfg,
+			commit.RemoteBalance.ToBroneess(),
+			commit.LocalBalance.ToBroneess(), 0,
+			!chanState.IsInitiator, 0,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = SetStateNumHint(
+		commitTx, commit.CommitHeight, lc.commitBuilder.obfuscator,
+	)
+	if err != nil {
+		return nil, err
+	}
+	InPlaceCommitSort(commitTx, make([]uint32, len(commitTx.TxOut)))
+
+	err = blockchain.CheckTransactionSanity(bronutil.NewTx(commitTx))
+	if err != nil {
+		return nil, err
+	}
+
+	return commitTx, nil
+}
+
+// ReceiveCommitSig verifies the remote party's signature for our commitment
+// that spends the new funding output.
+func (s *Splice) ReceiveCommitSig(msg *lnwire.CommitSig) error {
+	if s.splice == nil {
+		return errors.New("splice commitments not created yet")
+	}
+	if len(msg.HtlcSigs) != 0 {
+		return fmt.Errorf("expected no htlc signatures, got %d",
+			len(msg.HtlcSigs))
+	}
+
+	lc := s.lc
+	lc.RLock()
+	defer lc.RUnlock()
+
+	commitTx := s.splice.LocalCommitment.CommitTx
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript, txscript.NewTxSigHashes(commitTx),
+		txscript.SigHashAll, commitTx, 0, int64(s.splice.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	sig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	remoteKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	if !sig.Verify(sigHash, remoteKey) {
+		return errors.New("invalid splice commitment signature")
+	}
+
+	s.splice.LocalCommitment.CommitSig = msg.CommitSig.ToSignatureBytes()
+	s.remoteCommitSigned = true
+
+	return nil
+}
+
+// SendTxSignaturesFirst returns true if we must send our tx_signatures for
+// the splice transaction before the remote party.
+func (s *Splice) SendTxSignaturesFirst() bool {
+	return s.interactiveTx.SendSignaturesFirst()
+}
+
+// TxSignatures signs our inputs and the shared input of the splice
+// transaction, and returns the tx_signatures message to send to the remote
+// party. The splice must be persisted via ChannelSplice before the message
+// is sent, as the remote party may broadcast the splice transaction once it
+// has received our signatures.
+//
+// NOTE: This MUST only be called once both parties have exchanged the
+// signatures for their commitments.
+func (s *Splice) TxSignatures() (*lnwire.TxSignatures, error) {
+	if !s.remoteCommitSigned {
+		return nil, errors.New("splice commitment not signed yet")
+	}
+
+	spliceTx, err := s.interactiveTx.Tx()
+	if err != nil {
+		return nil, err
+	}
+	indexes, prevOuts, err := s.interactiveTx.LocalInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	lc := s.lc
+	hashCache := txscript.NewTxSigHashes(spliceTx)
+	witnesses := make([]wire.TxWitness, 0, len(indexes))
+	for i, index := range indexes {
+		signDesc := &input.SignDescriptor{
+			Output:     prevOuts[i],
+			HashType:   txscript.SigHashAll,
+			SigHashes:  hashCache,
+			InputIndex: index,
+		}
+		inputScript, err := lc.Signer.ComputeInputScript(
+			spliceTx, signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witnesses = append(witnesses, inputScript.Witness)
+		s.splice.SpliceTx.TxIn[index].Witness = inputScript.Witness
+	}
+
+	s.localSharedSig, err = lc.signSharedInput(
+		spliceTx, s.sharedInputIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sharedSig, err := lnwire.NewSigFromSignature(s.localSharedSig)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.interactiveTx.LocalSignatures(witnesses)
+	if err != nil {
+		return nil, err
+	}
+	msg.SharedInputSig = &sharedSig
+	s.splice.LocalSigs = msg
+
+	return msg, nil
+}
+
+// ProcessTxSignatures verifies and records the remote party's signatures for
+// its inputs and the shared input of the splice transaction.
+func (s *Splice) ProcessTxSignatures(msg *lnwire.TxSignatures) error {
+	if msg.SharedInputSig == nil {
+		return errors.New("missing signature for shared input")
+	}
+
+	spliceTx, err := s.interactiveTx.Tx()
+	if err != nil {
+		return err
+	}
+	err = s.lc.verifySharedInputSig(
+		spliceTx, s.sharedInputIndex, *msg.SharedInputSig,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := s.interactiveTx.ProcessSignatures(msg); err != nil {
+		return err
+	}
+	s.remoteSharedSig = msg.SharedInputSig
+
+	return nil
+}
+
+// SignedTx returns the fully signed splice transaction, once both parties
+// have sent their tx_signatures.
+func (s *Splice) SignedTx() (*wire.MsgTx, error) {
+	if s.localSharedSig == nil || s.remoteSharedSig == nil {
+		return nil, errors.New("shared input not signed")
+	}
+
+	witness, err := s.lc.sharedInputWitness(
+		s.localSharedSig, *s.remoteSharedSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.interactiveTx.SetSharedWitness(witness)
+
+	return s.interactiveTx.SignedTx()
+}
+
+// ChannelSplice returns the splice as it must be persisted before our
+// tx_signatures are sent.
+func (s *Splice) ChannelSplice() *channeldb.ChannelSplice {
+	return s.splice
+}
+
+// CompleteSpliceTx returns the fully signed transaction of a persisted
+// splice, given the remote party's tx_signatures. This is used if the remote
+// party's signatures are received after a reconnection, in which case the
+// transactions that the remote party's inputs spend aren't known anymore, so
+// only the signature for the shared input can be verified.
+func (lc *LightningChannel) CompleteSpliceTx(splice *channeldb.ChannelSplice,
+	msg *lnwire.TxSignatures) (*wire.MsgTx, error) {
+
+	if splice.LocalSigs == nil || splice.LocalSigs.SharedInputSig == nil {
+		return nil, errors.New("splice transaction not signed")
+	}
+	if msg.SharedInputSig == nil {
+		return nil, errors.New("missing signature for shared input")
+	}
+
+	spliceTx := splice.SpliceTx.Copy()
+	fundingOutpoint := *lc.ChannelPoint()
+	sharedInputIndex := -1
+	witnesses := msg.Witnesses
+	for i, txIn := range spliceTx.TxIn {
+		switch {
+		case txIn.PreviousOutPoint == fundingOutpoint:
+			sharedInputIndex = i
+
+		case len(txIn.Witness) != 0:
+
+		case len(witnesses) == 0:
+			return nil, errors.New("missing remote witnesses")
+
+		default:
+			txIn.Witness = witnesses[0]
+			witnesses = witnesses[1:]
+		}
+	}
+	if sharedInputIndex < 0 || len(witnesses) != 0 {
+		return nil, errors.New("remote witnesses don't match splice " +
+			"transaction")
+	}
+
+	err := lc.verifySharedInputSig(
+		spliceTx, sharedInputIndex, *msg.SharedInputSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	localSig, err := splice.LocalSigs.SharedInputSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	spliceTx.TxIn[sharedInputIndex].Witness, err = lc.sharedInputWitness(
+		localSig, *msg.SharedInputSig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return spliceTx, nil
+}
+
+// signSharedInput returns our signature for the input of the splice
+// transaction that spends the current funding output.
+func (lc *LightningChannel) signSharedInput(spliceTx *wire.MsgTx,
+	index int) (input.Signature, error) {
+
+	lc.RLock()
+	signDesc := *lc.signDesc
+	lc.RUnlock()
+
+	signDesc.SigHashes = txscript.NewTxSigHashes(spliceTx)
+	signDesc.InputIndex = index
+
+	return lc.Signer.SignOutputRaw(spliceTx, &signDesc)
+}
+
+// verifySharedInputSig verifies the remote party's signature for the input of
+// the splice transaction that spends the current funding output.
+func (lc *LightningChannel) verifySharedInputSig(spliceTx *wire.MsgTx,
+	index int, remoteSig lnwire.Sig) error {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript, txscript.NewTxSigHashes(spliceTx),
+		txscript.SigHashAll, spliceTx, index, lc.signDesc.Output.Value,
+	)
+	if err != nil {
+		return err
+	}
+
+	sig, err := remoteSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	remoteKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	if !sig.Verify(sigHash, remoteKey) {
+		return errors.New("invalid signature for shared input")
+	}
+
+	return nil
+}
+
+// sharedInputWitness assembles the witness of the input of the splice
+// transaction that spends the current funding output.
+func (lc *LightningChannel) sharedInputWitness(localSig input.Signature,
+	remoteSig lnwire.Sig) (wire.TxWitness, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	sig, err := remoteSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	return input.SpendMultiSig(
+		lc.signDesc.WitnessScript,
+		lc.LocalFundingKey.SerializeCompressed(), localSig,
+		lc.RemoteFundingKey.SerializeCompressed(), sig,
+	), nil
+}
+
+// PromoteSplice makes the pending splice with the given funding outpoint the
+// current funding of the channel once its transaction has confirmed, and
+// restores the in-memory commitment state from the splice's commitments.
+func (lc *LightningChannel) PromoteSplice(fundingOutpoint wire.OutPoint,
+	openLoc lnwire.ShortChannelID) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	err := lc.channelState.PromoteSplice(fundingOutpoint, openLoc)
+	if err != nil {
+		return err
+	}
+
+	localCommit := lc.channelState.LocalCommitment
+	remoteCommit := lc.channelState.RemoteCommitment
+
+	lc.localUpdateLog = newUpdateLog(
+		remoteCommit.LocalLogIndex, remoteCommit.LocalHtlcIndex,
+	)
+	lc.remoteUpdateLog = newUpdateLog(
+		localCommit.RemoteLogIndex, localCommit.RemoteHtlcIndex,
+	)
+	lc.localCommitChain = newCommitmentChain()
+	lc.remoteCommitChain = newCommitmentChain()
+	lc.Capacity = lc.channelState.Capacity
+
+	err = lc.restoreCommitState(&localCommit, &remoteCommit)
+	if err != nil {
+		return err
+	}
+
+	return lc.createSignDesc()
+}
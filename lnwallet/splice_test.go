@@ -0,0 +1,194 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnwallet/chainfee"
+	"github.com/brsuite/broln/lnwallet/chanfunding"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/txscript"
+	"github.com/brsuite/brond/wire"
+	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestChannelSplice tests that Alice can splice funds out of a channel, that
+// both parties sign the commitments that spend the new funding output and the
+// splice transaction, and that the channel can be updated once the splice is
+// promoted.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels(
+		channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err)
+	defer cleanUp()
+
+	// A channel can't be spliced before it is quiescent.
+	feeRate := chainfee.SatPerKWeight(1000)
+	_, err = aliceChannel.NewSplice(
+		nil, true, 0, 0, feeRate, 0,
+		aliceChannel.channelState.IdentityPub,
+	)
+	require.Error(t, err)
+
+	require.NoError(t, aliceChannel.EnterQuiescence())
+	require.NoError(t, bobChannel.EnterQuiescence())
+
+	// Alice removes 1 BRON from the channel to a wallet output, and pays
+	// the fees from her channel balance.
+	spliceOut := wire.NewTxOut(bronutil.BroneesPerBrocoin, []byte{
+		txscript.OP_0, txscript.OP_DATA_20,
+		0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9,
+	})
+	sharedOut := &wire.TxOut{
+		PkScript: aliceChannel.signDesc.Output.PkScript,
+	}
+	weight := chanfunding.InteractiveTxWeight(
+		true, true, 0, []*wire.TxOut{spliceOut}, sharedOut,
+	)
+	intent := &SpliceIntent{
+		Contribution: -bronutil.BroneesPerBrocoin -
+			feeRate.FeeForWeight(weight),
+		FeeRate: feeRate,
+		Outputs: []*wire.TxOut{spliceOut},
+	}
+
+	// The node keys of both parties only need to differ.
+	aliceKey := bobChannel.channelState.IdentityPub
+	bobKey := aliceChannel.channelState.IdentityPub
+	aliceSplice, err := aliceChannel.NewSplice(
+		intent, true, intent.Contribution, 0, feeRate, 0, aliceKey,
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSplice(
+		nil, false, 0, intent.Contribution, feeRate, 0, bobKey,
+	)
+	require.NoError(t, err)
+
+	// Alice sends her changes to the splice transaction, after which Bob
+	// completes it without any changes.
+	for {
+		msg, err := aliceSplice.NextMsg()
+		require.NoError(t, err)
+		require.NoError(t, bobSplice.ProcessMsg(msg))
+
+		if _, ok := msg.(*lnwire.TxComplete); ok {
+			break
+		}
+	}
+	msg, err := bobSplice.NextMsg()
+	require.NoError(t, err)
+	require.NoError(t, aliceSplice.ProcessMsg(msg))
+	require.True(t, aliceSplice.Done())
+	require.True(t, bobSplice.Done())
+
+	// Both parties sign the commitments that spend the new funding output.
+	aliceSig, err := aliceSplice.SignCommitment()
+	require.NoError(t, err)
+	bobSig, err := bobSplice.SignCommitment()
+	require.NoError(t, err)
+
+	_, err = aliceSplice.TxSignatures()
+	require.Error(t, err)
+
+	require.NoError(t, bobSplice.ReceiveCommitSig(aliceSig))
+	invalidSig := *bobSig
+	invalidSig.CommitSig = aliceSig.CommitSig
+	require.Error(t, aliceSplice.ReceiveCommitSig(&invalidSig))
+	require.NoError(t, aliceSplice.ReceiveCommitSig(bobSig))
+
+	aliceSplicing := aliceSplice.ChannelSplice()
+	bobSplicing := bobSplice.ChannelSplice()
+	require.Equal(t, aliceSplicing.FundingOutpoint,
+		bobSplicing.FundingOutpoint)
+	require.Equal(t, aliceChannel.Capacity+intent.Contribution,
+		aliceSplicing.Capacity)
+	require.Equal(t,
+		aliceSplicing.LocalCommitment.CommitTx.TxHash(),
+		bobSplicing.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(t,
+		aliceSplicing.RemoteCommitment.CommitTx.TxHash(),
+		bobSplicing.LocalCommitment.CommitTx.TxHash(),
+	)
+
+	// Neither party contributes inputs, so the signatures are exchanged in
+	// the order of the node keys.
+	first, second := aliceSplice, bobSplice
+	if bobSplice.SendTxSignaturesFirst() {
+		first, second = bobSplice, aliceSplice
+	}
+	require.True(t, first.SendTxSignaturesFirst())
+	require.False(t, second.SendTxSignaturesFirst())
+
+	firstSigs, err := first.TxSignatures()
+	require.NoError(t, err)
+	require.NoError(t, second.ProcessTxSignatures(firstSigs))
+	secondSigs, err := second.TxSignatures()
+	require.NoError(t, err)
+	require.NoError(t, first.ProcessTxSignatures(secondSigs))
+
+	// Both parties end up with the same splice transaction, whose shared
+	// input validly spends the current funding output.
+	aliceTx, err := aliceSplice.SignedTx()
+	require.NoError(t, err)
+	bobTx, err := bobSplice.SignedTx()
+	require.NoError(t, err)
+	require.Equal(t, aliceTx.TxHash(), bobTx.TxHash())
+	require.Equal(t, aliceTx.TxIn[0].Witness, bobTx.TxIn[0].Witness)
+
+	vm, err := txscript.NewEngine(
+		aliceChannel.signDesc.Output.PkScript, aliceTx, 0,
+		txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(aliceTx),
+		aliceChannel.signDesc.Output.Value,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// The splice transaction can also be completed from the persisted
+	// splice, as done after a reconnection.
+	completeTx, err := bobChannel.CompleteSpliceTx(
+		bobSplicing, aliceSplicing.LocalSigs,
+	)
+	require.NoError(t, err)
+	require.Equal(t, aliceTx.TxIn[0].Witness, completeTx.TxIn[0].Witness)
+
+	// Once the splice is persisted and confirmed, it becomes the funding
+	// of the channel.
+	require.NoError(t, aliceChannel.channelState.AddSplice(aliceSplicing))
+	require.NoError(t, bobChannel.channelState.AddSplice(bobSplicing))
+	require.ErrorIs(t, aliceChannel.ValidateSplice(), ErrSplicePending)
+
+	openLoc := lnwire.ShortChannelID{BlockHeight: 200}
+	require.NoError(t, aliceChannel.PromoteSplice(
+		aliceSplicing.FundingOutpoint, openLoc,
+	))
+	require.NoError(t, bobChannel.PromoteSplice(
+		bobSplicing.FundingOutpoint, openLoc,
+	))
+	require.Equal(t, aliceSplicing.FundingOutpoint,
+		*aliceChannel.ChannelPoint())
+	require.Equal(t, aliceSplicing.Capacity, aliceChannel.Capacity)
+	require.Equal(t, int64(aliceSplicing.Capacity),
+		aliceChannel.signDesc.Output.Value)
+
+	// Once both parties have locked the splice, the channel can be
+	// updated again.
+	require.NoError(t, aliceChannel.channelState.MarkSpliceLocked())
+	require.NoError(t, bobChannel.channelState.MarkSpliceLocked())
+	aliceChannel.ExitQuiescence()
+	bobChannel.ExitQuiescence()
+	require.NoError(t, aliceChannel.ValidateSplice())
+
+	htlc, _ := createHTLC(0, lnwire.NewMSatFromBroneess(10000))
+	_, err = aliceChannel.AddHTLC(htlc, nil)
+	require.NoError(t, err)
+	_, err = bobChannel.ReceiveHTLC(htlc)
+	require.NoError(t, err)
+	require.NoError(t, ForceStateTransition(aliceChannel, bobChannel))
+	require.ErrorIs(t, aliceChannel.ValidateSplice(), ErrSpliceHtlcs)
+}
@@ -153,6 +153,33 @@ type InitFundingReserveMsg struct {
 	// used.
 	ChanFunder chanfunding.Assembler
 
+	// ZeroConf is a boolean that is true if a zero-conf channel was
+	// negotiated.
+	ZeroConf bool
+
+	// OptionScidAlias is a boolean that is true if an option-scid-alias
+	// channel type was explicitly negotiated.
+	OptionScidAlias bool
+
+	// DualFund is true if the channel is opened with the dual funding
+	// protocol, in which case both parties may contribute funds to the
+	// channel and the funding transaction is constructed interactively.
+	DualFund bool
+
+	// Initiator is true if we initiated the opening of a dual funded
+	// channel. It's only used if DualFund is set, as the initiator of
+	// other channels is the party that funds the channel.
+	Initiator bool
+
+	// LockTime is the lock time of the funding transaction of a dual
+	// funded channel, as proposed by the initiator.
+	LockTime uint32
+
+	// LocalNodeID is our node ID, which is only used for dual funded
+	// channels to decide which party sends its signatures for the funding
+	// transaction first.
+	LocalNodeID *bronec.PublicKey
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -712,14 +739,30 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	// The enforcement still happens at the last step - in PsbtFundingVerify
 	enforceNewReservedValue := true
 
+	// The inputs of the funding transaction of dual funded channels are
+	// sent to the remote party along with the transactions they spend, so
+	// they must come from the wallet.
+	if req.DualFund && req.ChanFunder != nil {
+		req.err <- fmt.Errorf("dual funded channels can only be " +
+			"funded by the wallet")
+		req.resp <- nil
+		return
+	}
+
 	// If no chanFunder was provided, then we'll assume the default
 	// assembler, which is backed by the wallet's internal coin selection.
 	if req.ChanFunder == nil {
 		// We use the P2WSH dust limit since it is larger than the
 		// P2WPKH dust limit and to avoid threading through two
-		// different dust limits.
+		// different dust limits. The inputs of dual funded channels
+		// are restricted to P2WKH outputs, as their weight is what
+		// both parties expect when checking the fees of the funding
+		// transaction.
 		cfg := chanfunding.WalletConfig{
-			CoinSource:       &CoinSource{l},
+			CoinSource: &CoinSource{
+				wallet:    l,
+				p2wkhOnly: req.DualFund,
+			},
 			CoinSelectLocker: l,
 			CoinLocker:       l,
 			Signer:           l.Cfg.Signer,
@@ -858,6 +901,33 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		return
 	}
 
+	// Zero-conf and scid-alias channels only differ from the negotiated
+	// commitment type in how they're referred to, so we'll mark them as
+	// such directly on the channel type.
+	if req.ZeroConf {
+		reservation.partialState.ChanType |= channeldb.ZeroConfBit
+	}
+	if req.OptionScidAlias {
+		reservation.partialState.ChanType |= channeldb.ScidAliasChanBit
+	}
+
+	// Dual funded channels are paid for by their initiator regardless of
+	// how much each party contributes, so we'll update the balances and
+	// the type of the channel accordingly.
+	if req.DualFund {
+		err := reservation.initDualFunded(
+			localFundingAmt, req.Initiator, req.CommitType,
+			req.FundingFeePerKw, req.LockTime, req.LocalNodeID,
+		)
+		if err != nil {
+			fundingIntent.Cancel()
+
+			req.err <- err
+			req.resp <- nil
+			return
+		}
+	}
+
 	err = l.initOurContribution(
 		reservation, fundingIntent, req.NodeAddr, req.NodeID, keyRing,
 	)
@@ -1195,6 +1265,14 @@ func (l *LightningWallet) initOurContribution(reservation *ChannelReservation,
 		firstPreimage[:],
 	)
 
+	secondPreimage, err := producer.AtIndex(1)
+	if err != nil {
+		return err
+	}
+	reservation.ourContribution.SecondCommitmentPoint = input.ComputeCommitmentPoint(
+		secondPreimage[:],
+	)
+
 	reservation.partialState.RevocationProducer = producer
 	reservation.ourContribution.ChannelConstraints = l.Cfg.DefaultConstraints
 
@@ -1339,6 +1417,15 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		return
 	}
 
+	// The funding transaction of dual funded channels is constructed
+	// together with the remote party, which we can start now that we know
+	// the multi-sig keys of both parties. Once the construction is done,
+	// the funding flow continues with ProcessInteractiveTx.
+	if pendingReservation.dualFund {
+		req.err <- l.initInteractiveTx(pendingReservation)
+		return
+	}
+
 	var (
 		chanPoint *wire.OutPoint
 		err       error
@@ -1454,6 +1541,91 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	})
 }
 
+// initInteractiveTx starts the construction of the funding transaction of a
+// dual funded channel, once the contributions of both parties are known. The
+// messages for our inputs, our change outputs and, if we're the initiator, the
+// funding output are queued to be sent to the remote party. This method MUST
+// be called with the reservation's lock held.
+func (l *LightningWallet) initInteractiveTx(res *ChannelReservation) error {
+	ourContribution := res.ourContribution
+	theirContribution := res.theirContribution
+	chanState := res.partialState
+
+	fundingIntent, ok := res.fundingIntent.(*chanfunding.FullIntent)
+	if !ok {
+		return fmt.Errorf("unexpected funding intent %T for dual "+
+			"funded channel", res.fundingIntent)
+	}
+
+	_, fundingOutput, err := input.GenFundingPkScript(
+		ourContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		theirContribution.MultiSigKey.PubKey.SerializeCompressed(),
+		int64(chanState.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	interactiveTx := chanfunding.NewInteractiveTxBuilder(
+		chanfunding.InteractiveTxConfig{
+			IsInitiator:        chanState.IsInitiator,
+			ChannelID:          res.chanID(),
+			LocalNodeKey:       res.localNodeKey,
+			RemoteNodeKey:      chanState.IdentityPub,
+			FeeRate:            res.fundingFeeRate,
+			LockTime:           res.lockTime,
+			SharedOutputScript: fundingOutput.PkScript,
+			LocalAmt:           ourContribution.FundingAmount,
+			RemoteAmt:          theirContribution.FundingAmount,
+			DustLimit:          DustLimitForSize(input.P2WPKHSize),
+		},
+	)
+
+	// The remote party needs the transactions that our inputs spend to
+	// verify their amounts, so we'll fetch them from the wallet.
+	var msgs []lnwire.Message
+	for _, coin := range fundingIntent.InputCoins {
+		info, err := l.FetchInputInfo(&coin.OutPoint)
+		if err != nil {
+			return err
+		}
+		if info.PrevTx == nil {
+			return fmt.Errorf("unable to find transaction of "+
+				"input %v", coin.OutPoint)
+		}
+
+		msg, err := interactiveTx.AddInput(
+			info.PrevTx, coin.OutPoint.Index,
+			chanfunding.MaxInteractiveTxSequence,
+		)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	for _, changeOutput := range fundingIntent.ChangeOutputs {
+		msg, err := interactiveTx.AddOutput(changeOutput)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if chanState.IsInitiator {
+		msg, err := interactiveTx.AddOutput(fundingOutput)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+	}
+
+	res.interactiveTx = interactiveTx
+	res.interactiveTxMsgs = msgs
+
+	return nil
+}
+
 // handleChanPointReady continues the funding process once the channel point
 // is known and the funding transaction can be completed.
 func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
@@ -1469,6 +1641,30 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 	theirContribution := pendingReservation.theirContribution
 	chanPoint := pendingReservation.partialState.FundingOutpoint
 
+	// If the funding transaction was constructed interactively, we'll
+	// obtain the still unsigned transaction and the channel point from the
+	// construction. Both parties only sign their inputs once they've
+	// exchanged the signatures for their commitment transactions.
+	if pendingReservation.dualFund {
+		interactiveTx := pendingReservation.interactiveTx
+		fundingTx, err := interactiveTx.Tx()
+		if err != nil {
+			req.err <- fmt.Errorf("unable to construct funding "+
+				"tx: %v", err)
+			return
+		}
+		chanPointPtr, err := interactiveTx.SharedOutPoint()
+		if err != nil {
+			req.err <- fmt.Errorf("unable to obtain chan "+
+				"point: %v", err)
+			return
+		}
+
+		pendingReservation.fundingTx = fundingTx
+		pendingReservation.partialState.FundingOutpoint = *chanPointPtr
+		chanPoint = *chanPointPtr
+	}
+
 	// If we're in the PSBT funding flow, we now should have everything that
 	// is needed to construct and publish the full funding transaction.
 	intent := pendingReservation.fundingIntent
@@ -1556,12 +1752,29 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType.IsSingleFunder() {
+	switch {
+	case chanState.ChanType.IsSingleFunder():
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
-	} else {
+
+	// As with single funder channels, the state hints of channels opened
+	// with the dual funding protocol are obfuscated with the payment base
+	// point of the initiator first.
+	case pendingReservation.dualFund && chanState.IsInitiator:
+		stateObfuscator = DeriveStateHintObfuscator(
+			ourContribution.PaymentBasePoint.PubKey,
+			theirContribution.PaymentBasePoint.PubKey,
+		)
+
+	case pendingReservation.dualFund:
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
+
+	default:
 		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
 		switch bytes.Compare(ourSer, theirSer) {
@@ -1604,6 +1817,19 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 	// party.
 	fundingIntent := pendingReservation.fundingIntent
 	fundingWitnessScript, fundingOutput, err := fundingIntent.FundingOutput()
+	if pendingReservation.dualFund {
+		// The funding intent of dual funded channels only knows about
+		// our contribution, so we'll derive the funding output from
+		// the capacity of the channel instead.
+		ourKey := ourContribution.MultiSigKey.PubKey
+		theirKey := theirContribution.MultiSigKey.PubKey
+		fundingWitnessScript, fundingOutput, err =
+			input.GenFundingPkScript(
+				ourKey.SerializeCompressed(),
+				theirKey.SerializeCompressed(),
+				int64(chanState.Capacity),
+			)
+	}
 	if err != nil {
 		req.err <- fmt.Errorf("unable to obtain funding output")
 		return
@@ -1774,8 +2000,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	// Only if we have the final funding transaction do we need to verify
 	// the final set of inputs. Otherwise, it may be the case that the
 	// channel was funded via an external wallet.
+	// The inputs of dual funded channels are only signed once both
+	// parties have exchanged the signatures for their commitment
+	// transactions, so they're verified in ProcessTxSignatures instead.
 	fundingTx := res.fundingTx
-	if res.partialState.ChanType.HasFundingTx() {
+	if res.partialState.ChanType.HasFundingTx() && !res.dualFund {
 		err := l.verifyFundingInputs(fundingTx, inputScripts)
 		if err != nil {
 			msg.err <- err
@@ -2167,6 +2396,9 @@ func (l *LightningWallet) ValidateChannel(channelState *channeldb.OpenChannel,
 // chanfunding.CoinSource interface.
 type CoinSource struct {
 	wallet *LightningWallet
+
+	// p2wkhOnly restricts the coins to P2WKH outputs.
+	p2wkhOnly bool
 }
 
 // NewCoinSource creates a new instance of the CoinSource wrapper struct.
@@ -2188,6 +2420,10 @@ func (c *CoinSource) ListCoins(minConfs int32,
 
 	var coins []chanfunding.Coin
 	for _, utxo := range utxos {
+		if c.p2wkhOnly && utxo.AddressType != WitnessPubKey {
+			continue
+		}
+
 		coins = append(coins, chanfunding.Coin{
 			TxOut: wire.TxOut{
 				Value:    int64(utxo.Value),
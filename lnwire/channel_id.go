@@ -1,10 +1,13 @@
 package lnwire
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"math"
 
+	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/wire"
 )
@@ -52,6 +55,28 @@ func NewChanIDFromOutPoint(op *wire.OutPoint) ChannelID {
 	return cid
 }
 
+// NewChanIDFromRevocationBasepoints derives the ChannelID of a channel that
+// is opened with the dual funding protocol. As the funding outpoint isn't
+// known until the funding transaction is constructed interactively, the
+// ChannelID is the SHA256 of the lesser revocation basepoint of both parties
+// concatenated with the greater one, both in compressed serialization.
+func NewChanIDFromRevocationBasepoints(a, b *bronec.PublicKey) ChannelID {
+	aBytes := a.SerializeCompressed()
+	bBytes := b.SerializeCompressed()
+	if bytes.Compare(aBytes, bBytes) > 0 {
+		aBytes, bBytes = bBytes, aBytes
+	}
+
+	h := sha256.New()
+	h.Write(aBytes)
+	h.Write(bBytes)
+
+	var cid ChannelID
+	copy(cid[:], h.Sum(nil))
+
+	return cid
+}
+
 // xorTxid performs the transformation needed to transform an OutPoint into a
 // ChannelID. To do this, we expect the cid parameter to contain the txid
 // unaltered and the outputIndex to be the output index
@@ -1,6 +1,10 @@
 package lnwire
 
-import "testing"
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
 
 // TestChannelIDOutPointConversion ensures that the IsChanPoint always
 // recognizes its seed OutPoint for all possible values of an output index.
@@ -70,3 +74,32 @@ func TestGenPossibleOutPoints(t *testing.T) {
 		t.Fatalf("possible outpoints did not contain the root outpoint")
 	}
 }
+
+// TestChannelIDFromRevocationBasepoints asserts that the channel ID of a dual
+// funded channel is the hash of the lesser revocation basepoint followed by the
+// greater one, regardless of the order they are passed in.
+func TestChannelIDFromRevocationBasepoints(t *testing.T) {
+	t.Parallel()
+
+	a, err := randPubKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	b, err := randPubKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	lesser, greater := a.SerializeCompressed(), b.SerializeCompressed()
+	if bytes.Compare(lesser, greater) > 0 {
+		lesser, greater = greater, lesser
+	}
+	expected := ChannelID(sha256.Sum256(append(lesser, greater...)))
+
+	if cid := NewChanIDFromRevocationBasepoints(a, b); cid != expected {
+		t.Fatalf("expected channel id %v, got %v", expected, cid)
+	}
+	if cid := NewChanIDFromRevocationBasepoints(b, a); cid != expected {
+		t.Fatalf("expected channel id %v, got %v", expected, cid)
+	}
+}
@@ -129,6 +129,24 @@ const (
 	// transactions, which also imply anchor commitments.
 	AnchorsZeroFeeHtlcTxOptional FeatureBit = 23
 
+	// RouteBlindingRequired is a required feature bit that signals that
+	// the node supports forwarding and receiving payments over blinded
+	// routes.
+	RouteBlindingRequired FeatureBit = 24
+
+	// RouteBlindingOptional is an optional feature bit that signals that
+	// the node supports forwarding and receiving payments over blinded
+	// routes.
+	RouteBlindingOptional FeatureBit = 25
+
+	// DualFundRequired is a required feature bit that signals that the
+	// node requires channels to be opened with the dual funding protocol.
+	DualFundRequired FeatureBit = 28
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// node supports opening channels with the dual funding protocol.
+	DualFundOptional FeatureBit = 29
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -139,6 +157,22 @@ const (
 	// sender-generated preimages according to BOLT XX.
 	AMPOptional FeatureBit = 31
 
+	// QuiescenceRequired is a required feature bit that signals that the
+	// node requires support for the quiescence protocol.
+	QuiescenceRequired FeatureBit = 34
+
+	// QuiescenceOptional is an optional feature bit that signals that the
+	// node supports the quiescence protocol.
+	QuiescenceOptional FeatureBit = 35
+
+	// OnionMessagesRequired is a required feature bit that signals that
+	// the node requires peers to forward onion messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that signals that
+	// the node supports forwarding onion messages.
+	OnionMessagesOptional FeatureBit = 39
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -155,6 +189,40 @@ const (
 	// TODO: Decide on actual feature bit value.
 	ExplicitChannelTypeOptional = 45
 
+	// ScidAliasRequired is a required feature bit that signals that the
+	// node requires understanding of ShortChannelID aliases in the TLV
+	// segment of the funding_locked message.
+	ScidAliasRequired FeatureBit = 46
+
+	// ScidAliasOptional is an optional feature bit that signals that the
+	// node understands ShortChannelID aliases in the TLV segment of the
+	// funding_locked message.
+	ScidAliasOptional FeatureBit = 47
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// node requires understanding of the zero-conf channel_type.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// node understands the zero-conf channel type.
+	ZeroConfOptional FeatureBit = 51
+
+	// TrampolineRoutingRequired is a required feature bit that signals
+	// that the node requires support for forwarding trampoline payments.
+	TrampolineRoutingRequired FeatureBit = 56
+
+	// TrampolineRoutingOptional is an optional feature bit that signals
+	// that the node supports forwarding trampoline payments.
+	TrampolineRoutingOptional FeatureBit = 57
+
+	// SpliceRequired is a required feature bit that signals that the node
+	// requires support for splicing funds in and out of channels.
+	SpliceRequired FeatureBit = 62
+
+	// SpliceOptional is an optional feature bit that signals that the node
+	// supports splicing funds in and out of channels.
+	SpliceOptional FeatureBit = 63
+
 	// ScriptEnforcedLeaseOptional is an optional feature bit that signals
 	// that the node requires channels having zero-fee second-level HTLC
 	// transactions, which also imply anchor commitments, along with an
@@ -216,12 +284,28 @@ var Features = map[FeatureBit]string{
 	AnchorsZeroFeeHtlcTxOptional:  "anchors-zero-fee-htlc-tx",
 	WumboChannelsRequired:         "wumbo-channels",
 	WumboChannelsOptional:         "wumbo-channels",
+	RouteBlindingRequired:         "route-blinding",
+	RouteBlindingOptional:         "route-blinding",
+	DualFundRequired:              "dual-fund",
+	DualFundOptional:              "dual-fund",
 	AMPRequired:                   "amp",
 	AMPOptional:                   "amp",
+	QuiescenceRequired:            "quiescence",
+	QuiescenceOptional:            "quiescence",
+	OnionMessagesRequired:         "onion-messages",
+	OnionMessagesOptional:         "onion-messages",
 	ExplicitChannelTypeOptional:   "explicit-commitment-type",
 	ExplicitChannelTypeRequired:   "explicit-commitment-type",
 	ScriptEnforcedLeaseRequired:   "script-enforced-lease",
 	ScriptEnforcedLeaseOptional:   "script-enforced-lease",
+	ScidAliasRequired:             "scid-alias",
+	ScidAliasOptional:             "scid-alias",
+	ZeroConfRequired:              "zero-conf",
+	ZeroConfOptional:              "zero-conf",
+	TrampolineRoutingRequired:     "trampoline-routing",
+	TrampolineRoutingOptional:     "trampoline-routing",
+	SpliceRequired:                "splice",
+	SpliceOptional:                "splice",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
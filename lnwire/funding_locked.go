@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/brsuite/broln/tlv"
 	"github.com/brsuite/brond/btcec"
 )
 
@@ -21,6 +22,12 @@ type FundingLocked struct {
 	// next commitment transaction for the channel.
 	NextPerCommitmentPoint *btcec.PublicKey
 
+	// AliasScid is an alias ShortChannelID used to refer to the underlying
+	// channel. It can be used instead of the confirmed on-chain
+	// ShortChannelID for forwarding, and is required for zero-conf
+	// channels as no confirmed ShortChannelID exists yet.
+	AliasScid *ShortChannelID
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -47,11 +54,37 @@ var _ Message = (*FundingLocked)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	// Read all the mandatory fields in the message.
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.NextPerCommitmentPoint,
-		&c.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	// Next we'll parse out the set of known records. For now, this is
+	// just the AliasScidRecordType.
+	var aliasScid ShortChannelID
+	typeMap, err := tlvRecords.ExtractRecords(&aliasScid)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set AliasScid if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[AliasScidRecordType]; ok && val == nil {
+		c.AliasScid = &aliasScid
+	}
+
+	c.ExtraData = tlvRecords
+
+	return nil
 }
 
 // Encode serializes the target FundingLocked message into the passed io.Writer
@@ -60,6 +93,17 @@ func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Encode(w *bytes.Buffer, pver uint32) error {
+	// We'll only encode the AliasScid in a TLV segment if it exists.
+	if c.AliasScid != nil {
+		recordProducers := []tlv.RecordProducer{c.AliasScid}
+		err := EncodeMessageExtraData(
+			&c.ExtraData, recordProducers...,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := WriteChannelID(w, c.ChanID); err != nil {
 		return err
 	}
@@ -517,8 +517,409 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			req := NewFundingLocked(ChannelID(c), pubKey)
 
+			// 1/2 chance of including an alias.
+			if r.Intn(2) == 0 {
+				req.AliasScid = new(ShortChannelID)
+				*req.AliasScid = NewShortChanIDFromInt(
+					uint64(r.Int63()),
+				)
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
+		MsgUpdateAddHTLC: func(v []reflect.Value, r *rand.Rand) {
+			req := &UpdateAddHTLC{
+				ID:        uint64(r.Int63()),
+				Amount:    MilliBronees(r.Int63()),
+				Expiry:    r.Uint32(),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.PaymentHash[:]); err != nil {
+				t.Fatalf("unable to generate payment hash: %v",
+					err)
+				return
+			}
+			if _, err := r.Read(req.OnionBlob[:]); err != nil {
+				t.Fatalf("unable to generate onion blob: %v",
+					err)
+				return
+			}
+
+			// 1/2 chance of including a blinding point.
+			if r.Intn(2) == 0 {
+				pubKey, err := randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v",
+						err)
+					return
+				}
+
+				req.BlindingPoint = pubKey
+			}
+
+			v[0] = reflect.ValueOf(*req)
+		},
+		MsgOnionMessage: func(v []reflect.Value, r *rand.Rand) {
+			pubKey, err := randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate key: %v", err)
+				return
+			}
+
+			req := &OnionMessage{
+				BlindingPoint: pubKey,
+				OnionBlob:     make([]byte, OnionPacketSize),
+				ExtraData:     make([]byte, 0),
+			}
+			if _, err := r.Read(req.OnionBlob); err != nil {
+				t.Fatalf("unable to generate onion blob: %v",
+					err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(*req)
+		},
+		MsgOpenChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := OpenChannel2{
+				FundingFeePerKiloWeight: uint32(r.Int63()),
+				CommitFeePerKiloWeight:  uint32(r.Int63()),
+				FundingAmount:           bronutil.Amount(r.Int63()),
+				DustLimit:               bronutil.Amount(r.Int63()),
+				MaxValueInFlight:        MilliBronees(r.Int63()),
+				HtlcMinimum:             MilliBronees(r.Int31()),
+				CsvDelay:                uint16(r.Int31()),
+				MaxAcceptedHTLCs:        uint16(r.Int31()),
+				LockTime:                uint32(r.Int31()),
+				ChannelFlags:            FundingFlag(uint8(r.Int31())),
+			}
+
+			if _, err := r.Read(req.ChainHash[:]); err != nil {
+				t.Fatalf("unable to generate chain hash: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			for _, key := range []**bronec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			} {
+				var err error
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v", err)
+					return
+				}
+			}
+
+			// The channel type is always set, as the extra data
+			// would otherwise be encoded as nil but decoded as an
+			// empty slice.
+			req.ChannelType = new(ChannelType)
+			*req.ChannelType = ChannelType(*randRawFeatureVector(r))
+
+			// 1/2 chance empty upfront shutdown script.
+			if r.Intn(2) == 0 {
+				var err error
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery address: %v", err)
+					return
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgAcceptChannel2: func(v []reflect.Value, r *rand.Rand) {
+			req := AcceptChannel2{
+				FundingAmount:    bronutil.Amount(r.Int63()),
+				DustLimit:        bronutil.Amount(r.Int63()),
+				MaxValueInFlight: MilliBronees(r.Int63()),
+				HtlcMinimum:      MilliBronees(r.Int31()),
+				MinAcceptDepth:   uint32(r.Int31()),
+				CsvDelay:         uint16(r.Int31()),
+				MaxAcceptedHTLCs: uint16(r.Int31()),
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			for _, key := range []**bronec.PublicKey{
+				&req.FundingKey, &req.RevocationPoint,
+				&req.PaymentPoint, &req.DelayedPaymentPoint,
+				&req.HtlcPoint, &req.FirstCommitmentPoint,
+				&req.SecondCommitmentPoint,
+			} {
+				var err error
+				*key, err = randPubKey()
+				if err != nil {
+					t.Fatalf("unable to generate key: %v", err)
+					return
+				}
+			}
+
+			// The channel type is always set, as the extra data
+			// would otherwise be encoded as nil but decoded as an
+			// empty slice.
+			req.ChannelType = new(ChannelType)
+			*req.ChannelType = ChannelType(*randRawFeatureVector(r))
+
+			// 1/2 chance empty upfront shutdown script.
+			if r.Intn(2) == 0 {
+				var err error
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate delivery address: %v", err)
+					return
+				}
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddInput{
+				SerialID:   uint64(r.Int63()),
+				PrevTxVout: uint32(r.Int31()),
+				Sequence:   uint32(r.Int63()),
+				ExtraData:  make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			var prevOut wire.OutPoint
+			if _, err := r.Read(prevOut.Hash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+			prevOut.Index = uint32(r.Int31())
+
+			pkScript := make([]byte, 22)
+			if _, err := r.Read(pkScript); err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
+
+			req.PrevTx = wire.NewMsgTx(2)
+			req.PrevTx.AddTxIn(wire.NewTxIn(&prevOut, []byte{}, nil))
+			req.PrevTx.AddTxOut(wire.NewTxOut(r.Int63(), pkScript))
+
+			// 1/2 chance of adding the shared input of a splice
+			// instead, which is sent without its transaction.
+			if r.Intn(2) == 0 {
+				req.PrevTx = nil
+				req.SharedInputTxID = &prevOut.Hash
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				SerialID:  uint64(r.Int63()),
+				Amount:    bronutil.Amount(r.Int63()),
+				PkScript:  make([]byte, r.Intn(35)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate script: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxRemoveInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxRemoveInput{
+				SerialID:  uint64(r.Int63()),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxRemoveOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxRemoveOutput{
+				SerialID:  uint64(r.Int63()),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxComplete: func(v []reflect.Value, r *rand.Rand) {
+			req := TxComplete{
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			req := TxSignatures{
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.TxID[:]); err != nil {
+				t.Fatalf("unable to generate txid: %v", err)
+				return
+			}
+
+			req.Witnesses = make([]wire.TxWitness, r.Intn(4))
+			for i := range req.Witnesses {
+				req.Witnesses[i] = wire.TxWitness{
+					make([]byte, 72), make([]byte, 33),
+				}
+				for _, item := range req.Witnesses[i] {
+					if _, err := r.Read(item); err != nil {
+						t.Fatalf("unable to generate "+
+							"witness: %v", err)
+						return
+					}
+				}
+			}
+
+			// 1/2 chance of including a shared input signature.
+			if r.Intn(2) == 0 {
+				sig, err := NewSigFromSignature(testSig)
+				if err != nil {
+					t.Fatalf("unable to parse sig: %v", err)
+					return
+				}
+				req.SharedInputSig = &sig
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAbort: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAbort{
+				Data:      make([]byte, r.Intn(100)),
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.Data); err != nil {
+				t.Fatalf("unable to generate data: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceInit: func(v []reflect.Value, r *rand.Rand) {
+			// The contribution is negative if funds are spliced
+			// out.
+			contribution := bronutil.Amount(r.Int63() - r.Int63())
+			req := SpliceInit{
+				FundingContribution:     contribution,
+				FundingFeePerKiloWeight: uint32(r.Int63()),
+				LockTime:                uint32(r.Int31()),
+				ExtraData:               make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			var err error
+			req.FundingKey, err = randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate key: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceAck: func(v []reflect.Value, r *rand.Rand) {
+			contribution := bronutil.Amount(r.Int63() - r.Int63())
+			req := SpliceAck{
+				FundingContribution: contribution,
+				ExtraData:           make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			var err error
+			req.FundingKey, err = randPubKey()
+			if err != nil {
+				t.Fatalf("unable to generate key: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgSpliceLocked: func(v []reflect.Value, r *rand.Rand) {
+			req := SpliceLocked{
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChannelID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			if _, err := r.Read(req.SpliceTxID[:]); err != nil {
+				t.Fatalf("unable to generate txid: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgStfu: func(v []reflect.Value, r *rand.Rand) {
+			req := Stfu{
+				Initiator: r.Intn(2) == 0,
+				ExtraData: make([]byte, 0),
+			}
+
+			if _, err := r.Read(req.ChanID[:]); err != nil {
+				t.Fatalf("unable to generate chan id: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
 			req := ClosingSigned{
 				FeeBroneess: bronutil.Amount(r.Int63()),
@@ -1062,6 +1463,90 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgOnionMessage,
+			scenario: func(m OnionMessage) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgOpenChannel2,
+			scenario: func(m OpenChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgAcceptChannel2,
+			scenario: func(m AcceptChannel2) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveInput,
+			scenario: func(m TxRemoveInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxRemoveOutput,
+			scenario: func(m TxRemoveOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAbort,
+			scenario: func(m TxAbort) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceLocked,
+			scenario: func(m SpliceLocked) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStfu,
+			scenario: func(m Stfu) bool {
+				return mainScenario(&m)
+			},
+		},
 	}
 	for _, test := range tests {
 		var config *quick.Config
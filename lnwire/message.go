@@ -22,7 +22,8 @@ type MessageType uint16
 // The currently defined message types within this current version of the
 // Lightning protocol.
 const (
-	MsgInit                    MessageType = 16
+	MsgStfu                    MessageType = 2
+	MsgInit                                = 16
 	MsgError                               = 17
 	MsgPing                                = 18
 	MsgPong                                = 19
@@ -33,6 +34,18 @@ const (
 	MsgFundingLocked                       = 36
 	MsgShutdown                            = 38
 	MsgClosingSigned                       = 39
+	MsgOpenChannel2                        = 64
+	MsgAcceptChannel2                      = 65
+	MsgTxAddInput                          = 66
+	MsgTxAddOutput                         = 67
+	MsgTxRemoveInput                       = 68
+	MsgTxRemoveOutput                      = 69
+	MsgTxComplete                          = 70
+	MsgTxSignatures                        = 71
+	MsgTxAbort                             = 74
+	MsgSpliceLocked                        = 77
+	MsgSpliceInit                          = 80
+	MsgSpliceAck                           = 81
 	MsgUpdateAddHTLC                       = 128
 	MsgUpdateFulfillHTLC                   = 130
 	MsgUpdateFailHTLC                      = 131
@@ -50,6 +63,7 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+	MsgOnionMessage                        = 513
 )
 
 // ErrorEncodeMessage is used when failed to encode the message payload.
@@ -132,6 +146,34 @@ func (t MessageType) String() string {
 		return "ReplyChannelRange"
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
+	case MsgOnionMessage:
+		return "OnionMessage"
+	case MsgOpenChannel2:
+		return "MsgOpenChannel2"
+	case MsgAcceptChannel2:
+		return "MsgAcceptChannel2"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxRemoveInput:
+		return "TxRemoveInput"
+	case MsgTxRemoveOutput:
+		return "TxRemoveOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
+	case MsgTxAbort:
+		return "TxAbort"
+	case MsgStfu:
+		return "Stfu"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAck:
+		return "SpliceAck"
+	case MsgSpliceLocked:
+		return "SpliceLocked"
 	default:
 		return "<unknown>"
 	}
@@ -232,6 +274,34 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &ReplyChannelRange{}
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
+	case MsgOnionMessage:
+		msg = &OnionMessage{}
+	case MsgOpenChannel2:
+		msg = &OpenChannel2{}
+	case MsgAcceptChannel2:
+		msg = &AcceptChannel2{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxRemoveInput:
+		msg = &TxRemoveInput{}
+	case MsgTxRemoveOutput:
+		msg = &TxRemoveOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
+	case MsgTxAbort:
+		msg = &TxAbort{}
+	case MsgStfu:
+		msg = &Stfu{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAck:
+		msg = &SpliceAck{}
+	case MsgSpliceLocked:
+		msg = &SpliceLocked{}
 	default:
 		if msgType < CustomTypeStart {
 			return nil, &UnknownMessage{msgType}
@@ -0,0 +1,98 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/brsuite/brond/bronec"
+)
+
+// OnionMessage is sent by a node to relay an onion encrypted message to a
+// peer. Onion messages are routed over blinded paths: the blinding point
+// allows the receiving node to derive the shared secret needed to decrypt
+// its own route data, and to process the onion that was encrypted to its
+// blinded node ID.
+type OnionMessage struct {
+	// BlindingPoint is the route blinding ephemeral public key that the
+	// receiving node uses to process the onion.
+	BlindingPoint *bronec.PublicKey
+
+	// OnionBlob is the serialized Sphinx packet that carries the message.
+	OnionBlob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// NewOnionMessage creates a new OnionMessage.
+func NewOnionMessage(blindingPoint *bronec.PublicKey,
+	onion []byte) *OnionMessage {
+
+	return &OnionMessage{
+		BlindingPoint: blindingPoint,
+		OnionBlob:     onion,
+	}
+}
+
+// A compile-time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// Decode deserializes a serialized OnionMessage stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, pver uint32) error {
+	if err := ReadElement(r, &o.BlindingPoint); err != nil {
+		return err
+	}
+
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return err
+	}
+
+	o.OnionBlob = make([]byte, binary.BigEndian.Uint16(l[:]))
+	if _, err := io.ReadFull(r, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return o.ExtraData.Decode(r)
+}
+
+// Encode serializes the target OnionMessage into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WritePublicKey(w, o.BlindingPoint); err != nil {
+		return err
+	}
+
+	if len(o.OnionBlob) > MaxMsgBody {
+		return fmt.Errorf("onion message packet too large: %d bytes",
+			len(o.OnionBlob))
+	}
+
+	if err := WriteUint16(w, uint16(len(o.OnionBlob))); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
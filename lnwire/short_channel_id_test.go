@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/stretchr/testify/require"
 )
 
 func TestShortChannelIDEncoding(t *testing.T) {
@@ -39,3 +40,27 @@ func TestShortChannelIDEncoding(t *testing.T) {
 		}
 	}
 }
+
+// TestShortChannelIDRecord tests that we're able to properly encode and decode
+// a ShortChannelID within a TLV stream.
+func TestShortChannelIDRecord(t *testing.T) {
+	t.Parallel()
+
+	alias := ShortChannelID{
+		BlockHeight: 16_000_000,
+		TxIndex:     1,
+		TxPosition:  0,
+	}
+
+	var extraData ExtraOpaqueData
+	require.NoError(t, extraData.PackRecords(&alias))
+
+	var alias2 ShortChannelID
+	tlvs, err := extraData.ExtractRecords(&alias2)
+	require.NoError(t, err)
+
+	require.Contains(t, tlvs, AliasScidRecordType)
+	require.Equal(t, alias, alias2)
+	require.False(t, alias2.IsDefault())
+	require.True(t, ShortChannelID{}.IsDefault())
+}
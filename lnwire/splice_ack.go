@@ -0,0 +1,82 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/bronutil"
+)
+
+// SpliceAck is sent in response to a SpliceInit message to accept the splice,
+// optionally contributing funds to the channel as well.
+type SpliceAck struct {
+	// ChannelID identifies the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount that the sender adds to the
+	// channel, which is negative if it removes funds from the channel.
+	FundingContribution bronutil.Amount
+
+	// FundingKey is the key of the sender within the 2-of-2 multi-sig
+	// output of the splice transaction.
+	FundingKey *bronec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceAck implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAck)(nil)
+
+// Encode serializes the target SpliceAck into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBronees(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceAck message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&s.ChannelID,
+		&s.FundingContribution,
+		&s.FundingKey,
+		&s.ExtraData,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MsgType() MessageType {
+	return MsgSpliceAck
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceAck) TargetChanID() ChannelID {
+	return s.ChannelID
+}
@@ -0,0 +1,101 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/bronutil"
+)
+
+// SpliceInit is sent by the initiator of a splice once the channel is
+// quiescent, to propose a new funding transaction that spends the current
+// funding output. Afterwards, both parties construct the splice transaction
+// interactively.
+type SpliceInit struct {
+	// ChannelID identifies the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount that the initiator adds to the
+	// channel, which is negative if it removes funds from the channel.
+	FundingContribution bronutil.Amount
+
+	// FundingFeePerKiloWeight is the fee rate, in sat per kilo-weight,
+	// that the splice transaction is constructed with.
+	FundingFeePerKiloWeight uint32
+
+	// LockTime is the lock time of the splice transaction.
+	LockTime uint32
+
+	// FundingKey is the key of the initiator within the 2-of-2 multi-sig
+	// output of the splice transaction.
+	FundingKey *bronec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBronees(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.FundingFeePerKiloWeight); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.LockTime); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceInit message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r,
+		&s.ChannelID,
+		&s.FundingContribution,
+		&s.FundingFeePerKiloWeight,
+		&s.LockTime,
+		&s.FundingKey,
+		&s.ExtraData,
+	)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceInit) TargetChanID() ChannelID {
+	return s.ChannelID
+}
@@ -0,0 +1,68 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/brond/chaincfg/chainhash"
+)
+
+// SpliceLocked is sent once the splice transaction has reached the required
+// number of confirmations, after which the previous funding output is no
+// longer used by the sender.
+type SpliceLocked struct {
+	// ChannelID identifies the channel that is spliced.
+	ChannelID ChannelID
+
+	// SpliceTxID is the ID of the splice transaction that is confirmed.
+	SpliceTxID chainhash.Hash
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceLocked implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceLocked)(nil)
+
+// Encode serializes the target SpliceLocked into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, s.SpliceTxID[:]); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceLocked message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChannelID, s.SpliceTxID[:], &s.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MsgType() MessageType {
+	return MsgSpliceLocked
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *SpliceLocked) TargetChanID() ChannelID {
+	return s.ChannelID
+}
@@ -0,0 +1,69 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// Stfu is sent by either party to request the channel to become quiescent,
+// i.e. to stop sending updates. It may only be sent once all updates of the
+// sender are irrevocably committed on both commitments. Once both parties have
+// sent it, the channel is quiescent, and protocols that require a clean
+// channel state such as splicing may be carried out.
+type Stfu struct {
+	// ChanID identifies the channel that should become quiescent.
+	ChanID ChannelID
+
+	// Initiator is true if the sender requests the channel to become
+	// quiescent, and false if it responds to an Stfu message of the other
+	// party.
+	Initiator bool
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure Stfu implements the lnwire.Message interface.
+var _ Message = (*Stfu)(nil)
+
+// Encode serializes the target Stfu into the passed io.Writer observing the
+// protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, s.ChanID); err != nil {
+		return err
+	}
+
+	if err := WriteBool(w, s.Initiator); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized Stfu message stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &s.ChanID, &s.Initiator, &s.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MsgType() MessageType {
+	return MsgStfu
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (s *Stfu) TargetChanID() ChannelID {
+	return s.ChanID
+}
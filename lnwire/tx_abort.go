@@ -0,0 +1,66 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxAbort is sent to abort the interactive construction of a transaction, or
+// the signing of a transaction that hasn't been broadcast yet.
+type TxAbort struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// Data is an optional human readable reason for the abort.
+	Data ErrorData
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAbort implements the lnwire.Message
+// interface.
+var _ Message = (*TxAbort)(nil)
+
+// Encode serializes the target TxAbort into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteErrorData(w, t.Data); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAbort message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &t.ChannelID, &t.Data, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) MsgType() MessageType {
+	return MsgTxAbort
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAbort) TargetChanID() ChannelID {
+	return t.ChannelID
+}
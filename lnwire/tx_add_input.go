@@ -0,0 +1,185 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+	"github.com/brsuite/brond/wire"
+)
+
+// SharedInputTxIDRecordType is the type of the TLV record within the extra
+// data of a TxAddInput that marks the input as the shared input of a splice.
+const SharedInputTxIDRecordType tlv.Type = 0
+
+// TxAddInput is sent during the interactive construction of a transaction to
+// add an input to it.
+type TxAddInput struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID uniquely identifies the input within the transaction and
+	// determines its position. The initiator of the construction uses even
+	// serial IDs, the other party odd ones.
+	SerialID uint64
+
+	// PrevTx is the transaction that contains the output that is spent by
+	// the input, which lets the receiver verify the amount and script of
+	// that output. It is nil for the shared input of a splice.
+	PrevTx *wire.MsgTx
+
+	// PrevTxVout is the index of the output of PrevTx that is spent.
+	PrevTxVout uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// SharedInputTxID is set if the input spends the current funding
+	// output of a channel that is spliced, in which case it is the txid of
+	// the funding transaction. Both parties know that output already, so
+	// PrevTx is omitted.
+	SharedInputTxID *chainhash.Hash
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, pver uint32) error {
+	// We'll only encode the SharedInputTxID in a TLV segment if it exists.
+	if t.SharedInputTxID != nil {
+		err := EncodeMessageExtraData(
+			&t.ExtraData, &sharedInputTxIDRecord{t.SharedInputTxID},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	// The shared input of a splice is sent without its previous
+	// transaction, which is signaled by a zero length.
+	var prevTx bytes.Buffer
+	if t.PrevTx != nil {
+		if err := t.PrevTx.Serialize(&prevTx); err != nil {
+			return err
+		}
+	}
+	if err := WriteUint16(w, uint16(prevTx.Len())); err != nil {
+		return err
+	}
+	if err := WriteBytes(w, prevTx.Bytes()); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxVout); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddInput message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	var prevTxLen uint16
+	err := ReadElements(r, &t.ChannelID, &t.SerialID, &prevTxLen)
+	if err != nil {
+		return err
+	}
+
+	if prevTxLen > 0 {
+		prevTx := make([]byte, prevTxLen)
+		if _, err := io.ReadFull(r, prevTx); err != nil {
+			return err
+		}
+
+		t.PrevTx = &wire.MsgTx{}
+		err := t.PrevTx.Deserialize(bytes.NewReader(prevTx))
+		if err != nil {
+			return err
+		}
+	}
+
+	var tlvRecords ExtraOpaqueData
+	err = ReadElements(r, &t.PrevTxVout, &t.Sequence, &tlvRecords)
+	if err != nil {
+		return err
+	}
+
+	// Next we'll parse out the set of known records. For now, this is
+	// just the SharedInputTxIDRecordType.
+	var sharedInputTxID chainhash.Hash
+	typeMap, err := tlvRecords.ExtractRecords(
+		&sharedInputTxIDRecord{&sharedInputTxID},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputTxID if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[SharedInputTxIDRecordType]; ok && val == nil {
+		t.SharedInputTxID = &sharedInputTxID
+	}
+
+	t.ExtraData = tlvRecords
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddInput) TargetChanID() ChannelID {
+	return t.ChannelID
+}
+
+// sharedInputTxIDRecord is a tlv.RecordProducer that encodes the txid of the
+// shared input of a TxAddInput as a TLV record.
+type sharedInputTxIDRecord struct {
+	txid *chainhash.Hash
+}
+
+// Record returns a TLV record that can be used to encode/decode the txid
+// to/from a TLV stream.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (s *sharedInputTxIDRecord) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(
+		SharedInputTxIDRecordType, (*[32]byte)(s.txid),
+	)
+}
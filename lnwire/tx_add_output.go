@@ -0,0 +1,100 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/bronutil"
+)
+
+// TxAddOutput is sent during the interactive construction of a transaction to
+// add an output to it.
+type TxAddOutput struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID uniquely identifies the output within the transaction and
+	// determines its position. The initiator of the construction uses even
+	// serial IDs, the other party odd ones.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount bronutil.Amount
+
+	// PkScript is the script of the output.
+	PkScript PkScript
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteBronees(w, t.Amount); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, uint16(len(t.PkScript))); err != nil {
+		return err
+	}
+	if err := WriteBytes(w, t.PkScript); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddOutput message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, pver uint32) error {
+	var scriptLen uint16
+	err := ReadElements(
+		r, &t.ChannelID, &t.SerialID, &t.Amount, &scriptLen,
+	)
+	if err != nil {
+		return err
+	}
+
+	t.PkScript = make([]byte, scriptLen)
+	if _, err := io.ReadFull(r, t.PkScript); err != nil {
+		return err
+	}
+
+	return ReadElements(r, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxAddOutput) TargetChanID() ChannelID {
+	return t.ChannelID
+}
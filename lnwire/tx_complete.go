@@ -0,0 +1,60 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxComplete is sent during the interactive construction of a transaction to
+// signal that the sender has no further inputs or outputs to add. The
+// construction is finished once both parties have sent it consecutively.
+type TxComplete struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.Message
+// interface.
+var _ Message = (*TxComplete)(nil)
+
+// Encode serializes the target TxComplete into the passed io.Writer observing
+// the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxComplete message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &t.ChannelID, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MsgType() MessageType {
+	return MsgTxComplete
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxComplete) TargetChanID() ChannelID {
+	return t.ChannelID
+}
@@ -0,0 +1,66 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxRemoveInput is sent during the interactive construction of a transaction
+// to remove an input that the sender added before.
+type TxRemoveInput struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID is the serial ID of the input to remove.
+	SerialID uint64
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxRemoveInput implements the
+// lnwire.Message interface.
+var _ Message = (*TxRemoveInput)(nil)
+
+// Encode serializes the target TxRemoveInput into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) Encode(w *bytes.Buffer, pver uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxRemoveInput message stored in the
+// passed io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) Decode(r io.Reader, pver uint32) error {
+	return ReadElements(r, &t.ChannelID, &t.SerialID, &t.ExtraData)
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) MsgType() MessageType {
+	return MsgTxRemoveInput
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxRemoveInput) TargetChanID() ChannelID {
+	return t.ChannelID
+}
@@ -0,0 +1,206 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+	"github.com/brsuite/brond/wire"
+)
+
+// SharedInputSigRecordType is the type of the TLV record within the extra data
+// of a TxSignatures that carries the signature for the shared input of a
+// splice.
+const SharedInputSigRecordType tlv.Type = 0
+
+// TxSignatures is sent once an interactively constructed transaction is
+// complete, and carries the witnesses for all inputs that the sender added to
+// it.
+type TxSignatures struct {
+	// ChannelID identifies the channel that the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// TxID is the ID of the transaction that is signed.
+	TxID chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs that the sender added to
+	// the transaction, ordered by their serial IDs.
+	Witnesses []wire.TxWitness
+
+	// SharedInputSig is the sender's signature for the shared input of a
+	// splice transaction, which spends the current funding output of the
+	// channel. The shared input isn't included in Witnesses, as its
+	// witness can only be assembled once both signatures are known.
+	SharedInputSig *Sig
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, pver uint32) error {
+	// We'll only encode the SharedInputSig in a TLV segment if it exists.
+	if t.SharedInputSig != nil {
+		err := EncodeMessageExtraData(
+			&t.ExtraData, &sharedInputSigRecord{t.SharedInputSig},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxID[:]); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, uint16(len(t.Witnesses))); err != nil {
+		return err
+	}
+
+	// Each witness is written in the format of the transaction
+	// serialization, prefixed with its length.
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		err := wire.WriteVarInt(&b, 0, uint64(len(witness)))
+		if err != nil {
+			return err
+		}
+		for _, item := range witness {
+			if err := wire.WriteVarBytes(&b, 0, item); err != nil {
+				return err
+			}
+		}
+
+		if err := WriteUint16(w, uint16(b.Len())); err != nil {
+			return err
+		}
+		if err := WriteBytes(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxSignatures message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	var numWitnesses uint16
+	err := ReadElements(r, &t.ChannelID, t.TxID[:], &numWitnesses)
+	if err != nil {
+		return err
+	}
+
+	t.Witnesses = make([]wire.TxWitness, 0, numWitnesses)
+	for i := 0; i < int(numWitnesses); i++ {
+		var witnessLen uint16
+		if err := ReadElements(r, &witnessLen); err != nil {
+			return err
+		}
+
+		witnessBytes := make([]byte, witnessLen)
+		if _, err := io.ReadFull(r, witnessBytes); err != nil {
+			return err
+		}
+
+		witnessReader := bytes.NewReader(witnessBytes)
+		numItems, err := wire.ReadVarInt(witnessReader, 0)
+		if err != nil {
+			return err
+		}
+
+		// Each item takes at least one byte, so the number of items
+		// is bounded by the length of the witness.
+		if numItems > uint64(witnessLen) {
+			return io.ErrUnexpectedEOF
+		}
+
+		witness := make(wire.TxWitness, 0, numItems)
+		for j := uint64(0); j < numItems; j++ {
+			item, err := wire.ReadVarBytes(
+				witnessReader, 0, uint32(witnessLen),
+				"witness item",
+			)
+			if err != nil {
+				return err
+			}
+			witness = append(witness, item)
+		}
+
+		t.Witnesses = append(t.Witnesses, witness)
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	// Next we'll parse out the set of known records. For now, this is
+	// just the SharedInputSigRecordType.
+	var sharedInputSig Sig
+	typeMap, err := tlvRecords.ExtractRecords(
+		&sharedInputSigRecord{&sharedInputSig},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set SharedInputSig if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[SharedInputSigRecordType]; ok && val == nil {
+		t.SharedInputSig = &sharedInputSig
+	}
+
+	t.ExtraData = tlvRecords
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// TargetChanID returns the channel id of the link for which this message is
+// intended.
+//
+// NOTE: Part of peer.LinkUpdater interface.
+func (t *TxSignatures) TargetChanID() ChannelID {
+	return t.ChannelID
+}
+
+// sharedInputSigRecord is a tlv.RecordProducer that encodes the shared input
+// signature of a TxSignatures as a TLV record.
+type sharedInputSigRecord struct {
+	sig *Sig
+}
+
+// Record returns a TLV record that can be used to encode/decode the signature
+// to/from a TLV stream.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (s *sharedInputSigRecord) Record() tlv.Record {
+	return tlv.MakePrimitiveRecord(
+		SharedInputSigRecordType, (*[64]byte)(s.sig),
+	)
+}
@@ -0,0 +1,63 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/brsuite/broln/tlv"
+)
+
+const (
+	// FeeRecordType is the type of the experimental record used to
+	// communicate inbound fees within the extra opaque data of a channel
+	// update.
+	FeeRecordType tlv.Type = 55555
+)
+
+// Fee represents a fee schedule. Unlike the regular fee fields of a channel
+// update, both values are signed so that the fee can also be a discount.
+type Fee struct {
+	// BaseFee is the fixed part of the fee in milli-bronees.
+	BaseFee int32
+
+	// FeeRate is the proportional part of the fee in parts per million.
+	FeeRate int32
+}
+
+// Record returns a TLV record that can be used to encode/decode the Fee type
+// from a given TLV stream.
+func (f *Fee) Record() tlv.Record {
+	return tlv.MakeStaticRecord(FeeRecordType, f, 8, feeEncoder, feeDecoder)
+}
+
+// feeEncoder is a custom TLV encoder for the Fee record.
+func feeEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*Fee); ok {
+		if err := tlv.EUint32T(w, uint32(v.BaseFee), buf); err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, uint32(v.FeeRate), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.Fee")
+}
+
+// feeDecoder is a custom TLV decoder for the Fee record.
+func feeDecoder(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*Fee); ok && l == 8 {
+		var baseFee, feeRate uint32
+		if err := tlv.DUint32(r, &baseFee, buf, 4); err != nil {
+			return err
+		}
+		if err := tlv.DUint32(r, &feeRate, buf, 4); err != nil {
+			return err
+		}
+
+		v.BaseFee = int32(baseFee)
+		v.FeeRate = int32(feeRate)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.Fee", l, 8)
+}
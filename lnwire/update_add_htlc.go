@@ -3,6 +3,9 @@ package lnwire
 import (
 	"bytes"
 	"io"
+
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
 )
 
 // OnionPacketSize is the size of the serialized Sphinx onion packet included
@@ -11,6 +14,10 @@ import (
 // of per-hop data, and a 32-byte HMAC over the entire packet.
 const OnionPacketSize = 1366
 
+// BlindingPointRecordType is the type of the TLV record within the extra data
+// of an UpdateAddHTLC that carries the route blinding point.
+const BlindingPointRecordType tlv.Type = 0
+
 // UpdateAddHTLC is the message sent by Alice to Bob when she wishes to add an
 // HTLC to his remote commitment transaction. In addition to information
 // detailing the value, the ID, expiry, and the onion blob is also included
@@ -54,6 +61,12 @@ type UpdateAddHTLC struct {
 	// used in the subsequent UpdateAddHTLC message.
 	OnionBlob [OnionPacketSize]byte
 
+	// BlindingPoint is the ephemeral public key that the receiving node
+	// uses to derive the shared secrets needed to process the HTLC if it
+	// is part of a blinded route. It is only set for HTLCs sent to nodes
+	// within a blinded route that aren't the introduction node.
+	BlindingPoint *bronec.PublicKey
+
 	// ExtraData is the set of data that was appended to this message to
 	// fill out the full maximum transport message size. These fields can
 	// be used to specify optional data such as custom TLV fields.
@@ -74,15 +87,43 @@ var _ Message = (*UpdateAddHTLC)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	// Read all the mandatory fields in the message.
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.ID,
 		&c.Amount,
 		c.PaymentHash[:],
 		&c.Expiry,
 		c.OnionBlob[:],
-		&c.ExtraData,
 	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	// Next we'll parse out the set of known records. For now, this is
+	// just the BlindingPointRecordType.
+	var blindingPoint *bronec.PublicKey
+	typeMap, err := tlvRecords.ExtractRecords(
+		&blindingPointRecord{&blindingPoint},
+	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only set BlindingPoint if the corresponding TLV type was
+	// included in the stream.
+	if val, ok := typeMap[BlindingPointRecordType]; ok && val == nil {
+		c.BlindingPoint = blindingPoint
+	}
+
+	c.ExtraData = tlvRecords
+
+	return nil
 }
 
 // Encode serializes the target UpdateAddHTLC into the passed io.Writer
@@ -90,6 +131,16 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Encode(w *bytes.Buffer, pver uint32) error {
+	// We'll only encode the BlindingPoint in a TLV segment if it exists.
+	if c.BlindingPoint != nil {
+		err := EncodeMessageExtraData(
+			&c.ExtraData, &blindingPointRecord{&c.BlindingPoint},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := WriteChannelID(w, c.ChanID); err != nil {
 		return err
 	}
@@ -132,3 +183,20 @@ func (c *UpdateAddHTLC) MsgType() MessageType {
 func (c *UpdateAddHTLC) TargetChanID() ChannelID {
 	return c.ChanID
 }
+
+// blindingPointRecord is a tlv.RecordProducer that encodes the blinding point
+// of an UpdateAddHTLC as a TLV record.
+type blindingPointRecord struct {
+	pubKey **bronec.PublicKey
+}
+
+// Record returns a TLV record that can be used to encode/decode the blinding
+// point to/from a TLV stream.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (b *blindingPointRecord) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		BlindingPointRecordType, b.pubKey, 33, tlv.EPubKey,
+		tlv.DPubKey,
+	)
+}
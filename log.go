@@ -19,6 +19,7 @@ import (
 	"github.com/brsuite/broln/invoices"
 	"github.com/brsuite/broln/lnrpc/autopilotrpc"
 	"github.com/brsuite/broln/lnrpc/chainrpc"
+	"github.com/brsuite/broln/lnrpc/devrpc"
 	"github.com/brsuite/broln/lnrpc/invoicesrpc"
 	"github.com/brsuite/broln/lnrpc/routerrpc"
 	"github.com/brsuite/broln/lnrpc/signrpc"
@@ -31,14 +32,18 @@ import (
 	"github.com/brsuite/broln/lnwallet/rpcwallet"
 	"github.com/brsuite/broln/monitoring"
 	"github.com/brsuite/broln/netann"
+	"github.com/brsuite/broln/offers"
+	"github.com/brsuite/broln/onionmessage"
 	"github.com/brsuite/broln/peer"
 	"github.com/brsuite/broln/peernotifier"
 	"github.com/brsuite/broln/routing"
 	"github.com/brsuite/broln/routing/localchans"
+	"github.com/brsuite/broln/routing/prober"
 	"github.com/brsuite/broln/rpcperms"
 	"github.com/brsuite/broln/signal"
 	"github.com/brsuite/broln/sweep"
 	"github.com/brsuite/broln/tor"
+	"github.com/brsuite/broln/trampoline"
 	"github.com/brsuite/broln/watchtower"
 	"github.com/brsuite/broln/watchtower/wtclient"
 	"github.com/brsuite/brond/connmgr"
@@ -153,11 +158,16 @@ func SetupLoggers(root *build.RotatingLogWriter, interceptor signal.Interceptor)
 	AddSubLogger(root, "CHFD", interceptor, chanfunding.UseLogger)
 	AddSubLogger(root, "PEER", interceptor, peer.UseLogger)
 	AddSubLogger(root, "CHCL", interceptor, chancloser.UseLogger)
+	AddSubLogger(root, "OMSG", interceptor, onionmessage.UseLogger)
+	AddSubLogger(root, "TRMP", interceptor, trampoline.UseLogger)
 
 	AddSubLogger(root, routing.Subsystem, interceptor, routing.UseLogger, localchans.UseLogger)
+	AddSubLogger(root, prober.Subsystem, interceptor, prober.UseLogger)
+	AddSubLogger(root, offers.Subsystem, interceptor, offers.UseLogger)
 	AddSubLogger(root, routerrpc.Subsystem, interceptor, routerrpc.UseLogger)
 	AddSubLogger(root, chanfitness.Subsystem, interceptor, chanfitness.UseLogger)
 	AddSubLogger(root, verrpc.Subsystem, interceptor, verrpc.UseLogger)
+	AddSubLogger(root, devrpc.Subsystem, interceptor, devrpc.UseLogger)
 	AddSubLogger(root, healthcheck.Subsystem, interceptor, healthcheck.UseLogger)
 	AddSubLogger(root, chainreg.Subsystem, interceptor, chainreg.UseLogger)
 	AddSubLogger(root, chanacceptor.Subsystem, interceptor, chanacceptor.UseLogger)
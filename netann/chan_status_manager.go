@@ -52,10 +52,10 @@ type ChanStatusConfig struct {
 	MessageSigner lnwallet.MessageSigner
 
 	// IsChannelActive checks whether the channel identified by the provided
-	// ChannelID is considered active. This should only return true if the
-	// channel has been sufficiently confirmed, the channel has received
-	// FundingLocked, and the remote peer is online.
-	IsChannelActive func(lnwire.ChannelID) bool
+	// funding outpoint is considered active. This should only return true
+	// if the channel has been sufficiently confirmed, the channel has
+	// received FundingLocked, and the remote peer is online.
+	IsChannelActive func(wire.OutPoint) bool
 
 	// ApplyChannelUpdate processes new ChannelUpdates signed by our node by
 	// updating our local routing table and broadcasting the update to our
@@ -385,8 +385,7 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint,
 
 	// Quickly check to see if the requested channel is active within the
 	// htlcswitch and return an error if it isn't.
-	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
-	if !m.cfg.IsChannelActive(chanID) {
+	if !m.cfg.IsChannelActive(outpoint) {
 		return ErrEnableInactiveChan
 	}
 
@@ -522,8 +521,7 @@ func (m *ChanStatusManager) markPendingInactiveChannels() {
 		// If our bookkeeping shows the channel as active, sample the
 		// htlcswitch to see if it believes the link is also active. If
 		// so, we will skip marking it as ChanStatusPendingDisabled.
-		chanID := lnwire.NewChanIDFromOutPoint(&c.FundingOutpoint)
-		if m.cfg.IsChannelActive(chanID) {
+		if m.cfg.IsChannelActive(c.FundingOutpoint) {
 			continue
 		}
 
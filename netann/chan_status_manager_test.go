@@ -284,10 +284,12 @@ func newMockSwitch() *mockSwitch {
 	}
 }
 
-func (s *mockSwitch) HasActiveLink(chanID lnwire.ChannelID) bool {
+func (s *mockSwitch) HasActiveLink(chanPoint wire.OutPoint) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+
 	// If the link is found, we will returns it's active status. In the
 	// real switch, it returns EligibleToForward().
 	active, ok := s.isActive[chanID]
@@ -36,6 +36,15 @@ func ChanUpdSetDisable(disabled bool) ChannelUpdateModifier {
 	}
 }
 
+// ChanUpdSetScid is a functional option that sets the short channel ID of the
+// update to the given one. This is used to hand out updates for channels that
+// our peer knows by an alias rather than their real short channel ID.
+func ChanUpdSetScid(scid lnwire.ShortChannelID) ChannelUpdateModifier {
+	return func(update *lnwire.ChannelUpdate) {
+		update.ShortChannelID = scid
+	}
+}
+
 // ChanUpdSetTimestamp is a functional option that sets the timestamp of the
 // update to the current time, or increments it if the timestamp is already in
 // the future.
@@ -0,0 +1,644 @@
+// Package offers issues BOLT12 offers and pays them. Payers request an invoice
+// for an offer from its issuer over onion messages, and the issuer replies
+// with an invoice that is paid over its blinded paths.
+package offers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/brsuite/broln/bolt12"
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/onionmessage"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/subscribe"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg"
+	"github.com/brsuite/brond/chaincfg/chainhash"
+)
+
+const (
+	// DefaultFetchTimeout is the default time that we wait for the issuer
+	// of an offer to reply to an invoice request.
+	DefaultFetchTimeout = time.Minute
+
+	// InvoiceExpiry is the expiry of the invoices that we create for
+	// invoice requests.
+	InvoiceExpiry = bolt12.DefaultInvoiceExpiry
+
+	// metadataLength is the length of the random metadata of our offers
+	// and invoice requests.
+	metadataLength = 32
+)
+
+var (
+	// ErrFetchTimeout is returned when the issuer of an offer doesn't
+	// reply to an invoice request in time.
+	ErrFetchTimeout = errors.New("timeout waiting for invoice")
+
+	// ErrUnknownOffer is returned when an invoice is requested for an
+	// offer that we haven't issued.
+	ErrUnknownOffer = errors.New("unknown offer")
+
+	// ErrOfferExpired is returned when an invoice is requested for an
+	// offer that has expired.
+	ErrOfferExpired = errors.New("offer expired")
+
+	// ErrOfferDisabled is returned when an invoice is requested for an
+	// offer that has been disabled.
+	ErrOfferDisabled = errors.New("offer disabled")
+
+	// ErrUnsupportedChain is returned when an offer or invoice request
+	// isn't for the chain that we are on.
+	ErrUnsupportedChain = errors.New("chain not supported")
+
+	// ErrUnknownRequiredFeatures is returned when a message requires
+	// features that we don't know.
+	ErrUnknownRequiredFeatures = errors.New("unknown required features")
+
+	// ErrInvoiceMismatch is returned when an invoice doesn't mirror the
+	// invoice request that it was requested with.
+	ErrInvoiceMismatch = errors.New("invoice doesn't match invoice " +
+		"request")
+
+	// ErrInvoiceExpired is returned when the invoice that we received in
+	// reply to an invoice request has already expired.
+	ErrInvoiceExpired = errors.New("invoice expired")
+)
+
+// Messenger sends and receives onion messages.
+type Messenger interface {
+	// SendMessage sends an onion message as described by the request,
+	// returning the path ID of the reply path if one was requested.
+	SendMessage(req *onionmessage.SendRequest) ([]byte, error)
+
+	// SubscribeMessages returns a client that receives the onion messages
+	// for which we are the final recipient.
+	SubscribeMessages() (*subscribe.Client, error)
+}
+
+// Config contains the dependencies of the Manager.
+type Config struct {
+	// Messenger is used to exchange invoice requests and invoices.
+	Messenger Messenger
+
+	// Store persists the offers that we've issued.
+	Store *channeldb.OfferStore
+
+	// NodePubKey is the public key of our node, which is the issuer id of
+	// our offers and signs our invoices.
+	NodePubKey *bronec.PublicKey
+
+	// ChainParams are the parameters of the chain that we are on.
+	ChainParams *chaincfg.Params
+
+	// AddInvoice adds an invoice for the given amount to the invoice
+	// registry, returning its payment hash and the blinded paths that it
+	// can be paid over.
+	AddInvoice func(amt lnwire.MilliBronees, memo string,
+		expiry time.Duration) (lntypes.Hash,
+		[]*blindedpath.BlindedPayment, error)
+
+	// SignInvoice signs the digest of an invoice with our node key.
+	SignInvoice func(digest [32]byte) ([64]byte, error)
+
+	// FetchTimeout is the time that we wait for the issuer of an offer to
+	// reply to an invoice request.
+	FetchTimeout time.Duration
+
+	// Clock is the time source of the manager.
+	Clock clock.Clock
+}
+
+// Manager issues offers and replies to invoice requests for them, and fetches
+// invoices for the offers of others.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// pending maps the path IDs of the reply paths of our invoice requests
+	// to the channel that the reply is delivered on.
+	pending   map[string]chan *onionmessage.ReceivedMessage
+	pendingMu sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new offers manager.
+func New(cfg *Config) *Manager {
+	return &Manager{
+		cfg:     cfg,
+		pending: make(map[string]chan *onionmessage.ReceivedMessage),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts handling the onion messages that are sent to us.
+func (m *Manager) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Offers manager starting")
+
+		var client *subscribe.Client
+		client, err = m.cfg.Messenger.SubscribeMessages()
+		if err != nil {
+			return
+		}
+
+		m.wg.Add(1)
+		go m.messageLoop(client)
+	})
+
+	return err
+}
+
+// Stop stops the manager.
+func (m *Manager) Stop() error {
+	m.stopped.Do(func() {
+		log.Info("Offers manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+
+	return nil
+}
+
+// chainHash returns the genesis hash of the chain that we are on.
+func (m *Manager) chainHash() chainhash.Hash {
+	return *m.cfg.ChainParams.GenesisHash
+}
+
+// isMainChain returns true if we are on the main chain, which offers and
+// invoice requests don't need to specify.
+func (m *Manager) isMainChain() bool {
+	return m.chainHash() == *chaincfg.MainNetParams.GenesisHash
+}
+
+// AddOffer issues the offer with our node as issuer, and stores it such that
+// we reply to invoice requests for it. The issuer id, chain and metadata of
+// the offer are set by the manager. The id of the offer is returned.
+func (m *Manager) AddOffer(offer *bolt12.Offer) ([32]byte, error) {
+	offer.IssuerID = m.cfg.NodePubKey
+	if !m.isMainChain() {
+		offer.Chains = []chainhash.Hash{m.chainHash()}
+	}
+
+	// Random metadata gives offers with the same fields different ids.
+	offer.Metadata = make([]byte, metadataLength)
+	if _, err := rand.Read(offer.Metadata); err != nil {
+		return [32]byte{}, err
+	}
+
+	if err := offer.Validate(); err != nil {
+		return [32]byte{}, err
+	}
+
+	b, err := offer.Serialize()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	id, err := offer.ID()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	err = m.cfg.Store.AddOffer(&channeldb.Offer{
+		ID:           id,
+		CreationDate: m.cfg.Clock.Now(),
+		Offer:        b,
+	})
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	log.Infof("Added offer %x", id)
+
+	return id, nil
+}
+
+// ListOffers returns all offers that we've issued.
+func (m *Manager) ListOffers() ([]*channeldb.Offer, error) {
+	return m.cfg.Store.FetchOffers()
+}
+
+// DisableOffer disables the offer with the given id, such that we no longer
+// reply to invoice requests for it.
+func (m *Manager) DisableOffer(id [32]byte) error {
+	if err := m.cfg.Store.DisableOffer(id); err != nil {
+		return err
+	}
+
+	log.Infof("Disabled offer %x", id)
+
+	return nil
+}
+
+// messageLoop dispatches the onion messages that are sent to us.
+func (m *Manager) messageLoop(client *subscribe.Client) {
+	defer m.wg.Done()
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*onionmessage.ReceivedMessage)
+			if !ok {
+				continue
+			}
+
+			m.handleMessage(msg)
+
+		case <-client.Quit():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleMessage handles an invoice request, or delivers the reply to one of
+// our invoice requests. Other messages are ignored.
+func (m *Manager) handleMessage(msg *onionmessage.ReceivedMessage) {
+	if req, ok := msg.FinalHopTLVs[bolt12.InvoiceRequestOnionType]; ok {
+		m.wg.Add(1)
+		go func() {
+			defer m.wg.Done()
+
+			m.handleInvoiceRequest(req, msg.ReplyPath)
+		}()
+
+		return
+	}
+
+	if len(msg.PathID) == 0 {
+		return
+	}
+
+	m.pendingMu.Lock()
+	replyChan, ok := m.pending[string(msg.PathID)]
+	delete(m.pending, string(msg.PathID))
+	m.pendingMu.Unlock()
+
+	if !ok {
+		log.Debugf("Ignoring onion message for unknown path id %x",
+			msg.PathID)
+		return
+	}
+
+	// The channel is buffered, so this never blocks.
+	replyChan <- msg
+}
+
+// handleInvoiceRequest replies to an invoice request with an invoice, or with
+// an invoice error if we can't create one.
+func (m *Manager) handleInvoiceRequest(b []byte,
+	replyPath *blindedpath.BlindedPath) {
+
+	if replyPath == nil {
+		log.Debugf("Ignoring invoice request without reply path")
+		return
+	}
+
+	var (
+		recordType uint64
+		record     []byte
+	)
+	invoice, err := m.createInvoice(b)
+	if err != nil {
+		log.Debugf("Unable to create invoice for invoice request: %v",
+			err)
+
+		invoiceErr, ok := err.(*bolt12.InvoiceError)
+		if !ok {
+			invoiceErr = &bolt12.InvoiceError{Message: err.Error()}
+		}
+
+		recordType = bolt12.InvoiceErrorOnionType
+		record, err = invoiceErr.Serialize()
+	} else {
+		recordType = bolt12.InvoiceOnionType
+		record, err = invoice.Serialize()
+	}
+	if err != nil {
+		log.Errorf("Unable to encode reply to invoice request: %v", err)
+		return
+	}
+
+	_, err = m.cfg.Messenger.SendMessage(&onionmessage.SendRequest{
+		BlindedPath:  replyPath,
+		FinalHopTLVs: map[uint64][]byte{recordType: record},
+	})
+	if err != nil {
+		log.Errorf("Unable to reply to invoice request: %v", err)
+	}
+}
+
+// createInvoice creates and signs an invoice for the invoice request.
+func (m *Manager) createInvoice(b []byte) (*bolt12.Invoice, error) {
+	req, err := bolt12.DeserializeInvoiceRequest(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.VerifySignature(); err != nil {
+		return nil, err
+	}
+
+	// Refunds don't have an offer, which we don't support.
+	if req.Offer == nil || req.Offer.IssuerID == nil ||
+		!req.Offer.IssuerID.IsEqual(m.cfg.NodePubKey) {
+
+		return nil, ErrUnknownOffer
+	}
+
+	id, err := req.Offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := m.cfg.Store.FetchOffer(id)
+	switch {
+	case err == channeldb.ErrOfferNotFound:
+		return nil, ErrUnknownOffer
+
+	case err != nil:
+		return nil, err
+
+	case offer.Disabled:
+		return nil, ErrOfferDisabled
+
+	case req.Offer.Expired(m.cfg.Clock.Now()):
+		return nil, ErrOfferExpired
+	}
+
+	chain := *chaincfg.MainNetParams.GenesisHash
+	if req.Chain != nil {
+		chain = *req.Chain
+	}
+	if chain != m.chainHash() {
+		return nil, ErrUnsupportedChain
+	}
+
+	if hasUnknownRequiredFeatures(req.Features) {
+		return nil, ErrUnknownRequiredFeatures
+	}
+
+	amt, err := req.InvoiceAmount()
+	if err != nil {
+		return nil, err
+	}
+
+	paymentHash, paths, err := m.cfg.AddInvoice(
+		amt, req.Offer.Description, InvoiceExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The invoice mirrors all fields of the request, apart from its
+	// signature.
+	req.Signature = nil
+	invoice := &bolt12.Invoice{
+		InvoiceRequest: req,
+		Paths:          paths,
+		CreatedAt:      m.cfg.Clock.Now(),
+		RelativeExpiry: InvoiceExpiry,
+		PaymentHash:    paymentHash,
+		Amount:         amt,
+		Features:       lnwire.NewRawFeatureVector(lnwire.MPPOptional),
+		NodeID:         m.cfg.NodePubKey,
+	}
+
+	digest, err := invoice.SignatureDigest()
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := m.cfg.SignInvoice(digest)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Signature = &sig
+
+	log.Infof("Created invoice %v for offer %x, amount=%v", paymentHash,
+		id, amt)
+
+	return invoice, nil
+}
+
+// FetchInvoice requests an invoice for the offer from its issuer, and waits
+// for the reply. The amount is only required if the offer doesn't have one,
+// and the quantity only if the offer has a maximum quantity.
+func (m *Manager) FetchInvoice(ctx context.Context, offer *bolt12.Offer,
+	amt lnwire.MilliBronees, quantity uint64,
+	payerNote string) (*bolt12.Invoice, error) {
+
+	mainChain := *chaincfg.MainNetParams.GenesisHash
+	switch {
+	case !offer.SupportsChain(m.chainHash(), mainChain):
+		return nil, ErrUnsupportedChain
+
+	case offer.Expired(m.cfg.Clock.Now()):
+		return nil, ErrOfferExpired
+
+	case hasUnknownRequiredFeatures(offer.Features):
+		return nil, ErrUnknownRequiredFeatures
+
+	case offer.Currency != "" && amt == 0:
+		return nil, bolt12.ErrCurrencyNotSupported
+	}
+
+	// Each request is signed with a new key, so that our requests can't
+	// be linked to each other or to our node.
+	payerKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make([]byte, metadataLength)
+	if _, err := rand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	req := &bolt12.InvoiceRequest{
+		Offer:     offer,
+		Metadata:  metadata,
+		Amount:    amt,
+		Quantity:  quantity,
+		PayerID:   payerKey.PubKey(),
+		PayerNote: payerNote,
+	}
+	if !m.isMainChain() {
+		chain := m.chainHash()
+		req.Chain = &chain
+	}
+
+	if err := req.Sign(payerKey); err != nil {
+		return nil, err
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	b, err := req.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	sendReq := &onionmessage.SendRequest{
+		FinalHopTLVs: map[uint64][]byte{
+			bolt12.InvoiceRequestOnionType: b,
+		},
+		ReplyPath: true,
+	}
+	if len(offer.Paths) > 0 {
+		sendReq.BlindedPath = offer.Paths[0]
+	} else {
+		sendReq.Destination = offer.IssuerID
+	}
+
+	reply, err := m.sendRequest(ctx, sendReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if b, ok := reply.FinalHopTLVs[bolt12.InvoiceErrorOnionType]; ok {
+		invoiceErr, err := bolt12.DeserializeInvoiceError(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("invoice request failed: %w", invoiceErr)
+	}
+
+	b, ok := reply.FinalHopTLVs[bolt12.InvoiceOnionType]
+	if !ok {
+		return nil, errors.New("reply to invoice request doesn't " +
+			"contain invoice")
+	}
+
+	invoice, err := bolt12.DeserializeInvoice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.verifyInvoice(req, invoice); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// sendRequest sends the onion message and waits for the reply that is sent
+// over its reply path.
+func (m *Manager) sendRequest(ctx context.Context,
+	req *onionmessage.SendRequest) (*onionmessage.ReceivedMessage, error) {
+
+	replyChan := make(chan *onionmessage.ReceivedMessage, 1)
+
+	// We hold the lock while sending, so that a reply that is received
+	// before SendMessage returns is only dispatched once the reply channel
+	// is registered.
+	m.pendingMu.Lock()
+	pathID, err := m.cfg.Messenger.SendMessage(req)
+	if err != nil {
+		m.pendingMu.Unlock()
+		return nil, err
+	}
+	m.pending[string(pathID)] = replyChan
+	m.pendingMu.Unlock()
+
+	defer func() {
+		m.pendingMu.Lock()
+		delete(m.pending, string(pathID))
+		m.pendingMu.Unlock()
+	}()
+
+	timeout := m.cfg.FetchTimeout
+	if timeout == 0 {
+		timeout = DefaultFetchTimeout
+	}
+
+	select {
+	case reply := <-replyChan:
+		return reply, nil
+
+	case <-m.cfg.Clock.TickAfter(timeout):
+		return nil, ErrFetchTimeout
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-m.quit:
+		return nil, errors.New("offers manager shutting down")
+	}
+}
+
+// verifyInvoice asserts that the invoice was signed by the issuer of the
+// offer in reply to our invoice request, and that it can be paid.
+func (m *Manager) verifyInvoice(req *bolt12.InvoiceRequest,
+	invoice *bolt12.Invoice) error {
+
+	// The invoice must mirror our request, apart from its signature.
+	unsigned := *req
+	unsigned.Signature = nil
+	expected, err := unsigned.Serialize()
+	if err != nil {
+		return err
+	}
+	mirrored, err := invoice.InvoiceRequest.Serialize()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected, mirrored) {
+		return ErrInvoiceMismatch
+	}
+
+	if err := invoice.VerifySignature(); err != nil {
+		return err
+	}
+
+	amt, err := req.InvoiceAmount()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case invoice.Amount != amt:
+		return fmt.Errorf("invoice amount %v doesn't match requested "+
+			"amount %v", invoice.Amount, amt)
+
+	case !m.cfg.Clock.Now().Before(invoice.Expiry()):
+		return ErrInvoiceExpired
+
+	case hasUnknownRequiredFeatures(invoice.Features):
+		return ErrUnknownRequiredFeatures
+	}
+
+	return nil
+}
+
+// hasUnknownRequiredFeatures returns true if the feature vector requires a
+// feature that we don't know.
+func hasUnknownRequiredFeatures(features *lnwire.RawFeatureVector) bool {
+	if features == nil {
+		return false
+	}
+
+	fv := lnwire.NewFeatureVector(features, lnwire.Features)
+	return len(fv.UnknownRequiredFeatures()) > 0
+}
@@ -0,0 +1,329 @@
+package offers
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/bolt12"
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/onionmessage"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/broln/subscribe"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+// mockNetwork delivers onion messages between mock messengers.
+type mockNetwork struct {
+	mu    sync.Mutex
+	nodes map[route.Vertex]*mockMessenger
+}
+
+// mockMessenger is a Messenger that delivers messages directly to the
+// messenger of the destination node. The path ID of its reply paths is
+// carried in the cipher text of their only hop.
+type mockMessenger struct {
+	network *mockNetwork
+	pubKey  *bronec.PublicKey
+	server  *subscribe.Server
+}
+
+// newMockMessenger creates a messenger for the node and adds it to the
+// network.
+func (n *mockNetwork) newMockMessenger(t *testing.T,
+	pubKey *bronec.PublicKey) *mockMessenger {
+
+	m := &mockMessenger{
+		network: n,
+		pubKey:  pubKey,
+		server:  subscribe.NewServer(),
+	}
+	require.NoError(t, m.server.Start())
+	t.Cleanup(func() {
+		require.NoError(t, m.server.Stop())
+	})
+
+	n.mu.Lock()
+	n.nodes[route.NewVertex(pubKey)] = m
+	n.mu.Unlock()
+
+	return m
+}
+
+// SendMessage delivers the message to the messenger of its destination.
+// Messages to unknown nodes are dropped.
+func (m *mockMessenger) SendMessage(req *onionmessage.SendRequest) ([]byte,
+	error) {
+
+	received := &onionmessage.ReceivedMessage{
+		FinalHopTLVs: req.FinalHopTLVs,
+	}
+
+	target := req.Destination
+	if req.BlindedPath != nil {
+		target = req.BlindedPath.IntroductionPoint
+		received.PathID = req.BlindedPath.BlindedHops[0].CipherText
+	}
+
+	var pathID []byte
+	if req.ReplyPath {
+		pathID = make([]byte, 32)
+		if _, err := rand.Read(pathID); err != nil {
+			return nil, err
+		}
+
+		received.ReplyPath = &blindedpath.BlindedPath{
+			IntroductionPoint: m.pubKey,
+			BlindingPoint:     m.pubKey,
+			BlindedHops: []*blindedpath.BlindedHop{{
+				BlindedNodePub: m.pubKey,
+				CipherText:     pathID,
+			}},
+		}
+	}
+
+	m.network.mu.Lock()
+	node, ok := m.network.nodes[route.NewVertex(target)]
+	m.network.mu.Unlock()
+	if !ok {
+		return pathID, nil
+	}
+
+	return pathID, node.server.SendUpdate(received)
+}
+
+// SubscribeMessages returns a client that receives the messages that are sent
+// to the node.
+func (m *mockMessenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.server.Subscribe()
+}
+
+// testNode is a node with an offers manager.
+type testNode struct {
+	manager *Manager
+	privKey *bronec.PrivateKey
+
+	// invoices holds the payment hashes of the invoices that were added
+	// by the manager, along with their amounts.
+	invoices   map[lntypes.Hash]lnwire.MilliBronees
+	invoicesMu sync.Mutex
+}
+
+// newTestNode creates a node with a started offers manager on the network.
+// The manager signs invoices with signKey, or with its node key if nil.
+func newTestNode(t *testing.T, network *mockNetwork,
+	signKey *bronec.PrivateKey) *testNode {
+
+	privKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	if signKey == nil {
+		signKey = privKey
+	}
+
+	db, cleanup, err := channeldb.MakeTestDB()
+	require.NoError(t, err)
+	t.Cleanup(cleanup)
+
+	store, err := channeldb.NewOfferStore(db)
+	require.NoError(t, err)
+
+	node := &testNode{
+		privKey:  privKey,
+		invoices: make(map[lntypes.Hash]lnwire.MilliBronees),
+	}
+
+	addInvoice := func(amt lnwire.MilliBronees, memo string,
+		expiry time.Duration) (lntypes.Hash,
+		[]*blindedpath.BlindedPayment, error) {
+
+		var hash lntypes.Hash
+		if _, err := rand.Read(hash[:]); err != nil {
+			return hash, nil, err
+		}
+
+		node.invoicesMu.Lock()
+		node.invoices[hash] = amt
+		node.invoicesMu.Unlock()
+
+		path := &blindedpath.BlindedPath{
+			IntroductionPoint: privKey.PubKey(),
+			BlindingPoint:     privKey.PubKey(),
+			BlindedHops: []*blindedpath.BlindedHop{{
+				BlindedNodePub: privKey.PubKey(),
+				CipherText:     []byte{1},
+			}},
+		}
+
+		return hash, []*blindedpath.BlindedPayment{{
+			BlindedPath:     path,
+			CltvExpiryDelta: 40,
+			HtlcMaximum:     uint64(amt),
+		}}, nil
+	}
+
+	node.manager = New(&Config{
+		Messenger:   network.newMockMessenger(t, privKey.PubKey()),
+		Store:       store,
+		NodePubKey:  privKey.PubKey(),
+		ChainParams: &chaincfg.RegressionNetParams,
+		AddInvoice:  addInvoice,
+		SignInvoice: func(digest [32]byte) ([64]byte, error) {
+			return bolt12.SignDigest(signKey, digest)
+		},
+		FetchTimeout: time.Second,
+		Clock:        clock.NewDefaultClock(),
+	})
+	require.NoError(t, node.manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, node.manager.Stop())
+	})
+
+	return node
+}
+
+// addOffer adds an offer to the node and returns it in the form that payers
+// decode it in.
+func (n *testNode) addOffer(t *testing.T, offer *bolt12.Offer) (
+	*bolt12.Offer, [32]byte) {
+
+	id, err := n.manager.AddOffer(offer)
+	require.NoError(t, err)
+
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+
+	decoded, err := bolt12.DecodeOffer(encoded)
+	require.NoError(t, err)
+
+	return decoded, id
+}
+
+// TestFetchInvoice asserts that invoices are fetched for offers from their
+// issuer, and that the issuer rejects requests for unknown, disabled and
+// expired offers.
+func TestFetchInvoice(t *testing.T) {
+	t.Parallel()
+
+	network := &mockNetwork{
+		nodes: make(map[route.Vertex]*mockMessenger),
+	}
+	alice := newTestNode(t, network, nil)
+	bob := newTestNode(t, network, nil)
+
+	ctx := context.Background()
+
+	quantityMax := uint64(5)
+	offer, id := bob.addOffer(t, &bolt12.Offer{
+		Amount:      1000,
+		Description: "coffee",
+		QuantityMax: &quantityMax,
+	})
+	require.True(t, offer.IssuerID.IsEqual(bob.privKey.PubKey()))
+	require.Equal(
+		t, *chaincfg.RegressionNetParams.GenesisHash, offer.Chains[0],
+	)
+
+	// The invoice is for the amount of the offer times the quantity, and
+	// was added to the invoices of the issuer.
+	invoice, err := alice.manager.FetchInvoice(ctx, offer, 0, 2, "thanks")
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, invoice.Amount)
+	require.Equal(t, "thanks", invoice.InvoiceRequest.PayerNote)
+	require.Len(t, invoice.Paths, 1)
+	require.Contains(t, bob.invoices, invoice.PaymentHash)
+	require.EqualValues(t, 2000, bob.invoices[invoice.PaymentHash])
+
+	// The payer may pay more than the offer asks for, but not less.
+	invoice, err = alice.manager.FetchInvoice(ctx, offer, 2500, 2, "")
+	require.NoError(t, err)
+	require.EqualValues(t, 2500, invoice.Amount)
+
+	_, err = alice.manager.FetchInvoice(ctx, offer, 1500, 2, "")
+	require.ErrorIs(t, err, bolt12.ErrAmountBelowOffer)
+
+	// An offer without amount requires the payer to set one.
+	donation, _ := bob.addOffer(t, &bolt12.Offer{
+		Description: "donation",
+	})
+	_, err = alice.manager.FetchInvoice(ctx, donation, 0, 0, "")
+	require.ErrorIs(t, err, bolt12.ErrNoAmount)
+
+	invoice, err = alice.manager.FetchInvoice(ctx, donation, 5000, 0, "")
+	require.NoError(t, err)
+	require.EqualValues(t, 5000, invoice.Amount)
+
+	// An offer that the issuer doesn't know is rejected with an invoice
+	// error.
+	unknown := *offer
+	unknown.Description = "tea"
+	_, err = alice.manager.FetchInvoice(ctx, &unknown, 0, 1, "")
+	requireInvoiceError(t, err, ErrUnknownOffer)
+
+	// Once disabled, the issuer no longer creates invoices for the offer.
+	require.NoError(t, bob.manager.DisableOffer(id))
+	_, err = alice.manager.FetchInvoice(ctx, offer, 0, 1, "")
+	requireInvoiceError(t, err, ErrOfferDisabled)
+
+	// Expired offers are rejected by the payer.
+	expired, _ := bob.addOffer(t, &bolt12.Offer{
+		Amount:         1000,
+		Description:    "coffee",
+		AbsoluteExpiry: time.Unix(1700000000, 0),
+	})
+	_, err = alice.manager.FetchInvoice(ctx, expired, 0, 0, "")
+	require.ErrorIs(t, err, ErrOfferExpired)
+
+	offers, err := bob.manager.ListOffers()
+	require.NoError(t, err)
+	require.Len(t, offers, 3)
+}
+
+// requireInvoiceError asserts that the error is an invoice error with the
+// message of the expected error.
+func requireInvoiceError(t *testing.T, err, expected error) {
+	t.Helper()
+
+	var invoiceErr *bolt12.InvoiceError
+	require.True(t, errors.As(err, &invoiceErr), "got error: %v", err)
+	require.Equal(t, expected.Error(), invoiceErr.Message)
+}
+
+// TestFetchInvoiceVerification asserts that invoices that aren't signed by the
+// issuer of the offer are rejected, and that requests time out if the issuer
+// doesn't reply.
+func TestFetchInvoiceVerification(t *testing.T) {
+	t.Parallel()
+
+	network := &mockNetwork{
+		nodes: make(map[route.Vertex]*mockMessenger),
+	}
+	alice := newTestNode(t, network, nil)
+
+	// Bob signs his invoices with a key other than his node key.
+	wrongKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	bob := newTestNode(t, network, wrongKey)
+
+	ctx := context.Background()
+
+	offer, _ := bob.addOffer(t, &bolt12.Offer{
+		Amount:      1000,
+		Description: "coffee",
+	})
+	_, err = alice.manager.FetchInvoice(ctx, offer, 0, 0, "")
+	require.ErrorIs(t, err, bolt12.ErrInvalidSignature)
+
+	// An issuer that isn't reachable never replies.
+	offer.IssuerID = wrongKey.PubKey()
+	_, err = alice.manager.FetchInvoice(ctx, offer, 0, 0, "")
+	require.ErrorIs(t, err, ErrFetchTimeout)
+}
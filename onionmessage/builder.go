@@ -0,0 +1,162 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	sphinx "github.com/brsuite/lightning-onion"
+)
+
+var (
+	// ErrNoPath is returned when a blinded path for an onion message is
+	// requested without any nodes or destination path.
+	ErrNoPath = errors.New("no nodes to route onion message over")
+
+	// ErrPathTooLong is returned when an onion message is to be sent over
+	// a path that has more hops than fit into the onion.
+	ErrPathTooLong = fmt.Errorf("onion message path exceeds %d hops",
+		sphinx.NumMaxHops)
+)
+
+// BuildPath creates a blinded path that routes an onion message over the
+// passed nodes. If dest is nil, the last of the nodes is the recipient of the
+// message. Otherwise, the nodes lead to the introduction node of dest, which
+// is not part of them, and the message continues along dest from there.
+func BuildPath(sessionKey *bronec.PrivateKey, nodes []*bronec.PublicKey,
+	dest *blindedpath.BlindedPath) (*blindedpath.BlindedPath, error) {
+
+	switch {
+	// If the introduction node of the destination path is the first node
+	// of the route, we can send the message over it as is.
+	case len(nodes) == 0 && dest != nil:
+		return dest, nil
+
+	case len(nodes) == 0:
+		return nil, ErrNoPath
+	}
+
+	hops := make([]*blindedpath.HopInfo, 0, len(nodes))
+	for i, node := range nodes {
+		var data record.BlindedRouteData
+		switch {
+		// Each node forwards the message to the next one.
+		case i < len(nodes)-1:
+			data.NextNodeID = nodes[i+1]
+
+		// The last of our nodes hands the message over to the
+		// introduction node of the destination path, along with the
+		// blinding point of that path.
+		case dest != nil:
+			data.NextNodeID = dest.IntroductionPoint
+			data.NextBlindingOverride = dest.BlindingPoint
+		}
+
+		plainText, err := record.EncodeBlindedRouteData(&data)
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, &blindedpath.HopInfo{
+			NodePub:   node,
+			PlainText: plainText,
+		})
+	}
+
+	path, err := blindedpath.BuildBlindedPath(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	if dest != nil {
+		path.BlindedHops = append(path.BlindedHops, dest.BlindedHops...)
+	}
+
+	return path, nil
+}
+
+// BuildReplyPath creates a single hop blinded path to the passed node, which
+// the recipient of an onion message may use to reply to it. The path ID is
+// included in the route data of the node, such that replies can be matched to
+// the original message.
+func BuildReplyPath(sessionKey *bronec.PrivateKey, node *bronec.PublicKey,
+	pathID []byte) (*blindedpath.BlindedPath, error) {
+
+	plainText, err := record.EncodeBlindedRouteData(
+		&record.BlindedRouteData{
+			PathID: pathID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return blindedpath.BuildBlindedPath(sessionKey, []*blindedpath.HopInfo{
+		{
+			NodePub:   node,
+			PlainText: plainText,
+		},
+	})
+}
+
+// BuildOnionMessage creates an onion message that is sent over the passed
+// blinded path. The reply path and the final hop records are included in the
+// payload of the last hop of the path. The message must be sent to the
+// introduction node of the path.
+func BuildOnionMessage(sessionKey *bronec.PrivateKey,
+	path *blindedpath.BlindedPath, replyPath *blindedpath.BlindedPath,
+	finalHopTLVs map[uint64][]byte) (*lnwire.OnionMessage, error) {
+
+	numHops := len(path.BlindedHops)
+	switch {
+	case numHops == 0:
+		return nil, blindedpath.ErrNoHops
+
+	case numHops > sphinx.NumMaxHops:
+		return nil, ErrPathTooLong
+	}
+
+	var sphinxPath sphinx.PaymentPath
+	for i, hop := range path.BlindedHops {
+		payload := &record.OnionMessagePayload{
+			EncryptedData: hop.CipherText,
+		}
+		if i == numHops-1 {
+			payload.ReplyPath = replyPath
+			payload.FinalHopTLVs = finalHopTLVs
+		}
+
+		payloadBytes, err := record.EncodeOnionMessagePayload(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		hopPayload, err := sphinx.NewHopPayload(nil, payloadBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		sphinxPath[i] = sphinx.OnionHop{
+			NodePub:    *hop.BlindedNodePub,
+			HopPayload: hopPayload,
+		}
+	}
+
+	onionPkt, err := sphinx.NewOnionPacket(
+		&sphinxPath, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := onionPkt.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return lnwire.NewOnionMessage(path.BlindingPoint, b.Bytes()), nil
+}
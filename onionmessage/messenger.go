@@ -0,0 +1,357 @@
+package onionmessage
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/brsuite/broln/htlcswitch/hop"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/broln/subscribe"
+	"github.com/brsuite/brond/bronec"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRateLimit is the default number of onion messages per second
+	// that each peer may send us on average.
+	DefaultRateLimit = rate.Limit(10)
+
+	// DefaultBurst is the default number of onion messages that each peer
+	// may send us in a burst, above the rate limit.
+	DefaultBurst = 50
+
+	// pathIDLength is the length of the path IDs of our reply paths.
+	pathIDLength = 32
+)
+
+var (
+	// ErrNoDestination is returned when an onion message is to be sent
+	// without a destination node or blinded path.
+	ErrNoDestination = errors.New("either destination or blinded path " +
+		"required")
+
+	// ErrSendToSelf is returned when an onion message is to be sent to
+	// our own node.
+	ErrSendToSelf = errors.New("cannot send onion message to self")
+)
+
+// OnionProcessor peels a layer off onion messages that are received from our
+// peers.
+type OnionProcessor interface {
+	// ProcessOnionMessage peels a layer off the passed onion message.
+	ProcessOnionMessage(msg *lnwire.OnionMessage) (
+		*hop.ProcessedOnionMessage, error)
+}
+
+// Config contains the dependencies of the Messenger.
+type Config struct {
+	// OurPubKey is the public key of our node.
+	OurPubKey *bronec.PublicKey
+
+	// OnionProcessor is used to peel onion messages received from our
+	// peers.
+	OnionProcessor OnionProcessor
+
+	// Graph is the channel graph used to find routes to the destination
+	// of the onion messages we send.
+	Graph Graph
+
+	// IsPeer returns true if we are connected to the given node.
+	IsPeer func(node route.Vertex) bool
+
+	// SendToPeer sends the onion message to the given connected peer.
+	SendToPeer func(peer route.Vertex, msg *lnwire.OnionMessage) error
+
+	// RateLimit is the number of onion messages per second that each peer
+	// may send us on average. Messages exceeding the limit are dropped.
+	RateLimit rate.Limit
+
+	// Burst is the number of onion messages that each peer may send us in
+	// a burst, above the rate limit.
+	Burst int
+}
+
+// ReceivedMessage is an onion message for which we are the final recipient.
+type ReceivedMessage struct {
+	// PathID is the path ID that we included in the route data of our
+	// hop, if the message was sent over one of our reply paths.
+	PathID []byte
+
+	// ReplyPath is an optional blinded path that the sender of the
+	// message included to allow us to reply to it.
+	ReplyPath *blindedpath.BlindedPath
+
+	// FinalHopTLVs are the records that the sender included for us.
+	FinalHopTLVs map[uint64][]byte
+}
+
+// SendRequest describes an onion message to be sent.
+type SendRequest struct {
+	// Destination is the node to send the message to. Either this or
+	// BlindedPath must be set.
+	Destination *bronec.PublicKey
+
+	// BlindedPath is the blinded path to send the message over. Either
+	// this or Destination must be set.
+	BlindedPath *blindedpath.BlindedPath
+
+	// FinalHopTLVs are the records to include for the recipient. All types
+	// must be at least record.FinalHopTLVTypeStart.
+	FinalHopTLVs map[uint64][]byte
+
+	// ReplyPath indicates whether a blinded path to our node should be
+	// included, which the recipient may use to reply to the message.
+	ReplyPath bool
+}
+
+// Messenger sends and receives onion messages, and forwards those that are
+// destined for other nodes.
+type Messenger struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	ntfnServer *subscribe.Server
+
+	// rateLimiters contains the rate limiter for each connected peer that
+	// has sent us onion messages.
+	rateLimiters   map[route.Vertex]*rate.Limiter
+	rateLimitersMu sync.Mutex
+}
+
+// New creates a new onion messenger.
+func New(cfg *Config) *Messenger {
+	return &Messenger{
+		cfg:          cfg,
+		ntfnServer:   subscribe.NewServer(),
+		rateLimiters: make(map[route.Vertex]*rate.Limiter),
+	}
+}
+
+// Start starts the Messenger's subscription server.
+func (m *Messenger) Start() error {
+	var err error
+
+	m.started.Do(func() {
+		log.Info("Onion messenger starting")
+		err = m.ntfnServer.Start()
+	})
+
+	return err
+}
+
+// Stop signals the messenger for a graceful shutdown.
+func (m *Messenger) Stop() error {
+	var err error
+	m.stopped.Do(func() {
+		log.Info("Onion messenger shutting down")
+		err = m.ntfnServer.Stop()
+	})
+	return err
+}
+
+// SubscribeMessages returns a subscribe.Client that will receive a
+// ReceivedMessage for every onion message that we are the final recipient of.
+func (m *Messenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// HandleMessage processes an onion message that was received from the given
+// peer. The message is either delivered to our subscribers, or forwarded to
+// the next node of its path.
+func (m *Messenger) HandleMessage(peer [33]byte,
+	msg *lnwire.OnionMessage) error {
+
+	if !m.allow(peer) {
+		log.Debugf("Dropping onion message from peer %x due to rate "+
+			"limit", peer)
+		return nil
+	}
+
+	return m.processMessage(msg)
+}
+
+// allow returns true if the rate limit of the peer permits another onion
+// message.
+func (m *Messenger) allow(peer route.Vertex) bool {
+	m.rateLimitersMu.Lock()
+	defer m.rateLimitersMu.Unlock()
+
+	limiter, ok := m.rateLimiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(m.cfg.RateLimit, m.cfg.Burst)
+		m.rateLimiters[peer] = limiter
+	}
+
+	return limiter.Allow()
+}
+
+// RemovePeer forgets the rate limiter of a peer. It should be called once the
+// peer disconnected, so that we don't keep state for every peer that has ever
+// sent us an onion message.
+func (m *Messenger) RemovePeer(peer route.Vertex) {
+	m.rateLimitersMu.Lock()
+	defer m.rateLimitersMu.Unlock()
+
+	delete(m.rateLimiters, peer)
+}
+
+// processMessage peels a layer off the onion message, and either delivers it
+// to our subscribers or forwards it.
+func (m *Messenger) processMessage(msg *lnwire.OnionMessage) error {
+	processed, err := m.cfg.OnionProcessor.ProcessOnionMessage(msg)
+	if err != nil {
+		return fmt.Errorf("unable to process onion message: %v", err)
+	}
+
+	if processed.IsFinal() {
+		log.Debugf("Received onion message with %d final hop records",
+			len(processed.Payload.FinalHopTLVs))
+
+		return m.ntfnServer.SendUpdate(&ReceivedMessage{
+			PathID:       processed.RouteData.PathID,
+			ReplyPath:    processed.Payload.ReplyPath,
+			FinalHopTLVs: processed.Payload.FinalHopTLVs,
+		})
+	}
+
+	// If the path continues through our own node, which is the case if
+	// we are the introduction node of a path that we are also the next
+	// hop of, we'll process the next layer right away.
+	next := route.NewVertex(processed.NextNode)
+	if processed.NextNode.IsEqual(m.cfg.OurPubKey) {
+		return m.processMessage(processed.NextMessage)
+	}
+
+	// Onion messages are only forwarded to connected peers, so we'll drop
+	// the message otherwise.
+	if !m.cfg.IsPeer(next) {
+		log.Debugf("Dropping onion message for unconnected node %v",
+			next)
+		return nil
+	}
+
+	log.Tracef("Forwarding onion message to %v", next)
+
+	return m.cfg.SendToPeer(next, processed.NextMessage)
+}
+
+// SendMessage sends an onion message as described by the request. If a reply
+// path was requested, its path ID is returned, which is delivered along with
+// replies to the message.
+func (m *Messenger) SendMessage(req *SendRequest) ([]byte, error) {
+	var target *bronec.PublicKey
+	switch {
+	case req.BlindedPath != nil:
+		target = req.BlindedPath.IntroductionPoint
+
+	case req.Destination != nil:
+		target = req.Destination
+
+	default:
+		return nil, ErrNoDestination
+	}
+
+	if target.IsEqual(m.cfg.OurPubKey) {
+		return nil, ErrSendToSelf
+	}
+
+	for recordType := range req.FinalHopTLVs {
+		if recordType < uint64(record.FinalHopTLVTypeStart) {
+			return nil, fmt.Errorf("final hop record type %v below "+
+				"%v", recordType, record.FinalHopTLVTypeStart)
+		}
+	}
+
+	// Find the nodes that lead to the target. If the target is one of our
+	// peers, we can send the message to it directly.
+	targetVertex := route.NewVertex(target)
+	nodes := []route.Vertex{targetVertex}
+	if !m.cfg.IsPeer(targetVertex) {
+		var err error
+		nodes, err = findPath(
+			m.cfg.Graph, route.NewVertex(m.cfg.OurPubKey),
+			targetVertex,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The introduction node of a blinded path is the first hop of that
+	// path, so it's not part of the route we create.
+	if req.BlindedPath != nil {
+		nodes = nodes[:len(nodes)-1]
+	}
+
+	nodeKeys := make([]*bronec.PublicKey, 0, len(nodes))
+	for _, node := range nodes {
+		nodeKey, err := bronec.ParsePubKey(node[:], bronec.S256())
+		if err != nil {
+			return nil, err
+		}
+		nodeKeys = append(nodeKeys, nodeKey)
+	}
+
+	pathKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := BuildPath(pathKey, nodeKeys, req.BlindedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		replyPath *blindedpath.BlindedPath
+		pathID    []byte
+	)
+	if req.ReplyPath {
+		pathID = make([]byte, pathIDLength)
+		if _, err := rand.Read(pathID); err != nil {
+			return nil, err
+		}
+
+		replyKey, err := bronec.NewPrivateKey(bronec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		replyPath, err = BuildReplyPath(
+			replyKey, m.cfg.OurPubKey, pathID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := BuildOnionMessage(
+		sessionKey, path, replyPath, req.FinalHopTLVs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	firstHop := route.NewVertex(path.IntroductionPoint)
+
+	log.Debugf("Sending onion message to %v over %d hops", firstHop,
+		len(path.BlindedHops))
+
+	if err := m.cfg.SendToPeer(firstHop, msg); err != nil {
+		return nil, err
+	}
+
+	return pathID, nil
+}
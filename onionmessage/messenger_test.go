@@ -0,0 +1,295 @@
+package onionmessage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/htlcswitch/hop"
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/brond/chaincfg"
+	sphinx "github.com/brsuite/lightning-onion"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// mockGraph is a channel graph in which every node supports onion messages.
+type mockGraph struct {
+	channels map[route.Vertex][]route.Vertex
+}
+
+func (g *mockGraph) addChannel(a, b route.Vertex) {
+	g.channels[a] = append(g.channels[a], b)
+	g.channels[b] = append(g.channels[b], a)
+}
+
+func (g *mockGraph) ForEachNodeChannel(tx kvdb.RTx, node route.Vertex,
+	cb func(channel *channeldb.DirectedChannel) error) error {
+
+	for _, other := range g.channels[node] {
+		err := cb(&channeldb.DirectedChannel{OtherNode: other})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *mockGraph) FetchNodeFeatures(
+	node route.Vertex) (*lnwire.FeatureVector, error) {
+
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.OnionMessagesOptional),
+		lnwire.Features,
+	), nil
+}
+
+// testNode is a node of the test network along with its messenger.
+type testNode struct {
+	pubKey    route.Vertex
+	messenger *Messenger
+}
+
+// testNetwork is a set of nodes whose messengers deliver onion messages to
+// each other directly.
+type testNetwork struct {
+	t     *testing.T
+	graph *mockGraph
+	nodes map[route.Vertex]*testNode
+
+	// connected contains the pairs of nodes that are connected as peers.
+	connected map[[2]route.Vertex]struct{}
+}
+
+func newTestNetwork(t *testing.T) *testNetwork {
+	return &testNetwork{
+		t: t,
+		graph: &mockGraph{
+			channels: make(map[route.Vertex][]route.Vertex),
+		},
+		nodes:     make(map[route.Vertex]*testNode),
+		connected: make(map[[2]route.Vertex]struct{}),
+	}
+}
+
+func (n *testNetwork) addNode() *testNode {
+	privKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(n.t, err)
+
+	nodeKey := &keychain.PrivKeyECDH{PrivKey: privKey}
+	router := sphinx.NewRouter(
+		nodeKey, &chaincfg.RegressionNetParams,
+		sphinx.NewMemoryReplayLog(),
+	)
+
+	node := &testNode{
+		pubKey: route.NewVertex(privKey.PubKey()),
+	}
+	node.messenger = New(&Config{
+		OurPubKey:      privKey.PubKey(),
+		OnionProcessor: hop.NewOnionProcessor(router, nodeKey),
+		Graph:          n.graph,
+		IsPeer: func(peer route.Vertex) bool {
+			_, ok := n.connected[[2]route.Vertex{node.pubKey, peer}]
+			return ok
+		},
+		SendToPeer: func(peer route.Vertex,
+			msg *lnwire.OnionMessage) error {
+
+			return n.nodes[peer].messenger.HandleMessage(
+				node.pubKey, msg,
+			)
+		},
+		RateLimit: DefaultRateLimit,
+		Burst:     DefaultBurst,
+	})
+	require.NoError(n.t, node.messenger.Start())
+	n.t.Cleanup(func() {
+		require.NoError(n.t, node.messenger.Stop())
+	})
+
+	n.nodes[node.pubKey] = node
+
+	return node
+}
+
+func (n *testNetwork) connect(a, b *testNode) {
+	n.graph.addChannel(a.pubKey, b.pubKey)
+	n.connected[[2]route.Vertex{a.pubKey, b.pubKey}] = struct{}{}
+	n.connected[[2]route.Vertex{b.pubKey, a.pubKey}] = struct{}{}
+}
+
+func pubKey(t *testing.T, node *testNode) *bronec.PublicKey {
+	key, err := bronec.ParsePubKey(node.pubKey[:], bronec.S256())
+	require.NoError(t, err)
+
+	return key
+}
+
+func waitForMessage(t *testing.T, updates <-chan interface{}) *ReceivedMessage {
+	select {
+	case update := <-updates:
+		return update.(*ReceivedMessage)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no onion message received")
+		return nil
+	}
+}
+
+// TestSendOnionMessage asserts that onion messages are forwarded along a
+// route to their destination, and that the recipient is able to reply to
+// them over the included reply path.
+func TestSendOnionMessage(t *testing.T) {
+	t.Parallel()
+
+	network := newTestNetwork(t)
+	alice := network.addNode()
+	bob := network.addNode()
+	carol := network.addNode()
+	dave := network.addNode()
+
+	// Alice -> Bob -> Carol -> Dave.
+	network.connect(alice, bob)
+	network.connect(bob, carol)
+	network.connect(carol, dave)
+
+	aliceClient, err := alice.messenger.SubscribeMessages()
+	require.NoError(t, err)
+	defer aliceClient.Cancel()
+
+	daveClient, err := dave.messenger.SubscribeMessages()
+	require.NoError(t, err)
+	defer daveClient.Cancel()
+
+	// Alice sends a message to Dave, asking for a reply.
+	tlvs := map[uint64][]byte{
+		64:  {1, 2, 3},
+		101: {4, 5},
+	}
+	pathID, err := alice.messenger.SendMessage(&SendRequest{
+		Destination:  pubKey(t, dave),
+		FinalHopTLVs: tlvs,
+		ReplyPath:    true,
+	})
+	require.NoError(t, err)
+	require.Len(t, pathID, pathIDLength)
+
+	msg := waitForMessage(t, daveClient.Updates())
+	require.Equal(t, tlvs, msg.FinalHopTLVs)
+	require.Nil(t, msg.PathID)
+	require.NotNil(t, msg.ReplyPath)
+
+	// Dave replies over the reply path, which routes the message through
+	// Carol and Bob to its introduction node, Alice.
+	reply := map[uint64][]byte{65: {9}}
+	_, err = dave.messenger.SendMessage(&SendRequest{
+		BlindedPath:  msg.ReplyPath,
+		FinalHopTLVs: reply,
+	})
+	require.NoError(t, err)
+
+	msg = waitForMessage(t, aliceClient.Updates())
+	require.Equal(t, reply, msg.FinalHopTLVs)
+	require.Equal(t, pathID, msg.PathID)
+	require.Nil(t, msg.ReplyPath)
+
+	// Bob can send a message to Dave over a path that Dave created, whose
+	// introduction node is Bob's peer Carol.
+	carolKey, daveKey := pubKey(t, carol), pubKey(t, dave)
+	pathKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	path, err := BuildPath(
+		pathKey, []*bronec.PublicKey{carolKey, daveKey}, nil,
+	)
+	require.NoError(t, err)
+
+	_, err = bob.messenger.SendMessage(&SendRequest{
+		BlindedPath:  path,
+		FinalHopTLVs: tlvs,
+	})
+	require.NoError(t, err)
+
+	msg = waitForMessage(t, daveClient.Updates())
+	require.Equal(t, tlvs, msg.FinalHopTLVs)
+
+	// Messages without destination, to ourselves or with invalid records
+	// are rejected.
+	_, err = alice.messenger.SendMessage(&SendRequest{})
+	require.ErrorIs(t, err, ErrNoDestination)
+
+	_, err = alice.messenger.SendMessage(&SendRequest{
+		Destination: pubKey(t, alice),
+	})
+	require.ErrorIs(t, err, ErrSendToSelf)
+
+	_, err = alice.messenger.SendMessage(&SendRequest{
+		Destination:  daveKey,
+		FinalHopTLVs: map[uint64][]byte{10: {1}},
+	})
+	require.Error(t, err)
+
+	// A node that isn't part of the graph can't be reached.
+	eve := network.addNode()
+	_, err = alice.messenger.SendMessage(&SendRequest{
+		Destination: pubKey(t, eve),
+	})
+	require.ErrorIs(t, err, ErrNoRoute)
+}
+
+// TestOnionMessageRateLimit asserts that onion messages from a peer that
+// exceed its rate limit are dropped.
+func TestOnionMessageRateLimit(t *testing.T) {
+	t.Parallel()
+
+	network := newTestNetwork(t)
+	alice := network.addNode()
+	bob := network.addNode()
+	network.connect(alice, bob)
+
+	// Bob only accepts a single message from each peer.
+	bob.messenger.cfg.RateLimit = rate.Every(time.Hour)
+	bob.messenger.cfg.Burst = 1
+
+	bobClient, err := bob.messenger.SubscribeMessages()
+	require.NoError(t, err)
+	defer bobClient.Cancel()
+
+	for i := 0; i < 2; i++ {
+		_, err := alice.messenger.SendMessage(&SendRequest{
+			Destination:  pubKey(t, bob),
+			FinalHopTLVs: map[uint64][]byte{64: {byte(i)}},
+		})
+		require.NoError(t, err)
+	}
+
+	msg := waitForMessage(t, bobClient.Updates())
+	require.Equal(t, []byte{0}, msg.FinalHopTLVs[64])
+
+	select {
+	case <-bobClient.Updates():
+		t.Fatalf("rate limited message delivered")
+
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once alice disconnects, her rate limiter is removed and she starts
+	// with a fresh limit after reconnecting.
+	bob.messenger.RemovePeer(route.NewVertex(pubKey(t, alice)))
+	require.Empty(t, bob.messenger.rateLimiters)
+
+	_, err = alice.messenger.SendMessage(&SendRequest{
+		Destination:  pubKey(t, bob),
+		FinalHopTLVs: map[uint64][]byte{64: {2}},
+	})
+	require.NoError(t, err)
+
+	msg = waitForMessage(t, bobClient.Updates())
+	require.Equal(t, []byte{2}, msg.FinalHopTLVs[64])
+}
@@ -0,0 +1,109 @@
+package onionmessage
+
+import (
+	"errors"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/kvdb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+)
+
+const (
+	// maxPathLength is the maximum number of hops of the routes that
+	// onion messages are sent over to reach their destination.
+	maxPathLength = 10
+)
+
+var (
+	// ErrNoRoute is returned when no route to the destination of an onion
+	// message can be found.
+	ErrNoRoute = errors.New("unable to find route for onion message")
+)
+
+// Graph is the channel graph that routes for onion messages are searched
+// for in.
+type Graph interface {
+	// ForEachNodeChannel iterates through all channels of the given node.
+	ForEachNodeChannel(tx kvdb.RTx, node route.Vertex,
+		cb func(channel *channeldb.DirectedChannel) error) error
+
+	// FetchNodeFeatures returns the features of the given node.
+	FetchNodeFeatures(node route.Vertex) (*lnwire.FeatureVector, error)
+}
+
+// findPath searches the graph for the shortest route from source to target
+// over nodes that forward onion messages. The returned route starts with the
+// first hop after the source and ends with the target. As onion messages
+// don't carry any value, the route only needs to be short rather than cheap,
+// so a breadth first search is all that's needed.
+func findPath(graph Graph, source, target route.Vertex) ([]route.Vertex,
+	error) {
+
+	// supportsOnionMessages returns whether the node may be used as an
+	// intermediate hop of the route.
+	supportsOnionMessages := func(node route.Vertex) bool {
+		features, err := graph.FetchNodeFeatures(node)
+		if err != nil {
+			return false
+		}
+
+		return features.HasFeature(lnwire.OnionMessagesOptional)
+	}
+
+	var (
+		prev     = map[route.Vertex]route.Vertex{source: source}
+		distance = map[route.Vertex]int{source: 0}
+		queue    = []route.Vertex{source}
+		found    bool
+	)
+	for len(queue) > 0 && !found {
+		node := queue[0]
+		queue = queue[1:]
+
+		if distance[node] >= maxPathLength {
+			continue
+		}
+
+		err := graph.ForEachNodeChannel(nil, node,
+			func(channel *channeldb.DirectedChannel) error {
+				next := channel.OtherNode
+				if _, ok := prev[next]; ok {
+					return nil
+				}
+
+				// The target only needs to understand onion
+				// messages, which is checked by the caller.
+				if next != target && !supportsOnionMessages(next) {
+					return nil
+				}
+
+				prev[next] = node
+				distance[next] = distance[node] + 1
+				if next == target {
+					found = true
+					return nil
+				}
+
+				queue = append(queue, next)
+
+				return nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !found {
+		return nil, ErrNoRoute
+	}
+
+	path := make([]route.Vertex, distance[target])
+	for node, i := target, len(path)-1; i >= 0; i-- {
+		path[i] = node
+		node = prev[node]
+	}
+
+	return path, nil
+}
@@ -327,6 +327,15 @@ type Config struct {
 	// from the peer.
 	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
 
+	// HandleOnionMessage is called whenever an onion message is received
+	// from the peer. If nil, onion messages are ignored.
+	HandleOnionMessage func(peer [33]byte, msg *lnwire.OnionMessage) error
+
+	// TrampolineHandler is passed to the ChannelLink on creation and
+	// forwards the trampoline payments that we receive. If nil, htlcs
+	// carrying a trampoline onion are handed to the invoice registry.
+	TrampolineHandler htlcswitch.TrampolineHandler
+
 	// PongBuf is a slice we'll reuse instead of allocating memory on the
 	// heap. Since only reads will occur and no writes, there is no need
 	// for any synchronization primitives. As a result, it's safe to share
@@ -669,7 +678,7 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 
 		chanPoint := &dbChan.FundingOutpoint
 
-		chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+		chanID := dbChan.ChanID()
 
 		peerLog.Infof("NodeKey(%x) loading ChannelPoint(%v)",
 			p.PubKey(), chanPoint)
@@ -737,6 +746,14 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
 			}
+
+			inboundFee, err := selfPolicy.InboundFee()
+			if err != nil {
+				peerLog.Warnf("Unable to decode inbound fee "+
+					"for channel %v: %v", chanPoint, err)
+			} else {
+				forwardingPolicy.InboundFee = inboundFee
+			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
 				"for channel %v, using default values",
@@ -829,6 +846,15 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		towerClient = p.cfg.TowerClient
 	}
 
+	nodeKey, err := bronec.ParsePubKey(p.cfg.ServerPubKey[:], bronec.S256())
+	if err != nil {
+		return err
+	}
+
+	publishTx := func(tx *wire.MsgTx, label string) error {
+		return p.cfg.Wallet.PublishTransaction(tx, label)
+	}
+
 	linkCfg := htlcswitch.ChannelLinkConfig{
 		Peer:                    p,
 		DecodeHopIterators:      p.cfg.Sphinx.DecodeHopIterators,
@@ -836,6 +862,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		FetchLastChannelUpdate:  p.cfg.FetchLastChanUpdate,
 		HodlMask:                p.cfg.Hodl.Mask(),
 		Registry:                p.cfg.Invoices,
+		TrampolineHandler:       p.cfg.TrampolineHandler,
 		BestHeight:              p.cfg.Switch.BestHeight,
 		Circuits:                p.cfg.Switch.CircuitModifier(),
 		ForwardPackets:          p.cfg.InterceptSwitch.ForwardPackets,
@@ -862,13 +889,16 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		NotifyActiveChannel:     p.cfg.ChannelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel:   p.cfg.ChannelNotifier.NotifyInactiveChannelEvent,
 		HtlcNotifier:            p.cfg.HtlcNotifier,
+		NodeKey:                 nodeKey,
+		PublishTransaction:      publishTx,
+		SplicedShortChanID:      p.cfg.Switch.UpdateSplicedShortChanID,
 	}
 
 	// Before adding our new link, purge the switch of any pending or live
 	// links going by the same channel id. If one is found, we'll shut it
 	// down to ensure that the mailboxes are only ever under the control of
 	// one link.
-	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	chanID := lnChan.ChanID()
 	p.cfg.Switch.RemoveLink(chanID)
 
 	// With the channel link created, we'll now notify the htlc switch so
@@ -1213,24 +1243,22 @@ func waitUntilLinkActive(p *Brontide,
 		// that the event is for this channel. Otherwise, we discard the
 		// message.
 		case e := <-sub.Updates():
-			event, ok := e.(channelnotifier.ActiveLinkEvent)
+			_, ok := e.(channelnotifier.ActiveLinkEvent)
 			if !ok {
 				// Ignore this notification.
 				continue
 			}
 
-			chanPoint := event.ChannelPoint
-
-			// Check whether the retrieved chanPoint matches the target
-			// channel id.
-			if !cid.IsChanPoint(chanPoint) {
+			// Check whether the link that became active is the one
+			// of the target channel id. As the id of dual funded
+			// channels can't be derived from their channel point,
+			// we'll look the link up by its id instead.
+			link := p.fetchLinkFromKeyAndCid(cid)
+			if link == nil {
 				continue
 			}
 
-			// The link shouldn't be nil as we received an
-			// ActiveLinkEvent. If it is nil, we return nil and the
-			// calling function should catch it.
-			return p.fetchLinkFromKeyAndCid(cid)
+			return link
 
 		case <-p.quit:
 			return nil
@@ -1407,10 +1435,29 @@ out:
 			*lnwire.AcceptChannel,
 			*lnwire.FundingCreated,
 			*lnwire.FundingSigned,
-			*lnwire.FundingLocked:
+			*lnwire.FundingLocked,
+			*lnwire.OpenChannel2,
+			*lnwire.AcceptChannel2:
 
 			p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 
+		// The messages that construct a transaction interactively fund
+		// dual funded channels, but also splice open channels, in which
+		// case they're handled by the link.
+		case *lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxAbort:
+
+			targetChan = msg.(LinkUpdater).TargetChanID()
+			isLinkUpdate = p.isOpenChannel(targetChan)
+			if !isLinkUpdate {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+			}
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -1451,6 +1498,21 @@ out:
 			targetChan = msg.TargetChanID()
 			isLinkUpdate = p.isActiveChannel(targetChan)
 
+			// The first commitment signatures of a dual funded
+			// channel are exchanged while the channel is still
+			// being funded, so they're handled by the funding
+			// manager.
+			commitSig, ok := msg.(*lnwire.CommitSig)
+			if ok && !isLinkUpdate &&
+				p.cfg.FundingManager.IsPendingChannel(
+					targetChan, p,
+				) {
+
+				p.cfg.FundingManager.ProcessFundingMsg(
+					commitSig, p,
+				)
+			}
+
 		case *lnwire.ChannelUpdate,
 			*lnwire.ChannelAnnouncement,
 			*lnwire.NodeAnnouncement,
@@ -1470,6 +1532,13 @@ out:
 				peerLog.Errorf("peer: %v, %v", p, err)
 			}
 
+		case *lnwire.OnionMessage:
+			err := p.handleOnionMessage(msg)
+			if err != nil {
+				p.storeError(err)
+				peerLog.Errorf("peer: %v, %v", p, err)
+			}
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -1518,6 +1587,18 @@ func (p *Brontide) handleCustomMessage(msg *lnwire.Custom) error {
 	return p.cfg.HandleCustomMessage(p.PubKey(), msg)
 }
 
+// handleOnionMessage hands the given onion message to the onion message
+// handler. Support for onion messages is optional, so they are ignored if no
+// handler is registered.
+func (p *Brontide) handleOnionMessage(msg *lnwire.OnionMessage) error {
+	if p.cfg.HandleOnionMessage == nil {
+		peerLog.Tracef("peer: %v, ignoring onion message", p)
+		return nil
+	}
+
+	return p.cfg.HandleOnionMessage(p.PubKey(), msg)
+}
+
 // isActiveChannel returns true if the provided channel id is active, otherwise
 // returns false.
 func (p *Brontide) isActiveChannel(chanID lnwire.ChannelID) bool {
@@ -1527,6 +1608,40 @@ func (p *Brontide) isActiveChannel(chanID lnwire.ChannelID) bool {
 	return ok
 }
 
+// isOpenChannel returns true if the channel with the given ID is active and
+// no longer pending, which means that it has a link.
+func (p *Brontide) isOpenChannel(chanID lnwire.ChannelID) bool {
+	p.activeChanMtx.RLock()
+	lnChan := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+
+	return lnChan != nil
+}
+
+// chanIDFromOutPoint returns the ID of the active channel with the given
+// funding outpoint. The ID of dual funded channels isn't derived from their
+// funding outpoint, so we'll look it up among our active channels first, and
+// only fall back to the outpoint derived ID if the channel isn't found.
+func (p *Brontide) chanIDFromOutPoint(
+	chanPoint *wire.OutPoint) lnwire.ChannelID {
+
+	p.activeChanMtx.RLock()
+	defer p.activeChanMtx.RUnlock()
+
+	for chanID, channel := range p.activeChannels {
+		// Pending channels will be nil in the activeChannels map.
+		if channel == nil {
+			continue
+		}
+
+		if *channel.ChannelPoint() == *chanPoint {
+			return chanID
+		}
+	}
+
+	return lnwire.NewChanIDFromOutPoint(chanPoint)
+}
+
 // storeError stores an error in our peer's buffer of recent errors with the
 // current timestamp. Errors are only stored if we have at least one active
 // channel with the peer to mitigate a dos vector where a peer costlessly
@@ -1658,6 +1773,10 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, id=%v, fail_code=%v",
 			msg.ChanID, msg.ID, msg.FailureCode)
 
+	case *lnwire.Stfu:
+		return fmt.Sprintf("chan_id=%v, initiator=%v", msg.ChanID,
+			msg.Initiator)
+
 	case *lnwire.Error:
 		return fmt.Sprintf("%v", msg.Error())
 
@@ -2191,7 +2310,7 @@ out:
 		case newChanReq := <-p.newChannels:
 			newChan := newChanReq.channel
 			chanPoint := &newChan.FundingOutpoint
-			chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+			chanID := newChan.ChanID()
 
 			// Only update RemoteNextRevocation if the channel is in the
 			// activeChannels map and if we added the link to the switch.
@@ -2540,7 +2659,7 @@ func chooseDeliveryScript(upfront,
 // handleLocalCloseReq kicks-off the workflow to execute a cooperative or
 // forced unilateral closure of the channel initiated by a local subsystem.
 func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
-	chanID := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
+	chanID := p.chanIDFromOutPoint(req.ChanPoint)
 
 	p.activeChanMtx.RLock()
 	channel, ok := p.activeChannels[chanID]
@@ -2672,7 +2791,7 @@ type linkFailureReport struct {
 func (p *Brontide) handleLinkFailure(failure linkFailureReport) {
 	// Retrieve the channel from the map of active channels. We do this to
 	// have access to it even after WipeChannel remove it from the map.
-	chanID := lnwire.NewChanIDFromOutPoint(&failure.chanPoint)
+	chanID := failure.chanID
 	p.activeChanMtx.Lock()
 	lnChan := p.activeChannels[chanID]
 	p.activeChanMtx.Unlock()
@@ -2887,7 +3006,7 @@ func WaitForChanToClose(bestHeight uint32, notifier chainntnfs.ChainNotifier,
 // WipeChannel removes the passed channel point from all indexes associated with
 // the peer and the switch.
 func (p *Brontide) WipeChannel(chanPoint *wire.OutPoint) {
-	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+	chanID := p.chanIDFromOutPoint(chanPoint)
 
 	p.activeChanMtx.Lock()
 	delete(p.activeChannels, chanID)
@@ -32,6 +32,11 @@ type messageSwitch interface {
 	// ChannelUpdateHandler interface) based on the provided public key.
 	GetLinksByInterface(pub [33]byte) ([]htlcswitch.ChannelUpdateHandler,
 		error)
+
+	// UpdateSplicedShortChanID updates the short channel ID of a live link
+	// once a splice of its channel has been confirmed.
+	UpdateSplicedShortChanID(cid lnwire.ChannelID,
+		oldShortChanID lnwire.ShortChannelID) error
 }
 
 // LinkUpdater is an interface implemented by most messages in BOLT 2 that are
@@ -335,7 +335,7 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		MessageSigner:            nodeSignerAlice,
 		OurPubKey:                aliceKeyPub,
 		OurKeyLoc:                testKeyLoc,
-		IsChannelActive:          func(lnwire.ChannelID) bool { return true },
+		IsChannelActive:          func(wire.OutPoint) bool { return true },
 		ApplyChannelUpdate:       func(*lnwire.ChannelUpdate) error { return nil },
 	})
 	if err != nil {
@@ -367,7 +367,9 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		Switch:      mockSwitch,
 
 		ChanActiveTimeout: chanActiveTimeout,
-		InterceptSwitch:   htlcswitch.NewInterceptableSwitch(nil),
+		InterceptSwitch: htlcswitch.NewInterceptableSwitch(
+			&htlcswitch.InterceptableSwitchConfig{},
+		),
 
 		ChannelDB:      dbAlice.ChannelStateDB(),
 		FeeEstimator:   estimator,
@@ -421,6 +423,13 @@ func (m *mockMessageSwitch) GetLinksByInterface(pub [33]byte) (
 	return m.links, nil
 }
 
+// UpdateSplicedShortChanID currently returns nil.
+func (m *mockMessageSwitch) UpdateSplicedShortChanID(lnwire.ChannelID,
+	lnwire.ShortChannelID) error {
+
+	return nil
+}
+
 // mockUpdateHandler is a mock implementation of the ChannelUpdateHandler
 // interface. It is used in mockMessageSwitch's GetLinksByInterface method.
 type mockUpdateHandler struct {
@@ -452,6 +461,25 @@ func (m *mockUpdateHandler) MayAddOutgoingHtlc(lnwire.MilliBronees) error { retu
 // ShutdownIfChannelClean currently returns nil.
 func (m *mockUpdateHandler) ShutdownIfChannelClean() error { return nil }
 
+// Quiesce currently returns a channel that immediately receives a result.
+func (m *mockUpdateHandler) Quiesce() <-chan htlcswitch.QuiescenceResult {
+	resp := make(chan htlcswitch.QuiescenceResult, 1)
+	resp <- htlcswitch.QuiescenceResult{}
+	return resp
+}
+
+// ExitQuiescence currently returns nil.
+func (m *mockUpdateHandler) ExitQuiescence() error { return nil }
+
+// Splice currently returns a channel that immediately receives a result.
+func (m *mockUpdateHandler) Splice(
+	*htlcswitch.SpliceRequest) <-chan htlcswitch.SpliceResult {
+
+	resp := make(chan htlcswitch.SpliceResult, 1)
+	resp <- htlcswitch.SpliceResult{}
+	return resp
+}
+
 type mockMessageConn struct {
 	t *testing.T
 
@@ -0,0 +1,314 @@
+package record
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// blindedPaddingType is the type of the padding record that may be
+	// included in blinded route data to obscure its length.
+	blindedPaddingType tlv.Type = 1
+
+	// blindedScidType is the type of the short_channel_id record of
+	// blinded route data.
+	blindedScidType tlv.Type = 2
+
+	// blindedNextNodeType is the type of the next_node_id record of
+	// blinded route data.
+	blindedNextNodeType tlv.Type = 4
+
+	// blindedPathIDType is the type of the path_id record of blinded route
+	// data.
+	blindedPathIDType tlv.Type = 6
+
+	// blindedNextBlindingType is the type of the next_blinding_override
+	// record of blinded route data.
+	blindedNextBlindingType tlv.Type = 8
+
+	// blindedRelayInfoType is the type of the payment_relay record of
+	// blinded route data.
+	blindedRelayInfoType tlv.Type = 10
+
+	// blindedConstraintsType is the type of the payment_constraints record
+	// of blinded route data.
+	blindedConstraintsType tlv.Type = 12
+)
+
+// PaymentRelayInfo describes the relay policy that a forwarding node within a
+// blinded route applies to the payments it forwards.
+type PaymentRelayInfo struct {
+	// CltvExpiryDelta is the expiry delta that the forwarding node
+	// subtracts from the incoming HTLC's expiry.
+	CltvExpiryDelta uint16
+
+	// FeeRate is the proportional fee, in millionths, that the forwarding
+	// node charges.
+	FeeRate uint32
+
+	// BaseFee is the base fee, in millibroneess, that the forwarding node
+	// charges.
+	BaseFee uint32
+}
+
+// PaymentConstraints are the restrictions that the creator of a blinded route
+// places on the payments that may use it, limiting how the route can be
+// probed.
+type PaymentConstraints struct {
+	// MaxCltvExpiry is the maximum expiry that an HTLC may have when it
+	// reaches the hop.
+	MaxCltvExpiry uint32
+
+	// HtlcMinimumMsat is the minimum amount that an HTLC must carry when
+	// it reaches the hop.
+	HtlcMinimumMsat lnwire.MilliBronees
+}
+
+// BlindedRouteData is the plaintext of the encrypted_recipient_data that the
+// creator of a blinded route provides to each hop within it. Forwarding hops
+// learn where to forward the payment to, while the final hop learns the path
+// ID it handed out when creating the route.
+type BlindedRouteData struct {
+	// ShortChannelID is the channel over which a forwarding hop should
+	// send the payment to the next hop.
+	ShortChannelID *lnwire.ShortChannelID
+
+	// NextNodeID is the public key of the next node in the route.
+	NextNodeID *bronec.PublicKey
+
+	// PathID is an identifier the creator of the route gives to itself as
+	// the final hop, allowing it to authenticate that the payment arrived
+	// over a route it created.
+	PathID []byte
+
+	// NextBlindingOverride, if set, replaces the blinding point that is
+	// handed to the next hop.
+	NextBlindingOverride *bronec.PublicKey
+
+	// RelayInfo is the relay policy that a forwarding hop should apply.
+	RelayInfo *PaymentRelayInfo
+
+	// Constraints are the restrictions on the payments that may use the
+	// route.
+	Constraints *PaymentConstraints
+}
+
+// EncodeBlindedRouteData serializes the passed BlindedRouteData into the TLV
+// stream that is encrypted for a hop within a blinded route.
+func EncodeBlindedRouteData(data *BlindedRouteData) ([]byte, error) {
+	var records []tlv.Record
+
+	if data.ShortChannelID != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			blindedScidType, data.ShortChannelID, 8,
+			lnwire.EShortChannelID, lnwire.DShortChannelID,
+		))
+	}
+
+	if data.NextNodeID != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			blindedNextNodeType, &data.NextNodeID, 33,
+			tlv.EPubKey, tlv.DPubKey,
+		))
+	}
+
+	if data.PathID != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			blindedPathIDType, &data.PathID,
+		))
+	}
+
+	if data.NextBlindingOverride != nil {
+		records = append(records, tlv.MakeStaticRecord(
+			blindedNextBlindingType, &data.NextBlindingOverride, 33,
+			tlv.EPubKey, tlv.DPubKey,
+		))
+	}
+
+	if data.RelayInfo != nil {
+		records = append(records, newRelayInfoRecord(data.RelayInfo))
+	}
+
+	if data.Constraints != nil {
+		records = append(
+			records, newConstraintsRecord(data.Constraints),
+		)
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeBlindedRouteData parses the decrypted TLV stream of a hop within a
+// blinded route.
+func DecodeBlindedRouteData(r io.Reader) (*BlindedRouteData, error) {
+	var (
+		padding       []byte
+		scid          lnwire.ShortChannelID
+		nextNode      *bronec.PublicKey
+		pathID        []byte
+		nextBlinding  *bronec.PublicKey
+		relayInfo     PaymentRelayInfo
+		constraints   PaymentConstraints
+		data          BlindedRouteData
+		recordsToRead = []tlv.Record{
+			tlv.MakePrimitiveRecord(blindedPaddingType, &padding),
+			tlv.MakeStaticRecord(
+				blindedScidType, &scid, 8,
+				lnwire.EShortChannelID, lnwire.DShortChannelID,
+			),
+			tlv.MakeStaticRecord(
+				blindedNextNodeType, &nextNode, 33,
+				tlv.EPubKey, tlv.DPubKey,
+			),
+			tlv.MakePrimitiveRecord(blindedPathIDType, &pathID),
+			tlv.MakeStaticRecord(
+				blindedNextBlindingType, &nextBlinding, 33,
+				tlv.EPubKey, tlv.DPubKey,
+			),
+			newRelayInfoRecord(&relayInfo),
+			newConstraintsRecord(&constraints),
+		}
+	)
+
+	tlvStream, err := tlv.NewStream(recordsToRead...)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[blindedScidType]; ok {
+		data.ShortChannelID = &scid
+	}
+	if _, ok := parsedTypes[blindedNextNodeType]; ok {
+		data.NextNodeID = nextNode
+	}
+	if _, ok := parsedTypes[blindedPathIDType]; ok {
+		data.PathID = pathID
+	}
+	if _, ok := parsedTypes[blindedNextBlindingType]; ok {
+		data.NextBlindingOverride = nextBlinding
+	}
+	if _, ok := parsedTypes[blindedRelayInfoType]; ok {
+		data.RelayInfo = &relayInfo
+	}
+	if _, ok := parsedTypes[blindedConstraintsType]; ok {
+		data.Constraints = &constraints
+	}
+
+	return &data, nil
+}
+
+// newRelayInfoRecord creates a tlv.Record that encodes the payment_relay
+// (type 10) of blinded route data.
+func newRelayInfoRecord(info *PaymentRelayInfo) tlv.Record {
+	size := func() uint64 {
+		return 2 + 4 + tlv.SizeTUint32(info.BaseFee)
+	}
+
+	return tlv.MakeDynamicRecord(
+		blindedRelayInfoType, info, size, encodeRelayInfo,
+		decodeRelayInfo,
+	)
+}
+
+// encodeRelayInfo writes a PaymentRelayInfo to the provided io.Writer.
+func encodeRelayInfo(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*PaymentRelayInfo); ok {
+		if err := tlv.EUint16T(w, v.CltvExpiryDelta, buf); err != nil {
+			return err
+		}
+		if err := tlv.EUint32T(w, v.FeeRate, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint32T(w, v.BaseFee, buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentRelayInfo")
+}
+
+// decodeRelayInfo reads a PaymentRelayInfo from the provided io.Reader.
+func decodeRelayInfo(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentRelayInfo); ok && 6 <= l && l <= 10 {
+		err := tlv.DUint16(r, &v.CltvExpiryDelta, buf, 2)
+		if err != nil {
+			return err
+		}
+		if err := tlv.DUint32(r, &v.FeeRate, buf, 4); err != nil {
+			return err
+		}
+
+		return tlv.DTUint32(r, &v.BaseFee, buf, l-6)
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentRelayInfo", l, 10)
+}
+
+// newConstraintsRecord creates a tlv.Record that encodes the
+// payment_constraints (type 12) of blinded route data.
+func newConstraintsRecord(constraints *PaymentConstraints) tlv.Record {
+	size := func() uint64 {
+		return 4 + tlv.SizeTUint64(
+			uint64(constraints.HtlcMinimumMsat),
+		)
+	}
+
+	return tlv.MakeDynamicRecord(
+		blindedConstraintsType, constraints, size, encodeConstraints,
+		decodeConstraints,
+	)
+}
+
+// encodeConstraints writes PaymentConstraints to the provided io.Writer.
+func encodeConstraints(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*PaymentConstraints); ok {
+		if err := tlv.EUint32T(w, v.MaxCltvExpiry, buf); err != nil {
+			return err
+		}
+
+		return tlv.ETUint64T(w, uint64(v.HtlcMinimumMsat), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "PaymentConstraints")
+}
+
+// decodeConstraints reads PaymentConstraints from the provided io.Reader.
+func decodeConstraints(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*PaymentConstraints); ok && 4 <= l && l <= 12 {
+		if err := tlv.DUint32(r, &v.MaxCltvExpiry, buf, 4); err != nil {
+			return err
+		}
+
+		var htlcMin uint64
+		if err := tlv.DTUint64(r, &htlcMin, buf, l-4); err != nil {
+			return err
+		}
+		v.HtlcMinimumMsat = lnwire.MilliBronees(htlcMin)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "PaymentConstraints", l, 12)
+}
@@ -2,6 +2,7 @@ package record
 
 import (
 	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
 )
 
 const (
@@ -16,6 +17,21 @@ const (
 	// NextHopOnionType is the type used in the onion to reference the ID
 	// of the next hop.
 	NextHopOnionType tlv.Type = 6
+
+	// EncryptedDataOnionType is the type used in the onion to reference
+	// the encrypted_recipient_data that the creator of a blinded route
+	// provided for a hop within it.
+	EncryptedDataOnionType tlv.Type = 10
+
+	// BlindingPointOnionType is the type used in the onion to reference
+	// the current_blinding_point given to the introduction node of a
+	// blinded route.
+	BlindingPointOnionType tlv.Type = 12
+
+	// TotalAmtMsatBlindedType is the type used in the onion to reference
+	// the total amount of a payment made to the final hop of a blinded
+	// route.
+	TotalAmtMsatBlindedType tlv.Type = 18
 )
 
 // NewAmtToFwdRecord creates a tlv.Record that encodes the amount_to_forward
@@ -45,3 +61,33 @@ func NewLockTimeRecord(lockTime *uint32) tlv.Record {
 func NewNextHopIDRecord(cid *uint64) tlv.Record {
 	return tlv.MakePrimitiveRecord(NextHopOnionType, cid)
 }
+
+// NewEncryptedDataRecord creates a tlv.Record that encodes the
+// encrypted_recipient_data (type 10) for an onion payload.
+func NewEncryptedDataRecord(data *[]byte) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		EncryptedDataOnionType, data, func() uint64 {
+			return uint64(len(*data))
+		},
+		tlv.EVarBytes, tlv.DVarBytes,
+	)
+}
+
+// NewBlindingPointRecord creates a tlv.Record that encodes the
+// current_blinding_point (type 12) for an onion payload.
+func NewBlindingPointRecord(point **bronec.PublicKey) tlv.Record {
+	return tlv.MakeStaticRecord(
+		BlindingPointOnionType, point, 33, tlv.EPubKey, tlv.DPubKey,
+	)
+}
+
+// NewTotalAmtMsatBlinded creates a tlv.Record that encodes the
+// total_amount_msat (type 18) for an onion payload.
+func NewTotalAmtMsatBlinded(amt *uint64) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TotalAmtMsatBlindedType, amt, func() uint64 {
+			return tlv.SizeTUint64(*amt)
+		},
+		tlv.ETUint64, tlv.DTUint64,
+	)
+}
@@ -0,0 +1,278 @@
+package record
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// ReplyPathOnionMessageType is the type of the record within an onion
+	// message payload that carries a blinded path the recipient can use
+	// to reply to the message.
+	ReplyPathOnionMessageType tlv.Type = 2
+
+	// EncryptedDataOnionMessageType is the type of the record within an
+	// onion message payload that carries the encrypted route data of the
+	// hop.
+	EncryptedDataOnionMessageType tlv.Type = 4
+
+	// FinalHopTLVTypeStart is the first type of the range of records
+	// within an onion message payload that carry data for the final
+	// recipient of the message.
+	FinalHopTLVTypeStart tlv.Type = 64
+)
+
+var (
+	// ErrOnionMessageNoEncryptedData is returned when an onion message
+	// payload doesn't contain any encrypted route data, which every hop
+	// requires to process the message.
+	ErrOnionMessageNoEncryptedData = errors.New("onion message payload " +
+		"missing encrypted data")
+)
+
+// OnionMessagePayload is the payload of a single hop of an onion message.
+type OnionMessagePayload struct {
+	// ReplyPath is an optional blinded path to the sender of the message,
+	// which the final recipient may use to reply to it.
+	ReplyPath *blindedpath.BlindedPath
+
+	// EncryptedData is the route data that the creator of the blinded
+	// path encrypted for the hop.
+	EncryptedData []byte
+
+	// FinalHopTLVs are the records intended for the final recipient of
+	// the message, keyed by their type. All types must be at least
+	// FinalHopTLVTypeStart.
+	FinalHopTLVs map[uint64][]byte
+}
+
+// EncodeOnionMessagePayload serializes the passed payload into the TLV stream
+// that is placed into the onion for a hop.
+func EncodeOnionMessagePayload(payload *OnionMessagePayload) ([]byte, error) {
+	var records []tlv.Record
+
+	if payload.ReplyPath != nil {
+		var b bytes.Buffer
+		if err := EncodeBlindedPath(&b, payload.ReplyPath); err != nil {
+			return nil, err
+		}
+
+		replyPath := b.Bytes()
+		records = append(records, tlv.MakePrimitiveRecord(
+			ReplyPathOnionMessageType, &replyPath,
+		))
+	}
+
+	if payload.EncryptedData != nil {
+		records = append(records, tlv.MakePrimitiveRecord(
+			EncryptedDataOnionMessageType, &payload.EncryptedData,
+		))
+	}
+
+	for recordType, value := range payload.FinalHopTLVs {
+		if recordType < uint64(FinalHopTLVTypeStart) {
+			return nil, fmt.Errorf("final hop record type %v below "+
+				"%v", recordType, FinalHopTLVTypeStart)
+		}
+
+		value := value
+		records = append(records, tlv.MakePrimitiveRecord(
+			tlv.Type(recordType), &value,
+		))
+	}
+
+	// The final hop records are appended in random order, so the records
+	// need to be sorted before creating the stream.
+	tlv.SortRecords(records)
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodeOnionMessagePayload parses the TLV stream of a hop of an onion
+// message.
+func DecodeOnionMessagePayload(r io.Reader) (*OnionMessagePayload, error) {
+	var (
+		replyPath     []byte
+		encryptedData []byte
+		payload       OnionMessagePayload
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(ReplyPathOnionMessageType, &replyPath),
+		tlv.MakePrimitiveRecord(
+			EncryptedDataOnionMessageType, &encryptedData,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[ReplyPathOnionMessageType]; ok {
+		payload.ReplyPath, err = DecodeBlindedPath(
+			bytes.NewReader(replyPath),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reply path: %v", err)
+		}
+	}
+
+	if _, ok := parsedTypes[EncryptedDataOnionMessageType]; !ok {
+		return nil, ErrOnionMessageNoEncryptedData
+	}
+	payload.EncryptedData = encryptedData
+
+	for recordType, value := range parsedTypes {
+		// Known records are stored with a nil value.
+		if value == nil {
+			continue
+		}
+
+		// Unknown even types below the final hop range must be
+		// understood by every hop, so we can't process the message.
+		if recordType < FinalHopTLVTypeStart {
+			if recordType%2 == 0 {
+				return nil, fmt.Errorf("unknown required onion "+
+					"message record type %v", recordType)
+			}
+
+			continue
+		}
+
+		if payload.FinalHopTLVs == nil {
+			payload.FinalHopTLVs = make(map[uint64][]byte)
+		}
+		payload.FinalHopTLVs[uint64(recordType)] = value
+	}
+
+	return &payload, nil
+}
+
+// EncodeBlindedPath serializes a blinded path into the following format: the
+// introduction node (33 bytes), the blinding point (33 bytes), the number of
+// hops (u8) and, for each hop, its blinded node pubkey (33 bytes) along with
+// its length-prefixed (u16) encrypted data.
+func EncodeBlindedPath(w io.Writer, path *blindedpath.BlindedPath) error {
+	if len(path.BlindedHops) == 0 {
+		return blindedpath.ErrNoHops
+	}
+	if len(path.BlindedHops) > 255 {
+		return fmt.Errorf("blinded path has too many hops: %d",
+			len(path.BlindedHops))
+	}
+
+	_, err := w.Write(path.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(path.BlindingPoint.SerializeCompressed()); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{byte(len(path.BlindedHops))}); err != nil {
+		return err
+	}
+
+	for _, hop := range path.BlindedHops {
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		if len(hop.CipherText) > 0xffff {
+			return fmt.Errorf("blinded hop data too large: %d",
+				len(hop.CipherText))
+		}
+
+		var dataLen [2]byte
+		binary.BigEndian.PutUint16(dataLen[:], uint16(len(hop.CipherText)))
+		if _, err := w.Write(dataLen[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeBlindedPath deserializes a blinded path that was encoded using
+// EncodeBlindedPath.
+func DecodeBlindedPath(r io.Reader) (*blindedpath.BlindedPath, error) {
+	introductionPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+	blindingPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, blindedpath.ErrNoHops
+	}
+
+	hops := make([]*blindedpath.BlindedHop, 0, numHops[0])
+	for i := 0; i < int(numHops[0]); i++ {
+		blindedNodePub, err := readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var dataLen [2]byte
+		if _, err := io.ReadFull(r, dataLen[:]); err != nil {
+			return nil, err
+		}
+
+		cipherText := make([]byte, binary.BigEndian.Uint16(dataLen[:]))
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, &blindedpath.BlindedHop{
+			BlindedNodePub: blindedNodePub,
+			CipherText:     cipherText,
+		})
+	}
+
+	return &blindedpath.BlindedPath{
+		IntroductionPoint: introductionPoint,
+		BlindingPoint:     blindingPoint,
+		BlindedHops:       hops,
+	}, nil
+}
+
+// readPubKey reads a compressed public key from the passed reader.
+func readPubKey(r io.Reader) (*bronec.PublicKey, error) {
+	var keyBytes [33]byte
+	if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return bronec.ParsePubKey(keyBytes[:], bronec.S256())
+}
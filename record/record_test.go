@@ -7,6 +7,8 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
 	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
 )
 
 type recordEncDecTest struct {
@@ -97,3 +99,62 @@ func TestRecordEncodeDecode(t *testing.T) {
 		})
 	}
 }
+
+// TestBlindedRouteDataEncodeDecode asserts that the route data of hops within
+// a blinded route can be encoded and decoded.
+func TestBlindedRouteDataEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	scid := lnwire.NewShortChanIDFromInt(12345)
+	tests := []struct {
+		name string
+		data *record.BlindedRouteData
+	}{
+		{
+			name: "intermediate hop",
+			data: &record.BlindedRouteData{
+				ShortChannelID:       &scid,
+				NextBlindingOverride: privKey.PubKey(),
+				RelayInfo: &record.PaymentRelayInfo{
+					CltvExpiryDelta: 144,
+					FeeRate:         10,
+					BaseFee:         1000,
+				},
+				Constraints: &record.PaymentConstraints{
+					MaxCltvExpiry:   1000,
+					HtlcMinimumMsat: 1,
+				},
+			},
+		},
+		{
+			name: "final hop",
+			data: &record.BlindedRouteData{
+				PathID: testAddr[:],
+				Constraints: &record.PaymentConstraints{
+					MaxCltvExpiry: 500,
+				},
+			},
+		},
+		{
+			name: "empty",
+			data: &record.BlindedRouteData{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := record.EncodeBlindedRouteData(test.data)
+			require.NoError(t, err)
+
+			decoded, err := record.DecodeBlindedRouteData(
+				bytes.NewReader(encoded),
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.data, decoded)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package record
+
+import (
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// OutgoingNodeIDOnionType is the type used in a trampoline onion to
+	// reference the public key of the next trampoline node, or of the
+	// final recipient, that the payment should be forwarded to.
+	OutgoingNodeIDOnionType tlv.Type = 14
+
+	// TrampolineOnionType is the type used in the outer onion to carry
+	// the trampoline onion packet for the trampoline node that the outer
+	// onion terminates at.
+	TrampolineOnionType tlv.Type = 20
+)
+
+// NewOutgoingNodeIDRecord creates a tlv.Record that encodes the
+// outgoing_node_id (type 14) for a trampoline onion payload.
+func NewOutgoingNodeIDRecord(nodeID **bronec.PublicKey) tlv.Record {
+	return tlv.MakeStaticRecord(
+		OutgoingNodeIDOnionType, nodeID, 33, tlv.EPubKey, tlv.DPubKey,
+	)
+}
+
+// NewTrampolineOnionRecord creates a tlv.Record that encodes the serialized
+// trampoline_onion_packet (type 20) for an onion payload.
+func NewTrampolineOnionRecord(onion *[]byte) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TrampolineOnionType, onion, func() uint64 {
+			return uint64(len(*onion))
+		},
+		tlv.EVarBytes, tlv.DVarBytes,
+	)
+}
@@ -0,0 +1,268 @@
+package blindedpath
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/brond/bronec"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var (
+	// blindedNodeIDInfo is the key used to derive the factor that a node's
+	// public key is blinded with.
+	blindedNodeIDInfo = []byte("blinded_node_id")
+
+	// rhoInfo is the key used to derive the key that a hop's route data is
+	// encrypted with.
+	rhoInfo = []byte("rho")
+
+	// zeroNonce is the nonce used when encrypting route data. Each key is
+	// only ever used once, so a fixed nonce is safe.
+	zeroNonce [chacha20poly1305.NonceSize]byte
+
+	// ErrNoHops is returned when a blinded path is requested for an empty
+	// set of hops.
+	ErrNoHops = errors.New("blinded path must contain at least one hop")
+)
+
+// HopInfo contains the public key of a hop within a blinded path along with
+// the plaintext route data that should be encrypted for it.
+type HopInfo struct {
+	// NodePub is the real public key of the hop.
+	NodePub *bronec.PublicKey
+
+	// PlainText is the serialized route data for the hop.
+	PlainText []byte
+}
+
+// BlindedHop is a hop within a blinded path, identified by its blinded public
+// key, along with the route data that was encrypted for it.
+type BlindedHop struct {
+	// BlindedNodePub is the blinded public key of the hop. Payers use it
+	// in place of the hop's real public key when constructing the onion.
+	BlindedNodePub *bronec.PublicKey
+
+	// CipherText is the encrypted route data for the hop, which payers
+	// include in the hop's onion payload as encrypted_recipient_data.
+	CipherText []byte
+}
+
+// BlindedPath is a route to a node that hides the identity of all hops within
+// it, apart from the introduction node.
+type BlindedPath struct {
+	// IntroductionPoint is the real public key of the first hop of the
+	// path, which payers route to in the clear.
+	IntroductionPoint *bronec.PublicKey
+
+	// BlindingPoint is the ephemeral public key that is handed to the
+	// introduction node so it can derive the shared secret for its hop.
+	BlindingPoint *bronec.PublicKey
+
+	// BlindedHops is the set of blinded hops of the path, starting with
+	// the introduction node.
+	BlindedHops []*BlindedHop
+}
+
+// BlindedPayment is a blinded path along with the aggregated relay parameters
+// of its hops, which is all a payer needs to route a payment over it.
+type BlindedPayment struct {
+	// BlindedPath is the blinded path to pay over.
+	BlindedPath *BlindedPath
+
+	// BaseFee is the total base fee, in millibroneess, charged by the
+	// hops of the path.
+	BaseFee uint32
+
+	// ProportionalFeeRate is the total proportional fee, in millionths,
+	// charged by the hops of the path.
+	ProportionalFeeRate uint32
+
+	// CltvExpiryDelta is the total expiry delta of the path, including the
+	// final expiry delta that the recipient requires.
+	CltvExpiryDelta uint16
+
+	// HtlcMinimum is the minimum amount, in millibroneess, that an HTLC
+	// sent over the path must carry.
+	HtlcMinimum uint64
+
+	// HtlcMaximum is the maximum amount, in millibroneess, that an HTLC
+	// sent over the path may carry.
+	HtlcMaximum uint64
+}
+
+// BuildBlindedPath creates a blinded path over the passed hops, using the
+// given session key as the initial blinding secret. For each hop, the shared
+// secret ss = SHA256(e * N) is used to blind the hop's public key as
+// HMAC256("blinded_node_id", ss) * N, and to encrypt its route data with the
+// key HMAC256("rho", ss). The blinding secret for the next hop is then
+// derived as SHA256(E || ss) * e.
+func BuildBlindedPath(sessionKey *bronec.PrivateKey,
+	hops []*HopInfo) (*BlindedPath, error) {
+
+	if len(hops) == 0 {
+		return nil, ErrNoHops
+	}
+
+	curve := bronec.S256()
+	path := &BlindedPath{
+		IntroductionPoint: hops[0].NodePub,
+		BlindingPoint:     sessionKey.PubKey(),
+		BlindedHops:       make([]*BlindedHop, 0, len(hops)),
+	}
+
+	blindingSecret := new(big.Int).Set(sessionKey.D)
+	blindingPoint := sessionKey.PubKey()
+	for i, hop := range hops {
+		ephemeralKey := &keychain.PrivKeyECDH{
+			PrivKey: scalarToPrivKey(blindingSecret),
+		}
+		sharedSecret, err := ephemeralKey.ECDH(hop.NodePub)
+		if err != nil {
+			return nil, err
+		}
+
+		cipherText, err := encryptData(sharedSecret, hop.PlainText)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt data for hop "+
+				"%d: %v", i, err)
+		}
+
+		path.BlindedHops = append(path.BlindedHops, &BlindedHop{
+			BlindedNodePub: scalarMult(
+				hop.NodePub, hmac256(blindedNodeIDInfo, sharedSecret),
+			),
+			CipherText: cipherText,
+		})
+
+		// Derive the blinding secret and point for the next hop.
+		factor := nextBlindingFactor(blindingPoint, sharedSecret)
+		blindingSecret.Mul(blindingSecret, new(big.Int).SetBytes(factor))
+		blindingSecret.Mod(blindingSecret, curve.N)
+		blindingPoint = scalarMult(blindingPoint, factor)
+	}
+
+	return path, nil
+}
+
+// DecryptBlindedHopData decrypts the route data that the creator of a blinded
+// path encrypted for the node with the given key, using the blinding point
+// that was handed to it.
+func DecryptBlindedHopData(nodeKey keychain.SingleKeyECDH,
+	blindingPoint *bronec.PublicKey, cipherText []byte) ([]byte, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.New(hmac256(rhoInfo, sharedSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, zeroNonce[:], cipherText, nil)
+}
+
+// NextBlindingPoint derives the blinding point that the node with the given
+// key should hand to the next hop of a blinded path.
+func NextBlindingPoint(nodeKey keychain.SingleKeyECDH,
+	blindingPoint *bronec.PublicKey) (*bronec.PublicKey, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return scalarMult(
+		blindingPoint, nextBlindingFactor(blindingPoint, sharedSecret),
+	), nil
+}
+
+// TweakOnionKey tweaks the ephemeral key of an onion packet that was encrypted
+// to the blinded public key of the node with the given key. Performing an ECDH
+// between the node's real key and the tweaked ephemeral key yields the same
+// shared secret as one between its blinded key and the original ephemeral
+// key, which allows the onion to be processed without having access to the
+// blinded private key.
+func TweakOnionKey(nodeKey keychain.SingleKeyECDH,
+	blindingPoint, onionKey *bronec.PublicKey) (*bronec.PublicKey, error) {
+
+	sharedSecret, err := nodeKey.ECDH(blindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return scalarMult(
+		onionKey, hmac256(blindedNodeIDInfo, sharedSecret),
+	), nil
+}
+
+// NextOnionKey derives the ephemeral key that should be forwarded to the next
+// hop of an onion that was processed using a key tweaked by TweakOnionKey. The
+// shared secret of the hop is derived from the tweaked key, while the blinding
+// factor of the next ephemeral key must commit to the original one.
+func NextOnionKey(nodeKey keychain.SingleKeyECDH,
+	tweakedKey, onionKey *bronec.PublicKey) (*bronec.PublicKey, error) {
+
+	sharedSecret, err := nodeKey.ECDH(tweakedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return scalarMult(
+		onionKey, nextBlindingFactor(onionKey, sharedSecret),
+	), nil
+}
+
+// encryptData encrypts the route data of a hop using the key derived from the
+// shared secret with it.
+func encryptData(sharedSecret [32]byte, plainText []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(hmac256(rhoInfo, sharedSecret))
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, zeroNonce[:], plainText, nil), nil
+}
+
+// nextBlindingFactor computes the factor SHA256(E || ss) that the blinding
+// point and secret are multiplied with to derive those of the next hop.
+func nextBlindingFactor(blindingPoint *bronec.PublicKey,
+	sharedSecret [32]byte) []byte {
+
+	h := sha256.New()
+	h.Write(blindingPoint.SerializeCompressed())
+	h.Write(sharedSecret[:])
+
+	return h.Sum(nil)
+}
+
+// hmac256 computes the HMAC-SHA256 of the shared secret using the given key.
+func hmac256(key []byte, sharedSecret [32]byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(sharedSecret[:])
+
+	return mac.Sum(nil)
+}
+
+// scalarMult multiplies the given public key by the scalar.
+func scalarMult(pub *bronec.PublicKey, scalar []byte) *bronec.PublicKey {
+	x, y := bronec.S256().ScalarMult(pub.X, pub.Y, scalar)
+
+	return &bronec.PublicKey{Curve: bronec.S256(), X: x, Y: y}
+}
+
+// scalarToPrivKey converts the scalar into a private key.
+func scalarToPrivKey(scalar *big.Int) *bronec.PrivateKey {
+	var keyBytes [32]byte
+	scalar.FillBytes(keyBytes[:])
+
+	privKey, _ := bronec.PrivKeyFromBytes(bronec.S256(), keyBytes[:])
+
+	return privKey
+}
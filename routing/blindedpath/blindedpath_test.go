@@ -0,0 +1,111 @@
+package blindedpath
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBlindedPathRoundTrip tests that each hop of a blinded path is able to
+// decrypt the route data intended for it, derive the blinding point of the
+// next hop, and process onions sent to its blinded public key.
+func TestBlindedPathRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const numHops = 3
+
+	var (
+		nodeKeys = make([]*bronec.PrivateKey, numHops)
+		hops     = make([]*HopInfo, numHops)
+	)
+	for i := 0; i < numHops; i++ {
+		privKey, err := bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		nodeKeys[i] = privKey
+		hops[i] = &HopInfo{
+			NodePub:   privKey.PubKey(),
+			PlainText: []byte{byte(i), 0x01, 0x02},
+		}
+	}
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	path, err := BuildBlindedPath(sessionKey, hops)
+	require.NoError(t, err)
+	require.True(t, path.IntroductionPoint.IsEqual(hops[0].NodePub))
+	require.Len(t, path.BlindedHops, numHops)
+
+	blindingPoint := path.BlindingPoint
+	for i, blindedHop := range path.BlindedHops {
+		nodeKey := &keychain.PrivKeyECDH{PrivKey: nodeKeys[i]}
+
+		plainText, err := DecryptBlindedHopData(
+			nodeKey, blindingPoint, blindedHop.CipherText,
+		)
+		require.NoError(t, err)
+		require.Equal(t, hops[i].PlainText, plainText)
+
+		// Derive the blinded private key of the hop, and make sure
+		// that it matches the blinded public key in the path.
+		sharedSecret, err := nodeKey.ECDH(blindingPoint)
+		require.NoError(t, err)
+
+		blindedPriv := new(big.Int).SetBytes(
+			hmac256(blindedNodeIDInfo, sharedSecret),
+		)
+		blindedPriv.Mul(blindedPriv, nodeKeys[i].D)
+		blindedPriv.Mod(blindedPriv, bronec.S256().N)
+		blindedKey := scalarToPrivKey(blindedPriv)
+		require.True(
+			t, blindedKey.PubKey().IsEqual(blindedHop.BlindedNodePub),
+		)
+
+		// An ECDH of the real key with the tweaked onion key should
+		// equal one of the blinded key with the original onion key.
+		onionKey, err := bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		tweakedKey, err := TweakOnionKey(
+			nodeKey, blindingPoint, onionKey.PubKey(),
+		)
+		require.NoError(t, err)
+
+		expected, err := (&keychain.PrivKeyECDH{
+			PrivKey: blindedKey,
+		}).ECDH(onionKey.PubKey())
+		require.NoError(t, err)
+
+		actual, err := nodeKey.ECDH(tweakedKey)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		// The ephemeral key for the next hop must be derived from the
+		// original onion key, as the sender would.
+		nextKey, err := NextOnionKey(
+			nodeKey, tweakedKey, onionKey.PubKey(),
+		)
+		require.NoError(t, err)
+		require.True(t, nextKey.IsEqual(scalarMult(
+			onionKey.PubKey(),
+			nextBlindingFactor(onionKey.PubKey(), expected),
+		)))
+
+		blindingPoint, err = NextBlindingPoint(nodeKey, blindingPoint)
+		require.NoError(t, err)
+	}
+
+	// Data encrypted for one hop must not be readable by another.
+	_, err = DecryptBlindedHopData(
+		&keychain.PrivKeyECDH{PrivKey: nodeKeys[1]}, path.BlindingPoint,
+		path.BlindedHops[0].CipherText,
+	)
+	require.Error(t, err)
+
+	_, err = BuildBlindedPath(sessionKey, nil)
+	require.ErrorIs(t, err, ErrNoHops)
+}
@@ -21,9 +21,16 @@ type nodeWithDist struct {
 
 	// amountToReceive is the amount that should be received by this node.
 	// Either as final payment to the final node or as an intermediate
-	// amount that includes also the fees for subsequent hops.
+	// amount that includes also the fees for subsequent hops. It doesn't
+	// include the inbound fee of this node, which depends on the channel
+	// that the htlc arrives over.
 	amountToReceive lnwire.MilliBronees
 
+	// outboundFee is the fee that this node charges for its outgoing
+	// channel. It bounds the discount that the node can give through its
+	// inbound fee.
+	outboundFee lnwire.MilliBronees
+
 	// incomingCltv is the expected absolute expiry height for the incoming
 	// htlc of this node. This value should already include the final cltv
 	// delta.
@@ -11,6 +11,7 @@ import (
 	"github.com/brsuite/broln/kvdb"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -67,6 +68,14 @@ func newIntegratedRoutingContext(t *testing.T) *integratedRoutingContext {
 	// defaults would break the unit tests. The actual values picked aren't
 	// critical to excite certain behavior, but do need to be aligned with
 	// the test case assertions.
+	aCfg := AprioriConfig{
+		PenaltyHalfLife:       30 * time.Minute,
+		AprioriHopProbability: 0.6,
+		AprioriWeight:         0.5,
+	}
+	estimator, err := NewAprioriEstimator(aCfg)
+	require.NoError(t, err)
+
 	ctx := integratedRoutingContext{
 		t:           t,
 		graph:       graph,
@@ -74,11 +83,7 @@ func newIntegratedRoutingContext(t *testing.T) *integratedRoutingContext {
 		finalExpiry: 40,
 
 		mcCfg: MissionControlConfig{
-			ProbabilityEstimatorCfg: ProbabilityEstimatorCfg{
-				PenaltyHalfLife:       30 * time.Minute,
-				AprioriHopProbability: 0.6,
-				AprioriWeight:         0.5,
-			},
+			Estimator: estimator,
 		},
 
 		pathFindingCfg: PathFindingConfig{
@@ -190,7 +195,7 @@ func (c *integratedRoutingContext) testPayment(maxParts uint32,
 	}
 
 	// Override default minimum shard amount.
-	session.minShardAmt = lnwire.NewMSatFromBroneess(5000)
+	session.planner.minShardAmt = lnwire.NewMSatFromBroneess(5000)
 
 	// Now the payment control loop starts. It will keep trying routes until
 	// the payment succeeds.
@@ -67,7 +67,12 @@ func TestProbabilityExtrapolation(t *testing.T) {
 	// If we use a static value for the node probability (no extrapolation
 	// of data from other channels), all ten bad channels will be tried
 	// first before switching to the paid channel.
-	ctx.mcCfg.AprioriWeight = 1
+	aCfg := ctx.mcCfg.Estimator.Config().(AprioriConfig)
+	aCfg.AprioriWeight = 1
+	estimator, err := NewAprioriEstimator(aCfg)
+	require.NoError(t, err)
+	ctx.mcCfg.Estimator = estimator
+
 	attempts, err = ctx.testPayment(1)
 	if err != nil {
 		t.Fatalf("payment failed: %v", err)
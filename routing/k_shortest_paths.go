@@ -0,0 +1,343 @@
+package routing
+
+import (
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
+)
+
+// candidatePath is an alternative path that was found during the search for
+// the k shortest paths, but isn't selected yet.
+type candidatePath struct {
+	// nodes is the sequence of nodes that the path visits, starting at the
+	// source and ending at the target.
+	nodes []route.Vertex
+
+	// edges contains the policies of the path.
+	edges []*channeldb.CachedEdgePolicy
+
+	// dist is the distance of the path in the same metric that findPath
+	// minimizes.
+	dist int64
+}
+
+// findPaths finds up to numPaths loopless paths from source to target, in
+// order of increasing distance. The first path is the one that findPath
+// returns. Alternatives are found using Yen's algorithm: for every node of the
+// previously selected path, a spur path is searched from that node to the
+// target that deviates from all selected paths that share the same root. Path
+// finding operates on node pairs rather than individual channels, so paths are
+// distinguished by the nodes that they visit.
+func findPaths(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+	source, target route.Vertex, amt lnwire.MilliBronees,
+	finalHtlcExpiry int32, numPaths int) ([][]*channeldb.CachedEdgePolicy,
+	error) {
+
+	path, err := findPath(g, r, cfg, source, target, amt, finalHtlcExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		paths      = [][]*channeldb.CachedEdgePolicy{path}
+		nodes      = [][]route.Vertex{pathNodes(source, path)}
+		candidates []*candidatePath
+		known      = map[string]struct{}{nodesKey(nodes[0]): {}}
+	)
+
+	for len(paths) < numPaths {
+		prevNodes := nodes[len(nodes)-1]
+
+		// Deviate from the previous path at every node except the
+		// target.
+		for i := 0; i < len(prevNodes)-1; i++ {
+			spurNode := prevNodes[i]
+			rootNodes := prevNodes[:i+1]
+
+			// The spur path may not revisit the nodes of the root
+			// path, which would create a loop.
+			ignoredNodes := make(map[route.Vertex]struct{})
+			for _, node := range rootNodes[:i] {
+				ignoredNodes[node] = struct{}{}
+			}
+
+			// It may also not continue any of the selected paths
+			// that share the same root.
+			ignoredPairs := make(map[DirectedNodePair]struct{})
+			for _, selected := range nodes {
+				if len(selected) <= i+1 ||
+					!sameNodes(selected[:i+1], rootNodes) {
+
+					continue
+				}
+
+				pair := NewDirectedNodePair(
+					selected[i], selected[i+1],
+				)
+				ignoredPairs[pair] = struct{}{}
+			}
+
+			spurRestrictions := *r
+			spurRestrictions.ProbabilitySource = func(
+				fromNode, toNode route.Vertex,
+				amt lnwire.MilliBronees,
+				capacity bronutil.Amount) float64 {
+
+				if _, ok := ignoredNodes[fromNode]; ok {
+					return 0
+				}
+
+				pair := NewDirectedNodePair(fromNode, toNode)
+				if _, ok := ignoredPairs[pair]; ok {
+					return 0
+				}
+
+				return r.ProbabilitySource(
+					fromNode, toNode, amt, capacity,
+				)
+			}
+
+			spurPath, err := findPath(
+				g, &spurRestrictions, cfg, spurNode, target,
+				amt, finalHtlcExpiry,
+			)
+			switch {
+			case err == errNoPathFound:
+				continue
+
+			case err != nil:
+				return nil, err
+			}
+
+			// Join the root and the spur path and skip the result
+			// if it was found before.
+			spurNodes := pathNodes(spurNode, spurPath)
+			totalNodes := make([]route.Vertex, 0, len(rootNodes))
+			totalNodes = append(totalNodes, rootNodes...)
+			totalNodes = append(totalNodes, spurNodes[1:]...)
+
+			key := nodesKey(totalNodes)
+			if _, ok := known[key]; ok {
+				continue
+			}
+			known[key] = struct{}{}
+
+			// The channels of the root path were selected for the
+			// amount of the previous path, so the policies of the
+			// joined path are selected again.
+			candidate, err := evaluatePath(
+				g, r, cfg, totalNodes, amt,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			// Skip the path if it violates the restrictions as a
+			// whole, for example because the fees of the root and
+			// the spur path together exceed the fee limit.
+			if candidate == nil {
+				continue
+			}
+
+			// The features of the target were determined by the
+			// search for the spur path.
+			lastEdge := candidate.edges[len(candidate.edges)-1]
+			lastEdge.ToNodeFeatures =
+				spurPath[len(spurPath)-1].ToNodeFeatures
+
+			candidates = append(candidates, candidate)
+		}
+
+		// If there are no candidates left, there are no more paths.
+		if len(candidates) == 0 {
+			break
+		}
+
+		// Select the candidate with the lowest distance. Candidates
+		// that were found first win ties.
+		best := 0
+		for i, candidate := range candidates {
+			if candidate.dist < candidates[best].dist {
+				best = i
+			}
+		}
+
+		paths = append(paths, candidates[best].edges)
+		nodes = append(nodes, candidates[best].nodes)
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+
+	return paths, nil
+}
+
+// evaluatePath selects the channel policies for the given sequence of nodes and
+// calculates the distance of the resulting path in the same way as findPath
+// does. If no policies can be selected or the path doesn't satisfy the
+// restrictions, nil is returned.
+func evaluatePath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+	nodes []route.Vertex, amt lnwire.MilliBronees) (*candidatePath,
+	error) {
+
+	var outgoingChanMap map[uint64]struct{}
+	if len(r.OutgoingChannelIDs) > 0 {
+		outgoingChanMap = make(map[uint64]struct{})
+		for _, outChan := range r.OutgoingChannelIDs {
+			outgoingChanMap[outChan] = struct{}{}
+		}
+	}
+
+	var (
+		self   = g.graph.sourceNode()
+		source = nodes[0]
+		target = nodes[len(nodes)-1]
+		edges  = make([]*channeldb.CachedEdgePolicy, len(nodes)-1)
+
+		amountToReceive = amt
+		outboundFee     lnwire.MilliBronees
+		cltvDelta       uint64
+		weight          int64
+		probability     = 1.0
+	)
+
+	// Walk the path backwards, just like findPath does, so that the fees
+	// and the amounts to forward are known when the policies are selected.
+	for i := len(nodes) - 1; i > 0; i-- {
+		fromNode, toNode := nodes[i-1], nodes[i]
+
+		u := newUnifiedPolicies(
+			self, toNode, outgoingChanMap, r.IgnoredChannels,
+		)
+
+		err := u.addGraphPolicies(g.graph)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range g.additionalEdges[fromNode] {
+			if edge.ToNodePubKey() == toNode {
+				u.addPolicy(fromNode, edge, 0)
+			}
+		}
+
+		unifiedPolicy, ok := u.policies[fromNode]
+		if !ok {
+			return nil, nil
+		}
+
+		policy := unifiedPolicy.getPolicy(
+			amountToReceive, g.bandwidthHints,
+		)
+		if policy == nil {
+			return nil, nil
+		}
+		capacity := unifiedPolicy.capacity(amountToReceive)
+
+		var inboundFee int64
+		if toNode != target {
+			inboundFee = policy.InboundFee.CalcFee(amountToReceive)
+
+			minInboundFee := -int64(outboundFee)
+			if inboundFee < minInboundFee {
+				inboundFee = minInboundFee
+			}
+		}
+
+		amountToSend := lnwire.MilliBronees(
+			int64(amountToReceive) + inboundFee,
+		)
+
+		edgeProbability := r.ProbabilitySource(
+			fromNode, toNode, amountToSend, capacity,
+		)
+
+		var fee lnwire.MilliBronees
+		var timeLockDelta uint16
+		if fromNode != source {
+			fee = policy.ComputeFee(amountToSend)
+			timeLockDelta = policy.TimeLockDelta
+		}
+
+		if edgeProbability == 0 {
+			return nil, nil
+		}
+
+		edgeFee := int64(fee) + inboundFee
+		if edgeFee < 0 {
+			edgeFee = 0
+		}
+
+		amountToReceive = amountToSend + fee
+		outboundFee = fee
+		cltvDelta += uint64(timeLockDelta)
+		probability *= edgeProbability
+		weight += edgeWeight(
+			amountToReceive, lnwire.MilliBronees(edgeFee),
+			timeLockDelta,
+		)
+
+		edges[i-1] = policy
+	}
+
+	switch {
+	case amountToReceive-amt > r.FeeLimit:
+		return nil, nil
+
+	case cltvDelta > uint64(r.CltvLimit):
+		return nil, nil
+
+	case probability < cfg.MinProbability:
+		return nil, nil
+	}
+
+	absoluteAttemptCost := int64(cfg.AttemptCost) +
+		int64(amt)*cfg.AttemptCostPPM/1000000
+
+	return &candidatePath{
+		nodes: nodes,
+		edges: edges,
+		dist: getProbabilityBasedDist(
+			weight, probability, absoluteAttemptCost,
+		),
+	}, nil
+}
+
+// pathNodes returns the sequence of nodes that the given path visits, starting
+// at the source.
+func pathNodes(source route.Vertex,
+	path []*channeldb.CachedEdgePolicy) []route.Vertex {
+
+	nodes := make([]route.Vertex, 0, len(path)+1)
+	nodes = append(nodes, source)
+	for _, edge := range path {
+		nodes = append(nodes, edge.ToNodePubKey())
+	}
+
+	return nodes
+}
+
+// sameNodes returns true if both sequences contain the same nodes in the same
+// order.
+func sameNodes(a, b []route.Vertex) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nodesKey returns a string that uniquely identifies a sequence of nodes.
+func nodesKey(nodes []route.Vertex) string {
+	key := make([]byte, 0, len(nodes)*route.VertexSize)
+	for _, node := range nodes {
+		key = append(key, node[:]...)
+	}
+
+	return string(key)
+}
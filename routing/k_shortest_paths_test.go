@@ -0,0 +1,189 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFindPaths tests that alternative paths are returned in order of
+// increasing cost and that they satisfy the restrictions.
+func TestFindPaths(t *testing.T) {
+	t.Run("with cache", func(tt *testing.T) {
+		testFindPaths(tt, true)
+	})
+	t.Run("without cache", func(tt *testing.T) {
+		testFindPaths(tt, false)
+	})
+}
+
+func testFindPaths(t *testing.T, useCache bool) {
+	policyWithFee := func(fee lnwire.MilliBronees) *testChannelPolicy {
+		return &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: fee,
+		}
+	}
+
+	// Create a graph in which the target can be reached via a, b and c at
+	// increasing fees. Additionally, a and b are connected, with a higher
+	// fee to forward from b to a.
+	testChannels := []*testChannel{
+		symmetricTestChannel(
+			"source", "a", 100000, policyWithFee(0), 1,
+		),
+		asymmetricTestChannel(
+			"a", "target", 100000, policyWithFee(100),
+			policyWithFee(0), 2,
+		),
+		symmetricTestChannel(
+			"source", "b", 100000, policyWithFee(0), 3,
+		),
+		asymmetricTestChannel(
+			"b", "target", 100000, policyWithFee(200),
+			policyWithFee(0), 4,
+		),
+		symmetricTestChannel(
+			"source", "c", 100000, policyWithFee(0), 5,
+		),
+		asymmetricTestChannel(
+			"c", "target", 100000, policyWithFee(400),
+			policyWithFee(0), 6,
+		),
+		asymmetricTestChannel(
+			"a", "b", 100000, policyWithFee(100),
+			policyWithFee(1000), 7,
+		),
+	}
+
+	testCases := []struct {
+		name          string
+		numPaths      int
+		feeLimit      lnwire.MilliBronees
+		expectedPaths [][]uint64
+	}{{
+		name:          "single path",
+		numPaths:      1,
+		feeLimit:      noFeeLimit,
+		expectedPaths: [][]uint64{{1, 2}},
+	}, {
+		name:     "alternatives",
+		numPaths: 4,
+		feeLimit: noFeeLimit,
+		expectedPaths: [][]uint64{
+			{1, 2}, {3, 4}, {1, 7, 4}, {5, 6},
+		},
+	}, {
+		// Only five loopless paths exist.
+		name:     "all paths",
+		numPaths: 10,
+		feeLimit: noFeeLimit,
+		expectedPaths: [][]uint64{
+			{1, 2}, {3, 4}, {1, 7, 4}, {5, 6}, {3, 7, 2},
+		},
+	}, {
+		// The spur path from a via b only pays the fee of b, but the
+		// joined path also pays the fee of a, which exceeds the fee
+		// limit.
+		name:          "fee limit",
+		numPaths:      10,
+		feeLimit:      250,
+		expectedPaths: [][]uint64{{1, 2}, {3, 4}},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newPathFindingTestContext(
+				t, useCache, testChannels, "source",
+			)
+			defer ctx.cleanup()
+
+			ctx.restrictParams.FeeLimit = tc.feeLimit
+
+			sourceNode, err := ctx.graph.SourceNode()
+			require.NoError(t, err)
+
+			routingGraph, err := NewCachedGraph(
+				sourceNode, ctx.graph,
+			)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, routingGraph.close())
+			}()
+
+			paths, err := findPaths(
+				&graphParams{
+					bandwidthHints: ctx.bandwidthHints,
+					graph:          routingGraph,
+				},
+				&ctx.restrictParams, &ctx.pathFindingConfig,
+				ctx.source, ctx.keyFromAlias("target"),
+				100000, 0, tc.numPaths,
+			)
+			require.NoError(t, err)
+
+			require.Len(t, paths, len(tc.expectedPaths))
+			for i, path := range paths {
+				ctx.assertPath(path, tc.expectedPaths[i])
+			}
+
+			// The target features must be known for every path to
+			// be able to construct the final hop.
+			for _, path := range paths {
+				lastEdge := path[len(path)-1]
+				require.NotNil(t, lastEdge.ToNodeFeatures)
+			}
+		})
+	}
+}
+
+// TestEvaluatePathNoPolicy tests that a path is rejected if there is no channel
+// between two of its nodes.
+func TestEvaluatePathNoPolicy(t *testing.T) {
+	policy := &testChannelPolicy{
+		Expiry: 144,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+	}
+
+	ctx := newPathFindingTestContext(t, true, testChannels, "source")
+	defer ctx.cleanup()
+
+	sourceNode, err := ctx.graph.SourceNode()
+	require.NoError(t, err)
+
+	routingGraph, err := NewCachedGraph(sourceNode, ctx.graph)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, routingGraph.close())
+	}()
+
+	g := &graphParams{
+		bandwidthHints: ctx.bandwidthHints,
+		graph:          routingGraph,
+	}
+
+	nodes := []route.Vertex{
+		ctx.source, ctx.keyFromAlias("a"), ctx.keyFromAlias("b"),
+	}
+	candidate, err := evaluatePath(
+		g, &ctx.restrictParams, &ctx.pathFindingConfig, nodes, 1000,
+	)
+	require.NoError(t, err)
+	require.NotNil(t, candidate)
+	ctx.assertPath(candidate.edges, []uint64{1, 2})
+
+	// There is no channel between the source and b.
+	nodes = []route.Vertex{ctx.source, ctx.keyFromAlias("b")}
+	candidate, err = evaluatePath(
+		g, &ctx.restrictParams, &ctx.pathFindingConfig, nodes, 1000,
+	)
+	require.NoError(t, err)
+	require.Nil(t, candidate)
+}
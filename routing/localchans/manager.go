@@ -105,10 +105,17 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			Edge: edge,
 		})
 
+		// The inbound fee was either validated or set above.
+		inboundFee, err := edge.InboundFee()
+		if err != nil {
+			return err
+		}
+
 		// Add updated policy to list of policies to send to switch.
 		policiesToUpdate[info.ChannelPoint] = htlcswitch.ForwardingPolicy{
 			BaseFee:       edge.FeeBaseMSat,
 			FeeRate:       edge.FeeProportionalMillionths,
+			InboundFee:    inboundFee,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLCOut:    edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
@@ -182,6 +189,18 @@ func (r *Manager) updateEdge(tx kvdb.RTx, chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// Update the inbound fee if a new one is specified. Otherwise, make
+	// sure that the current one can be parsed, because it is handed to the
+	// link as well.
+	if newSchema.InboundFee != nil {
+		err := edge.SetInboundFee(*newSchema.InboundFee)
+		if err != nil {
+			return err
+		}
+	} else if _, err := edge.InboundFee(); err != nil {
+		return err
+	}
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(tx, chanPoint)
 	if err != nil {
@@ -34,6 +34,7 @@ func TestManager(t *testing.T) {
 		maxPendingAmount   = lnwire.MilliBronees(999000)
 		minHTLC            = lnwire.MilliBronees(2000)
 		expectedNumUpdates int
+		expectedInboundFee channeldb.InboundFee
 		channelSet         []channel
 	)
 
@@ -75,6 +76,9 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if policy.InboundFee != expectedInboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 	}
 
 	propagateChanPolicyUpdate := func(
@@ -103,6 +107,15 @@ func TestManager(t *testing.T) {
 			if policy.MaxHTLC != newPolicy.MaxHTLC {
 				t.Fatal("unexpected max htlc")
 			}
+
+			inboundFee, err := policy.InboundFee()
+			if err != nil {
+				t.Fatalf("unable to decode inbound "+
+					"fee: %v", err)
+			}
+			if inboundFee != expectedInboundFee {
+				t.Fatal("unexpected inbound fee")
+			}
 		}
 
 		return nil
@@ -152,10 +165,16 @@ func TestManager(t *testing.T) {
 	noMaxHtlcPolicy := newPolicy
 	noMaxHtlcPolicy.MaxHTLC = 0
 
+	// Policy that sets an inbound discount.
+	inboundFee := channeldb.InboundFee{Base: -10, Rate: -20}
+	inboundFeePolicy := newPolicy
+	inboundFeePolicy.InboundFee = &inboundFee
+
 	tests := []struct {
 		name                   string
 		currentPolicy          channeldb.ChannelEdgePolicy
 		newPolicy              routing.ChannelPolicy
+		expectedInboundFee     channeldb.InboundFee
 		channelSet             []channel
 		specifiedChanPoints    []wire.OutPoint
 		expectedNumUpdates     int
@@ -234,6 +253,26 @@ func TestManager(t *testing.T) {
 			expectedUpdateFailures: []lnrpc.UpdateFailure{},
 			expectErr:              nil,
 		},
+		{
+			// Here, an inbound fee is specified, which should be
+			// propagated to both the gossiper and the links.
+			name:               "inbound fee specified",
+			currentPolicy:      currentPolicy,
+			newPolicy:          inboundFeePolicy,
+			expectedInboundFee: inboundFee,
+			channelSet: []channel{
+				{
+					edgeInfo: &channeldb.ChannelEdgeInfo{
+						Capacity:     chanCap,
+						ChannelPoint: chanPointValid,
+					},
+				},
+			},
+			specifiedChanPoints:    []wire.OutPoint{chanPointValid},
+			expectedNumUpdates:     1,
+			expectedUpdateFailures: []lnrpc.UpdateFailure{},
+			expectErr:              nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -242,6 +281,7 @@ func TestManager(t *testing.T) {
 			currentPolicy = test.currentPolicy
 			channelSet = test.channelSet
 			expectedNumUpdates = test.expectedNumUpdates
+			expectedInboundFee = test.expectedInboundFee
 
 			failedUpdates, err := manager.UpdatePolicy(test.newPolicy,
 				test.specifiedChanPoints...)
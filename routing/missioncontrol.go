@@ -10,14 +10,10 @@ import (
 	"github.com/brsuite/broln/kvdb"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
 )
 
 const (
-	// DefaultPenaltyHalfLife is the default half-life duration. The
-	// half-life duration defines after how much time a penalized node or
-	// channel is back at 50% probability.
-	DefaultPenaltyHalfLife = time.Hour
-
 	// minSecondChanceInterval is the minimum time required between
 	// second-chance failures.
 	//
@@ -49,13 +45,17 @@ const (
 	// to the database.
 	DefaultMcFlushInterval = time.Second
 
-	// prevSuccessProbability is the assumed probability for node pairs that
-	// successfully relayed the previous attempt.
-	prevSuccessProbability = 0.95
+	// DefaultMaxMcPairs is the default maximum number of node pairs whose
+	// state mission control keeps.
+	DefaultMaxMcPairs = 100_000
+
+	// DefaultMcPairMaxAge is the default age after which pair results are
+	// forgotten.
+	DefaultMcPairMaxAge = 7 * 24 * time.Hour
 
-	// DefaultAprioriWeight is the default a priori weight. See
-	// MissionControlConfig for further explanation.
-	DefaultAprioriWeight = 0.5
+	// mcPruneInterval is the interval at which outdated pair state is
+	// removed.
+	mcPruneInterval = time.Hour
 
 	// DefaultMinFailureRelaxInterval is the default minimum time that must
 	// have passed since the previously recorded failure before the failure
@@ -72,6 +72,16 @@ var (
 	// ErrInvalidFailureInterval is returned if we get an invalid failure
 	// interval.
 	ErrInvalidFailureInterval = errors.New("failure interval must be >= 0")
+
+	// ErrInvalidMcPairs is returned if we get a negative maximum number
+	// of mission control pairs.
+	ErrInvalidMcPairs = errors.New("mission control pair count must be " +
+		">= 0")
+
+	// ErrInvalidMcPairMaxAge is returned if we get a negative maximum age
+	// of mission control pair results.
+	ErrInvalidMcPairMaxAge = errors.New("mission control pair max age " +
+		"must be >= 0")
 )
 
 // NodeResults contains previous results from a node to its peers.
@@ -102,7 +112,13 @@ type MissionControl struct {
 
 	// estimator is the probability estimator that is used with the payment
 	// results that mission control collects.
-	estimator *probabilityEstimator
+	estimator Estimator
+
+	// maxPairs is the maximum number of node pairs whose state is kept.
+	maxPairs int
+
+	// pairMaxAge is the age after which pair results are forgotten.
+	pairMaxAge time.Duration
 
 	sync.Mutex
 
@@ -115,9 +131,8 @@ type MissionControl struct {
 // MissionControlConfig defines parameters that control mission control
 // behaviour.
 type MissionControlConfig struct {
-	// ProbabilityEstimatorConfig is the config we will use for probability
-	// calculations.
-	ProbabilityEstimatorCfg
+	// Estimator gives probability estimates for node pairs.
+	Estimator Estimator
 
 	// MaxMcHistory defines the maximum number of payment results that are
 	// held on disk.
@@ -131,10 +146,25 @@ type MissionControlConfig struct {
 	// since the previously recorded failure before the failure amount may
 	// be raised.
 	MinFailureRelaxInterval time.Duration
+
+	// MaxMcPairs is the maximum number of node pairs whose state is kept
+	// in memory and on disk. If the limit is exceeded, the least recently
+	// updated pairs are removed. Zero means no limit. It can only be set
+	// at startup.
+	MaxMcPairs int
+
+	// McPairMaxAge is the age after which the failure or success of a pair
+	// is forgotten. Zero means that results are kept until the pair limit
+	// is reached. It can only be set at startup.
+	McPairMaxAge time.Duration
 }
 
 func (c *MissionControlConfig) validate() error {
-	if err := c.ProbabilityEstimatorCfg.validate(); err != nil {
+	if c.Estimator == nil {
+		return ErrNoEstimator
+	}
+
+	if err := c.Estimator.Config().validate(); err != nil {
 		return err
 	}
 
@@ -146,15 +176,21 @@ func (c *MissionControlConfig) validate() error {
 		return ErrInvalidFailureInterval
 	}
 
+	if c.MaxMcPairs < 0 {
+		return ErrInvalidMcPairs
+	}
+
+	if c.McPairMaxAge < 0 {
+		return ErrInvalidMcPairMaxAge
+	}
+
 	return nil
 }
 
 // String returns a string representation of a mission control config.
 func (c *MissionControlConfig) String() string {
-	return fmt.Sprintf("Penalty Half Life: %v, Apriori Hop "+
-		"Probablity: %v, Maximum History: %v, Apriori Weight: %v, "+
-		"Minimum Failure Relax Interval: %v", c.PenaltyHalfLife,
-		c.AprioriHopProbability, c.MaxMcHistory, c.AprioriWeight,
+	return fmt.Sprintf("Estimator: %v, Maximum History: %v, Minimum "+
+		"Failure Relax Interval: %v", c.Estimator, c.MaxMcHistory,
 		c.MinFailureRelaxInterval)
 }
 
@@ -224,17 +260,14 @@ func NewMissionControl(db kvdb.Backend, self route.Vertex,
 		return nil, err
 	}
 
-	estimator := &probabilityEstimator{
-		ProbabilityEstimatorCfg: cfg.ProbabilityEstimatorCfg,
-		prevSuccessProbability:  prevSuccessProbability,
-	}
-
 	mc := &MissionControl{
-		state:     newMissionControlState(cfg.MinFailureRelaxInterval),
-		now:       time.Now,
-		selfNode:  self,
-		store:     store,
-		estimator: estimator,
+		state:      newMissionControlState(cfg.MinFailureRelaxInterval),
+		now:        time.Now,
+		selfNode:   self,
+		store:      store,
+		estimator:  cfg.Estimator,
+		maxPairs:   cfg.MaxMcPairs,
+		pairMaxAge: cfg.McPairMaxAge,
 	}
 
 	if err := mc.init(); err != nil {
@@ -246,7 +279,7 @@ func NewMissionControl(db kvdb.Backend, self route.Vertex,
 
 // RunStoreTicker runs the mission control store's ticker.
 func (m *MissionControl) RunStoreTicker() {
-	m.store.run()
+	m.store.run(mcPruneInterval, m.prune)
 }
 
 // StopStoreTicker stops the mission control store's ticker.
@@ -254,9 +287,41 @@ func (m *MissionControl) StopStoreTicker() {
 	m.store.stop()
 }
 
-// init initializes mission control with historical data.
+// init initializes mission control with the persisted pair state. If the
+// store doesn't hold pair state yet, the state is reconstructed from the
+// stored payment results instead and persisted right away.
 func (m *MissionControl) init() error {
-	log.Debugf("Mission control state reconstruction started")
+	if m.store.replayResults {
+		return m.replayResults()
+	}
+
+	log.Debugf("Mission control state loading started")
+
+	start := time.Now()
+
+	pairs, err := m.store.fetchPairs()
+	if err != nil {
+		return err
+	}
+
+	for pair, result := range pairs {
+		m.state.loadPairResult(pair, result)
+	}
+
+	// Remove results that expired while we were offline.
+	m.state.prune(m.now(), m.pairMaxAge, m.maxPairs)
+	m.store.AddPairs(m.state.takeChangedPairs())
+
+	log.Debugf("Mission control state loading finished: n=%v, time=%v",
+		len(pairs), time.Since(start))
+
+	return nil
+}
+
+// replayResults reconstructs the mission control state from the stored
+// payment results and writes it to the store.
+func (m *MissionControl) replayResults() error {
+	log.Infof("Mission control state reconstruction started")
 
 	start := time.Now()
 
@@ -269,12 +334,29 @@ func (m *MissionControl) init() error {
 		m.applyPaymentResult(result)
 	}
 
-	log.Debugf("Mission control state reconstruction finished: "+
+	m.state.prune(m.now(), m.pairMaxAge, m.maxPairs)
+	m.store.AddPairs(m.state.takeChangedPairs())
+
+	if err := m.store.storePairs(); err != nil {
+		return err
+	}
+
+	log.Infof("Mission control state reconstruction finished: "+
 		"n=%v, time=%v", len(results), time.Since(start))
 
 	return nil
 }
 
+// prune removes outdated pair results and the least recently updated pairs
+// beyond the configured limit.
+func (m *MissionControl) prune() {
+	m.Lock()
+	defer m.Unlock()
+
+	m.state.prune(m.now(), m.pairMaxAge, m.maxPairs)
+	m.store.AddPairs(m.state.takeChangedPairs())
+}
+
 // GetConfig returns the config that mission control is currently configured
 // with. All fields are copied by value, so we do not need to worry about
 // mutation.
@@ -283,10 +365,12 @@ func (m *MissionControl) GetConfig() *MissionControlConfig {
 	defer m.Unlock()
 
 	return &MissionControlConfig{
-		ProbabilityEstimatorCfg: m.estimator.ProbabilityEstimatorCfg,
+		Estimator:               m.estimator,
 		MaxMcHistory:            m.store.maxRecords,
 		McFlushInterval:         m.store.flushInterval,
 		MinFailureRelaxInterval: m.state.minFailureRelaxInterval,
+		MaxMcPairs:              m.maxPairs,
+		McPairMaxAge:            m.pairMaxAge,
 	}
 }
 
@@ -308,7 +392,7 @@ func (m *MissionControl) SetConfig(cfg *MissionControlConfig) error {
 
 	m.store.maxRecords = cfg.MaxMcHistory
 	m.state.minFailureRelaxInterval = cfg.MinFailureRelaxInterval
-	m.estimator.ProbabilityEstimatorCfg = cfg.ProbabilityEstimatorCfg
+	m.estimator = cfg.Estimator
 
 	return nil
 }
@@ -331,9 +415,9 @@ func (m *MissionControl) ResetHistory() error {
 }
 
 // GetProbability is expected to return the success probability of a payment
-// from fromNode along edge.
+// from fromNode along edge. The capacity of the channel is zero if unknown.
 func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliBronees) float64 {
+	amt lnwire.MilliBronees, capacity bronutil.Amount) float64 {
 
 	m.Lock()
 	defer m.Unlock()
@@ -343,10 +427,12 @@ func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
 
 	// Use a distinct probability estimation function for local channels.
 	if fromNode == m.selfNode {
-		return m.estimator.getLocalPairProbability(now, results, toNode)
+		return m.estimator.LocalPairProbability(now, results, toNode)
 	}
 
-	return m.estimator.getPairProbability(now, results, toNode, amt)
+	return m.estimator.PairProbability(
+		now, results, toNode, amt, capacity,
+	)
 }
 
 // GetHistorySnapshot takes a snapshot from the current mission control state
@@ -361,8 +447,7 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 }
 
 // ImportHistory imports the set of mission control results provided to our
-// in-memory state. These results are not persisted, so will not survive
-// restarts.
+// state. The resulting pair state is persisted.
 func (m *MissionControl) ImportHistory(history *MissionControlSnapshot,
 	force bool) error {
 
@@ -377,12 +462,69 @@ func (m *MissionControl) ImportHistory(history *MissionControlSnapshot,
 		len(history.Pairs))
 
 	imported := m.state.importSnapshot(history, force)
+	m.store.AddPairs(m.state.takeChangedPairs())
 
 	log.Infof("Imported %v results to mission control", imported)
 
 	return nil
 }
 
+// Export returns a copy of the current pair state. If includeResults is set,
+// the raw payment results that were written to the store are included too.
+func (m *MissionControl) Export(includeResults bool) (*MissionControlExport,
+	error) {
+
+	export := &MissionControlExport{
+		Version: McExportVersion,
+	}
+
+	if includeResults {
+		results, err := m.store.fetchAll()
+		if err != nil {
+			return nil, err
+		}
+		export.results = results
+	}
+
+	m.Lock()
+	export.Pairs = m.state.getSnapshot().Pairs
+	m.Unlock()
+
+	return export, nil
+}
+
+// Import merges an export into our state. Raw payment results are stored and
+// applied first, followed by the exported pair state, which is only used
+// where it is more recent than our own unless force is set. It returns the
+// number of imported pair results and payment results.
+func (m *MissionControl) Import(export *MissionControlExport,
+	force bool) (int, int, error) {
+
+	if export == nil {
+		return 0, 0, errors.New("cannot import nil export")
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	log.Infof("Importing %v pairs and %v payment results to mission "+
+		"control", len(export.Pairs), len(export.results))
+
+	for _, result := range export.results {
+		m.store.AddResult(result)
+		m.applyPaymentResult(result)
+	}
+
+	imported := m.state.importSnapshot(
+		&MissionControlSnapshot{Pairs: export.Pairs}, force,
+	)
+	m.store.AddPairs(m.state.takeChangedPairs())
+
+	log.Infof("Imported %v pair results to mission control", imported)
+
+	return imported, len(export.results), nil
+}
+
 // GetPairHistorySnapshot returns the stored history for a given node pair.
 func (m *MissionControl) GetPairHistorySnapshot(
 	fromNode, toNode route.Vertex) TimedPairResult {
@@ -457,8 +599,10 @@ func (m *MissionControl) processPaymentResult(result *paymentResult) (
 	m.Lock()
 	defer m.Unlock()
 
-	// Apply result to update mission control state.
+	// Apply result to update mission control state, and queue the changed
+	// pairs to be persisted.
 	reason := m.applyPaymentResult(result)
+	m.store.AddPairs(m.state.takeChangedPairs())
 
 	return reason, nil
 }
@@ -0,0 +1,220 @@
+package routing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/brsuite/brond/wire"
+)
+
+const (
+	// McExportVersion is the current version of the mission control
+	// export format.
+	McExportVersion uint16 = 1
+
+	// maxExportRecordSize is the maximum size of a single pair or payment
+	// result record in a mission control export.
+	maxExportRecordSize = 1 << 20
+)
+
+var (
+	// mcExportMagic is the prefix that identifies mission control exports.
+	mcExportMagic = [4]byte{'b', 'r', 'm', 'c'}
+
+	// ErrInvalidMcExport is returned when data that is not a mission
+	// control export is decoded.
+	ErrInvalidMcExport = errors.New("not a mission control export")
+)
+
+// MissionControlExport is a portable copy of the mission control state. It
+// holds the aggregated per-pair state and optionally the raw payment results
+// that mission control stored. Exports are encoded in a versioned binary
+// format, so that they can be written to files and imported into another
+// node.
+type MissionControlExport struct {
+	// Version is the version of the format that the export was decoded
+	// from.
+	Version uint16
+
+	// Pairs is the exported per-pair state.
+	Pairs []MissionControlPairSnapshot
+
+	// results is the list of exported raw payment results.
+	results []*paymentResult
+}
+
+// NumResults returns the number of raw payment results in the export.
+func (e *MissionControlExport) NumResults() int {
+	return len(e.results)
+}
+
+// Encode writes the export to w using the current version of the format.
+//
+// The encoding starts with a four byte magic and the two byte format version,
+// followed by the number of pairs and the pairs, and the number of results
+// and the results. Pairs are encoded as the pubkeys of both nodes followed by
+// the length-prefixed pair state, and results as their length-prefixed
+// database key and value.
+func (e *MissionControlExport) Encode(w io.Writer) error {
+	var header [6]byte
+	copy(header[:], mcExportMagic[:])
+	binary.BigEndian.PutUint16(header[4:], McExportVersion)
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+
+	err := writeUint32(w, uint32(len(e.Pairs)))
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range e.Pairs {
+		pair := pair
+
+		_, err := w.Write(serializePairKey(pair.Pair))
+		if err != nil {
+			return err
+		}
+
+		v, err := serializePairResult(&pair.TimedPairResult)
+		if err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, v); err != nil {
+			return err
+		}
+	}
+
+	if err := writeUint32(w, uint32(len(e.results))); err != nil {
+		return err
+	}
+
+	for _, result := range e.results {
+		k, v, err := serializeResult(result)
+		if err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, k); err != nil {
+			return err
+		}
+
+		if err := wire.WriteVarBytes(w, 0, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DecodeMissionControlExport reads an export that was encoded with Encode.
+func DecodeMissionControlExport(r io.Reader) (*MissionControlExport, error) {
+	var header [6]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, ErrInvalidMcExport
+	}
+
+	if !bytes.Equal(header[:4], mcExportMagic[:]) {
+		return nil, ErrInvalidMcExport
+	}
+
+	export := &MissionControlExport{
+		Version: binary.BigEndian.Uint16(header[4:]),
+	}
+	if export.Version != McExportVersion {
+		return nil, fmt.Errorf("unsupported mission control export "+
+			"version %v", export.Version)
+	}
+
+	numPairs, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numPairs; i++ {
+		k := make([]byte, pairKeyLength)
+		if _, err := io.ReadFull(r, k); err != nil {
+			return nil, err
+		}
+
+		pair, err := deserializePairKey(k)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := wire.ReadVarBytes(r, 0, maxExportRecordSize, "pair")
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := deserializePairResult(v)
+		if err != nil {
+			return nil, err
+		}
+
+		export.Pairs = append(export.Pairs, MissionControlPairSnapshot{
+			Pair:            pair,
+			TimedPairResult: result,
+		})
+	}
+
+	numResults, err := readUint32(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numResults; i++ {
+		k, err := wire.ReadVarBytes(
+			r, 0, maxExportRecordSize, "result key",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// The key holds the reply time, the payment id and the source
+		// pubkey.
+		if len(k) != 8+8+33 {
+			return nil, fmt.Errorf("invalid result key length %v",
+				len(k))
+		}
+
+		v, err := wire.ReadVarBytes(
+			r, 0, maxExportRecordSize, "result",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := deserializeResult(k, v)
+		if err != nil {
+			return nil, err
+		}
+
+		export.results = append(export.results, result)
+	}
+
+	return export, nil
+}
+
+// writeUint32 writes a big endian uint32 to w.
+func writeUint32(w io.Writer, v uint32) error {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	_, err := w.Write(b[:])
+
+	return err
+}
+
+// readUint32 reads a big endian uint32 from r.
+func readUint32(r io.Reader) (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint32(b[:]), nil
+}
@@ -0,0 +1,70 @@
+package routing
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMissionControlExportEncoding tests that mission control exports can be
+// encoded and decoded again.
+func TestMissionControlExportEncoding(t *testing.T) {
+	// Set time zone explicitly to keep test deterministic.
+	time.Local = time.UTC
+
+	testRoute := &route.Route{
+		SourcePubKey: route.Vertex{1},
+		Hops: []*route.Hop{
+			{
+				PubKeyBytes:   route.Vertex{2},
+				LegacyPayload: true,
+			},
+		},
+	}
+
+	failureSourceIdx := 1
+	export := &MissionControlExport{
+		Version: McExportVersion,
+		Pairs: []MissionControlPairSnapshot{
+			{
+				Pair: NewDirectedNodePair(
+					route.Vertex{1}, route.Vertex{2},
+				),
+				TimedPairResult: TimedPairResult{
+					FailTime:    testTime,
+					FailAmt:     1000,
+					SuccessTime: testTime.Add(time.Hour),
+					SuccessAmt:  500,
+				},
+			},
+		},
+		results: []*paymentResult{
+			{
+				route: testRoute,
+				failure: lnwire.NewFailIncorrectDetails(
+					100, 1000,
+				),
+				failureSourceIdx: &failureSourceIdx,
+				id:               3,
+				timeReply:        testTime,
+				timeFwd:          testTime.Add(-time.Minute),
+			},
+		},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, export.Encode(&b))
+
+	decoded, err := DecodeMissionControlExport(&b)
+	require.NoError(t, err)
+	require.Equal(t, export, decoded)
+	require.Equal(t, 1, decoded.NumResults())
+
+	// Data without the expected prefix is rejected.
+	_, err = DecodeMissionControlExport(bytes.NewReader([]byte("mcdata")))
+	require.ErrorIs(t, err, ErrInvalidMcExport)
+}
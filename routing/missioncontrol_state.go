@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"sort"
 	"time"
 
 	"github.com/brsuite/broln/routing/route"
@@ -24,6 +25,10 @@ type missionControlState struct {
 	// since the previously recorded failure before the failure amount may
 	// be raised.
 	minFailureRelaxInterval time.Duration
+
+	// dirty tracks the pairs whose result changed since the state was
+	// last persisted.
+	dirty map[DirectedNodePair]struct{}
 }
 
 // newMissionControlState instantiates a new mission control state object.
@@ -34,6 +39,7 @@ func newMissionControlState(
 		lastPairResult:          make(map[route.Vertex]NodeResults),
 		lastSecondChance:        make(map[DirectedNodePair]time.Time),
 		minFailureRelaxInterval: minFailureRelaxInterval,
+		dirty:                   make(map[DirectedNodePair]struct{}),
 	}
 }
 
@@ -50,6 +56,7 @@ func (m *missionControlState) getLastPairResult(node route.Vertex) (NodeResults,
 func (m *missionControlState) resetHistory() {
 	m.lastPairResult = make(map[route.Vertex]NodeResults)
 	m.lastSecondChance = make(map[DirectedNodePair]time.Time)
+	m.dirty = make(map[DirectedNodePair]struct{})
 }
 
 // setLastPairResult stores a result for a node pair.
@@ -135,6 +142,7 @@ func (m *missionControlState) setLastPairResult(fromNode, toNode route.Vertex,
 		fromNode, toNode, current.SuccessAmt, current.FailAmt)
 
 	nodePairs[toNode] = current
+	m.dirty[NewDirectedNodePair(fromNode, toNode)] = struct{}{}
 }
 
 // setAllFail stores a fail result for all known connections to and from the
@@ -148,9 +156,156 @@ func (m *missionControlState) setAllFail(node route.Vertex,
 				nodePairs[toNode] = TimedPairResult{
 					FailTime: timestamp,
 				}
+
+				pair := NewDirectedNodePair(fromNode, toNode)
+				m.dirty[pair] = struct{}{}
+			}
+		}
+	}
+}
+
+// loadPairResult sets the result of a pair as it was persisted. Unlike
+// setLastPairResult, the result isn't merged with existing data and the pair
+// isn't marked as changed.
+func (m *missionControlState) loadPairResult(pair DirectedNodePair,
+	result TimedPairResult) {
+
+	nodePairs, ok := m.lastPairResult[pair.From]
+	if !ok {
+		nodePairs = make(NodeResults)
+		m.lastPairResult[pair.From] = nodePairs
+	}
+
+	nodePairs[pair.To] = result
+}
+
+// pairUpdates maps node pairs to their current results. Pairs that were
+// removed from the state map to nil.
+type pairUpdates map[DirectedNodePair]*TimedPairResult
+
+// takeChangedPairs returns the current results of all pairs that changed
+// since the last call, and resets the set of changed pairs.
+func (m *missionControlState) takeChangedPairs() pairUpdates {
+	changed := make(pairUpdates, len(m.dirty))
+	for pair := range m.dirty {
+		var current *TimedPairResult
+		if result, ok := m.lastPairResult[pair.From][pair.To]; ok {
+			current = &result
+		}
+
+		changed[pair] = current
+	}
+
+	m.dirty = make(map[DirectedNodePair]struct{})
+
+	return changed
+}
+
+// prune expires the parts of pair results that are older than maxAge, and
+// forgets the pairs that were least recently updated if there are more than
+// maxPairs of them. The amounts of the remaining results are kept as they
+// are. Their timestamps are persisted alongside, so that the probability
+// estimator can decay the information over time when the state is used. A
+// zero maxAge or maxPairs disables the respective limit. Changed and removed
+// pairs are marked as changed.
+func (m *missionControlState) prune(now time.Time, maxAge time.Duration,
+	maxPairs int) {
+
+	type pairAge struct {
+		pair       DirectedNodePair
+		lastUpdate time.Time
+	}
+
+	var pairs []pairAge
+	for fromNode, nodePairs := range m.lastPairResult {
+		for toNode, result := range nodePairs {
+			pair := NewDirectedNodePair(fromNode, toNode)
+
+			if maxAge > 0 {
+				var expired bool
+				result, expired = expirePairResult(
+					result, now, maxAge,
+				)
+				if expired {
+					m.dirty[pair] = struct{}{}
+				}
 			}
+
+			// Remove pairs that don't hold any results anymore.
+			if result.FailTime.IsZero() &&
+				result.SuccessTime.IsZero() {
+
+				delete(nodePairs, toNode)
+				m.dirty[pair] = struct{}{}
+
+				continue
+			}
+
+			nodePairs[toNode] = result
+			pairs = append(pairs, pairAge{
+				pair:       pair,
+				lastUpdate: lastUpdate(result),
+			})
+		}
+
+		if len(nodePairs) == 0 {
+			delete(m.lastPairResult, fromNode)
 		}
 	}
+
+	if maxPairs == 0 || len(pairs) <= maxPairs {
+		return
+	}
+
+	// Remove the least recently updated pairs beyond the limit.
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i].lastUpdate.After(pairs[j].lastUpdate)
+	})
+
+	for _, p := range pairs[maxPairs:] {
+		nodePairs := m.lastPairResult[p.pair.From]
+		delete(nodePairs, p.pair.To)
+		if len(nodePairs) == 0 {
+			delete(m.lastPairResult, p.pair.From)
+		}
+
+		m.dirty[p.pair] = struct{}{}
+	}
+
+	log.Debugf("Pruned %v least recently updated mission control pairs",
+		len(pairs)-maxPairs)
+}
+
+// expirePairResult clears the failure and success of a pair result if they
+// are older than maxAge. It returns whether anything was cleared.
+func expirePairResult(result TimedPairResult, now time.Time,
+	maxAge time.Duration) (TimedPairResult, bool) {
+
+	var expired bool
+	if !result.FailTime.IsZero() && now.Sub(result.FailTime) > maxAge {
+		result.FailTime = time.Time{}
+		result.FailAmt = 0
+		expired = true
+	}
+
+	if !result.SuccessTime.IsZero() &&
+		now.Sub(result.SuccessTime) > maxAge {
+
+		result.SuccessTime = time.Time{}
+		result.SuccessAmt = 0
+		expired = true
+	}
+
+	return result, expired
+}
+
+// lastUpdate returns the time of the most recent result of a pair.
+func lastUpdate(result TimedPairResult) time.Time {
+	if result.FailTime.After(result.SuccessTime) {
+		return result.FailTime
+	}
+
+	return result.SuccessTime
 }
 
 // requestSecondChance checks whether the node fromNode can have a second chance
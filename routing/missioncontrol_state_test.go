@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/stretchr/testify/require"
 )
@@ -92,3 +93,61 @@ func TestMissionControlStateSetLastPairResult(t *testing.T) {
 	require.Equal(t, expected, result[to])
 
 }
+
+// TestMissionControlStatePrune tests that outdated pair results expire and
+// that the least recently updated pairs are removed beyond the limit.
+func TestMissionControlStatePrune(t *testing.T) {
+	state := newMissionControlState(0)
+
+	var (
+		node1 = route.Vertex{1}
+		node2 = route.Vertex{2}
+		node3 = route.Vertex{3}
+	)
+
+	// Pair 1->2 has an old failure and a recent success, pair 1->3 only
+	// has an old failure and pair 2->3 a recent failure.
+	state.setLastPairResult(
+		node1, node2, testTime, &pairResult{amt: 1000}, false,
+	)
+	state.setLastPairResult(
+		node1, node2, testTime.Add(2*time.Hour),
+		&pairResult{amt: 500, success: true}, false,
+	)
+	state.setLastPairResult(
+		node1, node3, testTime, &pairResult{amt: 1000}, false,
+	)
+	state.setLastPairResult(
+		node2, node3, testTime.Add(3*time.Hour),
+		&pairResult{amt: 1000}, false,
+	)
+	require.Len(t, state.takeChangedPairs(), 3)
+
+	// Prune results older than two hours.
+	state.prune(testTime.Add(3*time.Hour), 2*time.Hour, 0)
+
+	results, _ := state.getLastPairResult(node1)
+	require.Len(t, results, 1)
+	require.True(t, results[node2].FailTime.IsZero())
+	require.Zero(t, results[node2].FailAmt)
+	require.Equal(t, lnwire.MilliBronees(500), results[node2].SuccessAmt)
+
+	changed := state.takeChangedPairs()
+	require.Len(t, changed, 2)
+	require.NotNil(t, changed[NewDirectedNodePair(node1, node2)])
+	require.Nil(t, changed[NewDirectedNodePair(node1, node3)])
+
+	// Limit the number of pairs to one. The least recently updated pair
+	// 1->2 is expected to be removed.
+	state.prune(testTime.Add(3*time.Hour), 0, 1)
+
+	_, ok := state.getLastPairResult(node1)
+	require.False(t, ok)
+
+	results, _ = state.getLastPairResult(node2)
+	require.Len(t, results, 1)
+
+	changed = state.takeChangedPairs()
+	require.Len(t, changed, 1)
+	require.Nil(t, changed[NewDirectedNodePair(node1, node2)])
+}
@@ -19,6 +19,10 @@ var (
 	// stored.
 	resultsKey = []byte("missioncontrol-results")
 
+	// pairsKey is the fixed key under which the aggregated per-pair state
+	// is stored.
+	pairsKey = []byte("missioncontrol-pairs")
+
 	// Big endian is the preferred byte order, due to cursor scans over
 	// integer keys iterating in order.
 	byteOrder = binary.BigEndian
@@ -28,14 +32,22 @@ const (
 	// unknownFailureSourceIdx is the database encoding of an unknown error
 	// source.
 	unknownFailureSourceIdx = -1
+
+	// pairBatchSize is the maximum number of pair updates that are written
+	// in a single database transaction. Large flushes are split into
+	// multiple transactions to not hold the database lock for too long.
+	pairBatchSize = 1000
+
+	// pairKeyLength is the length of the key of a stored pair, which is
+	// the concatenation of the pubkeys of both nodes.
+	pairKeyLength = 2 * 33
 )
 
 // missionControlStore is a bolt db based implementation of a mission control
-// store. It stores the raw payment attempt data from which the internal mission
-// controls state can be rederived on startup. This allows the mission control
-// internal data structure to be changed without requiring a database migration.
-// Also changes to mission control parameters can be applied to historical data.
-// Finally, it enables importing raw data from an external source.
+// store. It stores the aggregated per-pair state of mission control, which is
+// loaded on startup, and a capped list of the raw payment attempt data, which
+// can be exported and imported. Pair updates are collected and flushed to the
+// database incrementally, so that only changed pairs need to be written.
 type missionControlStore struct {
 	done    chan struct{}
 	wg      sync.WaitGroup
@@ -45,6 +57,15 @@ type missionControlStore struct {
 	// queue stores all pending payment results not yet added to the store.
 	queue *list.List
 
+	// pendingPairs stores the pair updates not yet written to the store.
+	// Only the latest update of a pair is kept.
+	pendingPairs pairUpdates
+
+	// replayResults is true if the store didn't hold any pair state when
+	// it was opened. In that case the state needs to be reconstructed
+	// from the stored payment results once.
+	replayResults bool
+
 	// keys holds the stored MC store item keys in the order of storage.
 	// We use this list when adding/deleting items from the database to
 	// avoid cursor use which may be slow in the remote DB case.
@@ -66,8 +87,9 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 	flushInterval time.Duration) (*missionControlStore, error) {
 
 	var (
-		keys    *list.List
-		keysMap map[string]struct{}
+		keys          *list.List
+		keysMap       map[string]struct{}
+		replayResults bool
 	)
 
 	// Create buckets if not yet existing.
@@ -78,6 +100,16 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 				err)
 		}
 
+		// Stores that were created before the pair state was
+		// persisted need to replay their results once.
+		replayResults = tx.ReadWriteBucket(pairsKey) == nil
+
+		_, err = tx.CreateTopLevelBucket(pairsKey)
+		if err != nil {
+			return fmt.Errorf("cannot create pairs bucket: %v",
+				err)
+		}
+
 		// Collect all keys to be able to quickly calculate the
 		// difference when updating the DB state.
 		c := resultsBucket.ReadCursor()
@@ -90,6 +122,7 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 	}, func() {
 		keys = list.New()
 		keysMap = make(map[string]struct{})
+		replayResults = false
 	})
 	if err != nil {
 		return nil, err
@@ -99,6 +132,8 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int,
 		done:          make(chan struct{}),
 		db:            db,
 		queue:         list.New(),
+		pendingPairs:  make(pairUpdates),
+		replayResults: replayResults,
 		keys:          keys,
 		keysMap:       keysMap,
 		maxRecords:    maxRecords,
@@ -112,12 +147,17 @@ func (b *missionControlStore) clear() error {
 	defer b.queueMx.Unlock()
 
 	err := kvdb.Update(b.db, func(tx kvdb.RwTx) error {
-		if err := tx.DeleteTopLevelBucket(resultsKey); err != nil {
-			return err
+		for _, key := range [][]byte{resultsKey, pairsKey} {
+			if err := tx.DeleteTopLevelBucket(key); err != nil {
+				return err
+			}
+
+			if _, err := tx.CreateTopLevelBucket(key); err != nil {
+				return err
+			}
 		}
 
-		_, err := tx.CreateTopLevelBucket(resultsKey)
-		return err
+		return nil
 	}, func() {})
 
 	if err != nil {
@@ -125,6 +165,10 @@ func (b *missionControlStore) clear() error {
 	}
 
 	b.queue = list.New()
+	b.pendingPairs = make(pairUpdates)
+	b.keys = list.New()
+	b.keysMap = make(map[string]struct{})
+
 	return nil
 }
 
@@ -157,6 +201,120 @@ func (b *missionControlStore) fetchAll() ([]*paymentResult, error) {
 	return results, nil
 }
 
+// fetchPairs returns the state of all pairs currently stored in the database.
+func (b *missionControlStore) fetchPairs() (
+	map[DirectedNodePair]TimedPairResult, error) {
+
+	var pairs map[DirectedNodePair]TimedPairResult
+
+	err := kvdb.View(b.db, func(tx kvdb.RTx) error {
+		pairsBucket := tx.ReadBucket(pairsKey)
+
+		return pairsBucket.ForEach(func(k, v []byte) error {
+			pair, err := deserializePairKey(k)
+			if err != nil {
+				return err
+			}
+
+			result, err := deserializePairResult(v)
+			if err != nil {
+				return err
+			}
+
+			pairs[pair] = result
+
+			return nil
+		})
+	}, func() {
+		pairs = make(map[DirectedNodePair]TimedPairResult)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// serializePairKey returns the key under which the state of a pair is stored.
+func serializePairKey(pair DirectedNodePair) []byte {
+	key := make([]byte, pairKeyLength)
+	copy(key, pair.From[:])
+	copy(key[33:], pair.To[:])
+
+	return key
+}
+
+// deserializePairKey parses the key under which the state of a pair is
+// stored.
+func deserializePairKey(k []byte) (DirectedNodePair, error) {
+	var pair DirectedNodePair
+	if len(k) != pairKeyLength {
+		return pair, fmt.Errorf("invalid pair key length %v", len(k))
+	}
+
+	copy(pair.From[:], k[:33])
+	copy(pair.To[:], k[33:])
+
+	return pair, nil
+}
+
+// serializePairResult serializes the state of a pair.
+func serializePairResult(result *TimedPairResult) ([]byte, error) {
+	var b bytes.Buffer
+	err := channeldb.WriteElements(
+		&b,
+		timeToUnixNano(result.FailTime), uint64(result.FailAmt),
+		timeToUnixNano(result.SuccessTime), uint64(result.SuccessAmt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializePairResult deserializes the state of a pair.
+func deserializePairResult(v []byte) (TimedPairResult, error) {
+	var (
+		failTime, failAmt, successTime, successAmt uint64
+	)
+
+	err := channeldb.ReadElements(
+		bytes.NewReader(v), &failTime, &failAmt, &successTime,
+		&successAmt,
+	)
+	if err != nil {
+		return TimedPairResult{}, err
+	}
+
+	return TimedPairResult{
+		FailTime:    unixNanoToTime(failTime),
+		FailAmt:     lnwire.MilliBronees(failAmt),
+		SuccessTime: unixNanoToTime(successTime),
+		SuccessAmt:  lnwire.MilliBronees(successAmt),
+	}, nil
+}
+
+// timeToUnixNano encodes a timestamp as unix nanoseconds, using zero for the
+// zero time.
+func timeToUnixNano(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
+
+// unixNanoToTime decodes a timestamp that was encoded with timeToUnixNano.
+// Timestamps are converted to the local time zone for consistent logging.
+func unixNanoToTime(ns uint64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(ns)).Local()
+}
+
 // serializeResult serializes a payment result and returns a key and value byte
 // slice to insert into the bucket.
 func serializeResult(rp *paymentResult) ([]byte, []byte, error) {
@@ -269,29 +427,54 @@ func (b *missionControlStore) AddResult(rp *paymentResult) {
 	b.queue.PushBack(rp)
 }
 
-// stop stops the store ticker goroutine.
+// AddPairs queues pair updates to be written to the db. Updates replace
+// earlier updates of the same pair that weren't written yet.
+func (b *missionControlStore) AddPairs(updates pairUpdates) {
+	b.queueMx.Lock()
+	defer b.queueMx.Unlock()
+
+	for pair, result := range updates {
+		b.pendingPairs[pair] = result
+	}
+}
+
+// stop stops the store ticker goroutine and writes all pending updates.
 func (b *missionControlStore) stop() {
 	close(b.done)
 	b.wg.Wait()
+
+	if err := b.flush(); err != nil {
+		log.Errorf("Failed to flush mission control store: %v", err)
+	}
 }
 
-// run runs the MC store ticker goroutine.
-func (b *missionControlStore) run() {
+// run runs the MC store ticker goroutine. The prune closure is called every
+// pruneInterval to remove outdated state.
+func (b *missionControlStore) run(pruneInterval time.Duration,
+	prune func()) {
+
 	b.wg.Add(1)
 
 	go func() {
 		ticker := time.NewTicker(b.flushInterval)
 		defer ticker.Stop()
+
+		pruneTicker := time.NewTicker(pruneInterval)
+		defer pruneTicker.Stop()
+
 		defer b.wg.Done()
 
 		for {
 			select {
 			case <-ticker.C:
-				if err := b.storeResults(); err != nil {
+				if err := b.flush(); err != nil {
 					log.Errorf("Failed to update mission "+
 						"control store: %v", err)
 				}
 
+			case <-pruneTicker.C:
+				prune()
+
 			case <-b.done:
 				return
 			}
@@ -299,6 +482,102 @@ func (b *missionControlStore) run() {
 	}()
 }
 
+// flush writes all pending payment results and pair updates to the db.
+func (b *missionControlStore) flush() error {
+	if err := b.storeResults(); err != nil {
+		return err
+	}
+
+	return b.storePairs()
+}
+
+// storePairs writes all pending pair updates to the db in batches.
+func (b *missionControlStore) storePairs() error {
+	b.queueMx.Lock()
+	pending := b.pendingPairs
+	b.pendingPairs = make(pairUpdates)
+	b.queueMx.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batch := make([]DirectedNodePair, 0, pairBatchSize)
+	writeBatch := func() error {
+		err := kvdb.Update(b.db, func(tx kvdb.RwTx) error {
+			bucket := tx.ReadWriteBucket(pairsKey)
+
+			for _, pair := range batch {
+				key := serializePairKey(pair)
+
+				// Pairs without a result were removed from
+				// the state.
+				result := pending[pair]
+				if result == nil {
+					err := bucket.Delete(key)
+					if err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				v, err := serializePairResult(result)
+				if err != nil {
+					return err
+				}
+
+				if err := bucket.Put(key, v); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, func() {})
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range batch {
+			delete(pending, pair)
+		}
+		batch = batch[:0]
+
+		return nil
+	}
+
+	var err error
+	for pair := range pending {
+		batch = append(batch, pair)
+		if len(batch) < pairBatchSize {
+			continue
+		}
+
+		if err = writeBatch(); err != nil {
+			break
+		}
+	}
+	if err == nil && len(batch) > 0 {
+		err = writeBatch()
+	}
+
+	if err != nil {
+		// Requeue the updates that weren't written, unless they were
+		// superseded in the meantime.
+		b.queueMx.Lock()
+		for pair, result := range pending {
+			if _, ok := b.pendingPairs[pair]; !ok {
+				b.pendingPairs[pair] = result
+			}
+		}
+		b.queueMx.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
 // storeResults stores all accumulated results.
 func (b *missionControlStore) storeResults() error {
 	b.queueMx.Lock()
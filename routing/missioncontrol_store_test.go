@@ -144,3 +144,76 @@ func TestMissionControlStore(t *testing.T) {
 			spew.Sdump(results[1]))
 	}
 }
+
+// TestMissionControlStorePairs tests the persistence of pair state, including
+// updates that are split over multiple transactions.
+func TestMissionControlStorePairs(t *testing.T) {
+	// Set time zone explicitly to keep test deterministic.
+	time.Local = time.UTC
+
+	file, err := ioutil.TempFile("", "*.db")
+	require.NoError(t, err)
+
+	dbPath := file.Name()
+
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, dbPath, true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	store, err := newMissionControlStore(db, testMaxRecords, time.Second)
+	require.NoError(t, err)
+
+	// A new store has no pair state yet and needs to replay results.
+	require.True(t, store.replayResults)
+
+	// Queue more updates than fit in a single batch.
+	updates := make(pairUpdates)
+	for i := 0; i < pairBatchSize+10; i++ {
+		pair := NewDirectedNodePair(
+			route.Vertex{byte(i >> 8), byte(i)}, route.Vertex{1},
+		)
+		updates[pair] = &TimedPairResult{
+			FailTime: testTime,
+			FailAmt:  lnwire.MilliBronees(i),
+		}
+	}
+	store.AddPairs(updates)
+	require.NoError(t, store.storePairs())
+
+	pairs, err := store.fetchPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, pairBatchSize+10)
+
+	// Update one pair and remove another.
+	updatedPair := NewDirectedNodePair(route.Vertex{0, 1}, route.Vertex{1})
+	removedPair := NewDirectedNodePair(route.Vertex{0, 2}, route.Vertex{1})
+	updated := TimedPairResult{
+		SuccessTime: testTime.Add(time.Hour),
+		SuccessAmt:  2000,
+	}
+	store.AddPairs(pairUpdates{
+		updatedPair: &updated,
+		removedPair: nil,
+	})
+	require.NoError(t, store.storePairs())
+
+	// Reopen the store and check that the state was persisted.
+	store, err = newMissionControlStore(db, testMaxRecords, time.Second)
+	require.NoError(t, err)
+	require.False(t, store.replayResults)
+
+	pairs, err = store.fetchPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, pairBatchSize+9)
+	require.Equal(t, updated, pairs[updatedPair])
+	require.NotContains(t, pairs, removedPair)
+
+	// Clearing the store removes the pair state too.
+	require.NoError(t, store.clear())
+	pairs, err = store.fetchPairs()
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+}
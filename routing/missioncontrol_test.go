@@ -9,6 +9,7 @@ import (
 	"github.com/brsuite/broln/kvdb"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,6 +39,7 @@ var (
 	testPenaltyHalfLife       = 30 * time.Minute
 	testAprioriHopProbability = 0.9
 	testAprioriWeight         = 0.5
+	testCapacity              = bronutil.Amount(100_000)
 )
 
 type mcTestContext struct {
@@ -81,18 +83,19 @@ func (ctx *mcTestContext) restartMc() {
 	// Since we don't run a timer to store results in unit tests, we store
 	// them here before fetching back everything in NewMissionControl.
 	if ctx.mc != nil {
-		require.NoError(ctx.t, ctx.mc.store.storeResults())
+		require.NoError(ctx.t, ctx.mc.store.flush())
 	}
 
+	estimator, err := NewAprioriEstimator(AprioriConfig{
+		PenaltyHalfLife:       testPenaltyHalfLife,
+		AprioriHopProbability: testAprioriHopProbability,
+		AprioriWeight:         testAprioriWeight,
+	})
+	require.NoError(ctx.t, err)
+
 	mc, err := NewMissionControl(
 		ctx.db, mcTestSelf,
-		&MissionControlConfig{
-			ProbabilityEstimatorCfg: ProbabilityEstimatorCfg{
-				PenaltyHalfLife:       testPenaltyHalfLife,
-				AprioriHopProbability: testAprioriHopProbability,
-				AprioriWeight:         testAprioriWeight,
-			},
-		},
+		&MissionControlConfig{Estimator: estimator},
 	)
 	if err != nil {
 		ctx.t.Fatal(err)
@@ -112,7 +115,7 @@ func (ctx *mcTestContext) cleanup() {
 func (ctx *mcTestContext) expectP(amt lnwire.MilliBronees, expected float64) {
 	ctx.t.Helper()
 
-	p := ctx.mc.GetProbability(mcTestNode1, mcTestNode2, amt)
+	p := ctx.mc.GetProbability(mcTestNode1, mcTestNode2, amt, testCapacity)
 	if p != expected {
 		ctx.t.Fatalf("expected probability %v but got %v", expected, p)
 	}
@@ -151,7 +154,9 @@ func TestMissionControl(t *testing.T) {
 
 	// For local channels, we expect a higher probability than our a prior
 	// test probability.
-	selfP := ctx.mc.GetProbability(mcTestSelf, mcTestNode1, 100)
+	selfP := ctx.mc.GetProbability(
+		mcTestSelf, mcTestNode1, 100, testCapacity,
+	)
 	if selfP != prevSuccessProbability {
 		t.Fatalf("expected prev success prob for untried local chans")
 	}
@@ -223,3 +228,118 @@ func TestMissionControlChannelUpdate(t *testing.T) {
 	)
 	ctx.expectP(100, 0)
 }
+
+// TestMissionControlPairPersistence tests that the pair state is restored
+// from the store without replaying payment results, and that stores without
+// pair state are migrated by replaying the stored results.
+func TestMissionControlPairPersistence(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+	require.NoError(t, ctx.mc.store.flush())
+
+	// Remove the raw results. The pair state is expected to survive a
+	// restart regardless.
+	err := kvdb.Update(ctx.db, func(tx kvdb.RwTx) error {
+		if err := tx.DeleteTopLevelBucket(resultsKey); err != nil {
+			return err
+		}
+
+		_, err := tx.CreateTopLevelBucket(resultsKey)
+		return err
+	}, func() {})
+	require.NoError(t, err)
+
+	ctx.restartMc()
+	require.False(t, ctx.mc.store.replayResults)
+	ctx.expectP(1000, 0)
+
+	// Report another failure and remove the pair state instead. The state
+	// is expected to be reconstructed from the results and persisted.
+	ctx.reportFailure(500, lnwire.NewTemporaryChannelFailure(nil))
+	require.NoError(t, ctx.mc.store.flush())
+
+	err = kvdb.Update(ctx.db, func(tx kvdb.RwTx) error {
+		return tx.DeleteTopLevelBucket(pairsKey)
+	}, func() {})
+	require.NoError(t, err)
+
+	ctx.restartMc()
+	require.True(t, ctx.mc.store.replayResults)
+	ctx.expectP(500, 0)
+	ctx.expectP(250, testAprioriHopProbability)
+
+	pairs, err := ctx.mc.store.fetchPairs()
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+}
+
+// TestMissionControlPairLimits tests that mission control forgets pair
+// results that are older than the configured maximum age.
+func TestMissionControlPairLimits(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.mc.pairMaxAge = time.Hour
+	ctx.now = testTime
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	require.Len(t, ctx.mc.GetHistorySnapshot().Pairs, 2)
+
+	// Nothing is pruned before the maximum age is reached.
+	ctx.now = testTime.Add(30 * time.Minute)
+	ctx.mc.prune()
+	require.Len(t, ctx.mc.GetHistorySnapshot().Pairs, 2)
+
+	// Afterwards both pairs are removed, also from the store.
+	ctx.now = testTime.Add(2 * time.Hour)
+	ctx.mc.prune()
+	require.Empty(t, ctx.mc.GetHistorySnapshot().Pairs)
+
+	require.NoError(t, ctx.mc.store.flush())
+	pairs, err := ctx.mc.store.fetchPairs()
+	require.NoError(t, err)
+	require.Empty(t, pairs)
+}
+
+// TestMissionControlExportImport tests that mission control state can be
+// exported and imported into another instance.
+func TestMissionControlExportImport(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	require.NoError(t, ctx.mc.store.flush())
+
+	export, err := ctx.mc.Export(true)
+	require.NoError(t, err)
+	require.Len(t, export.Pairs, 2)
+	require.Equal(t, 1, export.NumResults())
+
+	// Import the export into a fresh instance.
+	ctx2 := createMcTestContext(t)
+	defer ctx2.cleanup()
+
+	ctx2.now = testTime
+	ctx2.expectP(1000, testAprioriHopProbability)
+
+	pairs, results, err := ctx2.mc.Import(export, false)
+	require.NoError(t, err)
+	require.NotZero(t, pairs)
+	require.Equal(t, 1, results)
+	ctx2.expectP(1000, 0)
+
+	// The imported state is expected to be persisted.
+	ctx2.restartMc()
+	ctx2.expectP(1000, 0)
+
+	stored, err := ctx2.mc.store.fetchAll()
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/bronutil"
 	"github.com/go-errors/errors"
 	"github.com/stretchr/testify/mock"
 )
@@ -139,7 +140,7 @@ func (m *mockMissionControlOld) ReportPaymentSuccess(paymentID uint64,
 }
 
 func (m *mockMissionControlOld) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliSatoshi) float64 {
+	amt lnwire.MilliSatoshi, capacity bronutil.Amount) float64 {
 
 	return 0
 }
@@ -618,9 +619,9 @@ func (m *mockMissionControl) ReportPaymentSuccess(paymentID uint64,
 }
 
 func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
-	amt lnwire.MilliSatoshi) float64 {
+	amt lnwire.MilliSatoshi, capacity bronutil.Amount) float64 {
 
-	args := m.Called(fromNode, toNode, amt)
+	args := m.Called(fromNode, toNode, amt, capacity)
 	return args.Get(0).(float64)
 }
 
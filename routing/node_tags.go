@@ -0,0 +1,96 @@
+package routing
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/brsuite/broln/routing/route"
+)
+
+// NodeTags maps nodes to the set of tags that describe them, such as the
+// country or the autonomous system they are hosted in. Tags are used to avoid
+// whole groups of nodes during path finding.
+type NodeTags map[route.Vertex]map[string]struct{}
+
+// LoadNodeTags reads node tags from the file at the given path. See
+// ParseNodeTags for the expected format.
+func LoadNodeTags(path string) (NodeTags, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tags, err := ParseNodeTags(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse node tags file %v: %v",
+			path, err)
+	}
+
+	return tags, nil
+}
+
+// ParseNodeTags parses node tags from the given reader. Every line holds the
+// hex-encoded public key of a node followed by its whitespace separated tags,
+// for example "country:xx asn:64496". Tags are case insensitive. Empty lines
+// and lines starting with # are ignored. A node may appear on multiple lines,
+// in which case its tags are merged.
+func ParseNodeTags(r io.Reader) (NodeTags, error) {
+	tags := make(NodeTags)
+
+	scanner := bufio.NewScanner(r)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %v: expected node public "+
+				"key followed by tags", lineNum)
+		}
+
+		node, err := route.NewVertexFromStr(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: invalid node public "+
+				"key: %v", lineNum, err)
+		}
+
+		nodeTags, ok := tags[node]
+		if !ok {
+			nodeTags = make(map[string]struct{})
+			tags[node] = nodeTags
+		}
+
+		for _, tag := range fields[1:] {
+			nodeTags[strings.ToLower(tag)] = struct{}{}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// NodesWithTags returns the set of nodes that have at least one of the given
+// tags.
+func (n NodeTags) NodesWithTags(tags []string) map[route.Vertex]struct{} {
+	nodes := make(map[route.Vertex]struct{})
+	for _, tag := range tags {
+		tag = strings.ToLower(tag)
+
+		for node, nodeTags := range n {
+			if _, ok := nodeTags[tag]; ok {
+				nodes[node] = struct{}{}
+			}
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,54 @@
+package routing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/brsuite/broln/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tagNodeKey1 = "0286098b97bc843372b4426d4b276cea9aa2f48f0428d6f5b66ae101befc14f8b4"
+	tagNodeKey2 = "02f274f48f3c0d590449a6776e3ce8825076ac376e470e992246eebc565ef8bb2a"
+)
+
+// TestParseNodeTags tests the parsing of node tags files and the lookup of
+// nodes by their tags.
+func TestParseNodeTags(t *testing.T) {
+	t.Parallel()
+
+	node1, err := route.NewVertexFromStr(tagNodeKey1)
+	require.NoError(t, err)
+	node2, err := route.NewVertexFromStr(tagNodeKey2)
+	require.NoError(t, err)
+
+	file := "# Nodes to avoid.\n" +
+		"\n" +
+		tagNodeKey1 + " Country:XX asn:64496\n" +
+		"  " + tagNodeKey2 + "\tcountry:yy\n" +
+		tagNodeKey2 + " asn:64496\n"
+
+	tags, err := ParseNodeTags(strings.NewReader(file))
+	require.NoError(t, err)
+
+	require.Equal(t, NodeTags{
+		node1: {"country:xx": {}, "asn:64496": {}},
+		node2: {"country:yy": {}, "asn:64496": {}},
+	}, tags)
+
+	require.Equal(t, map[route.Vertex]struct{}{node1: {}},
+		tags.NodesWithTags([]string{"COUNTRY:xx"}))
+	require.Equal(t, map[route.Vertex]struct{}{node1: {}, node2: {}},
+		tags.NodesWithTags([]string{"asn:64496"}))
+	require.Equal(t, map[route.Vertex]struct{}{node1: {}, node2: {}},
+		tags.NodesWithTags([]string{"country:xx", "country:yy"}))
+	require.Empty(t, tags.NodesWithTags([]string{"country:zz"}))
+
+	// Lines without tags and invalid keys are rejected.
+	_, err = ParseNodeTags(strings.NewReader(tagNodeKey1 + "\n"))
+	require.Error(t, err)
+
+	_, err = ParseNodeTags(strings.NewReader("0201 country:xx\n"))
+	require.Error(t, err)
+}
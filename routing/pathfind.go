@@ -11,7 +11,9 @@ import (
 	"github.com/brsuite/broln/feature"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
 	sphinx "github.com/brsuite/lightning-onion"
 )
 
@@ -65,10 +67,6 @@ var (
 	// DefaultMinRouteProbability is the default minimum probability for routes
 	// returned from findPath.
 	DefaultMinRouteProbability = float64(0.01)
-
-	// DefaultAprioriHopProbability is the default a priori probability for
-	// a hop.
-	DefaultAprioriHopProbability = float64(0.6)
 )
 
 // edgePolicyWithSource is a helper struct to keep track of the source node
@@ -89,6 +87,14 @@ type finalHopParams struct {
 	cltvDelta   uint16
 	records     record.CustomSet
 	paymentAddr *[32]byte
+
+	// trampolineOnion is the serialized trampoline onion that is handed
+	// to the final hop, if any.
+	trampolineOnion []byte
+
+	// blindedPayment is set if the final edge of the path is the edge
+	// from the introduction node of this blinded path to the target.
+	blindedPayment *blindedpath.BlindedPayment
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -138,6 +144,7 @@ func newRoute(sourceVertex route.Vertex,
 			tlvPayload       bool
 			customRecords    record.CustomSet
 			mpp              *record.MPP
+			trampolineOnion  []byte
 		)
 
 		// Define a helper function that checks this edge's feature
@@ -185,6 +192,14 @@ func newRoute(sourceVertex route.Vertex,
 			}
 			customRecords = finalHop.records
 
+			// Likewise, a trampoline onion can only be delivered
+			// within a TLV payload.
+			if !tlvPayload && finalHop.trampolineOnion != nil {
+				return nil, errors.New("cannot attach " +
+					"trampoline onion")
+			}
+			trampolineOnion = finalHop.trampolineOnion
+
 			// If we're attaching a payment addr but the receiver
 			// doesn't support both TLV and payment addrs, fail.
 			payAddr := supports(lnwire.PaymentAddrOptional)
@@ -194,9 +209,13 @@ func newRoute(sourceVertex route.Vertex,
 			}
 
 			// Otherwise attach the mpp record if it exists.
+			// Payments to blinded paths convey the total amount
+			// through the blinded payload instead.
 			// TODO(halseth): move this to payment life cycle,
 			// where AMP options are set.
-			if finalHop.paymentAddr != nil {
+			if finalHop.paymentAddr != nil &&
+				finalHop.blindedPayment == nil {
+
 				mpp = record.NewMPP(
 					finalHop.totalAmt,
 					*finalHop.paymentAddr,
@@ -211,8 +230,9 @@ func newRoute(sourceVertex route.Vertex,
 			// based on the amount that this hop needs to forward
 			// and its policy for the outgoing channel. This policy
 			// is stored as part of the incoming channel of
-			// the next hop.
-			fee = pathEdges[i+1].ComputeFee(amtToForward)
+			// the next hop. The hop also charges an inbound fee
+			// for the channel that the htlc arrives over.
+			fee = hopFee(amtToForward, edge, pathEdges[i+1])
 
 			// We'll take the total timelock of the preceding hop as
 			// the outgoing timelock or this hop. Then we'll
@@ -232,6 +252,7 @@ func newRoute(sourceVertex route.Vertex,
 			LegacyPayload:    !tlvPayload,
 			CustomRecords:    customRecords,
 			MPP:              mpp,
+			TrampolineOnion:  trampolineOnion,
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -242,6 +263,19 @@ func newRoute(sourceVertex route.Vertex,
 		nextIncomingAmount = amtToForward + fee
 	}
 
+	// If the payment is made to a blinded path, the final hop is the
+	// virtual edge from the introduction node to the target, which we'll
+	// replace with the hops of the blinded path.
+	if finalHop.blindedPayment != nil {
+		var err error
+		hops, err = blindHops(
+			hops, finalHop.blindedPayment, finalHop.totalAmt,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// With the base routing data expressed as hops, build the full route
 	newRoute, err := route.NewRouteFromHops(
 		nextIncomingAmount, totalTimeLock, route.Vertex(sourceVertex),
@@ -254,6 +288,101 @@ func newRoute(sourceVertex route.Vertex,
 	return newRoute, nil
 }
 
+// hopFee returns the fee that a routing node charges to forward the given
+// amount. It consists of the fee of the outgoing channel and the inbound fee
+// that the node charges for the incoming channel. Routing nodes treat a
+// negative total fee as zero.
+func hopFee(amtToForward lnwire.MilliBronees,
+	incoming, outgoing *channeldb.CachedEdgePolicy) lnwire.MilliBronees {
+
+	outboundFee := outgoing.ComputeFee(amtToForward)
+	inboundFee := incoming.InboundFee.CalcFee(amtToForward + outboundFee)
+
+	fee := int64(outboundFee) + inboundFee
+	if fee < 0 {
+		return 0
+	}
+
+	return lnwire.MilliBronees(fee)
+}
+
+// forwardAmt returns the largest amount that a routing node forwards over the
+// outgoing channel when it receives the given amount over the incoming
+// channel.
+func forwardAmt(incomingAmt lnwire.MilliBronees,
+	incoming, outgoing *channeldb.CachedEdgePolicy) lnwire.MilliBronees {
+
+	if incoming.InboundFee.IsZero() {
+		return incomingAmt - outgoing.ComputeFeeFromIncoming(
+			incomingAmt,
+		)
+	}
+
+	// The inbound fee is based on the amount including the outbound fee,
+	// which makes the inversion of both fees rather involved. Instead, we
+	// search for the largest amount whose fee is covered. The incoming
+	// amount that the node requires grows with the amount to forward.
+	low, high := lnwire.MilliBronees(0), incomingAmt
+	for low < high {
+		amt := low + (high-low+1)/2
+		if amt+hopFee(amt, incoming, outgoing) <= incomingAmt {
+			low = amt
+		} else {
+			high = amt - 1
+		}
+	}
+
+	return low
+}
+
+// blindHops replaces the final hop of the passed hops, which is reached over
+// the virtual edge from the introduction node of the blinded path to the
+// target, with the hops of the blinded path. The introduction node receives
+// the blinding point of the path along with its encrypted data, while the
+// amount and expiry are only included for the final blinded hop. The fees and
+// expiry deltas of the blinded hops are accounted for by the aggregated
+// policy of the virtual edge.
+func blindHops(hops []*route.Hop, payment *blindedpath.BlindedPayment,
+	totalAmt lnwire.MilliBronees) ([]*route.Hop, error) {
+
+	// The introduction node is the hop preceding the virtual final hop,
+	// so it can't be ourselves.
+	if len(hops) < 2 {
+		return nil, errors.New("cannot pay to blinded path with " +
+			"ourselves as introduction node")
+	}
+
+	var (
+		path        = payment.BlindedPath
+		finalHop    = hops[len(hops)-1]
+		intro       = hops[len(hops)-2]
+		blindedHops = []*route.Hop{intro}
+	)
+
+	intro.EncryptedData = path.BlindedHops[0].CipherText
+	intro.BlindingPoint = path.BlindingPoint
+	intro.LegacyPayload = false
+
+	for _, blindedHop := range path.BlindedHops[1:] {
+		blindedHops = append(blindedHops, &route.Hop{
+			PubKeyBytes:      route.NewVertex(blindedHop.BlindedNodePub),
+			AmtToForward:     finalHop.AmtToForward,
+			OutgoingTimeLock: finalHop.OutgoingTimeLock,
+			EncryptedData:    blindedHop.CipherText,
+		})
+	}
+
+	// The final hop of the blinded path is the recipient, which receives
+	// the amount, expiry and any custom records of the payment.
+	recipient := blindedHops[len(blindedHops)-1]
+	recipient.AmtToForward = finalHop.AmtToForward
+	recipient.OutgoingTimeLock = finalHop.OutgoingTimeLock
+	recipient.CustomRecords = finalHop.CustomRecords
+	recipient.TotalAmtMsat = totalAmt
+
+	return append(hops[:len(hops)-2], blindedHops...), nil
+}
+
 // edgeWeight computes the weight of an edge. This value is used when searching
 // for the shortest path within the channel graph between two nodes. Weight is
 // is the fee itself plus a time lock penalty added to it. This benefits
@@ -296,9 +425,10 @@ type graphParams struct {
 // found path must adhere to.
 type RestrictParams struct {
 	// ProbabilitySource is a callback that is expected to return the
-	// success probability of traversing the channel from the node.
+	// success probability of traversing the channel from the node. The
+	// capacity of the channel is zero if unknown.
 	ProbabilitySource func(route.Vertex, route.Vertex,
-		lnwire.MilliBronees) float64
+		lnwire.MilliBronees, bronutil.Amount) float64
 
 	// FeeLimit is a maximum fee amount allowed to be used on the path from
 	// the source to the target.
@@ -312,6 +442,10 @@ type RestrictParams struct {
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
 
+	// IgnoredChannels is the set of channel directions that the path may
+	// not use. If nil, any channel may be used.
+	IgnoredChannels map[EdgeLocator]struct{}
+
 	// CltvLimit is the maximum time lock of the route excluding the final
 	// ctlv. After path finding is complete, the caller needs to increase
 	// all cltv expiry heights with the required final cltv delta.
@@ -330,6 +464,10 @@ type RestrictParams struct {
 	// mitigate probing vectors and payment sniping attacks on overpaid
 	// invoices.
 	PaymentAddr *[32]byte
+
+	// TrampolineOnion is the serialized trampoline onion to drop off at
+	// the final hop, if any.
+	TrampolineOnion []byte
 }
 
 // PathFindingConfig defines global parameters that control the trade-off in
@@ -460,7 +598,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 	// If the caller needs to send custom records, check that our
 	// destination feature vector supports TLV.
-	if len(r.DestCustomRecords) > 0 &&
+	if (len(r.DestCustomRecords) > 0 || r.TrampolineOnion != nil) &&
 		!features.HasFeature(lnwire.TLVOnionPayloadOptional) {
 
 		return nil, errNoTlvPayload
@@ -547,7 +685,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		LegacyPayload: !features.HasFeature(
 			lnwire.TLVOnionPayloadOptional,
 		),
-		MPP: mpp,
+		MPP:             mpp,
+		TrampolineOnion: r.TrampolineOnion,
 	}
 
 	// We can't always assume that the end destination is publicly
@@ -566,7 +705,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		amountToReceive: amt,
 		incomingCltv:    finalHtlcExpiry,
 		probability:     1,
-		routingInfoSize: finalHop.PayloadSize(0),
+		routingInfoSize: finalHop.PayloadSize(0, true),
 	}
 
 	// Calculate the absolute cltv limit. Use uint64 to prevent an overflow
@@ -585,24 +724,44 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex,
 		fromFeatures *lnwire.FeatureVector,
-		edge *channeldb.CachedEdgePolicy, toNodeDist *nodeWithDist) {
+		edge *channeldb.CachedEdgePolicy, capacity bronutil.Amount,
+		toNodeDist *nodeWithDist) {
 
 		edgesExpanded++
 
+		// Calculate the inbound fee that the to node charges for htlcs
+		// that arrive over this edge. The final node doesn't charge an
+		// inbound fee.
+		var inboundFee int64
+		if toNodeDist.node != target {
+			inboundFee = edge.InboundFee.CalcFee(
+				toNodeDist.amountToReceive,
+			)
+
+			// Routing nodes treat a negative total fee as zero, so
+			// the discount can't exceed the outbound fee.
+			minInboundFee := -int64(toNodeDist.outboundFee)
+			if inboundFee < minInboundFee {
+				inboundFee = minInboundFee
+			}
+		}
+
 		// Calculate amount that the candidate node would have to send
 		// out.
-		amountToSend := toNodeDist.amountToReceive
+		amountToSend := lnwire.MilliBronees(
+			int64(toNodeDist.amountToReceive) + inboundFee,
+		)
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
-			fromVertex, toNodeDist.node, amountToSend,
+			fromVertex, toNodeDist.node, amountToSend, capacity,
 		)
 
 		log.Trace(newLogClosure(func() string {
 			return fmt.Sprintf("path finding probability: fromnode=%v,"+
-				" tonode=%v, amt=%v, probability=%v",
+				" tonode=%v, amt=%v, capacity=%v, probability=%v",
 				fromVertex, toNodeDist.node, amountToSend,
-				edgeProbability)
+				capacity, edgeProbability)
 		}))
 
 		// If the probability is zero, there is no point in trying.
@@ -663,8 +822,17 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// By adding fromVertex in the route, there will be an extra
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
-		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		// the HTLC that is handed out to fromVertex. The inbound fee of
+		// the to node is part of the fee. Because Dijkstra doesn't
+		// support negative weights, the combined fee is never negative.
+		edgeFee := int64(fee) + inboundFee
+		if edgeFee < 0 {
+			edgeFee = 0
+		}
+		weight := edgeWeight(
+			amountToReceive, lnwire.MilliBronees(edgeFee),
+			timeLockDelta,
+		)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -724,7 +892,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				LegacyPayload: !supportsTlv,
 			}
 
-			payloadSize = hop.PayloadSize(edge.ChannelID)
+			payloadSize = hop.PayloadSize(edge.ChannelID, false)
 		}
 
 		routingInfoSize := toNodeDist.routingInfoSize + payloadSize
@@ -743,6 +911,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			weight:          tempWeight,
 			node:            fromVertex,
 			amountToReceive: amountToReceive,
+			outboundFee:     fee,
 			incomingCltv:    incomingCltv,
 			probability:     probability,
 			nextHop:         edge,
@@ -807,7 +976,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		pivot := partialPath.node
 
 		// Create unified policies for all incoming connections.
-		u := newUnifiedPolicies(self, pivot, outgoingChanMap)
+		u := newUnifiedPolicies(
+			self, pivot, outgoingChanMap, r.IgnoredChannels,
+		)
 
 		err := u.addGraphPolicies(g.graph)
 		if err != nil {
@@ -858,9 +1029,17 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				continue
 			}
 
+			// The routing node may forward over any of its channels
+			// to the pivot, so we estimate the probability with the
+			// largest capacity that can carry the amount.
+			capacity := unifiedPolicy.capacity(amtToSend)
+
 			// Check if this candidate node is better than what we
 			// already have.
-			processEdge(fromNode, fromFeatures, policy, partialPath)
+			processEdge(
+				fromNode, fromFeatures, policy, capacity,
+				partialPath,
+			)
 		}
 
 		if nodeHeap.Len() == 0 {
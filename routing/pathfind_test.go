@@ -23,11 +23,13 @@ import (
 	"github.com/brsuite/broln/kvdb"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/wire"
 	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -104,7 +106,9 @@ var (
 
 // noProbabilitySource is used in testing to return the same probability 1 for
 // all edges.
-func noProbabilitySource(route.Vertex, route.Vertex, lnwire.MilliBronees) float64 {
+func noProbabilitySource(route.Vertex, route.Vertex, lnwire.MilliBronees,
+	bronutil.Amount) float64 {
+
 	return 1
 }
 
@@ -444,6 +448,7 @@ type testChannelPolicy struct {
 	LastUpdate  time.Time
 	Disabled    bool
 	Features    *lnwire.FeatureVector
+	InboundFee  channeldb.InboundFee
 }
 
 type testChannelEnd struct {
@@ -715,6 +720,10 @@ func createTestGraphFromChannels(useCache bool, testChannels []*testChannel,
 					Features:    node2Features,
 				},
 			}
+			err := edgePolicy.SetInboundFee(node1.InboundFee)
+			if err != nil {
+				return nil, err
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -753,6 +762,10 @@ func createTestGraphFromChannels(useCache bool, testChannels []*testChannel,
 					Features:    node1Features,
 				},
 			}
+			err := edgePolicy.SetInboundFee(node2.InboundFee)
+			if err != nil {
+				return nil, err
+			}
 			if err := graph.UpdateEdgePolicy(edgePolicy); err != nil {
 				return nil, err
 			}
@@ -825,12 +838,18 @@ func TestPathFinding(t *testing.T) {
 	}, {
 		name: "restrict last hop",
 		fn:   runRestrictLastHop,
+	}, {
+		name: "ignored channels",
+		fn:   runIgnoredChannels,
 	}, {
 		name: "CLTV limit",
 		fn:   runCltvLimit,
 	}, {
 		name: "probability routing",
 		fn:   runProbabilityRouting,
+	}, {
+		name: "capacity factor routing",
+		fn:   runCapacityFactorRouting,
 	}, {
 		name: "equal cost route selection",
 		fn:   runEqualCostRouteSelection,
@@ -840,6 +859,12 @@ func TestPathFinding(t *testing.T) {
 	}, {
 		name: "route to self",
 		fn:   runRouteToSelf,
+	}, {
+		name: "blinded payment",
+		fn:   runBlindedPayment,
+	}, {
+		name: "inbound fees",
+		fn:   runInboundFees,
 	}}
 
 	// Run with graph cache enabled.
@@ -2296,7 +2321,7 @@ func TestPathFindSpecExample(t *testing.T) {
 	carol := ctx.aliases["C"]
 	const amt lnwire.MilliBronees = 4999999
 	route, err := ctx.router.FindRoute(
-		bobNode.PubKeyBytes, carol, amt, noRestrictions, nil, nil,
+		bobNode.PubKeyBytes, carol, amt, noRestrictions, nil, nil, nil,
 		MinCLTVDelta,
 	)
 	if err != nil {
@@ -2352,7 +2377,7 @@ func TestPathFindSpecExample(t *testing.T) {
 
 	// We'll now request a route from A -> B -> C.
 	route, err = ctx.router.FindRoute(
-		source.PubKeyBytes, carol, amt, noRestrictions, nil, nil,
+		source.PubKeyBytes, carol, amt, noRestrictions, nil, nil, nil,
 		MinCLTVDelta,
 	)
 	if err != nil {
@@ -2460,6 +2485,43 @@ func TestNewRouteFromEmptyHops(t *testing.T) {
 	}
 }
 
+// TestForwardAmt tests that the amount that a routing node forwards is derived
+// correctly from the incoming amount when it charges an inbound fee.
+func TestForwardAmt(t *testing.T) {
+	t.Parallel()
+
+	outgoing := &channeldb.CachedEdgePolicy{
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 2000,
+	}
+
+	inboundFees := []channeldb.InboundFee{
+		{},
+		{Base: 500, Rate: 1000},
+		{Base: -500, Rate: -1000},
+		{Base: -5000, Rate: -10000},
+	}
+
+	for _, inboundFee := range inboundFees {
+		incoming := &channeldb.CachedEdgePolicy{
+			InboundFee: inboundFee,
+		}
+
+		for _, amt := range []lnwire.MilliBronees{1, 1234, 1_000_000} {
+			incomingAmt := amt + hopFee(amt, incoming, outgoing)
+
+			// The node forwards at least the amount whose fee is
+			// paid, and never more than is covered.
+			fwdAmt := forwardAmt(incomingAmt, incoming, outgoing)
+			require.GreaterOrEqual(t, fwdAmt, amt)
+			require.LessOrEqual(
+				t, fwdAmt+hopFee(fwdAmt, incoming, outgoing),
+				incomingAmt,
+			)
+		}
+	}
+}
+
 // runRestrictOutgoingChannel asserts that a outgoing channel restriction is
 // obeyed by the path finding algorithm.
 func runRestrictOutgoingChannel(t *testing.T, useCache bool) {
@@ -2584,6 +2646,73 @@ func runRestrictLastHop(t *testing.T, useCache bool) {
 	}
 }
 
+// runIgnoredChannels asserts that path finding avoids ignored channel
+// directions, but still uses the opposite direction and parallel channels.
+func runIgnoredChannels(t *testing.T, useCache bool) {
+	// Set up a test graph with two channels from a to target, and a more
+	// expensive path through b.
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+		}, 2),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 600,
+		}, 3),
+		symmetricTestChannel("source", "b", 100000, &testChannelPolicy{
+			Expiry: 144,
+		}, 4),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+		}, 5),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+	defer ctx.cleanup()
+
+	paymentAmt := lnwire.NewMSatFromBroneess(100)
+	a := ctx.keyFromAlias("a")
+	target := ctx.keyFromAlias("target")
+
+	assertPath := func(expected ...uint64) {
+		t.Helper()
+
+		path, err := ctx.findPath(target, paymentAmt)
+		require.NoError(t, err)
+		ctx.assertPath(path, expected)
+	}
+
+	// Without restrictions, the path through a is used. Parallel channels
+	// are unified, so the policy with the highest fee is selected.
+	assertPath(1, 3)
+
+	// Ignoring the direction from target to a doesn't affect the path.
+	ctx.restrictParams.IgnoredChannels = map[EdgeLocator]struct{}{
+		NewEdgeLocator(3, target, a): {},
+	}
+	assertPath(1, 3)
+
+	// If the direction from a to target is ignored, the parallel channel
+	// is used instead.
+	ctx.restrictParams.IgnoredChannels = map[EdgeLocator]struct{}{
+		NewEdgeLocator(3, a, target): {},
+	}
+	assertPath(1, 2)
+
+	// With both channels from a to target ignored, the payment has to go
+	// through b.
+	ctx.restrictParams.IgnoredChannels = map[EdgeLocator]struct{}{
+		NewEdgeLocator(2, a, target): {},
+		NewEdgeLocator(3, a, target): {},
+	}
+	assertPath(4, 5)
+}
+
 // runCltvLimit asserts that a cltv limit is obeyed by the path finding
 // algorithm.
 func runCltvLimit(t *testing.T, useCache bool) {
@@ -2810,7 +2939,7 @@ func testProbabilityRouting(t *testing.T, useCache bool,
 
 	// Configure a probability source with the test parameters.
 	ctx.restrictParams.ProbabilitySource = func(fromNode, toNode route.Vertex,
-		amt lnwire.MilliBronees) float64 {
+		amt lnwire.MilliBronees, _ bronutil.Amount) float64 {
 
 		if amt == 0 {
 			t.Fatal("expected non-zero amount")
@@ -2855,6 +2984,157 @@ func testProbabilityRouting(t *testing.T, useCache bool,
 	}
 }
 
+// runCapacityFactorRouting asserts that the capacity factor of the apriori
+// estimator steers payments that are large compared to a channel's capacity
+// towards larger channels, and that no capacity factor is applied on top of
+// the bimodal estimator.
+func runCapacityFactorRouting(t *testing.T, useCache bool) {
+	newApriori := func(capacityFraction float64) Estimator {
+		estimator, err := NewAprioriEstimator(AprioriConfig{
+			AprioriHopProbability: 1,
+			AprioriWeight:         1,
+			CapacityFraction:      capacityFraction,
+		})
+		require.NoError(t, err)
+
+		return estimator
+	}
+
+	// The bimodal estimator uses a scale that is small compared to the
+	// channel capacities, so that the liquidity of both channels is
+	// assumed to be concentrated at their edges. It then estimates a
+	// success probability of about 0.5 for both routes, independent of
+	// their capacity.
+	bimodal, err := NewBimodalEstimator(BimodalConfig{
+		BimodalNodeWeight: 0.2,
+		BimodalScaleMsat:  lnwire.NewMSatFromBroneess(1_000),
+		BimodalDecayTime:  time.Hour,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		estimator    Estimator
+		amount       bronutil.Amount
+		expectedChan uint64
+	}{
+		// Without the capacity factor, the cheaper route over the
+		// small channel is selected.
+		{
+			name:         "apriori disabled",
+			estimator:    newApriori(0),
+			amount:       90_000,
+			expectedChan: 1,
+		},
+
+		// With the default capacity fraction, the factor for sending
+		// 90% of the small channel's capacity is about 0.59. The path
+		// finding distance of the route over the small channel is
+		// 1000 / 0.59 = 1692 sat, compared to 100 + 1000 = 1100 sat
+		// for the route over the large channel.
+		{
+			name:         "apriori large amount",
+			estimator:    newApriori(DefaultCapacityFraction),
+			amount:       90_000,
+			expectedChan: 2,
+		},
+
+		// Small amounts are barely affected by the capacity factor, so
+		// the cheaper route is still selected.
+		{
+			name:         "apriori small amount",
+			estimator:    newApriori(DefaultCapacityFraction),
+			amount:       10_000,
+			expectedChan: 1,
+		},
+
+		// The bimodal estimator already accounts for the capacity.
+		// The path finding distance of the route over the small
+		// channel is 1000 / 0.5 = 2000 sat, compared to
+		// 100 + 2000 = 2100 sat for the route over the large channel.
+		// If the capacity factor was applied on top, the distance of
+		// the first route would rise to 2000 / 0.59 = 3383 sat and the
+		// large channel would be selected instead.
+		{
+			name:         "bimodal large amount",
+			estimator:    bimodal,
+			amount:       90_000,
+			expectedChan: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testCapacityFactorRouting(
+				t, useCache, tc.estimator, tc.amount,
+				tc.expectedChan,
+			)
+		})
+	}
+}
+
+func testCapacityFactorRouting(t *testing.T, useCache bool,
+	estimator Estimator, paymentAmt bronutil.Amount,
+	expectedChan uint64) {
+
+	// Set up a test graph with two possible paths to the target: a cheap
+	// path via a small channel (channel 1) and a more expensive path via a
+	// large channel (channel 2).
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 1_000_000, &testChannelPolicy{
+			Expiry: 144,
+		}),
+		symmetricTestChannel("source", "b", 1_000_000, &testChannelPolicy{
+			Expiry: 144,
+		}),
+		symmetricTestChannel("a", "target", 100_000, &testChannelPolicy{
+			Expiry:  144,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("b", "target", 10_000_000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: lnwire.NewMSatFromBroneess(100),
+			MinHTLC:     1,
+		}, 2),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+	defer ctx.cleanup()
+
+	// Query the estimator in the same way mission control does, without
+	// any previous payment results.
+	ctx.restrictParams.ProbabilitySource = func(fromNode,
+		toNode route.Vertex, amt lnwire.MilliBronees,
+		capacity bronutil.Amount) float64 {
+
+		if fromNode == ctx.source {
+			return estimator.LocalPairProbability(
+				testTime, nil, toNode,
+			)
+		}
+
+		return estimator.PairProbability(
+			testTime, nil, toNode, amt, capacity,
+		)
+	}
+
+	ctx.pathFindingConfig = PathFindingConfig{
+		AttemptCost: lnwire.NewMSatFromBroneess(1000),
+	}
+
+	target := ctx.keyFromAlias("target")
+	path, err := ctx.findPath(
+		target, lnwire.NewMSatFromBroneess(paymentAmt),
+	)
+	require.NoError(t, err)
+	require.Len(t, path, 2)
+	require.Equal(t, expectedChan, path[1].ChannelID)
+}
+
 // runEqualCostRouteSelection asserts that route probability will be used as a
 // tie breaker in case the path finding probabilities are equal.
 func runEqualCostRouteSelection(t *testing.T, useCache bool) {
@@ -2891,7 +3171,7 @@ func runEqualCostRouteSelection(t *testing.T, useCache bool) {
 	target := ctx.testGraphInstance.aliasMap["target"]
 
 	ctx.restrictParams.ProbabilitySource = func(fromNode, toNode route.Vertex,
-		amt lnwire.MilliBronees) float64 {
+		amt lnwire.MilliBronees, _ bronutil.Amount) float64 {
 
 		switch {
 		case fromNode == alias["source"] && toNode == alias["a"]:
@@ -3036,6 +3316,252 @@ func runRouteToSelf(t *testing.T, useCache bool) {
 	ctx.assertPath(path, []uint64{1, 3, 2})
 }
 
+// runInboundFees tests that pathfinding and route construction account for the
+// inbound fees of the routing nodes, and that the total fee of a node is never
+// negative.
+func runInboundFees(t *testing.T, useCache bool) {
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	testCases := []struct {
+		name          string
+		inboundBase   int32
+		expectedHop   string
+		expectedTotal lnwire.MilliBronees
+	}{{
+		// The discount of a makes it cheaper than c.
+		name:          "discount",
+		inboundBase:   -800,
+		expectedHop:   "a",
+		expectedTotal: 101_200,
+	}, {
+		// The discount of a can't exceed its outbound fee.
+		name:          "discount exceeds fee",
+		inboundBase:   -2000,
+		expectedHop:   "a",
+		expectedTotal: 101_000,
+	}, {
+		// An inbound fee makes a more expensive than c.
+		name:          "inbound fee",
+		inboundBase:   1000,
+		expectedHop:   "c",
+		expectedTotal: 101_500,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &testChannelPolicy{
+				Expiry:      144,
+				FeeBaseMsat: 1000,
+			}
+			discountPolicy := *policy
+			discountPolicy.InboundFee = channeldb.InboundFee{
+				Base: tc.inboundBase,
+			}
+
+			// The final node charges an inbound fee too, which
+			// isn't applied.
+			targetPolicy := *policy
+			targetPolicy.InboundFee = channeldb.InboundFee{
+				Base: 5000,
+			}
+
+			cheapPolicy := *policy
+			cheapPolicy.FeeBaseMsat = 500
+
+			testChannels := []*testChannel{
+				symmetricTestChannel(
+					"source", "x", 100000, policy, 1,
+				),
+				asymmetricTestChannel(
+					"x", "a", 100000, policy,
+					&discountPolicy, 2,
+				),
+				asymmetricTestChannel(
+					"a", "target", 100000, policy,
+					&targetPolicy, 3,
+				),
+				symmetricTestChannel(
+					"x", "c", 100000, policy, 4,
+				),
+				asymmetricTestChannel(
+					"c", "target", 100000, &cheapPolicy,
+					&targetPolicy, 5,
+				),
+			}
+
+			ctx := newPathFindingTestContext(
+				t, useCache, testChannels, "source",
+			)
+			defer ctx.cleanup()
+
+			paymentAmt := lnwire.MilliBronees(100_000)
+			target := ctx.keyFromAlias("target")
+			path, err := ctx.findPath(target, paymentAmt)
+			require.NoError(t, err)
+
+			route, err := newRoute(
+				ctx.source, path, startingHeight,
+				finalHopParams{
+					amt:       paymentAmt,
+					cltvDelta: finalHopCLTV,
+				},
+			)
+			require.NoError(t, err)
+
+			require.Len(t, route.Hops, 3)
+			require.Equal(
+				t, ctx.keyFromAlias(tc.expectedHop),
+				route.Hops[1].PubKeyBytes,
+			)
+			require.Equal(t, tc.expectedTotal, route.TotalAmount)
+			require.Equal(
+				t, paymentAmt, route.Hops[2].AmtToForward,
+			)
+		})
+	}
+}
+
+// runBlindedPayment tests that pathfinding selects the cheapest of multiple
+// blinded paths and that the resulting route leads through the hops of the
+// selected path.
+func runBlindedPayment(t *testing.T, useCache bool) {
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	policy := &testChannelPolicy{
+		Expiry:      144,
+		FeeBaseMsat: 1000,
+		Features:    tlvFeatures,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("source", "a", 100000, policy, 1),
+		symmetricTestChannel("a", "b", 100000, policy, 2),
+		symmetricTestChannel("source", "c", 100000, policy, 3),
+	}
+
+	ctx := newPathFindingTestContext(t, useCache, testChannels, "source")
+	defer ctx.cleanup()
+
+	newBlindedPayment := func(intro string, numHops int,
+		baseFee uint32) *blindedpath.BlindedPayment {
+
+		introKey := ctx.keyFromAlias(intro)
+		introPub, err := bronec.ParsePubKey(
+			introKey[:], bronec.S256(),
+		)
+		require.NoError(t, err)
+
+		hops := []*blindedpath.HopInfo{{
+			NodePub:   introPub,
+			PlainText: []byte{0},
+		}}
+		for i := 1; i < numHops; i++ {
+			privKey, err := bronec.NewPrivateKey(bronec.S256())
+			require.NoError(t, err)
+
+			hops = append(hops, &blindedpath.HopInfo{
+				NodePub:   privKey.PubKey(),
+				PlainText: []byte{byte(i)},
+			})
+		}
+
+		sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		path, err := blindedpath.BuildBlindedPath(sessionKey, hops)
+		require.NoError(t, err)
+
+		return &blindedpath.BlindedPayment{
+			BlindedPath:     path,
+			BaseFee:         baseFee,
+			CltvExpiryDelta: 100,
+			HtlcMaximum:     uint64(lnwire.NewMSatFromBroneess(100000)),
+		}
+	}
+
+	// The path through b is cheaper, even though it takes an extra hop to
+	// reach its introduction node.
+	payments := []*blindedpath.BlindedPayment{
+		newBlindedPayment("c", 3, 5000),
+		newBlindedPayment("b", 2, 1000),
+	}
+
+	targetKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+	target := route.NewVertex(targetKey.PubKey())
+
+	edges := make(map[route.Vertex][]*channeldb.CachedEdgePolicy)
+	require.NoError(t, BlindedPaymentsToEdges(payments, target, edges))
+
+	ctx.restrictParams.DestFeatures = tlvFeatures
+	paymentAmt := lnwire.NewMSatFromBroneess(100)
+	path, err := dbFindPath(
+		ctx.graph, edges, ctx.bandwidthHints, &ctx.restrictParams,
+		&ctx.pathFindingConfig, ctx.source, target, paymentAmt, 0,
+	)
+	require.NoError(t, err)
+	ctx.assertPath(path, []uint64{1, 2, 1})
+
+	payment, err := blindedPaymentForPath(path, payments)
+	require.NoError(t, err)
+	require.Equal(t, payments[1], payment)
+
+	rt, err := newRoute(
+		ctx.source, path, startingHeight, finalHopParams{
+			amt:            paymentAmt,
+			totalAmt:       paymentAmt,
+			cltvDelta:      finalHopCLTV,
+			blindedPayment: payment,
+		},
+	)
+	require.NoError(t, err)
+
+	// The route should lead to the introduction node in the clear, which
+	// receives the blinding point, followed by the blinded hop.
+	blindedPath := payment.BlindedPath
+	require.Len(t, rt.Hops, 3)
+	require.Equal(t, ctx.keyFromAlias("b"), rt.Hops[1].PubKeyBytes)
+	require.Equal(t, uint64(2), rt.Hops[1].ChannelID)
+	require.Equal(t, blindedPath.BlindingPoint, rt.Hops[1].BlindingPoint)
+	require.Equal(
+		t, blindedPath.BlindedHops[0].CipherText,
+		rt.Hops[1].EncryptedData,
+	)
+
+	finalHop := rt.Hops[2]
+	require.Equal(
+		t, route.NewVertex(blindedPath.BlindedHops[1].BlindedNodePub),
+		finalHop.PubKeyBytes,
+	)
+	require.Equal(
+		t, blindedPath.BlindedHops[1].CipherText,
+		finalHop.EncryptedData,
+	)
+	require.Nil(t, finalHop.BlindingPoint)
+	require.Nil(t, finalHop.MPP)
+	require.Equal(t, paymentAmt, finalHop.AmtToForward)
+	require.Equal(t, paymentAmt, finalHop.TotalAmtMsat)
+
+	// The fee and expiry delta of the blinded path are charged by the
+	// introduction node, on top of the fee of a.
+	require.Equal(t, lnwire.MilliBronees(1000), rt.HopFee(1))
+	require.Equal(t, lnwire.MilliBronees(2000), rt.TotalFees())
+	require.Equal(
+		t, uint32(startingHeight+finalHopCLTV+100+144),
+		rt.TotalTimeLock,
+	)
+
+	_, err = rt.ToSphinxPath()
+	require.NoError(t, err)
+}
+
 type pathFindingTestContext struct {
 	t                 *testing.T
 	graph             *channeldb.ChannelGraph
@@ -244,6 +244,20 @@ lifecycle:
 			uint32(currentState.numShardsInFlight),
 			uint32(p.currentHeight),
 		)
+		// If the maximum number of shards is in flight, we'll wait
+		// for one of them to be resolved before launching another.
+		if err == errShardLimitReached &&
+			currentState.numShardsInFlight > 0 {
+
+			log.Debugf("Payment %v has reached its shard limit, "+
+				"waiting for a shard to complete", p.identifier)
+
+			if err := shardHandler.waitForShard(); err != nil {
+				return [32]byte{}, nil, err
+			}
+			continue lifecycle
+		}
+
 		if err != nil {
 			log.Warnf("Failed to find route for payment %v: %v",
 				p.identifier, err)
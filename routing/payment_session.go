@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/brsuite/broln/build"
@@ -9,6 +10,7 @@ import (
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/bronlog"
+	"github.com/brsuite/bronutil"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -63,6 +65,11 @@ const (
 	// errMissingDependentFeature is returned when the destination node
 	// misses a feature that a feature that we require depends on.
 	errMissingDependentFeature
+
+	// errShardLimitReached is returned when the maximum number of shards
+	// is already in flight, so that no new shard can be launched before
+	// one of them is resolved.
+	errShardLimitReached
 )
 
 var (
@@ -70,6 +77,11 @@ var (
 	// further split the payment if no route is found. It is the minimum
 	// amount that we use as the shard size when splitting.
 	DefaultShardMinAmt = lnwire.NewMSatFromBroneess(10000)
+
+	// errBlindedPathWithHints is returned when a payment to blinded paths
+	// also specifies route hints to the destination.
+	errBlindedPathWithHints = errors.New("route hints cannot be used " +
+		"when paying to blinded paths")
 )
 
 // Error returns the string representation of the noRouteError
@@ -96,6 +108,9 @@ func (e noRouteError) Error() string {
 	case errMissingDependentFeature:
 		return "missing dependent feature"
 
+	case errShardLimitReached:
+		return "shard limit reached"
+
 	default:
 		return "unknown no-route error"
 	}
@@ -110,7 +125,8 @@ func (e noRouteError) FailureReason() channeldb.FailureReason {
 		errNoPathFound,
 		errEmptyPaySession,
 		errUnknownRequiredFeature,
-		errMissingDependentFeature:
+		errMissingDependentFeature,
+		errShardLimitReached:
 
 		return channeldb.FailureReasonNoRoute
 
@@ -180,11 +196,9 @@ type paymentSession struct {
 
 	missionControl MissionController
 
-	// minShardAmt is the amount beyond which we won't try to further split
-	// the payment if no route is found. If the maximum number of htlcs
-	// specified in the payment is one, under no circumstances splitting
-	// will happen and this value remains unused.
-	minShardAmt lnwire.MilliBronees
+	// planner decides how the amount and fee budget of the payment are
+	// spread across its shards.
+	planner shardPlanner
 
 	// log is a payment session-specific logger.
 	log bronlog.Logger
@@ -202,6 +216,19 @@ func newPaymentSession(p *LightningPayment,
 		return nil, err
 	}
 
+	// Payments to blinded paths reach the target over the virtual edges
+	// of the paths.
+	if len(p.BlindedPayments) > 0 {
+		if len(p.RouteHints) > 0 {
+			return nil, errBlindedPathWithHints
+		}
+
+		err := BlindedPaymentsToEdges(p.BlindedPayments, p.Target, edges)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	logPrefix := fmt.Sprintf("PaymentSession(%x):", p.Identifier())
 
 	return &paymentSession{
@@ -212,7 +239,7 @@ func newPaymentSession(p *LightningPayment,
 		getRoutingGraph:   getRoutingGraph,
 		pathFindingConfig: pathFindingConfig,
 		missionControl:    missionControl,
-		minShardAmt:       DefaultShardMinAmt,
+		planner:           newShardPlanner(p),
 		log:               build.NewPrefixLog(logPrefix, log),
 	}, nil
 }
@@ -233,6 +260,11 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 		return nil, errEmptyPaySession
 	}
 
+	// Don't launch more shards than may be in flight at the same time.
+	if p.planner.freeSlots(activeShards) == 0 {
+		return nil, errShardLimitReached
+	}
+
 	// Add BlockPadding to the finalCltvDelta so that the receiving node
 	// does not reject the HTLC if some blocks are mined while it's in-flight.
 	finalCltvDelta := p.payment.FinalCLTVDelta
@@ -249,18 +281,27 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 	// to our destination, respecting the recommendations from
 	// MissionControl.
 	restrictions := &RestrictParams{
-		ProbabilitySource:  p.missionControl.GetProbability,
-		FeeLimit:           feeLimit,
+		ProbabilitySource:  p.getProbability,
 		OutgoingChannelIDs: p.payment.OutgoingChannelIDs,
 		LastHop:            p.payment.LastHop,
+		IgnoredChannels:    p.payment.IgnoredChannels,
 		CltvLimit:          cltvLimit,
 		DestCustomRecords:  p.payment.DestCustomRecords,
 		DestFeatures:       p.payment.DestFeatures,
 		PaymentAddr:        p.payment.PaymentAddr,
+		TrampolineOnion:    p.payment.TrampolineOnion,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
 
+	// The remaining amount and fee budget are shared by the shards that
+	// are still to be sent. The planner makes sure that the shard we
+	// request now leaves enough of both for the shards that follow.
+	var (
+		remainingAmt = maxAmt
+		minAmt       = p.planner.minAmt(remainingAmt, activeShards)
+	)
+
 	// Before we enter the loop below, we'll make sure to respect the max
 	// payment shard size (if it's set), which is effectively our
 	// client-side MTU that we'll attempt to respect at all times.
@@ -273,6 +314,16 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 		maxAmt = *p.payment.MaxShardAmt
 	}
 
+	// splitErr is non-nil if the payment can't be split. If it can, we
+	// may cap the first shard by the liquidity of our local channels.
+	splitErr := p.splitAllowed()
+	checkLiquidity := splitErr == nil
+
+	// If the planner decided to split a shard for which a route was found,
+	// we keep that route to fall back to if no route for the smaller
+	// shard can be found.
+	var fallback *route.Route
+
 	for {
 		// Get a routing graph.
 		routingGraph, cleanup, err := p.getRoutingGraph()
@@ -288,13 +339,51 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 		// attempt, because concurrent payments may change balances.
 		bandwidthHints, err := p.getBandwidthHints(routingGraph)
 		if err != nil {
+			cleanup()
 			return nil, err
 		}
 
-		p.log.Debugf("pathfinding for amt=%v", maxAmt)
-
 		sourceVertex := routingGraph.sourceNode()
 
+		// No single shard can carry more than the largest balance of
+		// our local channels, so we don't need to try larger ones. If
+		// our total balance doesn't cover the remaining amount, there
+		// is no point in splitting either.
+		if checkLiquidity {
+			checkLiquidity = false
+
+			maxLocal, totalLocal, err := p.localBandwidth(
+				routingGraph, bandwidthHints,
+			)
+			if err != nil {
+				cleanup()
+				return nil, err
+			}
+
+			if totalLocal < remainingAmt {
+				cleanup()
+
+				p.log.Debug("not splitting because local " +
+					"balance is insufficient")
+
+				return nil, errInsufficientBalance
+			}
+
+			if maxLocal < maxAmt && maxLocal >= minAmt {
+				p.log.Debugf("Capping shard from %v to largest "+
+					"local balance %v", maxAmt, maxLocal)
+
+				maxAmt = maxLocal
+			}
+		}
+
+		restrictions.FeeLimit = p.planner.feeBudget(
+			maxAmt, remainingAmt, feeLimit,
+		)
+
+		p.log.Debugf("pathfinding for amt=%v, fee_limit=%v", maxAmt,
+			restrictions.FeeLimit)
+
 		// Find a route for the current amount.
 		path, err := p.pathFinder(
 			&graphParams{
@@ -307,59 +396,53 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 			maxAmt, finalHtlcExpiry,
 		)
 
+		// If we found a path, the planner may still decide to split
+		// the shard if half of it is considerably more likely to
+		// succeed over the same path.
+		var split bool
+		if err == nil && fallback == nil && splitErr == nil &&
+			maxAmt/2 >= minAmt {
+
+			split, err = p.shouldSplit(
+				routingGraph, sourceVertex, path, maxAmt,
+			)
+		}
+
 		// Close routing graph.
 		cleanup()
 
 		switch {
 		case err == errNoPathFound:
-			// Don't split if this is a legacy payment without mpp
-			// record.
-			if p.payment.PaymentAddr == nil {
-				p.log.Debugf("not splitting because payment " +
-					"address is unspecified")
+			// If we split a shard that we had found a route for,
+			// we'll fall back to that route.
+			if fallback != nil {
+				p.log.Debugf("Falling back to route for "+
+					"amt=%v", fallback.ReceiverAmt())
 
-				return nil, errNoPathFound
+				return fallback, nil
 			}
 
-			if p.payment.DestFeatures == nil {
-				p.log.Debug("Not splitting because " +
-					"destination DestFeatures is nil")
-				return nil, errNoPathFound
-			}
-
-			destFeatures := p.payment.DestFeatures
-			if !destFeatures.HasFeature(lnwire.MPPOptional) &&
-				!destFeatures.HasFeature(lnwire.AMPOptional) {
-
-				p.log.Debug("not splitting because " +
-					"destination doesn't declare MPP or AMP")
+			if splitErr != nil {
+				p.log.Debugf("not splitting because %v",
+					splitErr)
 
 				return nil, errNoPathFound
 			}
 
-			// No splitting if this is the last shard.
-			isLastShard := activeShards+1 >= p.payment.MaxParts
-			if isLastShard {
+			// This is where the magic happens. If we can't find a
+			// route, try it for half the amount, as long as the
+			// remaining amount still fits into the shard slots
+			// that are left.
+			if maxAmt/2 < minAmt {
 				p.log.Debugf("not splitting because shard "+
-					"limit %v has been reached",
-					p.payment.MaxParts)
+					"amount %v would drop below %v",
+					maxAmt/2, minAmt)
 
 				return nil, errNoPathFound
 			}
 
-			// This is where the magic happens. If we can't find a
-			// route, try it for half the amount.
 			maxAmt /= 2
 
-			// Put a lower bound on the minimum shard size.
-			if maxAmt < p.minShardAmt {
-				p.log.Debugf("not splitting because minimum "+
-					"shard amount %v has been reached",
-					p.minShardAmt)
-
-				return nil, errNoPathFound
-			}
-
 			// Go pathfinding.
 			continue
 
@@ -377,25 +460,138 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliBronees,
 			return nil, err
 		}
 
-		// With the next candidate path found, we'll attempt to turn
-		// this into a route by applying the time-lock and fee
-		// requirements.
-		route, err := newRoute(
-			sourceVertex, path, height,
-			finalHopParams{
-				amt:         maxAmt,
-				totalAmt:    p.payment.Amount,
-				cltvDelta:   finalCltvDelta,
-				records:     p.payment.DestCustomRecords,
-				paymentAddr: p.payment.PaymentAddr,
-			},
+		route, err := p.newRoute(
+			sourceVertex, path, height, maxAmt, finalCltvDelta,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		return route, err
+		if !split {
+			return route, nil
+		}
+
+		p.log.Debugf("Splitting shard of amt=%v for a higher success "+
+			"probability", maxAmt)
+
+		fallback = route
+		maxAmt /= 2
+	}
+}
+
+// newRoute turns the path found for a shard of the given amount into a route
+// by applying the time-lock and fee requirements.
+func (p *paymentSession) newRoute(source route.Vertex,
+	path []*channeldb.CachedEdgePolicy, height uint32,
+	amt lnwire.MilliBronees, finalCltvDelta uint16) (*route.Route, error) {
+
+	blindedPayment, err := blindedPaymentForPath(
+		path, p.payment.BlindedPayments,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return newRoute(
+		source, path, height,
+		finalHopParams{
+			amt:            amt,
+			totalAmt:       p.payment.Amount,
+			cltvDelta:      finalCltvDelta,
+			records:        p.payment.DestCustomRecords,
+			paymentAddr:    p.payment.PaymentAddr,
+			blindedPayment: blindedPayment,
+
+			trampolineOnion: p.payment.TrampolineOnion,
+		},
+	)
+}
+
+// splitAllowed returns an error describing why the payment can't be split
+// into multiple shards, or nil if it can.
+func (p *paymentSession) splitAllowed() error {
+	// Don't split if this is a legacy payment without mpp record.
+	// Payments to blinded paths convey the total amount through the
+	// blinded payload instead.
+	isBlinded := len(p.payment.BlindedPayments) > 0
+	if p.payment.PaymentAddr == nil && !isBlinded {
+		return errors.New("payment address is unspecified")
+	}
+
+	if p.payment.DestFeatures == nil {
+		return errors.New("destination DestFeatures is nil")
+	}
+
+	destFeatures := p.payment.DestFeatures
+	if !destFeatures.HasFeature(lnwire.MPPOptional) &&
+		!destFeatures.HasFeature(lnwire.AMPOptional) {
+
+		return errors.New("destination doesn't declare MPP or AMP")
+	}
+
+	return nil
+}
+
+// localBandwidth returns the largest and the total balance of the local
+// channels that the payment may use.
+func (p *paymentSession) localBandwidth(g routingGraph,
+	bandwidthHints bandwidthHints) (lnwire.MilliBronees,
+	lnwire.MilliBronees, error) {
+
+	var outgoingChanMap map[uint64]struct{}
+	if len(p.payment.OutgoingChannelIDs) > 0 {
+		outgoingChanMap = make(map[uint64]struct{})
+		for _, outChan := range p.payment.OutgoingChannelIDs {
+			outgoingChanMap[outChan] = struct{}{}
+		}
+	}
+
+	return getOutgoingBalance(
+		g.sourceNode(), outgoingChanMap, bandwidthHints, g,
+	)
+}
+
+// getProbability returns the success probability that mission control
+// estimates for forwarding over the pair, or zero if the payment may not be
+// forwarded through the sending node or over the pair.
+func (p *paymentSession) getProbability(fromNode, toNode route.Vertex,
+	amt lnwire.MilliBronees, capacity bronutil.Amount) float64 {
+
+	if _, ok := p.payment.IgnoredNodes[fromNode]; ok {
+		return 0
+	}
+
+	pair := NewDirectedNodePair(fromNode, toNode)
+	if _, ok := p.payment.IgnoredPairs[pair]; ok {
+		return 0
 	}
+
+	return p.missionControl.GetProbability(
+		fromNode, toNode, amt, capacity,
+	)
+}
+
+// shouldSplit returns true if the planner prefers to send half of the given
+// amount over the path, because it is considerably more likely to succeed.
+func (p *paymentSession) shouldSplit(g routingGraph, source route.Vertex,
+	path []*channeldb.CachedEdgePolicy, amt lnwire.MilliBronees) (bool,
+	error) {
+
+	fullProbability, err := pathProbability(
+		g, source, path, amt, p.getProbability,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	halfProbability, err := pathProbability(
+		g, source, path, amt/2, p.getProbability,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return p.planner.shouldSplit(fullProbability, halfProbability), nil
 }
 
 // UpdateAdditionalEdge updates the channel edge policy for a private edge. It
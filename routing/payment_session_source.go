@@ -1,8 +1,11 @@
 package routing
 
 import (
+	"fmt"
+
 	"github.com/brsuite/broln/channeldb"
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/broln/zpay32"
 	"github.com/brsuite/brond/bronec"
@@ -148,3 +151,67 @@ func RouteHintsToEdges(routeHints [][]zpay32.HopHint, target route.Vertex) (
 
 	return edges, nil
 }
+
+// BlindedPaymentsToEdges adds an edge for each of the passed blinded payment
+// paths to the edge map. Each edge leads from the introduction node of its
+// path to the target and carries the aggregated policy of the path. As the
+// blinded hops themselves can't be routed to, the channel ID of the edge is
+// set to the index of its path, which allows route construction to expand the
+// edge into the hops of the path.
+func BlindedPaymentsToEdges(payments []*blindedpath.BlindedPayment,
+	target route.Vertex,
+	edges map[route.Vertex][]*channeldb.CachedEdgePolicy) error {
+
+	for i, payment := range payments {
+		path := payment.BlindedPath
+		if path == nil || len(path.BlindedHops) == 0 {
+			return blindedpath.ErrNoHops
+		}
+
+		edge := &channeldb.CachedEdgePolicy{
+			ToNodePubKey: func() route.Vertex {
+				return target
+			},
+			ToNodeFeatures: lnwire.EmptyFeatureVector(),
+			ChannelID:      uint64(i),
+			FeeBaseMSat:    lnwire.MilliBronees(payment.BaseFee),
+			FeeProportionalMillionths: lnwire.MilliBronees(
+				payment.ProportionalFeeRate,
+			),
+			TimeLockDelta: payment.CltvExpiryDelta,
+			MinHTLC:       lnwire.MilliBronees(payment.HtlcMinimum),
+		}
+
+		// A zero maximum signals that the path doesn't restrict the
+		// amount of HTLCs sent over it.
+		if payment.HtlcMaximum != 0 {
+			edge.MessageFlags = lnwire.ChanUpdateOptionMaxHtlc
+			edge.MaxHTLC = lnwire.MilliBronees(payment.HtlcMaximum)
+		}
+
+		v := route.NewVertex(path.IntroductionPoint)
+		edges[v] = append(edges[v], edge)
+	}
+
+	return nil
+}
+
+// blindedPaymentForPath returns the blinded payment path that the final edge
+// of the passed path was created for by BlindedPaymentsToEdges. If no blinded
+// payment paths are provided, nil is returned.
+func blindedPaymentForPath(path []*channeldb.CachedEdgePolicy,
+	payments []*blindedpath.BlindedPayment) (*blindedpath.BlindedPayment,
+	error) {
+
+	if len(payments) == 0 {
+		return nil, nil
+	}
+
+	idx := path[len(path)-1].ChannelID
+	if idx >= uint64(len(payments)) {
+		return nil, fmt.Errorf("final channel %v of path is not a "+
+			"blinded path", idx)
+	}
+
+	return payments[idx], nil
+}
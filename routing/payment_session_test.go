@@ -257,3 +257,195 @@ type sessionGraph struct {
 func (g *sessionGraph) sourceNode() route.Vertex {
 	return route.Vertex{}
 }
+
+// TestRequestRouteExclusions asserts that the nodes, node pairs and channel
+// directions that the payment may not use are passed on to path finding.
+func TestRequestRouteExclusions(t *testing.T) {
+	var (
+		ignoredNode = route.Vertex{1}
+		ignoredPair = NewDirectedNodePair(
+			route.Vertex{2}, route.Vertex{3},
+		)
+		ignoredChan = EdgeLocator{ChannelID: 5, Direction: 1}
+	)
+
+	payment := &LightningPayment{
+		CltvLimit:      30,
+		FinalCLTVDelta: 8,
+		Amount:         1000,
+		FeeLimit:       1000,
+		IgnoredNodes: map[route.Vertex]struct{}{
+			ignoredNode: {},
+		},
+		IgnoredPairs: map[DirectedNodePair]struct{}{
+			ignoredPair: {},
+		},
+		IgnoredChannels: map[EdgeLocator]struct{}{
+			ignoredChan: {},
+		},
+	}
+	require.NoError(t, payment.SetPaymentHash([32]byte{}))
+
+	session, err := newPaymentSession(
+		payment,
+		func(routingGraph) (bandwidthHints, error) {
+			return &mockBandwidthHints{}, nil
+		},
+		func() (routingGraph, func(), error) {
+			return &sessionGraph{}, func() {}, nil
+		},
+		&MissionControl{}, PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	session.pathFinder = func(
+		g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+		source, target route.Vertex, amt lnwire.MilliBronees,
+		finalHtlcExpiry int32) ([]*channeldb.CachedEdgePolicy, error) {
+
+		require.Equal(t, payment.IgnoredChannels, r.IgnoredChannels)
+
+		require.Zero(t, r.ProbabilitySource(
+			ignoredNode, route.Vertex{9}, amt, 0,
+		))
+		require.Zero(t, r.ProbabilitySource(
+			ignoredPair.From, ignoredPair.To, amt, 0,
+		))
+
+		return nil, errNoPathFound
+	}
+
+	_, err = session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 0, 10,
+	)
+	require.Equal(t, errNoPathFound, err)
+}
+
+// TestRequestRouteShardPlanner asserts that the payment session caps shards
+// by the largest local balance, gives them a proportional share of the fee
+// budget and doesn't launch more shards than may be in flight at once.
+func TestRequestRouteShardPlanner(t *testing.T) {
+	const (
+		height = 10
+
+		chanID1 = 1
+		chanID2 = 2
+	)
+
+	var paymentAddr [32]byte
+	payment := &LightningPayment{
+		CltvLimit:      100,
+		FinalCLTVDelta: 8,
+		Amount:         100_000,
+		FeeLimit:       1_000,
+		PaymentAddr:    &paymentAddr,
+		DestFeatures: lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+			), lnwire.Features,
+		),
+		MaxParts:          4,
+		MaxParallelShards: 2,
+	}
+	require.NoError(t, payment.SetPaymentHash([32]byte{}))
+
+	graph := &channelGraph{
+		channels: []*channeldb.DirectedChannel{
+			{ChannelID: chanID1, OutPolicySet: true},
+			{ChannelID: chanID2, OutPolicySet: true},
+		},
+	}
+	bandwidth := &mockBandwidthHints{
+		hints: map[uint64]lnwire.MilliBronees{
+			chanID1: 60_000,
+			chanID2: 50_000,
+		},
+	}
+
+	session, err := newPaymentSession(
+		payment,
+		func(routingGraph) (bandwidthHints, error) {
+			return bandwidth, nil
+		},
+		func() (routingGraph, func(), error) {
+			return graph, func() {}, nil
+		},
+		&MissionControl{}, PathFindingConfig{},
+	)
+	require.NoError(t, err)
+
+	session.planner.minShardAmt = 1_000
+
+	// Record the amount and fee limit of every pathfinding attempt, and
+	// only find paths for shards of up to 40k msat.
+	type attempt struct {
+		amt, feeLimit lnwire.MilliBronees
+	}
+	var attempts []attempt
+	session.pathFinder = func(
+		g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
+		source, target route.Vertex, amt lnwire.MilliBronees,
+		finalHtlcExpiry int32) ([]*channeldb.CachedEdgePolicy, error) {
+
+		attempts = append(attempts, attempt{amt, r.FeeLimit})
+		if amt > 40_000 {
+			return nil, errNoPathFound
+		}
+
+		return []*channeldb.CachedEdgePolicy{{
+			ToNodePubKey: func() route.Vertex {
+				return route.Vertex{}
+			},
+			ToNodeFeatures: payment.DestFeatures,
+		}}, nil
+	}
+
+	// With two parallel shards, the first shard must carry at least half
+	// of the amount. It starts at the largest local balance, and can't be
+	// split below half of the amount.
+	_, err = session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 0, height,
+	)
+	require.Equal(t, errNoPathFound, err)
+	require.Equal(t, []attempt{{60_000, 600}}, attempts)
+
+	// With four parallel shards, the shard is split once to an amount for
+	// which a path exists, and receives its share of the fee budget.
+	attempts = nil
+	session.planner.maxParallelShards = 4
+
+	rt, err := session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 0, height,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 30_000, rt.ReceiverAmt())
+	require.Equal(t, []attempt{{60_000, 600}, {30_000, 300}}, attempts)
+
+	// Once the maximum number of shards is in flight, no new shard is
+	// launched.
+	_, err = session.RequestRoute(
+		payment.Amount, payment.FeeLimit, 4, height,
+	)
+	require.Equal(t, errShardLimitReached, err)
+}
+
+// channelGraph is a session graph whose source node has the given channels.
+type channelGraph struct {
+	sessionGraph
+
+	channels []*channeldb.DirectedChannel
+}
+
+func (g *channelGraph) forEachNodeChannel(_ route.Vertex,
+	cb func(*channeldb.DirectedChannel) error) error {
+
+	for _, channel := range g.channels {
+		if err := cb(channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
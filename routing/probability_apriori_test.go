@@ -6,6 +6,8 @@ import (
 
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -27,7 +29,7 @@ const (
 
 type estimatorTestContext struct {
 	t         *testing.T
-	estimator *probabilityEstimator
+	estimator *AprioriEstimator
 
 	// results contains a list of last results. Every element in the list
 	// corresponds to the last result towards a node. The list index equals
@@ -39,8 +41,8 @@ type estimatorTestContext struct {
 func newEstimatorTestContext(t *testing.T) *estimatorTestContext {
 	return &estimatorTestContext{
 		t: t,
-		estimator: &probabilityEstimator{
-			ProbabilityEstimatorCfg: ProbabilityEstimatorCfg{
+		estimator: &AprioriEstimator{
+			AprioriConfig: AprioriConfig{
 				AprioriHopProbability: aprioriHopProb,
 				AprioriWeight:         aprioriWeight,
 				PenaltyHalfLife:       time.Hour,
@@ -64,7 +66,9 @@ func (c *estimatorTestContext) assertPairProbability(now time.Time,
 
 	const tolerance = 0.01
 
-	p := c.estimator.getPairProbability(now, results, route.Vertex{toNode}, amt)
+	p := c.estimator.PairProbability(
+		now, results, route.Vertex{toNode}, amt, testCapacity,
+	)
 	diff := p - expectedProb
 	if diff > tolerance || diff < -tolerance {
 		c.t.Fatalf("expected probability %v for node %v, but got %v",
@@ -161,3 +165,85 @@ func TestProbabilityEstimatorMix(t *testing.T) {
 	// the node probability = 0.47.
 	ctx.assertPairProbability(testTime, node2, 100, expectedNodeProb*0.75)
 }
+
+// TestCapacityFactor tests the reduction of the success probability depending
+// on the amount compared to the channel capacity.
+func TestCapacityFactor(t *testing.T) {
+	t.Parallel()
+
+	const capacity = bronutil.Amount(100_000)
+
+	testCases := []struct {
+		name             string
+		amount           lnwire.MilliBronees
+		capacity         bronutil.Amount
+		capacityFraction float64
+		expectedFactor   float64
+	}{
+		{
+			name:             "unknown capacity",
+			amount:           lnwire.NewMSatFromBroneess(1_000_000),
+			capacityFraction: DefaultCapacityFraction,
+			expectedFactor:   1,
+		},
+		{
+			name:             "disabled",
+			amount:           lnwire.NewMSatFromBroneess(capacity),
+			capacity:         capacity,
+			capacityFraction: 0,
+			expectedFactor:   1,
+		},
+		{
+			name:             "zero amount",
+			amount:           0,
+			capacity:         capacity,
+			capacityFraction: DefaultCapacityFraction,
+			expectedFactor:   0.9997,
+		},
+		{
+			name:             "cutoff amount",
+			amount:           lnwire.NewMSatFromBroneess(75_000),
+			capacity:         capacity,
+			capacityFraction: DefaultCapacityFraction,
+			expectedFactor:   0.75,
+		},
+		{
+			name:             "full capacity",
+			amount:           lnwire.NewMSatFromBroneess(capacity),
+			capacity:         capacity,
+			capacityFraction: DefaultCapacityFraction,
+			expectedFactor:   0.5379,
+		},
+		{
+			name:             "amount exceeds capacity",
+			amount:           lnwire.NewMSatFromBroneess(capacity) + 1,
+			capacity:         capacity,
+			capacityFraction: DefaultCapacityFraction,
+			expectedFactor:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			factor := capacityFactor(
+				tc.amount, tc.capacity, tc.capacityFraction,
+			)
+			require.InDelta(t, tc.expectedFactor, factor, 1e-4)
+		})
+	}
+
+	// The factor must decrease monotonically with the amount.
+	prev := 1.0
+	for amt := bronutil.Amount(0); amt <= capacity; amt += 1_000 {
+		factor := capacityFactor(
+			lnwire.NewMSatFromBroneess(amt), capacity,
+			DefaultCapacityFraction,
+		)
+		require.LessOrEqual(t, factor, prev)
+		prev = factor
+	}
+}
@@ -0,0 +1,462 @@
+package routing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// bimodalTolerance is the tolerance we allow for probabilities
+	// calculated by the bimodal estimator.
+	bimodalTolerance = 1e-6
+
+	// bimodalDecayTime is the decay time used for the bimodal estimator in
+	// tests.
+	bimodalDecayTime = time.Hour
+)
+
+// newTestBimodalEstimator returns a bimodal estimator with the given scale and
+// node weight.
+func newTestBimodalEstimator(t *testing.T, scale lnwire.MilliBronees,
+	nodeWeight float64) *BimodalEstimator {
+
+	estimator, err := NewBimodalEstimator(BimodalConfig{
+		BimodalScaleMsat:  scale,
+		BimodalNodeWeight: nodeWeight,
+		BimodalDecayTime:  bimodalDecayTime,
+	})
+	require.NoError(t, err)
+
+	return estimator
+}
+
+// TestBimodalProbabilityFormula tests the direct channel probability formula
+// for different liquidity distributions and prior knowledge.
+func TestBimodalProbabilityFormula(t *testing.T) {
+	t.Parallel()
+
+	const (
+		capacity = lnwire.MilliBronees(1_000_000)
+
+		// unbalancedScale is much smaller than the capacity, which
+		// puts the liquidity at the ends of the channel.
+		unbalancedScale = lnwire.MilliBronees(1_000)
+
+		// uniformScale is much larger than the capacity, which
+		// distributes the liquidity uniformly in the channel.
+		uniformScale = lnwire.MilliBronees(1_000_000_000_000)
+	)
+
+	tests := []struct {
+		name          string
+		scale         lnwire.MilliBronees
+		capacity      lnwire.MilliBronees
+		successAmount lnwire.MilliBronees
+		failAmount    lnwire.MilliBronees
+		amount        lnwire.MilliBronees
+		expectedProb  float64
+	}{
+		{
+			name:         "zero capacity",
+			scale:        unbalancedScale,
+			capacity:     0,
+			amount:       capacity,
+			expectedProb: 1.0,
+		},
+		{
+			name:         "amount exceeds capacity",
+			scale:        unbalancedScale,
+			capacity:     capacity,
+			failAmount:   capacity,
+			amount:       capacity + 1,
+			expectedProb: 0.0,
+		},
+		{
+			name:         "amount exceeds fail amount",
+			scale:        unbalancedScale,
+			capacity:     capacity,
+			failAmount:   capacity / 2,
+			amount:       capacity / 2,
+			expectedProb: 0.0,
+		},
+		{
+			name:          "fail amount below success amount",
+			scale:         unbalancedScale,
+			capacity:      capacity,
+			successAmount: capacity / 2,
+			failAmount:    capacity / 4,
+			amount:        capacity / 8,
+			expectedProb:  0.0,
+		},
+		{
+			name:          "amount below success amount",
+			scale:         unbalancedScale,
+			capacity:      capacity,
+			successAmount: capacity / 2,
+			failAmount:    capacity,
+			amount:        capacity / 4,
+			expectedProb:  1.0,
+		},
+		{
+			// The distribution is symmetric, so half of the
+			// capacity is sendable with a probability of 0.5.
+			name:         "unbalanced, half capacity",
+			scale:        unbalancedScale,
+			capacity:     capacity,
+			failAmount:   capacity,
+			amount:       capacity / 2,
+			expectedProb: 0.5,
+		},
+		{
+			// Liquidity is either at our side or at the remote
+			// side, so the amount doesn't matter much as long as it
+			// is well above the scale.
+			name:         "unbalanced, small amount",
+			scale:        unbalancedScale,
+			capacity:     capacity,
+			failAmount:   capacity,
+			amount:       capacity / 10,
+			expectedProb: 0.5,
+		},
+		{
+			// A previous success tells us that the liquidity is at
+			// our side of the channel.
+			name:          "unbalanced, previous success",
+			scale:         unbalancedScale,
+			capacity:      capacity,
+			successAmount: capacity / 10,
+			failAmount:    capacity,
+			amount:        capacity / 5,
+			expectedProb:  1.0,
+		},
+		{
+			// A previous failure tells us that the liquidity is at
+			// the remote side of the channel.
+			name:         "unbalanced, previous failure",
+			scale:        unbalancedScale,
+			capacity:     capacity,
+			failAmount:   capacity / 2,
+			amount:       capacity / 5,
+			expectedProb: 0.0,
+		},
+		{
+			name:         "uniform, no info",
+			scale:        uniformScale,
+			capacity:     capacity,
+			failAmount:   capacity,
+			amount:       capacity / 4,
+			expectedProb: 0.75,
+		},
+		{
+			name:          "uniform, success and failure",
+			scale:         uniformScale,
+			capacity:      capacity,
+			successAmount: 200_000,
+			failAmount:    800_000,
+			amount:        500_000,
+			expectedProb:  0.5,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			estimator := newTestBimodalEstimator(t, test.scale, 0)
+
+			p, err := estimator.probabilityFormula(
+				test.capacity, test.successAmount,
+				test.failAmount, test.amount,
+			)
+			require.NoError(t, err)
+			require.InDelta(t, test.expectedProb, p, 1e-3)
+		})
+	}
+}
+
+// TestBimodalTimeDecay tests that previous successes and failures are
+// forgotten over time.
+func TestBimodalTimeDecay(t *testing.T) {
+	t.Parallel()
+
+	const (
+		capacity = lnwire.MilliBronees(1_000_000)
+		amount   = lnwire.MilliBronees(400_000)
+	)
+
+	// A fresh success is fully taken into account, after one decay time
+	// only a fraction of 1/e is left.
+	require.Equal(t, amount, canSend(
+		amount, testTime, testTime, bimodalDecayTime,
+	))
+	require.InDelta(t, float64(amount)/math.E, float64(canSend(
+		amount, testTime.Add(bimodalDecayTime), testTime,
+		bimodalDecayTime,
+	)), 1)
+
+	// A fresh failure is fully taken into account, after a long time the
+	// fail amount approaches the capacity.
+	require.Equal(t, amount, cannotSend(
+		amount, capacity, testTime, testTime, bimodalDecayTime,
+	))
+	require.InDelta(t, float64(capacity), float64(cannotSend(
+		amount, capacity, testTime.Add(100*bimodalDecayTime),
+		testTime, bimodalDecayTime,
+	)), 1)
+
+	// Fail amounts larger than the capacity are capped.
+	require.Equal(t, capacity, cannotSend(
+		2*capacity, capacity, testTime, testTime, bimodalDecayTime,
+	))
+
+	// A failure that happened a long time ago is forgotten, such that we
+	// get back to the a priori probability of the channel.
+	estimator := newTestBimodalEstimator(t, 1_000, 0)
+	results := NodeResults{
+		route.Vertex{node1}: {
+			FailTime: testTime,
+			FailAmt:  amount,
+		},
+	}
+	capacitySat := bronutil.Amount(capacity / 1000)
+
+	p := estimator.PairProbability(
+		testTime, results, route.Vertex{node1}, amount/2, capacitySat,
+	)
+	require.InDelta(t, 0.0, p, bimodalTolerance)
+
+	p = estimator.PairProbability(
+		testTime.Add(100*bimodalDecayTime), results,
+		route.Vertex{node1}, amount/2, capacitySat,
+	)
+	require.InDelta(t, 0.5, p, bimodalTolerance)
+}
+
+// TestBimodalNodeWeight tests that results of other channels of the sending
+// node are mixed into the pair probability.
+func TestBimodalNodeWeight(t *testing.T) {
+	t.Parallel()
+
+	const (
+		capacity   = bronutil.Amount(1_000)
+		amount     = lnwire.MilliBronees(500_000)
+		nodeWeight = 0.2
+
+		// directProb is the probability of sending half of the
+		// capacity without any direct information.
+		directProb = 0.5
+	)
+
+	// Results recorded at testTime lie two decay times in the past, so
+	// they are not considered recent anymore.
+	now := testTime.Add(2 * bimodalDecayTime)
+	decay := math.Exp(-2)
+
+	tests := []struct {
+		name         string
+		nodeWeight   float64
+		results      NodeResults
+		expectedProb float64
+	}{
+		{
+			name:         "no results",
+			nodeWeight:   nodeWeight,
+			expectedProb: directProb,
+		},
+		{
+			name:       "zero node weight",
+			nodeWeight: 0,
+			results: NodeResults{
+				route.Vertex{node2}: {
+					FailTime: now,
+					FailAmt:  1,
+				},
+			},
+			expectedProb: directProb,
+		},
+		{
+			name:       "recent failures on other channels",
+			nodeWeight: nodeWeight,
+			results: NodeResults{
+				route.Vertex{node2}: {
+					FailTime: now,
+					FailAmt:  1,
+				},
+				route.Vertex{node3}: {
+					FailTime: now,
+					FailAmt:  1,
+				},
+			},
+			expectedProb: directProb / (1 + 2*nodeWeight),
+		},
+		{
+			name:       "recent successes on other channels",
+			nodeWeight: nodeWeight,
+			results: NodeResults{
+				route.Vertex{node2}: {
+					SuccessTime: now,
+					SuccessAmt:  1,
+				},
+				route.Vertex{node3}: {
+					SuccessTime: now,
+					SuccessAmt:  1,
+				},
+			},
+			expectedProb: (directProb + 2*nodeWeight) /
+				(1 + 2*nodeWeight),
+		},
+		{
+			name:       "old failure on other channel",
+			nodeWeight: nodeWeight,
+			results: NodeResults{
+				route.Vertex{node2}: {
+					FailTime: testTime,
+					FailAmt:  1,
+				},
+			},
+			expectedProb: directProb / (1 + decay*nodeWeight),
+		},
+		{
+			// Recent direct information takes precedence over the
+			// results of other channels.
+			name:       "recent direct result",
+			nodeWeight: nodeWeight,
+			results: NodeResults{
+				route.Vertex{node1}: {
+					SuccessTime: now,
+					SuccessAmt:  1,
+				},
+				route.Vertex{node2}: {
+					FailTime: now,
+					FailAmt:  1,
+				},
+			},
+			expectedProb: directProb,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			estimator := newTestBimodalEstimator(
+				t, 1_000, test.nodeWeight,
+			)
+
+			p := estimator.PairProbability(
+				now, test.results, route.Vertex{node1}, amount,
+				capacity,
+			)
+			require.InDelta(t, test.expectedProb, p, 1e-3)
+		})
+	}
+}
+
+// TestBimodalLocalPairProbability tests the probability estimation for our own
+// channels.
+func TestBimodalLocalPairProbability(t *testing.T) {
+	t.Parallel()
+
+	estimator := newTestBimodalEstimator(t, 1_000, DefaultBimodalNodeWeight)
+
+	// Without any failures we assume to be able to send.
+	p := estimator.LocalPairProbability(
+		testTime, NodeResults{}, route.Vertex{node1},
+	)
+	require.Equal(t, 1.0, p)
+
+	// A fresh failure reduces the probability to zero, which then recovers
+	// over time.
+	results := NodeResults{
+		route.Vertex{node1}: {
+			FailTime: testTime,
+			FailAmt:  1,
+		},
+	}
+	p = estimator.LocalPairProbability(testTime, results, route.Vertex{node1})
+	require.InDelta(t, 0.0, p, bimodalTolerance)
+
+	p = estimator.LocalPairProbability(
+		testTime.Add(bimodalDecayTime), results, route.Vertex{node1},
+	)
+	require.InDelta(t, 1-1/math.E, p, bimodalTolerance)
+
+	// Failures of other nodes don't influence the local probability.
+	p = estimator.LocalPairProbability(testTime, results, route.Vertex{node2})
+	require.Equal(t, 1.0, p)
+}
+
+// TestBimodalConfigValidation tests that invalid configurations are rejected.
+func TestBimodalConfigValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		modify      func(cfg *BimodalConfig)
+		expectedErr error
+	}{
+		{
+			name:   "default config",
+			modify: func(cfg *BimodalConfig) {},
+		},
+		{
+			name: "zero scale",
+			modify: func(cfg *BimodalConfig) {
+				cfg.BimodalScaleMsat = 0
+			},
+			expectedErr: ErrInvalidScale,
+		},
+		{
+			name: "scale exceeds supply",
+			modify: func(cfg *BimodalConfig) {
+				cfg.BimodalScaleMsat = BimodalScaleMsatMax + 1
+			},
+			expectedErr: ErrInvalidScale,
+		},
+		{
+			name: "negative node weight",
+			modify: func(cfg *BimodalConfig) {
+				cfg.BimodalNodeWeight = -0.1
+			},
+			expectedErr: ErrInvalidNodeWeight,
+		},
+		{
+			name: "node weight above one",
+			modify: func(cfg *BimodalConfig) {
+				cfg.BimodalNodeWeight = 1.1
+			},
+			expectedErr: ErrInvalidNodeWeight,
+		},
+		{
+			name: "zero decay time",
+			modify: func(cfg *BimodalConfig) {
+				cfg.BimodalDecayTime = 0
+			},
+			expectedErr: ErrInvalidDecayTime,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultBimodalConfig()
+			test.modify(&cfg)
+
+			_, err := NewBimodalEstimator(cfg)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,569 @@
+// Package prober sends probe payments to a set of destinations to discover the
+// liquidity of the channels on the way. Probes carry a random payment hash, so
+// that they can never be settled, and are failed by the destination once they
+// reach it. The router reports the outcome of every probe htlc to mission
+// control, which keeps its view of the network fresh for real payments.
+package prober
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/brsuite/broln/autopilot"
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/route"
+)
+
+const (
+	// DefaultInterval is the default time between two probes.
+	DefaultInterval = time.Minute
+
+	// DefaultAmount is the default amount of a probe.
+	DefaultAmount = lnwire.MilliBronees(100_000_000)
+
+	// DefaultMaxInFlight is the default maximum total amount of the probes
+	// that are in flight at the same time.
+	DefaultMaxInFlight = lnwire.MilliBronees(1_000_000_000)
+
+	// DefaultTimeout is the default time after which no new attempts are
+	// made for a probe.
+	DefaultTimeout = time.Minute
+
+	// DefaultFeeLimitPPM is the default fee limit of a probe in parts per
+	// million of its amount. Probes never pay fees, but the limit keeps
+	// them on routes that real payments would take.
+	DefaultFeeLimitPPM = 10_000
+
+	// DefaultNumCentralNodes is the default number of nodes with the
+	// highest betweenness centrality that are probed if no destinations
+	// are configured.
+	DefaultNumCentralNodes = 20
+
+	// centralNodesRefresh is the interval at which the most central nodes
+	// are recalculated.
+	centralNodesRefresh = 24 * time.Hour
+
+	// maxRecentResults is the number of probe results that are kept for
+	// status queries.
+	maxRecentResults = 50
+)
+
+var (
+	// ErrInvalidInterval is returned if the probe interval isn't
+	// positive.
+	ErrInvalidInterval = errors.New("probe interval must be positive")
+
+	// ErrInvalidAmount is returned if the probe amount isn't positive.
+	ErrInvalidAmount = errors.New("probe amount must be positive")
+
+	// ErrInvalidMaxInFlight is returned if the maximum amount in flight
+	// doesn't allow for a single probe.
+	ErrInvalidMaxInFlight = errors.New("maximum amount in flight must be " +
+		"at least the probe amount")
+)
+
+// Budget limits the rate and the amount of the probes that are sent.
+type Budget struct {
+	// Interval is the time between two probes.
+	Interval time.Duration
+
+	// Amount is the amount of a single probe.
+	Amount lnwire.MilliBronees
+
+	// MaxInFlight is the maximum total amount of the probes that are in
+	// flight at the same time. Probe htlcs lock up liquidity in our and
+	// other channels until they are failed back, so no new probes are
+	// sent while this amount is reached.
+	MaxInFlight lnwire.MilliBronees
+}
+
+// validate checks that the budget allows sending probes.
+func (b *Budget) validate() error {
+	if b.Interval <= 0 {
+		return ErrInvalidInterval
+	}
+
+	if b.Amount <= 0 {
+		return ErrInvalidAmount
+	}
+
+	if b.MaxInFlight < b.Amount {
+		return ErrInvalidMaxInFlight
+	}
+
+	return nil
+}
+
+// Config contains the dependencies of the Prober.
+type Config struct {
+	// SendPayment sends the passed payment and blocks until it either
+	// succeeded or failed.
+	SendPayment func(*routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// DeletePayment removes a completed probe payment from the database.
+	DeletePayment func(lntypes.Hash) error
+
+	// CentralNodes returns the n nodes of the graph with the highest
+	// betweenness centrality. It is used if no destinations are
+	// configured.
+	CentralNodes func(n int) ([]route.Vertex, error)
+
+	// Destinations is the list of nodes that are probed. If it is empty,
+	// the most central nodes of the graph are probed instead.
+	Destinations []route.Vertex
+
+	// NumCentralNodes is the number of central nodes that are probed if
+	// no destinations are configured.
+	NumCentralNodes int
+
+	// Timeout is the time after which no new attempts are made for a
+	// probe.
+	Timeout time.Duration
+
+	// FeeLimitPPM is the fee limit of a probe in parts per million of its
+	// amount.
+	FeeLimitPPM uint64
+
+	// CltvLimit is the maximum time lock of the routes of the probes.
+	CltvLimit uint32
+
+	// FinalCLTVDelta is the final cltv delta that is used for probes.
+	FinalCLTVDelta uint16
+
+	// Clock is the time source of the prober.
+	Clock clock.Clock
+}
+
+// Result is the outcome of a single probe.
+type Result struct {
+	// Destination is the node that was probed.
+	Destination route.Vertex
+
+	// Amount is the amount of the probe.
+	Amount lnwire.MilliBronees
+
+	// Reached is true if the probe reached the destination.
+	Reached bool
+
+	// Err is the reason why the probe didn't reach the destination.
+	Err error
+
+	// Time is the time at which the probe completed.
+	Time time.Time
+}
+
+// Status is a snapshot of the state of the prober.
+type Status struct {
+	// Active is true if the prober sends probes.
+	Active bool
+
+	// Budget is the current budget of the prober.
+	Budget Budget
+
+	// Destinations is the list of nodes that are currently probed.
+	Destinations []route.Vertex
+
+	// InFlight is the total amount of the probes that are in flight.
+	InFlight lnwire.MilliBronees
+
+	// Sent is the number of probes that were sent since startup.
+	Sent uint64
+
+	// Reached is the number of probes that reached their destination.
+	Reached uint64
+
+	// Failed is the number of probes that didn't reach their
+	// destination.
+	Failed uint64
+
+	// Recent holds the results of the most recent probes, oldest first.
+	Recent []Result
+}
+
+// Prober periodically sends probe payments to a set of destinations within a
+// budget.
+type Prober struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// update is signaled when the prober is activated or its budget
+	// changes, so that the main loop picks up the change.
+	update chan struct{}
+
+	// The fields below are protected by mu.
+	mu           sync.Mutex
+	active       bool
+	budget       Budget
+	destinations []route.Vertex
+	refreshTime  time.Time
+	next         int
+	inFlight     lnwire.MilliBronees
+	sent         uint64
+	reached      uint64
+	failed       uint64
+	recent       []Result
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// New creates a new prober with the given initial budget. If active is false,
+// no probes are sent until the prober is activated.
+func New(cfg *Config, budget Budget, active bool) (*Prober, error) {
+	if err := budget.validate(); err != nil {
+		return nil, err
+	}
+
+	return &Prober{
+		cfg:    cfg,
+		update: make(chan struct{}, 1),
+		active: active,
+		budget: budget,
+		quit:   make(chan struct{}),
+	}, nil
+}
+
+// Start starts the prober.
+func (p *Prober) Start() error {
+	p.started.Do(func() {
+		log.Infof("Prober starting, active=%v", p.isActive())
+
+		p.wg.Add(1)
+		go p.probeLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the prober. It waits for the probes in flight, which complete
+// once the router shuts down.
+func (p *Prober) Stop() error {
+	p.stopped.Do(func() {
+		log.Info("Prober shutting down")
+
+		close(p.quit)
+		p.wg.Wait()
+	})
+
+	return nil
+}
+
+// SetActive activates or deactivates the prober. Probes that are in flight
+// aren't affected.
+func (p *Prober) SetActive(active bool) {
+	p.mu.Lock()
+	p.active = active
+	p.mu.Unlock()
+
+	log.Infof("Prober active=%v", active)
+
+	p.signalUpdate()
+}
+
+// SetBudget replaces the budget of the prober.
+func (p *Prober) SetBudget(budget Budget) error {
+	if err := budget.validate(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	p.budget = budget
+	p.mu.Unlock()
+
+	log.Infof("Prober budget updated: interval=%v, amount=%v, "+
+		"max_in_flight=%v", budget.Interval, budget.Amount,
+		budget.MaxInFlight)
+
+	p.signalUpdate()
+
+	return nil
+}
+
+// Status returns a snapshot of the state of the prober.
+func (p *Prober) Status() *Status {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := &Status{
+		Active:       p.active,
+		Budget:       p.budget,
+		Destinations: make([]route.Vertex, len(p.destinations)),
+		InFlight:     p.inFlight,
+		Sent:         p.sent,
+		Reached:      p.reached,
+		Failed:       p.failed,
+		Recent:       make([]Result, len(p.recent)),
+	}
+	copy(status.Destinations, p.destinations)
+	copy(status.Recent, p.recent)
+
+	return status
+}
+
+// signalUpdate wakes up the main loop without blocking.
+func (p *Prober) signalUpdate() {
+	select {
+	case p.update <- struct{}{}:
+	default:
+	}
+}
+
+// isActive returns whether the prober is active.
+func (p *Prober) isActive() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.active
+}
+
+// probeLoop sends a probe every interval while the prober is active.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Prober) probeLoop() {
+	defer p.wg.Done()
+
+	for {
+		p.mu.Lock()
+		active, interval := p.active, p.budget.Interval
+		p.mu.Unlock()
+
+		// While inactive, we only wait for an update.
+		var tick <-chan time.Time
+		if active {
+			tick = p.cfg.Clock.TickAfter(interval)
+		}
+
+		select {
+		case <-tick:
+			p.probeNext()
+
+		case <-p.update:
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// probeNext launches a probe to the next destination if the budget allows
+// for it.
+func (p *Prober) probeNext() {
+	if err := p.refreshDestinations(); err != nil {
+		log.Errorf("Unable to determine probe destinations: %v", err)
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.active || len(p.destinations) == 0 {
+		return
+	}
+
+	amt := p.budget.Amount
+	if p.inFlight+amt > p.budget.MaxInFlight {
+		log.Debugf("Skipping probe, %v in flight", p.inFlight)
+		return
+	}
+
+	target := p.destinations[p.next%len(p.destinations)]
+	p.next++
+
+	p.inFlight += amt
+	p.sent++
+
+	p.wg.Add(1)
+	go p.probe(target, amt)
+}
+
+// refreshDestinations determines the nodes to probe. Unless destinations are
+// configured, the most central nodes of the graph are used, which are
+// recalculated periodically.
+func (p *Prober) refreshDestinations() error {
+	p.mu.Lock()
+	destinations := p.destinations
+	refreshTime := p.refreshTime
+	p.mu.Unlock()
+
+	now := p.cfg.Clock.Now()
+	switch {
+	case len(p.cfg.Destinations) > 0:
+		if destinations != nil {
+			return nil
+		}
+		destinations = p.cfg.Destinations
+
+	case destinations != nil && now.Before(refreshTime):
+		return nil
+
+	default:
+		// Calculating the centrality can take a while on a large
+		// graph, so we don't hold the lock while doing so.
+		var err error
+		destinations, err = p.cfg.CentralNodes(p.cfg.NumCentralNodes)
+		if err != nil {
+			return err
+		}
+
+		// The graph may not be synced yet, in which case we'll try
+		// again on the next tick.
+		if len(destinations) == 0 {
+			return nil
+		}
+
+		log.Infof("Probing %v central nodes", len(destinations))
+	}
+
+	p.mu.Lock()
+	p.destinations = destinations
+	p.refreshTime = now.Add(centralNodesRefresh)
+	p.mu.Unlock()
+
+	return nil
+}
+
+// probe sends a probe payment to the target and records the outcome.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *Prober) probe(target route.Vertex, amt lnwire.MilliBronees) {
+	defer p.wg.Done()
+
+	reached, err := p.sendProbe(target, amt)
+	if err != nil {
+		log.Debugf("Probe of %v to %v failed: %v", amt, target, err)
+	} else {
+		log.Debugf("Probe of %v reached %v", amt, target)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight -= amt
+	if reached {
+		p.reached++
+	} else {
+		p.failed++
+	}
+
+	p.recent = append(p.recent, Result{
+		Destination: target,
+		Amount:      amt,
+		Reached:     reached,
+		Err:         err,
+		Time:        p.cfg.Clock.Now(),
+	})
+	if len(p.recent) > maxRecentResults {
+		p.recent = p.recent[len(p.recent)-maxRecentResults:]
+	}
+}
+
+// sendProbe sends a probe payment with a random payment hash to the target
+// and returns whether it reached the target. Completed probe payments are
+// removed from the database again.
+func (p *Prober) sendProbe(target route.Vertex,
+	amt lnwire.MilliBronees) (bool, error) {
+
+	var paymentHash lntypes.Hash
+	if _, err := rand.Read(paymentHash[:]); err != nil {
+		return false, err
+	}
+
+	payment := &routing.LightningPayment{
+		Target: target,
+		Amount: amt,
+		FeeLimit: amt * lnwire.MilliBronees(p.cfg.FeeLimitPPM) /
+			1_000_000,
+		CltvLimit:         p.cfg.CltvLimit,
+		FinalCLTVDelta:    p.cfg.FinalCLTVDelta,
+		PayAttemptTimeout: p.cfg.Timeout,
+		MaxParts:          1,
+	}
+	if err := payment.SetPaymentHash(paymentHash); err != nil {
+		return false, err
+	}
+
+	_, _, err := p.cfg.SendPayment(payment)
+	if err == nil {
+		return false, fmt.Errorf("probe %v unexpectedly succeeded",
+			paymentHash)
+	}
+
+	// Only payments that failed with a final reason were completed and
+	// can be removed. Other errors are returned before the payment was
+	// initiated or on shutdown, in which case the payment is resumed on
+	// startup.
+	var reason channeldb.FailureReason
+	if !errors.As(err, &reason) {
+		return false, err
+	}
+
+	if delErr := p.cfg.DeletePayment(paymentHash); delErr != nil {
+		log.Errorf("Unable to delete probe %v: %v", paymentHash,
+			delErr)
+	}
+
+	// The destination fails the probe with incorrect payment details,
+	// because it doesn't know the payment hash.
+	if reason == channeldb.FailureReasonPaymentDetails {
+		return true, nil
+	}
+
+	return false, reason
+}
+
+// CentralNodes returns the n nodes of the graph with the highest betweenness
+// centrality, excluding our own node.
+func CentralNodes(graph autopilot.ChannelGraph, self route.Vertex,
+	n int) ([]route.Vertex, error) {
+
+	metric, err := autopilot.NewBetweennessCentralityMetric(
+		runtime.NumCPU(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := metric.Refresh(graph); err != nil {
+		return nil, err
+	}
+
+	centrality := metric.GetMetric(false)
+
+	nodes := make([]route.Vertex, 0, len(centrality))
+	for node := range centrality {
+		if route.Vertex(node) == self {
+			continue
+		}
+		nodes = append(nodes, route.Vertex(node))
+	}
+
+	// Sort by descending centrality, and by key for a stable order.
+	sort.Slice(nodes, func(i, j int) bool {
+		ci := centrality[autopilot.NodeID(nodes[i])]
+		cj := centrality[autopilot.NodeID(nodes[j])]
+		if ci != cj {
+			return ci > cj
+		}
+
+		return bytes.Compare(nodes[i][:], nodes[j][:]) < 0
+	})
+
+	if len(nodes) > n {
+		nodes = nodes[:n]
+	}
+
+	return nodes, nil
+}
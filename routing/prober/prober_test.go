@@ -0,0 +1,287 @@
+package prober
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testTime = time.Date(2018, time.January, 9, 14, 00, 00, 0, time.UTC)
+
+	testBudget = Budget{
+		Interval:    time.Minute,
+		Amount:      1000,
+		MaxInFlight: 2000,
+	}
+)
+
+// probeRequest is a probe payment that was sent by the prober under test.
+type probeRequest struct {
+	payment *routing.LightningPayment
+	result  chan error
+}
+
+// proberTestContext holds the prober under test and its mocked dependencies.
+type proberTestContext struct {
+	t          *testing.T
+	prober     *Prober
+	clock      *clock.TestClock
+	tickSignal chan time.Duration
+	probes     chan *probeRequest
+	deleted    chan lntypes.Hash
+
+	// routerQuit is closed to abort the probes in flight like the router
+	// does when it shuts down.
+	routerQuit chan struct{}
+}
+
+func newProberTestContext(t *testing.T, cfg *Config,
+	active bool) *proberTestContext {
+
+	ctx := &proberTestContext{
+		t:          t,
+		tickSignal: make(chan time.Duration),
+		probes:     make(chan *probeRequest),
+		deleted:    make(chan lntypes.Hash, 10),
+		routerQuit: make(chan struct{}),
+	}
+	ctx.clock = clock.NewTestClockWithTickSignal(testTime, ctx.tickSignal)
+
+	cfg.Clock = ctx.clock
+	cfg.FeeLimitPPM = 10_000
+	cfg.SendPayment = func(payment *routing.LightningPayment) ([32]byte,
+		*route.Route, error) {
+
+		req := &probeRequest{
+			payment: payment,
+			result:  make(chan error),
+		}
+
+		select {
+		case ctx.probes <- req:
+		case <-ctx.routerQuit:
+			return [32]byte{}, nil, routing.ErrRouterShuttingDown
+		}
+
+		select {
+		case err := <-req.result:
+			return [32]byte{}, nil, err
+		case <-ctx.routerQuit:
+			return [32]byte{}, nil, routing.ErrRouterShuttingDown
+		}
+	}
+	cfg.DeletePayment = func(hash lntypes.Hash) error {
+		ctx.deleted <- hash
+		return nil
+	}
+
+	var err error
+	ctx.prober, err = New(cfg, testBudget, active)
+	require.NoError(t, err)
+	require.NoError(t, ctx.prober.Start())
+
+	return ctx
+}
+
+// waitTick waits for the prober to wait for the next probe and returns the
+// interval it is waiting for.
+func (ctx *proberTestContext) waitTick() time.Duration {
+	ctx.t.Helper()
+
+	select {
+	case interval := <-ctx.tickSignal:
+		return interval
+
+	case <-time.After(time.Second):
+		ctx.t.Fatal("prober not waiting")
+		return 0
+	}
+}
+
+// tick waits for the prober to wait for the next probe and advances the
+// clock to trigger it.
+func (ctx *proberTestContext) tick() {
+	ctx.t.Helper()
+
+	interval := ctx.waitTick()
+	ctx.clock.SetTime(ctx.clock.Now().Add(interval))
+}
+
+// expectProbe waits for a probe to be sent.
+func (ctx *proberTestContext) expectProbe() *probeRequest {
+	ctx.t.Helper()
+
+	select {
+	case req := <-ctx.probes:
+		return req
+
+	case <-time.After(time.Second):
+		ctx.t.Fatal("no probe sent")
+		return nil
+	}
+}
+
+// stop shuts down the prober after aborting the probes in flight.
+func (ctx *proberTestContext) stop() {
+	ctx.t.Helper()
+
+	close(ctx.routerQuit)
+
+	// Drain the tick signal to let the prober shut down.
+	go func() {
+		for range ctx.tickSignal {
+		}
+	}()
+	require.NoError(ctx.t, ctx.prober.Stop())
+}
+
+// waitStatus waits until the prober's status satisfies the predicate.
+func (ctx *proberTestContext) waitStatus(pred func(*Status) bool) {
+	ctx.t.Helper()
+
+	require.Eventually(ctx.t, func() bool {
+		return pred(ctx.prober.Status())
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestProber tests that the prober probes its destinations in turn and stays
+// within its amount budget.
+func TestProber(t *testing.T) {
+	dest1, dest2 := route.Vertex{1}, route.Vertex{2}
+	ctx := newProberTestContext(t, &Config{
+		Destinations: []route.Vertex{dest1, dest2},
+	}, true)
+
+	// The first probe goes to the first destination.
+	ctx.tick()
+	probe1 := ctx.expectProbe()
+	require.Equal(t, dest1, probe1.payment.Target)
+	require.Equal(t, testBudget.Amount, probe1.payment.Amount)
+	require.Equal(t, lnwire.MilliBronees(10), probe1.payment.FeeLimit)
+	require.EqualValues(t, 1, probe1.payment.MaxParts)
+
+	// The second probe goes to the second destination.
+	ctx.tick()
+	probe2 := ctx.expectProbe()
+	require.Equal(t, dest2, probe2.payment.Target)
+
+	// With two probes in flight, the budget is exhausted and no further
+	// probe is sent. Once the prober waits again, the skipped probe was
+	// processed.
+	ctx.tick()
+	interval := ctx.waitTick()
+	select {
+	case <-ctx.probes:
+		t.Fatal("unexpected probe")
+	default:
+	}
+
+	// Let the first probe reach its destination and the second one fail.
+	probe1.result <- channeldb.FailureReasonPaymentDetails
+	probe2.result <- channeldb.FailureReasonNoRoute
+
+	ctx.waitStatus(func(s *Status) bool {
+		return s.Reached == 1 && s.Failed == 1
+	})
+
+	// Both completed probes are removed from the database.
+	hash1, hash2 := <-ctx.deleted, <-ctx.deleted
+	require.ElementsMatch(
+		t, []lntypes.Hash{
+			probe1.payment.Identifier(),
+			probe2.payment.Identifier(),
+		}, []lntypes.Hash{hash1, hash2},
+	)
+
+	status := ctx.prober.Status()
+	require.True(t, status.Active)
+	require.Zero(t, status.InFlight)
+	require.EqualValues(t, 2, status.Sent)
+	require.Len(t, status.Recent, 2)
+
+	// Probing continues with the first destination.
+	ctx.clock.SetTime(ctx.clock.Now().Add(interval))
+	probe3 := ctx.expectProbe()
+	require.Equal(t, dest1, probe3.payment.Target)
+
+	// A probe that is aborted before completion isn't removed.
+	probe3.result <- routing.ErrRouterShuttingDown
+	ctx.waitStatus(func(s *Status) bool {
+		return s.Failed == 2
+	})
+	require.Empty(t, ctx.deleted)
+
+	ctx.stop()
+}
+
+// TestProberControl tests activating the prober, changing its budget and
+// probing the most central nodes.
+func TestProberControl(t *testing.T) {
+	central := route.Vertex{3}
+	ctx := newProberTestContext(t, &Config{
+		NumCentralNodes: 1,
+		CentralNodes: func(n int) ([]route.Vertex, error) {
+			require.Equal(t, 1, n)
+			return []route.Vertex{central}, nil
+		},
+	}, false)
+
+	// The prober isn't active, so it isn't waiting for a probe.
+	select {
+	case <-ctx.tickSignal:
+		t.Fatal("inactive prober waiting to probe")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// An invalid budget is rejected.
+	err := ctx.prober.SetBudget(Budget{
+		Interval:    time.Second,
+		Amount:      1000,
+		MaxInFlight: 500,
+	})
+	require.ErrorIs(t, err, ErrInvalidMaxInFlight)
+
+	budget := Budget{
+		Interval:    time.Hour,
+		Amount:      5000,
+		MaxInFlight: 5000,
+	}
+	require.NoError(t, ctx.prober.SetBudget(budget))
+
+	ctx.prober.SetActive(true)
+
+	// Advance the clock until the probe is sent. The prober may restart
+	// its wait when it picks up the updates.
+	var probe *probeRequest
+	for probe == nil {
+		select {
+		case interval := <-ctx.tickSignal:
+			require.Equal(t, time.Hour, interval)
+			ctx.clock.SetTime(ctx.clock.Now().Add(interval))
+
+		case probe = <-ctx.probes:
+
+		case <-time.After(time.Second):
+			t.Fatal("no probe sent")
+		}
+	}
+	require.Equal(t, central, probe.payment.Target)
+	require.Equal(t, budget.Amount, probe.payment.Amount)
+
+	status := ctx.prober.Status()
+	require.Equal(t, budget, status.Budget)
+	require.Equal(t, []route.Vertex{central}, status.Destinations)
+	require.Equal(t, budget.Amount, status.InFlight)
+
+	probe.result <- channeldb.FailureReasonPaymentDetails
+
+	ctx.stop()
+}
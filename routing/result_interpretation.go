@@ -108,6 +108,17 @@ func (i *interpretedResult) processFail(
 		return
 	}
 
+	// Failures that originate from within a blinded route, apart from
+	// its final hop, can't be attributed to the hidden hops that the
+	// route consists of.
+	introIdx, isBlinded := introductionPointIndex(rt)
+	if isBlinded && *errSourceIdx > introIdx &&
+		*errSourceIdx < len(rt.Hops) {
+
+		i.processPaymentOutcomeBlinded(rt, introIdx)
+		return
+	}
+
 	switch *errSourceIdx {
 
 	// We are the source of the failure.
@@ -401,6 +412,33 @@ func (i *interpretedResult) processPaymentOutcomeIntermediate(
 	}
 }
 
+// processPaymentOutcomeBlinded handles failures sent by the introduction node
+// or intermediate hops of a blinded route. Pairs within the blinded route only
+// exist for this route, so penalizing them wouldn't keep us from retrying the
+// same blinded path. Instead we penalize the pair leading to the introduction
+// node, which steers subsequent attempts towards other blinded paths.
+func (i *interpretedResult) processPaymentOutcomeBlinded(
+	route *route.Route, introIdx int) {
+
+	i.failPair(route, introIdx)
+
+	if introIdx > 0 {
+		i.successPairRange(route, 0, introIdx-1)
+	}
+}
+
+// introductionPointIndex returns the index of the hop that is the
+// introduction node of a blinded route, and whether the route contains one.
+func introductionPointIndex(rt *route.Route) (int, bool) {
+	for idx, hop := range rt.Hops {
+		if hop.BlindingPoint != nil {
+			return idx, true
+		}
+	}
+
+	return 0, false
+}
+
 // processPaymentOutcomeUnknown processes a payment outcome for which no failure
 // message or source is available.
 func (i *interpretedResult) processPaymentOutcomeUnknown(route *route.Route) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/brond/bronec"
 
 	"github.com/brsuite/broln/routing/route"
 )
@@ -52,6 +53,23 @@ var (
 			{PubKeyBytes: hops[4], AmtToForward: 90},
 		},
 	}
+
+	// routeBlinded is a route to a blinded path with hops[2] as its
+	// introduction node.
+	routeBlinded = route.Route{
+		SourcePubKey: hops[0],
+		TotalAmount:  100,
+		Hops: []*route.Hop{
+			{PubKeyBytes: hops[1], AmtToForward: 99},
+			{
+				PubKeyBytes:   hops[2],
+				AmtToForward:  95,
+				BlindingPoint: &bronec.PublicKey{},
+			},
+			{PubKeyBytes: hops[3], AmtToForward: 95},
+			{PubKeyBytes: hops[4], AmtToForward: 95},
+		},
+	}
 )
 
 func getTestPair(from, to int) DirectedNodePair {
@@ -367,6 +385,42 @@ var resultTestCases = []resultTestCase{
 			policyFailure: getPolicyFailure(1, 2),
 		},
 	},
+
+	// Test that a failure from within a blinded route penalizes the pair
+	// leading to the introduction node.
+	{
+		name:          "blinded route intermediate failure",
+		route:         &routeBlinded,
+		failureSrcIdx: 3,
+		failure:       lnwire.NewTemporaryChannelFailure(nil),
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): failPairResult(0),
+				getTestPair(2, 1): failPairResult(0),
+			},
+		},
+	},
+
+	// Test that a failure from the recipient of a blinded route is
+	// interpreted as a regular final failure.
+	{
+		name:          "blinded route final failure",
+		route:         &routeBlinded,
+		failureSrcIdx: 4,
+		failure:       lnwire.NewFailIncorrectDetails(95, 0),
+
+		expectedResult: &interpretedResult{
+			pairResults: map[DirectedNodePair]pairResult{
+				getTestPair(0, 1): successPairResult(100),
+				getTestPair(1, 2): successPairResult(99),
+				getTestPair(2, 3): successPairResult(95),
+				getTestPair(3, 4): successPairResult(95),
+			},
+			finalFailureReason: &reasonIncorrectDetails,
+		},
+	},
 }
 
 // TestResultInterpretation executes a list of test cases that test the result
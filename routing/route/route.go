@@ -38,6 +38,18 @@ var (
 	// ErrAMPMissingMPP is returned when the caller tries to attach an AMP
 	// record but no MPP record is presented for the final hop.
 	ErrAMPMissingMPP = errors.New("cannot send AMP without MPP record")
+
+	// ErrIntermediateTotalAmt is returned when a hop tries to deliver the
+	// total amount of a blinded payment to an intermediate hop, only final
+	// hops can receive it.
+	ErrIntermediateTotalAmt = errors.New("cannot send blinded total " +
+		"amount to intermediate")
+
+	// ErrIntermediateTrampolineOnion is returned when a hop tries to
+	// deliver a trampoline onion to an intermediate hop, only final hops
+	// can receive it.
+	ErrIntermediateTrampolineOnion = errors.New("cannot send trampoline " +
+		"onion to intermediate")
 )
 
 // Vertex is a simple alias for the serialization of a compressed Brocoin
@@ -127,6 +139,26 @@ type Hop struct {
 	// understand the new TLV payload, so we must instead use the legacy
 	// payload.
 	LegacyPayload bool
+
+	// EncryptedData is the encrypted recipient data of a hop within a
+	// blinded route. It is set for all hops of a blinded route, including
+	// its introduction node.
+	EncryptedData []byte
+
+	// BlindingPoint is the blinding point that is handed to the
+	// introduction node of a blinded route. This field should only be set
+	// for the introduction node.
+	BlindingPoint *bronec.PublicKey
+
+	// TotalAmtMsat is the total amount of a payment made to a blinded
+	// route. This field should only be set for the final hop of a blinded
+	// route.
+	TotalAmtMsat lnwire.MilliBronees
+
+	// TrampolineOnion is the serialized trampoline onion packet that is
+	// handed to a trampoline node. This field should only be set for the
+	// final hop, which is the first trampoline node of the payment.
+	TrampolineOnion []byte
 }
 
 // Copy returns a deep copy of the Hop.
@@ -143,6 +175,16 @@ func (h *Hop) Copy() *Hop {
 		c.AMP = &a
 	}
 
+	if h.EncryptedData != nil {
+		c.EncryptedData = make([]byte, len(h.EncryptedData))
+		copy(c.EncryptedData, h.EncryptedData)
+	}
+
+	if h.TrampolineOnion != nil {
+		c.TrampolineOnion = make([]byte, len(h.TrampolineOnion))
+		copy(c.TrampolineOnion, h.TrampolineOnion)
+	}
+
 	return &c
 }
 
@@ -152,8 +194,11 @@ func (h *Hop) Copy() *Hop {
 // passed optional TLVRecords.  nextChanID is the unique channel ID that
 // references the _outgoing_ channel ID that follows this hop. This field
 // follows the same semantics as the NextAddress field in the onion: it should
-// be set to zero to indicate the terminal hop.
-func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
+// be set to zero to indicate the terminal hop. As hops within a blinded route
+// don't have a next channel ID, finalHop indicates whether this is the final
+// hop of the route.
+func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64,
+	finalHop bool) error {
 	// If this is a legacy payload, then we'll exit here as this method
 	// shouldn't be called.
 	if h.LegacyPayload == true {
@@ -165,12 +210,16 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 	// required routing fields, as well as these optional values.
 	var records []tlv.Record
 
-	// Every hop must have an amount to forward and CLTV expiry.
+	// Every hop must have an amount to forward and CLTV expiry, except
+	// for the intermediate hops of a blinded route which receive them in
+	// their encrypted data.
 	amt := uint64(h.AmtToForward)
-	records = append(records,
-		record.NewAmtToFwdRecord(&amt),
-		record.NewLockTimeRecord(&h.OutgoingTimeLock),
-	)
+	if h.EncryptedData == nil || finalHop {
+		records = append(records,
+			record.NewAmtToFwdRecord(&amt),
+			record.NewLockTimeRecord(&h.OutgoingTimeLock),
+		)
+	}
 
 	// BOLT 04 says the next_hop_id should be omitted for the final hop,
 	// but present for all others.
@@ -205,6 +254,48 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 		}
 	}
 
+	// Hops within a blinded route carry the data that the recipient
+	// encrypted for them, and the introduction node additionally needs
+	// the blinding point to decrypt it.
+	if h.EncryptedData != nil {
+		records = append(
+			records, record.NewEncryptedDataRecord(&h.EncryptedData),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		records = append(
+			records, record.NewBlindingPointRecord(&h.BlindingPoint),
+		)
+	}
+
+	// The total amount of a payment to a blinded route may only be sent
+	// to its final hop.
+	totalAmt := uint64(h.TotalAmtMsat)
+	if h.TotalAmtMsat != 0 {
+		if !finalHop {
+			return ErrIntermediateTotalAmt
+		}
+
+		records = append(
+			records, record.NewTotalAmtMsatBlinded(&totalAmt),
+		)
+	}
+
+	// The trampoline onion is handed to the trampoline node that the
+	// outer onion terminates at, so it may only be sent to the final hop.
+	if h.TrampolineOnion != nil {
+		if !finalHop {
+			return ErrIntermediateTrampolineOnion
+		}
+
+		records = append(
+			records, record.NewTrampolineOnionRecord(
+				&h.TrampolineOnion,
+			),
+		)
+	}
+
 	// Append any custom types destined for this hop.
 	tlvRecords := tlv.MapToRecords(h.CustomRecords)
 	records = append(records, tlvRecords...)
@@ -223,7 +314,7 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64) error {
 
 // Size returns the total size this hop's payload would take up in the onion
 // packet.
-func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
+func (h *Hop) PayloadSize(nextChanID uint64, finalHop bool) uint64 {
 	if h.LegacyPayload {
 		return sphinx.LegacyHopDataSize
 	}
@@ -235,14 +326,18 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 			tlv.VarIntSize(length) + length
 	}
 
-	// Add amount size.
-	addRecord(record.AmtOnionType, tlv.SizeTUint64(uint64(h.AmtToForward)))
-
-	// Add lock time size.
-	addRecord(
-		record.LockTimeOnionType,
-		tlv.SizeTUint64(uint64(h.OutgoingTimeLock)),
-	)
+	// Add amount and lock time size, unless they are omitted for an
+	// intermediate hop of a blinded route.
+	if h.EncryptedData == nil || finalHop {
+		addRecord(
+			record.AmtOnionType,
+			tlv.SizeTUint64(uint64(h.AmtToForward)),
+		)
+		addRecord(
+			record.LockTimeOnionType,
+			tlv.SizeTUint64(uint64(h.OutgoingTimeLock)),
+		)
+	}
 
 	// Add next hop if present.
 	if nextChanID != 0 {
@@ -259,6 +354,33 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 		addRecord(record.AMPOnionType, h.AMP.PayloadSize())
 	}
 
+	// Add the blinded route fields if present.
+	if h.EncryptedData != nil {
+		addRecord(
+			record.EncryptedDataOnionType,
+			uint64(len(h.EncryptedData)),
+		)
+	}
+
+	if h.BlindingPoint != nil {
+		addRecord(record.BlindingPointOnionType, 33)
+	}
+
+	if h.TotalAmtMsat != 0 {
+		addRecord(
+			record.TotalAmtMsatBlindedType,
+			tlv.SizeTUint64(uint64(h.TotalAmtMsat)),
+		)
+	}
+
+	// Add the trampoline onion if present.
+	if h.TrampolineOnion != nil {
+		addRecord(
+			record.TrampolineOnionType,
+			uint64(len(h.TrampolineOnion)),
+		)
+	}
+
 	// Add custom records.
 	for k, v := range h.CustomRecords {
 		addRecord(tlv.Type(k), uint64(len(v)))
@@ -449,7 +571,9 @@ func (r *Route) ToSphinxPath() (*sphinx.PaymentPath, error) {
 			// channel should be forwarded to so we can construct a
 			// valid payload.
 			var b bytes.Buffer
-			err := hop.PackHopPayload(&b, nextHop)
+			err := hop.PackHopPayload(
+				&b, nextHop, i == len(r.Hops)-1,
+			)
 			if err != nil {
 				return nil, err
 			}
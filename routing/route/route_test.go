@@ -104,7 +104,7 @@ func TestMPPHop(t *testing.T) {
 	// Encoding an MPP record to an intermediate hop should result in a
 	// failure.
 	var b bytes.Buffer
-	err := hop.PackHopPayload(&b, 2)
+	err := hop.PackHopPayload(&b, 2, false)
 	if err != ErrIntermediateMPPHop {
 		t.Fatalf("expected err: %v, got: %v",
 			ErrIntermediateMPPHop, err)
@@ -112,7 +112,7 @@ func TestMPPHop(t *testing.T) {
 
 	// Encoding an MPP record to a final hop should be successful.
 	b.Reset()
-	err = hop.PackHopPayload(&b, 0)
+	err = hop.PackHopPayload(&b, 0, true)
 	if err != nil {
 		t.Fatalf("expected err: %v, got: %v", nil, err)
 	}
@@ -134,7 +134,7 @@ func TestAMPHop(t *testing.T) {
 	// Encoding an AMP record to an intermediate hop w/o an MPP record
 	// should result in a failure.
 	var b bytes.Buffer
-	err := hop.PackHopPayload(&b, 2)
+	err := hop.PackHopPayload(&b, 2, false)
 	if err != ErrAMPMissingMPP {
 		t.Fatalf("expected err: %v, got: %v",
 			ErrAMPMissingMPP, err)
@@ -143,7 +143,7 @@ func TestAMPHop(t *testing.T) {
 	// Encoding an AMP record to a final hop w/o an MPP record should result
 	// in a failure.
 	b.Reset()
-	err = hop.PackHopPayload(&b, 0)
+	err = hop.PackHopPayload(&b, 0, true)
 	if err != ErrAMPMissingMPP {
 		t.Fatalf("expected err: %v, got: %v",
 			ErrAMPMissingMPP, err)
@@ -153,12 +153,60 @@ func TestAMPHop(t *testing.T) {
 	// successful.
 	hop.MPP = record.NewMPP(testAmt, testAddr)
 	b.Reset()
-	err = hop.PackHopPayload(&b, 0)
+	err = hop.PackHopPayload(&b, 0, true)
 	if err != nil {
 		t.Fatalf("expected err: %v, got: %v", nil, err)
 	}
 }
 
+// TestBlindedHop asserts that the hops of a blinded route only encode an
+// amount and expiry for the final hop, and that the total amount of the
+// payment can't be sent to intermediate hops.
+func TestBlindedHop(t *testing.T) {
+	t.Parallel()
+
+	hop := Hop{
+		OutgoingTimeLock: 44,
+		AmtToForward:     testAmt,
+		EncryptedData:    []byte{1, 2, 3},
+		TotalAmtMsat:     testAmt,
+	}
+
+	// Encoding the total amount to an intermediate hop should result in a
+	// failure.
+	var b bytes.Buffer
+	err := hop.PackHopPayload(&b, 0, false)
+	if err != ErrIntermediateTotalAmt {
+		t.Fatalf("expected err: %v, got: %v",
+			ErrIntermediateTotalAmt, err)
+	}
+
+	// Encoding it to the final hop should include the amount and expiry.
+	b.Reset()
+	if err := hop.PackHopPayload(&b, 0, true); err != nil {
+		t.Fatalf("expected err: %v, got: %v", nil, err)
+	}
+	finalLen := b.Len()
+
+	// Intermediate hops of a blinded route receive neither an amount nor
+	// an expiry.
+	hop.TotalAmtMsat = 0
+	b.Reset()
+	if err := hop.PackHopPayload(&b, 0, false); err != nil {
+		t.Fatalf("expected err: %v, got: %v", nil, err)
+	}
+
+	// The payload should only contain the encrypted data record.
+	if b.Len() != 2+len(hop.EncryptedData) {
+		t.Fatalf("expected payload of %v bytes, got %v",
+			2+len(hop.EncryptedData), b.Len())
+	}
+	if finalLen <= b.Len() {
+		t.Fatalf("expected final hop payload to be larger than %v "+
+			"bytes, got %v", b.Len(), finalLen)
+	}
+}
+
 // TestPayloadSize tests the payload size calculation that is provided by Hop
 // structs.
 func TestPayloadSize(t *testing.T) {
@@ -176,12 +224,27 @@ func TestPayloadSize(t *testing.T) {
 			OutgoingTimeLock: 700000,
 			ChannelID:        63584534844,
 		},
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1200,
+			OutgoingTimeLock: 700000,
+			ChannelID:        43584534844,
+			EncryptedData:    []byte{1, 2, 3, 4},
+			BlindingPoint:    testPubKey,
+		},
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1200,
+			OutgoingTimeLock: 700000,
+			EncryptedData:    []byte{5, 6, 7},
+		},
 		{
 			PubKeyBytes:      testPubKeyBytes,
 			AmtToForward:     1200,
 			OutgoingTimeLock: 700000,
 			MPP:              record.NewMPP(500, [32]byte{}),
 			AMP:              record.NewAMP([32]byte{}, [32]byte{}, 8),
+			TrampolineOnion:  []byte{1, 2, 3, 4, 5},
 			CustomRecords: map[uint64][]byte{
 				100000:  {1, 2, 3},
 				1000000: {4, 5},
@@ -205,7 +268,7 @@ func TestPayloadSize(t *testing.T) {
 		}
 
 		expected := uint64(onionHop.HopPayload.NumBytes())
-		actual := hop.PayloadSize(nextChan)
+		actual := hop.PayloadSize(nextChan, i == len(hops)-1)
 		if expected != actual {
 			t.Fatalf("unexpected payload size at hop %v: "+
 				"expected %v, got %v",
@@ -31,6 +31,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/multimutex"
 	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/chainview"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/broln/routing/shards"
@@ -226,9 +227,10 @@ type MissionController interface {
 	ReportPaymentSuccess(attemptID uint64, rt *route.Route) error
 
 	// GetProbability is expected to return the success probability of a
-	// payment from fromNode along edge.
+	// payment from fromNode along edge. The capacity of the channel is zero
+	// if unknown.
 	GetProbability(fromNode, toNode route.Vertex,
-		amt lnwire.MilliBronees) float64
+		amt lnwire.MilliBronees, capacity bronutil.Amount) float64
 }
 
 // FeeSchema is the set fee configuration for a Lightning Node on the network.
@@ -245,6 +247,11 @@ type FeeSchema struct {
 	// the effective fee rate charged per mSAT will be: (amount *
 	// FeeRate/1,000,000).
 	FeeRate uint32
+
+	// InboundFee is the fee charged for payments that arrive over the
+	// channel, which may be negative to offer a discount. If nil, the
+	// current inbound fee is kept.
+	InboundFee *channeldb.InboundFee
 }
 
 // ChannelPolicy holds the parameters that determine the policy we enforce
@@ -350,6 +357,12 @@ type Config struct {
 	// PathFindingConfig defines global path finding parameters.
 	PathFindingConfig PathFindingConfig
 
+	// IsAlias returns true if the passed ShortChannelID is one of the
+	// aliases we assigned to our own zero-conf or option-scid-alias
+	// channels. Edges using such an alias can't be validated against the
+	// chain.
+	IsAlias func(scid lnwire.ShortChannelID) bool
+
 	// Clock is mockable time provider.
 	Clock clock.Clock
 
@@ -372,6 +385,22 @@ type EdgeLocator struct {
 	Direction uint8
 }
 
+// NewEdgeLocator returns the locator of the direction of the channel in which
+// it is used to forward from one node to the other.
+func NewEdgeLocator(chanID uint64, from, to route.Vertex) EdgeLocator {
+	locator := EdgeLocator{
+		ChannelID: chanID,
+	}
+
+	// The lower pubkey is the first node of the channel, so the direction
+	// is reversed if we forward from the higher one.
+	if bytes.Compare(from[:], to[:]) > 0 {
+		locator.Direction = 1
+	}
+
+	return locator
+}
+
 // String returns a human readable version of the edgeLocator values.
 func (e *EdgeLocator) String() string {
 	return fmt.Sprintf("%v:%v", e.ChannelID, e.Direction)
@@ -1456,8 +1485,12 @@ func (r *ChannelRouter) processUpdate(msg interface{},
 		// If AssumeChannelValid is present, then we are unable to
 		// perform any of the expensive checks below, so we'll
 		// short-circuit our path straight to adding the edge to our
-		// graph.
-		if r.cfg.AssumeChannelValid {
+		// graph. The same applies to edges of our own channels that use
+		// an alias, as it doesn't point to a location in the chain.
+		isAlias := r.cfg.IsAlias != nil && r.cfg.IsAlias(
+			lnwire.NewShortChanIDFromInt(msg.ChannelID),
+		)
+		if r.cfg.AssumeChannelValid || isAlias {
 			if err := r.cfg.Graph.AddChannelEdge(msg, op...); err != nil {
 				return fmt.Errorf("unable to add edge: %v", err)
 			}
@@ -1732,13 +1765,59 @@ type routingMsg struct {
 // FindRoute attempts to query the ChannelRouter for the optimum path to a
 // particular target destination to which it is able to send `amt` after
 // factoring in channel capacities and cumulative fees along the route.
+//
+// If blinded payment paths are provided, the route leads through one of them
+// and target is only used as the virtual end point of the paths during path
+// finding. In that case routeHints must be empty.
 func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 	amt lnwire.MilliBronees, restrictions *RestrictParams,
 	destCustomRecords record.CustomSet,
 	routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
+	blindedPayments []*blindedpath.BlindedPayment,
 	finalExpiry uint16) (*route.Route, error) {
 
-	log.Debugf("Searching for path to %v, sending %v", target, amt)
+	routes, err := r.FindRoutes(
+		source, target, amt, restrictions, destCustomRecords,
+		routeHints, blindedPayments, finalExpiry, 1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return routes[0], nil
+}
+
+// FindRoutes is like FindRoute, but returns up to numRoutes alternative routes
+// in order of increasing cost. The first route is the one that FindRoute
+// returns. The alternatives are the next best loopless routes that visit a
+// different sequence of nodes. Fewer routes are returned if no more routes
+// exist that satisfy the restrictions.
+func (r *ChannelRouter) FindRoutes(source, target route.Vertex,
+	amt lnwire.MilliBronees, restrictions *RestrictParams,
+	destCustomRecords record.CustomSet,
+	routeHints map[route.Vertex][]*channeldb.CachedEdgePolicy,
+	blindedPayments []*blindedpath.BlindedPayment,
+	finalExpiry uint16, numRoutes int) ([]*route.Route, error) {
+
+	log.Debugf("Searching for %v paths to %v, sending %v", numRoutes,
+		target, amt)
+
+	additionalEdges := routeHints
+	if len(blindedPayments) > 0 {
+		if len(routeHints) > 0 {
+			return nil, errBlindedPathWithHints
+		}
+
+		additionalEdges = make(
+			map[route.Vertex][]*channeldb.CachedEdgePolicy,
+		)
+		err := BlindedPaymentsToEdges(
+			blindedPayments, target, additionalEdges,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	// We'll attempt to obtain a set of bandwidth hints that can help us
 	// eliminate certain routes early on in the path finding process.
@@ -1760,41 +1839,54 @@ func (r *ChannelRouter) FindRoute(source, target route.Vertex,
 	// execute our path finding algorithm.
 	finalHtlcExpiry := currentHeight + int32(finalExpiry)
 
-	path, err := findPath(
+	paths, err := findPaths(
 		&graphParams{
-			additionalEdges: routeHints,
+			additionalEdges: additionalEdges,
 			bandwidthHints:  bandwidthHints,
 			graph:           r.cachedGraph,
 		},
 		restrictions,
 		&r.cfg.PathFindingConfig,
-		source, target, amt, finalHtlcExpiry,
+		source, target, amt, finalHtlcExpiry, numRoutes,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create the route with absolute time lock values.
-	route, err := newRoute(
-		source, path, uint32(currentHeight),
-		finalHopParams{
-			amt:       amt,
-			totalAmt:  amt,
-			cltvDelta: finalExpiry,
-			records:   destCustomRecords,
-		},
-	)
-	if err != nil {
-		return nil, err
-	}
+	routes := make([]*route.Route, 0, len(paths))
+	for _, path := range paths {
+		blindedPayment, err := blindedPaymentForPath(
+			path, blindedPayments,
+		)
+		if err != nil {
+			return nil, err
+		}
 
-	go log.Tracef("Obtained path to send %v to %x: %v",
-		amt, target, newLogClosure(func() string {
-			return spew.Sdump(route)
-		}),
-	)
+		// Create the route with absolute time lock values.
+		route, err := newRoute(
+			source, path, uint32(currentHeight),
+			finalHopParams{
+				amt:            amt,
+				totalAmt:       amt,
+				cltvDelta:      finalExpiry,
+				records:        destCustomRecords,
+				blindedPayment: blindedPayment,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		go log.Tracef("Obtained path to send %v to %x: %v",
+			amt, target, newLogClosure(func() string {
+				return spew.Sdump(route)
+			}),
+		)
 
-	return route, nil
+		routes = append(routes, route)
+	}
+
+	return routes, nil
 }
 
 // generateNewSessionKey generates a new ephemeral private key to be used for a
@@ -1921,6 +2013,13 @@ type LightningPayment struct {
 	// destination successfully.
 	RouteHints [][]zpay32.HopHint
 
+	// BlindedPayments is an optional set of blinded paths to the
+	// destination. If set, the payment is routed to the introduction node
+	// of one of the paths and then through its blinded hops. In that case
+	// Target doesn't need to be a real node, but it should not be part of
+	// the graph, and route hints may not be provided.
+	BlindedPayments []*blindedpath.BlindedPayment
+
 	// OutgoingChannelIDs is the list of channels that are allowed for the
 	// first hop. If nil, any channel may be used.
 	OutgoingChannelIDs []uint64
@@ -1929,6 +2028,18 @@ type LightningPayment struct {
 	// is reached. If nil, any node may be used.
 	LastHop *route.Vertex
 
+	// IgnoredNodes is the set of nodes that the payment may not be
+	// forwarded through.
+	IgnoredNodes map[route.Vertex]struct{}
+
+	// IgnoredPairs is the set of directed node pairs that the payment may
+	// not be forwarded over.
+	IgnoredPairs map[DirectedNodePair]struct{}
+
+	// IgnoredChannels is the set of channel directions that the payment
+	// may not use.
+	IgnoredChannels map[EdgeLocator]struct{}
+
 	// DestFeatures specifies the set of features we assume the final node
 	// has for pathfinding. Typically these will be taken directly from an
 	// invoice, but they can also be manually supplied or assumed by the
@@ -1963,6 +2074,18 @@ type LightningPayment struct {
 	//
 	// NOTE: This field is _optional_.
 	MaxShardAmt *lnwire.MilliBronees
+
+	// MaxParallelShards is the maximum number of shards that may be in
+	// flight at the same time. Once it is reached, no new shards are
+	// launched until one of the in-flight shards is resolved. A value of
+	// zero means that only MaxParts limits the number of shards.
+	MaxParallelShards uint32
+
+	// TrampolineOnion is an optional serialized trampoline onion that is
+	// handed to the target, which then forwards the payment towards the
+	// final recipient. It requires the target to understand the TLV
+	// onion payload format.
+	TrampolineOnion []byte
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -2148,11 +2271,17 @@ func (r *ChannelRouter) SendToRoute(htlcHash lntypes.Hash, rt *route.Route) (
 
 	// If this is meant as a MP payment shard, we set the amount
 	// for the creating info to the total amount of the payment.
+	// Shards of payments to blinded routes carry it in the final hop
+	// instead.
 	finalHop := rt.Hops[len(rt.Hops)-1]
 	mpp := finalHop.MPP
 	if mpp != nil {
 		amt = mpp.TotalMsat()
 	}
+	isShard := mpp != nil || finalHop.TotalAmtMsat != 0
+	if finalHop.TotalAmtMsat != 0 {
+		amt = finalHop.TotalAmtMsat
+	}
 
 	// For non-AMP payments the overall payment identifier will be the same
 	// hash as used for this HTLC.
@@ -2178,7 +2307,7 @@ func (r *ChannelRouter) SendToRoute(htlcHash lntypes.Hash, rt *route.Route) (
 	switch {
 	// If this is an MPP attempt and the hash is already registered with
 	// the database, we can go on to launch the shard.
-	case err == channeldb.ErrPaymentInFlight && mpp != nil:
+	case err == channeldb.ErrPaymentInFlight && isShard:
 
 	// Any other error is not tolerated.
 	case err != nil:
@@ -2497,6 +2626,28 @@ func (r *ChannelRouter) GetChannelByID(chanID lnwire.ShortChannelID) (
 	return r.cfg.Graph.FetchChannelEdgesByID(chanID.ToUint64())
 }
 
+// FetchAmountPairCapacity determines the maximal public capacity between two
+// nodes depending on the amount we try to send.
+func (r *ChannelRouter) FetchAmountPairCapacity(nodeFrom, nodeTo route.Vertex,
+	amount lnwire.MilliBronees) (bronutil.Amount, error) {
+
+	// Create unified policies for all incoming connections.
+	u := newUnifiedPolicies(r.selfNode.PubKeyBytes, nodeTo, nil, nil)
+
+	err := u.addGraphPolicies(r.cachedGraph)
+	if err != nil {
+		return 0, err
+	}
+
+	edgeUnifier, ok := u.policies[nodeFrom]
+	if !ok {
+		return 0, fmt.Errorf("no edge info for node pair %v -> %v",
+			nodeFrom, nodeTo)
+	}
+
+	return edgeUnifier.capacity(amount), nil
+}
+
 // FetchLightningNode attempts to look up a target node by its identity public
 // key. channeldb.ErrGraphNodeNotFound is returned if the node doesn't exist
 // within the graph.
@@ -2711,6 +2862,9 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliBronees,
 	}
 
 	// Traverse hops backwards to accumulate fees in the running amounts.
+	// The outbound fee of the previously visited hop bounds the discount
+	// that it can give through its inbound fee.
+	var outboundFee lnwire.MilliBronees
 	source := r.selfNode.PubKeyBytes
 	for i := len(hops) - 1; i >= 0; i-- {
 		toNode := hops[i]
@@ -2726,7 +2880,7 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliBronees,
 
 		// Build unified policies for this hop based on the channels
 		// known in the graph.
-		u := newUnifiedPolicies(source, toNode, outgoingChans)
+		u := newUnifiedPolicies(source, toNode, outgoingChans, nil)
 
 		err := u.addGraphPolicies(r.cachedGraph)
 		if err != nil {
@@ -2760,9 +2914,24 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliBronees,
 			}
 		}
 
+		// Add the inbound fee that the to node charges for this
+		// channel. The final node doesn't charge an inbound fee, and
+		// routing nodes treat a negative total fee as zero.
+		if i < len(hops)-1 {
+			inboundFee := policy.InboundFee.CalcFee(runningAmt)
+			if inboundFee < -int64(outboundFee) {
+				inboundFee = -int64(outboundFee)
+			}
+			runningAmt = lnwire.MilliBronees(
+				int64(runningAmt) + inboundFee,
+			)
+		}
+
 		// Add fee for this hop.
+		outboundFee = 0
 		if !localChan {
-			runningAmt += policy.ComputeFee(runningAmt)
+			outboundFee = policy.ComputeFee(runningAmt)
+			runningAmt += outboundFee
 		}
 
 		log.Tracef("Select channel %v at position %v", policy.ChannelID, i)
@@ -2787,8 +2956,8 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliBronees,
 
 		if i > 0 {
 			// Decrease the amount to send while going forward.
-			receiverAmt -= policy.ComputeFeeFromIncoming(
-				receiverAmt,
+			receiverAmt = forwardAmt(
+				receiverAmt, pathEdges[i-1], policy,
 			)
 		}
 
@@ -0,0 +1,159 @@
+package routing
+
+import (
+	"math"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/bronutil"
+)
+
+// DefaultSplitProbabilityGain is the default increase in success probability
+// that sending half of a shard over the same path must achieve for the
+// planner to split the shard, even though a route for the full shard was
+// found.
+const DefaultSplitProbabilityGain = 0.1
+
+// shardPlanner decides how the amount and fee budget of a multi-part payment
+// are spread across its shards. It sizes shards by the liquidity of our local
+// channels and the estimated success probability of their routes, makes sure
+// that the remaining amount can still be sent in the shard slots that are
+// left, and gives every shard a share of the fee budget that is proportional
+// to its amount. This way the shards in flight never spend more than the fee
+// limit of the payment, and the first shards can't use up the budget of the
+// ones that follow.
+type shardPlanner struct {
+	// minShardAmt is the amount beyond which we won't try to further split
+	// the payment if no route is found.
+	minShardAmt lnwire.MilliBronees
+
+	// maxParts is the maximum number of shards that may be in flight.
+	maxParts uint32
+
+	// maxParallelShards is the maximum number of shards that may be in
+	// flight at the same time. Zero means that only maxParts applies.
+	maxParallelShards uint32
+
+	// splitProbabilityGain is the increase in success probability that
+	// half of a shard must achieve over the same path for the planner to
+	// split it.
+	splitProbabilityGain float64
+}
+
+// newShardPlanner creates a shard planner for the given payment.
+func newShardPlanner(p *LightningPayment) shardPlanner {
+	return shardPlanner{
+		minShardAmt:          DefaultShardMinAmt,
+		maxParts:             p.MaxParts,
+		maxParallelShards:    p.MaxParallelShards,
+		splitProbabilityGain: DefaultSplitProbabilityGain,
+	}
+}
+
+// freeSlots returns the number of shards that may still be launched given
+// the number of shards that are currently in flight.
+func (s *shardPlanner) freeSlots(activeShards uint32) uint32 {
+	// A payment without a limit on its parts isn't split.
+	limit := s.maxParts
+	if limit == 0 {
+		limit = 1
+	}
+	if s.maxParallelShards != 0 && s.maxParallelShards < limit {
+		limit = s.maxParallelShards
+	}
+
+	if activeShards >= limit {
+		return 0
+	}
+
+	return limit - activeShards
+}
+
+// minAmt returns the smallest amount that the next shard may carry. Shards
+// must not be smaller than the minimum shard amount, and large enough for
+// the remaining amount to fit into the free shard slots. If only one slot is
+// left, the shard has to carry the full remaining amount.
+func (s *shardPlanner) minAmt(remainingAmt lnwire.MilliBronees,
+	activeShards uint32) lnwire.MilliBronees {
+
+	slots := lnwire.MilliBronees(s.freeSlots(activeShards))
+	if slots == 0 {
+		slots = 1
+	}
+
+	amt := (remainingAmt + slots - 1) / slots
+	if amt < s.minShardAmt {
+		amt = s.minShardAmt
+	}
+	if amt > remainingAmt {
+		amt = remainingAmt
+	}
+
+	return amt
+}
+
+// feeBudget returns the part of the remaining fee budget that a shard of the
+// given amount may spend. It is proportional to the part of the remaining
+// amount that the shard carries, so that the last shard receives whatever is
+// left of the budget.
+func (s *shardPlanner) feeBudget(amt, remainingAmt,
+	remainingFees lnwire.MilliBronees) lnwire.MilliBronees {
+
+	if amt >= remainingAmt || remainingAmt == 0 {
+		return remainingFees
+	}
+
+	// Use floating point math to not overflow for large fee limits.
+	budget := float64(remainingFees) * float64(amt) / float64(remainingAmt)
+	if budget >= float64(remainingFees) {
+		return remainingFees
+	}
+
+	return lnwire.MilliBronees(math.Floor(budget))
+}
+
+// shouldSplit returns true if sending half of a shard over the same path is
+// estimated to succeed considerably more often than sending the full shard.
+// In that case, two half shards are expected to deliver more of the payment
+// than the full one.
+func (s *shardPlanner) shouldSplit(fullProbability,
+	halfProbability float64) bool {
+
+	return halfProbability-fullProbability > s.splitProbabilityGain
+}
+
+// pathProbability estimates the success probability of sending the given
+// amount over the path. The fees of the path are ignored, as the estimate is
+// only used to compare different amounts over the same path.
+func pathProbability(g routingGraph, source route.Vertex,
+	path []*channeldb.CachedEdgePolicy, amt lnwire.MilliBronees,
+	probabilitySource func(route.Vertex, route.Vertex,
+		lnwire.MilliBronees, bronutil.Amount) float64) (float64, error) {
+
+	probability := 1.0
+	from := source
+	for _, edge := range path {
+		// Look up the capacity of the channel, which remains zero for
+		// channels that are only known from route hints.
+		var capacity bronutil.Amount
+		err := g.forEachNodeChannel(
+			from, func(channel *channeldb.DirectedChannel) error {
+				if channel.ChannelID == edge.ChannelID {
+					capacity = channel.Capacity
+				}
+
+				return nil
+			},
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		to := edge.ToNodePubKey()
+		probability *= probabilitySource(from, to, amt, capacity)
+		from = to
+	}
+
+	return probability, nil
+}
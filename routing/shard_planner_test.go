@@ -0,0 +1,67 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestShardPlanner tests the shard slot, amount and fee budget decisions of
+// the shard planner.
+func TestShardPlanner(t *testing.T) {
+	t.Parallel()
+
+	planner := shardPlanner{
+		minShardAmt:          1_000,
+		maxParts:             4,
+		maxParallelShards:    2,
+		splitProbabilityGain: DefaultSplitProbabilityGain,
+	}
+
+	// The parallel shard limit applies if it is below the maximum number
+	// of parts.
+	require.EqualValues(t, 2, planner.freeSlots(0))
+	require.EqualValues(t, 1, planner.freeSlots(1))
+	require.EqualValues(t, 0, planner.freeSlots(2))
+	require.EqualValues(t, 0, planner.freeSlots(3))
+
+	// The remaining amount must fit into the free slots, but shards are
+	// never smaller than the minimum shard amount or larger than the
+	// remaining amount.
+	require.EqualValues(t, 50_000, planner.minAmt(100_000, 0))
+	require.EqualValues(t, 50_001, planner.minAmt(100_001, 0))
+	require.EqualValues(t, 100_000, planner.minAmt(100_000, 1))
+	require.EqualValues(t, 1_000, planner.minAmt(1_500, 0))
+	require.EqualValues(t, 500, planner.minAmt(500, 0))
+
+	// Without a parallel shard limit, the maximum number of parts applies.
+	planner.maxParallelShards = 0
+	require.EqualValues(t, 4, planner.freeSlots(0))
+	require.EqualValues(t, 25_000, planner.minAmt(100_000, 0))
+
+	// A payment without a limit on its parts isn't split.
+	planner.maxParts = 0
+	require.EqualValues(t, 1, planner.freeSlots(0))
+	require.EqualValues(t, 100_000, planner.minAmt(100_000, 0))
+
+	// Shards get a proportional part of the fee budget, and the shard
+	// that carries the remaining amount gets all of it.
+	require.EqualValues(t, 300, planner.feeBudget(30_000, 100_000, 1_000))
+	require.EqualValues(t, 333, planner.feeBudget(1, 3, 1_000))
+	require.EqualValues(
+		t, 1_000, planner.feeBudget(100_000, 100_000, 1_000),
+	)
+	require.EqualValues(t, 0, planner.feeBudget(1, 100_000, 0))
+
+	// Large fee limits must not overflow.
+	maxFee := lnwire.MaxMilliBronees
+	budget := planner.feeBudget(50_000, 100_000, maxFee)
+	require.True(t, budget > 0 && budget <= maxFee)
+
+	// Shards are only split if half of them is considerably more likely
+	// to succeed.
+	require.True(t, planner.shouldSplit(0.5, 0.7))
+	require.False(t, planner.shouldSplit(0.5, 0.55))
+	require.False(t, planner.shouldSplit(0.9, 0.9))
+}
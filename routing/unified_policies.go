@@ -22,18 +22,24 @@ type unifiedPolicies struct {
 	// outChanRestr is an optional outgoing channel restriction for the
 	// local channel to use.
 	outChanRestr map[uint64]struct{}
+
+	// ignoredChans is an optional set of channel directions that may not
+	// be used.
+	ignoredChans map[EdgeLocator]struct{}
 }
 
 // newUnifiedPolicies instantiates a new unifiedPolicies object. Channel
 // policies can be added to this object.
 func newUnifiedPolicies(sourceNode, toNode route.Vertex,
-	outChanRestr map[uint64]struct{}) *unifiedPolicies {
+	outChanRestr map[uint64]struct{},
+	ignoredChans map[EdgeLocator]struct{}) *unifiedPolicies {
 
 	return &unifiedPolicies{
 		policies:     make(map[route.Vertex]*unifiedPolicy),
 		toNode:       toNode,
 		sourceNode:   sourceNode,
 		outChanRestr: outChanRestr,
+		ignoredChans: ignoredChans,
 	}
 }
 
@@ -51,6 +57,14 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 		}
 	}
 
+	// Skip channel directions that may not be used.
+	if len(u.ignoredChans) > 0 {
+		locator := NewEdgeLocator(edge.ChannelID, fromNode, u.toNode)
+		if _, ok := u.ignoredChans[locator]; ok {
+			return
+		}
+	}
+
 	// Update the policies map.
 	policy, ok := u.policies[fromNode]
 	if !ok {
@@ -206,7 +220,7 @@ func (u *unifiedPolicy) getPolicyNetwork(
 
 	var (
 		bestPolicy  *channeldb.CachedEdgePolicy
-		maxFee      lnwire.MilliBronees
+		maxFee      int64
 		maxTimelock uint16
 	)
 
@@ -230,9 +244,11 @@ func (u *unifiedPolicy) getPolicyNetwork(
 		}
 
 		// Use the policy that results in the highest fee for this
-		// specific amount.
-		fee := edge.policy.ComputeFee(amt)
-		if fee < maxFee {
+		// specific amount. The inbound fee that the to node charges for
+		// the channel is part of the fee and may be negative.
+		fee := int64(edge.policy.ComputeFee(amt)) +
+			edge.policy.InboundFee.CalcFee(amt)
+		if bestPolicy != nil && fee < maxFee {
 			continue
 		}
 		maxFee = fee
@@ -261,6 +277,24 @@ func (u *unifiedPolicy) getPolicyNetwork(
 	return &modifiedPolicy
 }
 
+// capacity returns the largest capacity of the channels that can carry the
+// given amount. In a non-strict forwarding context, the routing node is free to
+// use any of them. Zero is returned if the capacity is unknown.
+func (u *unifiedPolicy) capacity(amt lnwire.MilliBronees) bronutil.Amount {
+	var maxCapacity bronutil.Amount
+	for _, edge := range u.edges {
+		if !edge.amtInRange(amt) {
+			continue
+		}
+
+		if edge.capacity > maxCapacity {
+			maxCapacity = edge.capacity
+		}
+	}
+
+	return maxCapacity
+}
+
 // minAmt returns the minimum amount that can be forwarded on this connection.
 func (u *unifiedPolicy) minAmt() lnwire.MilliBronees {
 	min := lnwire.MaxMilliBronees
@@ -17,7 +17,7 @@ func TestUnifiedPolicies(t *testing.T) {
 
 	bandwidthHints := &mockBandwidthHints{}
 
-	u := newUnifiedPolicies(source, toNode, nil)
+	u := newUnifiedPolicies(source, toNode, nil, nil)
 
 	// Add two channels between the pair of nodes.
 	p1 := channeldb.CachedEdgePolicy{
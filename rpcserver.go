@@ -49,6 +49,7 @@ import (
 	"github.com/brsuite/broln/lnwallet/chanfunding"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/macaroons"
+	"github.com/brsuite/broln/onionmessage"
 	"github.com/brsuite/broln/peer"
 	"github.com/brsuite/broln/peernotifier"
 	"github.com/brsuite/broln/record"
@@ -207,6 +208,11 @@ var (
 	// macaroon related services are used.
 	errMacaroonDisabled = fmt.Errorf("macaroon authentication disabled, " +
 		"remove --no-macaroons flag to enable")
+
+	// errOnionMessagesDisabled is returned when the onion message RPCs are
+	// used without the --protocol.onion-messages flag.
+	errOnionMessagesDisabled = errors.New("onion messages not enabled, " +
+		"use --protocol.onion-messages flag to enable")
 )
 
 // stringInSlice returns true if a string is contained in the given slice.
@@ -337,6 +343,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/BatchOpenChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -394,6 +407,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListAliases": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SubscribeChannelEvents": {{
 			Entity: "offchain",
 			Action: "read",
@@ -576,6 +593,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendOnionMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeOnionMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 	}
 }
 
@@ -680,6 +705,22 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 		return err
 	}
 	graph := s.graphDB
+
+	// Load the node tags that payments and route queries may avoid, if a
+	// node tags file is configured.
+	var nodeTags routing.NodeTags
+	if subServerCgs.RouterRPC.NodeTagsFile != "" {
+		nodeTags, err = routing.LoadNodeTags(
+			subServerCgs.RouterRPC.NodeTagsFile,
+		)
+		if err != nil {
+			return err
+		}
+
+		rpcsLog.Infof("Loaded tags of %v nodes from %v", len(nodeTags),
+			subServerCgs.RouterRPC.NodeTagsFile)
+	}
+
 	routerBackend := &routerrpc.RouterBackend{
 		SelfNode: selfNode.PubKeyBytes,
 		FetchChannelCapacity: func(chanID uint64) (bronutil.Amount,
@@ -706,14 +747,17 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 
 			return info.NodeKey1Bytes, info.NodeKey2Bytes, nil
 		},
-		FindRoute:              s.chanRouter.FindRoute,
-		MissionControl:         s.missionControl,
-		ActiveNetParams:        r.cfg.ActiveNetParams.Params,
-		Tower:                  s.controlTower,
-		MaxTotalTimelock:       r.cfg.MaxOutgoingCltvExpiry,
-		DefaultFinalCltvDelta:  uint16(r.cfg.Brocoin.TimeLockDelta),
-		SubscribeHtlcEvents:    s.htlcNotifier.SubscribeHtlcEvents,
-		InterceptableForwarder: s.interceptableSwitch,
+		FetchAmountPairCapacity: s.chanRouter.FetchAmountPairCapacity,
+		FindRoute:               s.chanRouter.FindRoute,
+		FindRoutes:              s.chanRouter.FindRoutes,
+		MissionControl:          s.missionControl,
+		ActiveNetParams:         r.cfg.ActiveNetParams.Params,
+		Tower:                   s.controlTower,
+		MaxTotalTimelock:        r.cfg.MaxOutgoingCltvExpiry,
+		DefaultFinalCltvDelta:   uint16(r.cfg.Brocoin.TimeLockDelta),
+		SubscribeHtlcEvents:     s.htlcNotifier.SubscribeHtlcEvents,
+		InterceptableForwarder:  s.interceptableSwitch,
+		BestHeight:              s.htlcSwitch.BestHeight,
 		SetChannelEnabled: func(outpoint wire.OutPoint) error {
 			return s.chanStatusMgr.RequestEnable(outpoint, true)
 		},
@@ -721,6 +765,14 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 			return s.chanStatusMgr.RequestDisable(outpoint, true)
 		},
 		SetChannelAuto: s.chanStatusMgr.RequestAuto,
+		NodeTags:       nodeTags,
+		Prober:         s.prober,
+		DeletePayment: func(hash lntypes.Hash) error {
+			return s.miscDB.DeletePayment(hash, false)
+		},
+	}
+	if s.offers != nil {
+		routerBackend.FetchOfferInvoice = s.offers.FetchInvoice
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
@@ -742,7 +794,8 @@ func (r *rpcServer) addDeps(s *server, macService *macaroons.Service,
 	// TODO(roasbeef): extend sub-sever config to have both (local vs remote) DB
 	err = subServerCgs.PopulateDependencies(
 		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, r.cfg.ActiveNetParams.Params, s.chanRouter,
+		s.invoiceHtlcModifier, s.offers, s.htlcSwitch,
+		r.cfg.ActiveNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
 		s.sweeper, tower, s.towerClient, s.anchorTowerClient,
 		r.cfg.net.ResolveTCPAddr, genInvoiceFeatures,
@@ -2024,6 +2077,66 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 			in.CommitmentType)
 	}
 
+	// Zero-conf and option-scid-alias channels are signalled through the
+	// channel type, so we'll add the respective bits to it, defaulting to
+	// an anchors channel if no commitment type was specified.
+	if in.ZeroConf || in.ScidAlias {
+		if in.ZeroConf && !r.cfg.ProtocolOptions.ZeroConf() {
+			return nil, fmt.Errorf("cannot open zero-conf " +
+				"channel, zero-conf protocol option not " +
+				"enabled")
+		}
+		if in.ScidAlias && !r.cfg.ProtocolOptions.ScidAlias() {
+			return nil, fmt.Errorf("cannot open option-scid-alias " +
+				"channel, option-scid-alias protocol option " +
+				"not enabled")
+		}
+		if in.ScidAlias && !in.Private {
+			return nil, fmt.Errorf("option-scid-alias channels " +
+				"must be private")
+		}
+
+		if channelType == nil {
+			channelType = new(lnwire.ChannelType)
+			*channelType = lnwire.ChannelType(
+				*lnwire.NewRawFeatureVector(
+					lnwire.StaticRemoteKeyRequired,
+					lnwire.AnchorsZeroFeeHtlcTxRequired,
+				),
+			)
+		}
+
+		rawFeatures := (*lnwire.RawFeatureVector)(channelType)
+		if in.ZeroConf {
+			rawFeatures.Set(lnwire.ZeroConfRequired)
+		}
+		if in.ScidAlias {
+			rawFeatures.Set(lnwire.ScidAliasRequired)
+		}
+	}
+
+	// Dual funded channels are funded by the wallet of both parties, so
+	// there's nothing to push to the remote party.
+	if in.DualFund {
+		if !r.cfg.ProtocolOptions.DualFund() {
+			return nil, fmt.Errorf("cannot open dual funded " +
+				"channel, dual-fund protocol option not " +
+				"enabled")
+		}
+		if in.ZeroConf {
+			return nil, fmt.Errorf("dual funded channels can't " +
+				"be zero-conf")
+		}
+		if remoteInitialBalance != 0 {
+			return nil, fmt.Errorf("dual funded channels can't " +
+				"push funds to the remote peer")
+		}
+		if in.FundingShim != nil {
+			return nil, fmt.Errorf("dual funded channels can " +
+				"only be funded by the wallet")
+		}
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -2042,6 +2155,7 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 		MaxHtlcs:         maxHtlcs,
 		MaxLocalCsv:      uint16(in.MaxLocalCsv),
 		ChannelType:      channelType,
+		DualFund:         in.DualFund,
 	}, nil
 }
 
@@ -2357,8 +2471,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 			//  * so only need to grab from database
 			peer.WipeChannel(&channel.FundingOutpoint)
 		} else {
-			chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
-			r.server.htlcSwitch.RemoveLink(chanID)
+			r.server.htlcSwitch.RemoveLink(channel.ChanID())
 		}
 
 		// With the necessary indexes cleaned up, we'll now force close
@@ -2411,8 +2524,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 
 		// If the link is not known by the switch, we cannot gracefully close
 		// the channel.
-		channelID := lnwire.NewChanIDFromOutPoint(chanPoint)
-		if _, err := r.server.htlcSwitch.GetLink(channelID); err != nil {
+		if _, err := r.server.htlcSwitch.GetLink(channel.ChanID()); err != nil {
 			rpcsLog.Debugf("Trying to non-force close offline channel with "+
 				"chan_point=%v", chanPoint)
 			return fmt.Errorf("unable to gracefully close channel while peer "+
@@ -2536,6 +2648,113 @@ func createRPCCloseUpdate(update interface{}) (
 	return nil, errors.New("unknown close status update")
 }
 
+// SpliceChannel splices funds into or out of an active channel. The call
+// returns once the splice transaction is signed by both parties and broadcast.
+func (r *rpcServer) SpliceChannel(ctx context.Context,
+	in *lnrpc.SpliceChannelRequest) (*lnrpc.SpliceChannelResponse, error) {
+
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	if !r.cfg.ProtocolOptions.Splice() {
+		return nil, fmt.Errorf("cannot splice channel, splice " +
+			"protocol option not enabled")
+	}
+
+	if in.GetChannelPoint() == nil {
+		return nil, fmt.Errorf("must specify channel point in splice " +
+			"channel")
+	}
+
+	switch {
+	case in.Amount != 0 && len(in.Psbt) != 0:
+		return nil, fmt.Errorf("either amount or psbt should be set, " +
+			"but not both")
+
+	case in.Amount == 0 && len(in.Psbt) == 0:
+		return nil, fmt.Errorf("must specify amount or psbt to " +
+			"splice channel")
+	}
+
+	var (
+		packet *psbt.Packet
+		err    error
+	)
+	if len(in.Psbt) != 0 {
+		packet, err = psbt.NewFromRawBytes(
+			bytes.NewReader(in.Psbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing PSBT: %v", err)
+		}
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	feeRate, err := calculateFeeRate(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[splicechannel] request for ChannelPoint(%v), "+
+		"amount=%v, fee_rate=%v", chanPoint, in.Amount, feeRate)
+
+	channel, err := r.server.chanStateDB.FetchChannel(nil, *chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := r.server.htlcSwitch.GetLinkByChanPoint(*chanPoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find link of channel: %v",
+			err)
+	}
+
+	// The lock time of the splice transaction is set to the current
+	// height to discourage fee sniping.
+	_, bestHeight, err := r.server.cc.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	intent, err := r.server.cc.Wallet.FundSplice(
+		channel, bronutil.Amount(in.Amount), packet, feeRate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resultChan := link.Splice(&htlcswitch.SpliceRequest{
+		Intent:   intent,
+		LockTime: uint32(bestHeight),
+	})
+
+	select {
+	case result := <-resultChan:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+
+		return &lnrpc.SpliceChannelResponse{
+			Txid: result.SpliceTx.TxHash().String(),
+		}, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+}
+
 // abandonChanFromGraph attempts to remove a channel from the channel graph. If
 // we can't find the chanID in the graph, then we assume it has already been
 // removed, and will return a nop.
@@ -2697,8 +2916,7 @@ func (r *rpcServer) GetInfo(_ context.Context,
 
 	var activeChannels uint32
 	for _, channel := range openChannels {
-		chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
-		if r.server.htlcSwitch.HasActiveLink(chanID) {
+		if r.server.htlcSwitch.HasActiveLink(channel.ChanID()) {
 			activeChannels++
 		}
 	}
@@ -3778,6 +3996,31 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
+// ListAliases returns the set of all aliases that have ever existed with
+// their confirmed SCID (if it exists) and/or the base SCID (in the case of
+// zero conf).
+func (r *rpcServer) ListAliases(ctx context.Context,
+	in *lnrpc.ListAliasesRequest) (*lnrpc.ListAliasesResponse, error) {
+
+	rpcsLog.Debugf("[listaliases]")
+
+	resp := &lnrpc.ListAliasesResponse{}
+	for base, aliases := range r.server.aliasStore.ListAliases() {
+		aliasMap := &lnrpc.AliasMap{
+			BaseScid: base.ToUint64(),
+		}
+		for _, alias := range aliases {
+			aliasMap.Aliases = append(
+				aliasMap.Aliases, alias.ToUint64(),
+			)
+		}
+
+		resp.AliasMaps = append(resp.AliasMaps, aliasMap)
+	}
+
+	return resp, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -3813,7 +4056,6 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	for _, dbChannel := range dbChannels {
 		nodePub := dbChannel.IdentityPub
 		nodePubBytes := nodePub.SerializeCompressed()
-		chanPoint := dbChannel.FundingOutpoint
 
 		// If the caller requested channels for a target node, skip any
 		// that don't match the provided pubkey.
@@ -3826,7 +4068,7 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 			peerOnline = true
 		}
 
-		channelID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+		channelID := dbChannel.ChanID()
 		var linkActive bool
 		if link, err := r.server.htlcSwitch.GetLink(channelID); err == nil {
 			// A channel is only considered active if it is known
@@ -3969,6 +4211,20 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		CsvDelay:             uint32(dbChannel.LocalChanCfg.CsvDelay),
 		LocalChanReserveSat:  int64(dbChannel.LocalChanCfg.ChanReserve),
 		RemoteChanReserveSat: int64(dbChannel.RemoteChanCfg.ChanReserve),
+		ZeroConf:             dbChannel.IsZeroConf(),
+	}
+
+	// Report any aliases of the channel, as well as its confirmed short
+	// channel ID if it was opened as a zero-conf channel.
+	aliases := r.server.aliasStore.GetAliases(dbChannel.ShortChannelID)
+	for _, alias := range aliases {
+		channel.AliasScids = append(
+			channel.AliasScids, alias.ToUint64(),
+		)
+	}
+	if dbChannel.IsZeroConf() && dbChannel.ZeroConfConfirmed() {
+		channel.ZeroConfConfirmedScid =
+			dbChannel.ZeroConfRealScid().ToUint64()
 	}
 
 	for i, htlc := range localCommit.Htlcs {
@@ -4406,6 +4662,12 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 			case channelnotifier.ActiveLinkEvent:
 				continue
 
+			// The new channel point of a spliced channel isn't
+			// exposed as an event type yet, it is reflected by
+			// ListChannels instead.
+			case channelnotifier.SplicedChannelEvent:
+				continue
+
 			case channelnotifier.FullyResolvedChannelEvent:
 				update = &lnrpc.ChannelEventUpdate{
 					Type: lnrpc.ChannelEventUpdate_FULLY_RESOLVED_CHANNEL,
@@ -5180,25 +5442,13 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 func (r *rpcServer) AddInvoice(ctx context.Context,
 	invoice *lnrpc.Invoice) (*lnrpc.AddInvoiceResponse, error) {
 
-	defaultDelta := r.cfg.Brocoin.TimeLockDelta
-	if r.cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
-		defaultDelta = r.cfg.Litecoin.TimeLockDelta
-	}
-
-	addInvoiceCfg := &invoicesrpc.AddInvoiceConfig{
-		AddInvoice:        r.server.invoices.AddInvoice,
-		IsChannelActive:   r.server.htlcSwitch.HasActiveLink,
-		ChainParams:       r.cfg.ActiveNetParams.Params,
-		NodeSigner:        r.server.nodeSigner,
-		DefaultCLTVExpiry: defaultDelta,
-		ChanDB:            r.server.chanStateDB,
-		Graph:             r.server.graphDB,
-		GenInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoice)
-		},
-		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
-			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
-		},
+	addInvoiceCfg := r.server.addInvoiceConfig()
+
+	// Blinded invoices can only be paid through peers that support route
+	// blinding, and we need to signal that we understand it as well.
+	if invoice.IsBlinded && !r.cfg.ProtocolOptions.RouteBlinding() {
+		return nil, fmt.Errorf("blinded invoices require route " +
+			"blinding to be enabled with protocol.route-blinding")
 	}
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMsat)
@@ -5221,6 +5471,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		Private:         invoice.Private,
 		RouteHints:      routeHints,
 		Amp:             invoice.IsAmp,
+		Blind:           invoice.IsBlinded,
 	}
 
 	if invoice.RPreimage != nil {
@@ -5600,6 +5851,14 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			Disabled:         c1.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
 			LastUpdate:       uint32(c1.LastUpdate.Unix()),
 		}
+
+		// Policies with malformed extra data are still reported, just
+		// without their inbound fee.
+		if inboundFee, err := c1.InboundFee(); err == nil {
+			policy := edge.Node1Policy
+			policy.InboundFeeBaseMsat = inboundFee.Base
+			policy.InboundFeeRateMilliMsat = inboundFee.Rate
+		}
 	}
 
 	if c2 != nil {
@@ -5612,6 +5871,14 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			Disabled:         c2.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
 			LastUpdate:       uint32(c2.LastUpdate.Unix()),
 		}
+
+		// Policies with malformed extra data are still reported, just
+		// without their inbound fee.
+		if inboundFee, err := c2.InboundFee(); err == nil {
+			policy := edge.Node2Policy
+			policy.InboundFeeBaseMsat = inboundFee.Base
+			policy.InboundFeeRateMilliMsat = inboundFee.Rate
+		}
 	}
 
 	return edge
@@ -6321,13 +6588,20 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		feeRateFixedPoint := edgePolicy.FeeProportionalMillionths
 		feeRate := float64(feeRateFixedPoint) / feeBase
 
+		inboundFee, err := edgePolicy.InboundFee()
+		if err != nil {
+			return err
+		}
+
 		// TODO(roasbeef): also add stats for revenue for each channel
 		feeReports = append(feeReports, &lnrpc.ChannelFeeReport{
-			ChanId:       chanInfo.ChannelID,
-			ChannelPoint: chanInfo.ChannelPoint.String(),
-			BaseFeeMsat:  int64(edgePolicy.FeeBaseMSat),
-			FeePerMil:    int64(feeRateFixedPoint),
-			FeeRate:      feeRate,
+			ChanId:             chanInfo.ChannelID,
+			ChannelPoint:       chanInfo.ChannelPoint.String(),
+			BaseFeeMsat:        int64(edgePolicy.FeeBaseMSat),
+			FeePerMil:          int64(feeRateFixedPoint),
+			FeeRate:            feeRate,
+			InboundBaseFeeMsat: inboundFee.Base,
+			InboundFeePerMil:   inboundFee.Rate,
 		})
 
 		return nil
@@ -6511,6 +6785,14 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		FeeRate: feeRateFixed,
 	}
 
+	// The inbound fee is only changed if it is set.
+	if req.InboundFee != nil {
+		feeSchema.InboundFee = &channeldb.InboundFee{
+			Base: req.InboundFee.BaseFeeMsat,
+			Rate: req.InboundFee.FeeRatePpm,
+		}
+	}
+
 	maxHtlc := lnwire.MilliBronees(req.MaxHtlcMsat)
 	var minHtlc *lnwire.MilliBronees
 	if req.MinHtlcMsatSpecified {
@@ -6527,9 +6809,9 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
 		"rate_fixed=%v, time_lock_delta: %v, "+
-		"min_htlc=%v, max_htlc=%v, targets=%v",
+		"min_htlc=%v, max_htlc=%v, inbound_fee=%v, targets=%v",
 		req.BaseFeeMsat, feeRateFixed, req.TimeLockDelta,
-		minHtlc, maxHtlc,
+		minHtlc, maxHtlc, spew.Sdump(feeSchema.InboundFee),
 		spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the local channel
@@ -7544,6 +7826,100 @@ func (r *rpcServer) SubscribeCustomMessages(req *lnrpc.SubscribeCustomMessagesRe
 	}
 }
 
+// SendOnionMessage sends an onion message to a node.
+func (r *rpcServer) SendOnionMessage(ctx context.Context,
+	req *lnrpc.SendOnionMessageRequest) (*lnrpc.SendOnionMessageResponse,
+	error) {
+
+	if r.server.onionMessenger == nil {
+		return nil, errOnionMessagesDisabled
+	}
+
+	sendReq := &onionmessage.SendRequest{
+		FinalHopTLVs: req.FinalHopTlvs,
+		ReplyPath:    req.ReplyPath,
+	}
+
+	switch {
+	case len(req.Destination) > 0 && req.BlindedPath != nil:
+		return nil, errors.New("destination and blinded_path cannot " +
+			"appear together")
+
+	case len(req.Destination) > 0:
+		dest, err := bronec.ParsePubKey(req.Destination, bronec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination: %v", err)
+		}
+		sendReq.Destination = dest
+
+	case req.BlindedPath != nil:
+		path, err := routerrpc.UnmarshalBlindedPath(req.BlindedPath)
+		if err != nil {
+			return nil, err
+		}
+		sendReq.BlindedPath = path
+
+	default:
+		return nil, onionmessage.ErrNoDestination
+	}
+
+	pathID, err := r.server.onionMessenger.SendMessage(sendReq)
+	switch {
+	case err == ErrPeerNotConnected:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &lnrpc.SendOnionMessageResponse{
+		ReplyPathId: pathID,
+	}, nil
+}
+
+// SubscribeOnionMessages subscribes to a stream of onion messages for which
+// we are the final recipient.
+func (r *rpcServer) SubscribeOnionMessages(
+	req *lnrpc.SubscribeOnionMessagesRequest,
+	server lnrpc.Lightning_SubscribeOnionMessagesServer) error {
+
+	if r.server.onionMessenger == nil {
+		return errOnionMessagesDisabled
+	}
+
+	client, err := r.server.onionMessenger.SubscribeMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case <-client.Quit():
+			return errors.New("shutdown")
+
+		case <-server.Context().Done():
+			return server.Context().Err()
+
+		case update := <-client.Updates():
+			msg := update.(*onionmessage.ReceivedMessage)
+
+			rpcMsg := &lnrpc.OnionMessage{
+				PathId:       msg.PathID,
+				FinalHopTlvs: msg.FinalHopTLVs,
+			}
+			if msg.ReplyPath != nil {
+				rpcMsg.ReplyPath = routerrpc.MarshalBlindedPath(
+					msg.ReplyPath,
+				)
+			}
+
+			if err := server.Send(rpcMsg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // rpcInitiator returns the correct lnrpc initiator for channels where we have
 // a record of the opening channel.
 func rpcInitiator(isInitiator bool) lnrpc.Initiator {
@@ -0,0 +1,627 @@
+// Package musig2 implements the MuSig2 multi-signature scheme as specified by
+// BIP327. The signers aggregate their public keys into a single key, exchange
+// two public nonces each and create partial signatures that are combined into
+// a BIP340 schnorr signature which is valid for the aggregate key.
+package musig2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"math/big"
+
+	"github.com/brsuite/broln/schnorr"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// PubKeySize is the size of the serialized public key of a signer.
+	PubKeySize = 33
+
+	// PubNonceSize is the size of a public nonce, which consists of two
+	// compressed points.
+	PubNonceSize = 2 * PubKeySize
+
+	// SecNonceSize is the size of a secret nonce, which consists of two
+	// scalars and the public key of the signer it was created for.
+	SecNonceSize = 2*32 + PubKeySize
+
+	// PartialSigSize is the size of a partial signature.
+	PartialSigSize = 32
+)
+
+var (
+	// curve is the secp256k1 curve.
+	curve = bronec.S256()
+
+	// curveN is the order of the curve.
+	curveN = curve.Params().N
+
+	// The tags of the hashes that are used by BIP327.
+	keyAggListTag  = []byte("KeyAgg list")
+	keyAggCoeffTag = []byte("KeyAgg coefficient")
+	nonceAuxTag    = []byte("MuSig/aux")
+	nonceTag       = []byte("MuSig/nonce")
+	nonceCoeffTag  = []byte("MuSig/noncecoef")
+	tapTweakTag    = []byte("TapTweak")
+
+	// ErrNoKeys is returned when an aggregate key is requested for an
+	// empty set of public keys.
+	ErrNoKeys = errors.New("no public keys to aggregate")
+
+	// ErrInfinity is returned when an aggregate key or a tweaked key is
+	// the point at infinity.
+	ErrInfinity = errors.New("key is the point at infinity")
+
+	// ErrInvalidTweak is returned when a tweak isn't smaller than the
+	// order of the curve.
+	ErrInvalidTweak = errors.New("invalid tweak")
+
+	// ErrUnknownSigner is returned when a public key isn't part of the
+	// aggregate key.
+	ErrUnknownSigner = errors.New("public key isn't part of the " +
+		"aggregate key")
+
+	// ErrInvalidNonce is returned when a public or secret nonce can't be
+	// parsed.
+	ErrInvalidNonce = errors.New("invalid nonce")
+
+	// ErrNonceKeyMismatch is returned when a secret nonce is used with a
+	// different private key than the one it was created for.
+	ErrNonceKeyMismatch = errors.New("secret nonce doesn't belong to " +
+		"the private key")
+
+	// ErrInvalidPartialSig is returned when a partial signature isn't
+	// valid.
+	ErrInvalidPartialSig = errors.New("invalid partial signature")
+
+	// ErrInvalidSignature is returned when the combined signature isn't
+	// valid for the aggregate key.
+	ErrInvalidSignature = errors.New("invalid combined signature")
+)
+
+// AggregateKey is the aggregate public key of a set of signers, together with
+// the tweaks that were applied to it.
+type AggregateKey struct {
+	// FinalKey is the aggregate key with all tweaks applied. Signatures
+	// of a session are valid for the x-only form of this key.
+	FinalKey *bronec.PublicKey
+
+	// PreTweakedKey is the aggregate key before any tweaks were applied.
+	// If the key is tweaked as a taproot output key, this is the internal
+	// key.
+	PreTweakedKey *bronec.PublicKey
+
+	// keys are the serialized public keys of the signers in the order in
+	// which they were aggregated.
+	keys [][PubKeySize]byte
+
+	// keysHash is the hash of all public keys of the signers.
+	keysHash [32]byte
+
+	// secondKey is the first key that differs from the first key in the
+	// list. Its coefficient is one.
+	secondKey [PubKeySize]byte
+
+	// gacc and tacc accumulate the sign and the value of the tweaks.
+	gacc *big.Int
+	tacc *big.Int
+}
+
+// AggregateKeys aggregates the public keys of the signers into a single key.
+// The order of the keys matters, so all signers must pass them in the same
+// order.
+func AggregateKeys(pubKeys []*bronec.PublicKey) (*AggregateKey, error) {
+	if len(pubKeys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	keys := make([][PubKeySize]byte, len(pubKeys))
+	var keysBytes []byte
+	for i, pubKey := range pubKeys {
+		copy(keys[i][:], pubKey.SerializeCompressed())
+		keysBytes = append(keysBytes, keys[i][:]...)
+	}
+
+	aggKey := &AggregateKey{
+		keys:     keys,
+		keysHash: schnorr.TaggedHash(keyAggListTag, keysBytes),
+		gacc:     big.NewInt(1),
+		tacc:     new(big.Int),
+	}
+
+	// The second distinct key in the list is assigned a coefficient of
+	// one. If all keys are the same, the zero key is used, which can't
+	// match any of them.
+	for _, key := range keys[1:] {
+		if key != keys[0] {
+			aggKey.secondKey = key
+			break
+		}
+	}
+
+	// The aggregate key is the sum of the keys of all signers, each
+	// multiplied by its coefficient.
+	x, y := new(big.Int), new(big.Int)
+	for i, pubKey := range pubKeys {
+		coeff := aggKey.coefficient(keys[i])
+		px, py := curve.ScalarMult(
+			pubKey.X, pubKey.Y, scalarBytes(coeff),
+		)
+		x, y = curve.Add(x, y, px, py)
+	}
+	if isInfinity(x, y) {
+		return nil, ErrInfinity
+	}
+
+	aggKey.FinalKey = newPubKey(x, y)
+	aggKey.PreTweakedKey = newPubKey(x, y)
+
+	return aggKey, nil
+}
+
+// coefficient returns the coefficient of the key of a signer.
+func (k *AggregateKey) coefficient(key [PubKeySize]byte) *big.Int {
+	if key == k.secondKey {
+		return big.NewInt(1)
+	}
+
+	hash := schnorr.TaggedHash(keyAggCoeffTag, k.keysHash[:], key[:])
+	coeff := new(big.Int).SetBytes(hash[:])
+
+	return coeff.Mod(coeff, curveN)
+}
+
+// signerCoefficient returns the coefficient of the key of a signer, or an
+// error if the key isn't part of the aggregate key.
+func (k *AggregateKey) signerCoefficient(
+	pubKey *bronec.PublicKey) (*big.Int, error) {
+
+	var key [PubKeySize]byte
+	copy(key[:], pubKey.SerializeCompressed())
+
+	for _, signerKey := range k.keys {
+		if signerKey == key {
+			return k.coefficient(key), nil
+		}
+	}
+
+	return nil, ErrUnknownSigner
+}
+
+// ApplyTweak adds the tweak to the aggregate key. An x-only tweak is added to
+// the key with an even y coordinate, as is done to derive a taproot output
+// key, while a plain tweak is added to the key as is.
+func (k *AggregateKey) ApplyTweak(tweak [32]byte, isXOnly bool) error {
+	t := new(big.Int).SetBytes(tweak[:])
+	if t.Cmp(curveN) >= 0 {
+		return ErrInvalidTweak
+	}
+
+	// An x-only tweak negates the key first if its y coordinate is odd.
+	g := big.NewInt(1)
+	qx, qy := k.FinalKey.X, k.FinalKey.Y
+	if isXOnly && !hasEvenY(qy) {
+		g.Sub(curveN, g)
+		qx, qy = negate(qx, qy)
+	}
+
+	tx, ty := curve.ScalarBaseMult(scalarBytes(t))
+	x, y := curve.Add(qx, qy, tx, ty)
+	if isInfinity(x, y) {
+		return ErrInfinity
+	}
+
+	k.FinalKey = newPubKey(x, y)
+	k.gacc = mulMod(g, k.gacc)
+	k.tacc = addMod(t, mulMod(g, k.tacc))
+
+	return nil
+}
+
+// ApplyTaprootTweak tweaks the aggregate key to become the taproot output key
+// that commits to the script root. The current key is used as the internal
+// key. An empty script root commits to a key spend only output, as specified
+// by BIP86.
+func (k *AggregateKey) ApplyTaprootTweak(scriptRoot []byte) error {
+	internalKey := schnorr.XOnly(k.FinalKey)
+	tweak := schnorr.TaggedHash(tapTweakTag, internalKey[:], scriptRoot)
+
+	return k.ApplyTweak(tweak, true)
+}
+
+// Nonces are the nonces of a signer for a single signing session.
+type Nonces struct {
+	// PubNonce is the public nonce that is sent to the other signers.
+	PubNonce [PubNonceSize]byte
+
+	// SecNonce is the secret nonce that must be kept private and must
+	// only be used to create a single partial signature.
+	SecNonce [SecNonceSize]byte
+}
+
+// GenNonces creates fresh nonces for the signer with the given private key.
+// The aggregate key is optional and only hardens the nonce derivation.
+func GenNonces(privKey *bronec.PrivateKey,
+	aggKey *bronec.PublicKey) (*Nonces, error) {
+
+	var randBytes [32]byte
+	if _, err := rand.Read(randBytes[:]); err != nil {
+		return nil, err
+	}
+
+	// The randomness is masked with the private key, so that the nonces
+	// remain secret even if the random number generator is broken.
+	auxHash := schnorr.TaggedHash(nonceAuxTag, randBytes[:])
+	privKeyBytes := scalarBytes(privKey.D)
+	for i := range randBytes {
+		randBytes[i] = privKeyBytes[i] ^ auxHash[i]
+	}
+
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	var aggKeyBytes []byte
+	if aggKey != nil {
+		aggKeyX := schnorr.XOnly(aggKey)
+		aggKeyBytes = aggKeyX[:]
+	}
+
+	var nonces Nonces
+	for i := 0; i < 2; i++ {
+		// The message is not known yet, so the empty message prefix
+		// and empty extra input are committed to.
+		var extraLen [4]byte
+		hash := schnorr.TaggedHash(
+			nonceTag, randBytes[:], []byte{byte(len(pubKey))},
+			pubKey, []byte{byte(len(aggKeyBytes))}, aggKeyBytes,
+			[]byte{0}, extraLen[:], []byte{byte(i)},
+		)
+
+		k := new(big.Int).SetBytes(hash[:])
+		k.Mod(k, curveN)
+		if k.Sign() == 0 {
+			return nil, ErrInvalidNonce
+		}
+
+		rx, ry := curve.ScalarBaseMult(scalarBytes(k))
+		copy(nonces.SecNonce[i*32:], scalarBytes(k))
+		copy(
+			nonces.PubNonce[i*PubKeySize:],
+			newPubKey(rx, ry).SerializeCompressed(),
+		)
+	}
+	copy(nonces.SecNonce[64:], pubKey)
+
+	return &nonces, nil
+}
+
+// AggregateNonces combines the public nonces of all signers into the
+// aggregate nonce of a session.
+func AggregateNonces(
+	pubNonces [][PubNonceSize]byte) ([PubNonceSize]byte, error) {
+
+	var aggNonce [PubNonceSize]byte
+	for i := 0; i < 2; i++ {
+		x, y := new(big.Int), new(big.Int)
+		for _, pubNonce := range pubNonces {
+			r, err := parsePoint(
+				pubNonce[i*PubKeySize : (i+1)*PubKeySize],
+			)
+			if err != nil {
+				return aggNonce, err
+			}
+
+			x, y = curve.Add(x, y, r.X, r.Y)
+		}
+
+		// The aggregate nonce points may be the point at infinity,
+		// which is encoded as all zeros.
+		if isInfinity(x, y) {
+			continue
+		}
+		copy(
+			aggNonce[i*PubKeySize:],
+			newPubKey(x, y).SerializeCompressed(),
+		)
+	}
+
+	return aggNonce, nil
+}
+
+// sessionValues are the values that are derived from the aggregate nonce, the
+// aggregate key and the message of a signing session.
+type sessionValues struct {
+	// b is the coefficient of the second nonce point.
+	b *big.Int
+
+	// e is the BIP340 challenge of the signature.
+	e *big.Int
+
+	// rx and ry are the coordinates of the final nonce point.
+	rx, ry *big.Int
+}
+
+// newSessionValues derives the values of a signing session.
+func newSessionValues(aggNonce [PubNonceSize]byte, aggKey *AggregateKey,
+	msg [32]byte) (*sessionValues, error) {
+
+	qx := schnorr.XOnly(aggKey.FinalKey)
+	hash := schnorr.TaggedHash(nonceCoeffTag, aggNonce[:], qx[:], msg[:])
+	b := new(big.Int).SetBytes(hash[:])
+	b.Mod(b, curveN)
+
+	r1x, r1y, err := parsePointExt(aggNonce[:PubKeySize])
+	if err != nil {
+		return nil, err
+	}
+	r2x, r2y, err := parsePointExt(aggNonce[PubKeySize:])
+	if err != nil {
+		return nil, err
+	}
+
+	// R = R1 + b*R2. If R is the point at infinity, the generator is used
+	// instead.
+	bx, by := curve.ScalarMult(r2x, r2y, scalarBytes(b))
+	rx, ry := curve.Add(r1x, r1y, bx, by)
+	if isInfinity(rx, ry) {
+		rx, ry = curve.Params().Gx, curve.Params().Gy
+	}
+
+	rBytes := scalarBytes(rx)
+	e := schnorr.Challenge(rBytes, qx[:], msg[:])
+
+	return &sessionValues{
+		b:  b,
+		e:  e,
+		rx: rx,
+		ry: ry,
+	}, nil
+}
+
+// Sign creates the partial signature of a signer for the message. The secret
+// nonce must have been created for the private key and must never be used
+// again, as reusing it leaks the private key.
+func Sign(secNonce [SecNonceSize]byte, privKey *bronec.PrivateKey,
+	aggNonce [PubNonceSize]byte, aggKey *AggregateKey,
+	msg [32]byte) ([PartialSigSize]byte, error) {
+
+	var sig [PartialSigSize]byte
+
+	k1 := new(big.Int).SetBytes(secNonce[:32])
+	k2 := new(big.Int).SetBytes(secNonce[32:64])
+	if !isValidScalar(k1) || !isValidScalar(k2) {
+		return sig, ErrInvalidNonce
+	}
+
+	pubKey := privKey.PubKey()
+	if !bytes.Equal(secNonce[64:], pubKey.SerializeCompressed()) {
+		return sig, ErrNonceKeyMismatch
+	}
+
+	d := new(big.Int).Set(privKey.D)
+	if !isValidScalar(d) {
+		return sig, errors.New("invalid private key")
+	}
+
+	a, err := aggKey.signerCoefficient(pubKey)
+	if err != nil {
+		return sig, err
+	}
+
+	values, err := newSessionValues(aggNonce, aggKey, msg)
+	if err != nil {
+		return sig, err
+	}
+
+	// The nonces are negated if the final nonce point has an odd y
+	// coordinate, and the private key is negated to match the sign of
+	// the aggregate key and the accumulated tweaks.
+	if !hasEvenY(values.ry) {
+		k1.Sub(curveN, k1)
+		k2.Sub(curveN, k2)
+	}
+	d = mulMod(mulMod(signOf(aggKey.FinalKey), aggKey.gacc), d)
+
+	// s = k1 + b*k2 + e*a*d.
+	s := addMod(k1, mulMod(values.b, k2))
+	s = addMod(s, mulMod(mulMod(values.e, a), d))
+	s.FillBytes(sig[:])
+
+	// As a safety measure, we verify the partial signature before
+	// returning it.
+	var pubNonce [PubNonceSize]byte
+	for i := 0; i < 2; i++ {
+		k := new(big.Int).SetBytes(secNonce[i*32 : (i+1)*32])
+		rx, ry := curve.ScalarBaseMult(scalarBytes(k))
+		copy(
+			pubNonce[i*PubKeySize:],
+			newPubKey(rx, ry).SerializeCompressed(),
+		)
+	}
+	if !VerifyPartialSig(sig, pubNonce, pubKey, aggNonce, aggKey, msg) {
+		return [PartialSigSize]byte{}, ErrInvalidPartialSig
+	}
+
+	return sig, nil
+}
+
+// VerifyPartialSig returns true if the partial signature is valid for the
+// signer with the given public nonce and public key.
+func VerifyPartialSig(sig [PartialSigSize]byte, pubNonce [PubNonceSize]byte,
+	pubKey *bronec.PublicKey, aggNonce [PubNonceSize]byte,
+	aggKey *AggregateKey, msg [32]byte) bool {
+
+	s := new(big.Int).SetBytes(sig[:])
+	if s.Cmp(curveN) >= 0 {
+		return false
+	}
+
+	a, err := aggKey.signerCoefficient(pubKey)
+	if err != nil {
+		return false
+	}
+
+	values, err := newSessionValues(aggNonce, aggKey, msg)
+	if err != nil {
+		return false
+	}
+
+	r1, err := parsePoint(pubNonce[:PubKeySize])
+	if err != nil {
+		return false
+	}
+	r2, err := parsePoint(pubNonce[PubKeySize:])
+	if err != nil {
+		return false
+	}
+
+	// The effective nonce of the signer is R1 + b*R2, negated if the final
+	// nonce point has an odd y coordinate.
+	bx, by := curve.ScalarMult(r2.X, r2.Y, scalarBytes(values.b))
+	rx, ry := curve.Add(r1.X, r1.Y, bx, by)
+	if !hasEvenY(values.ry) {
+		rx, ry = negate(rx, ry)
+	}
+
+	// s*G must equal the effective nonce plus e*a*g*gacc*P.
+	g := mulMod(signOf(aggKey.FinalKey), aggKey.gacc)
+	c := mulMod(mulMod(values.e, a), g)
+	px, py := curve.ScalarMult(pubKey.X, pubKey.Y, scalarBytes(c))
+	ex, ey := curve.Add(rx, ry, px, py)
+
+	sx, sy := curve.ScalarBaseMult(scalarBytes(s))
+
+	return sx.Cmp(ex) == 0 && sy.Cmp(ey) == 0
+}
+
+// CombineSigs combines the partial signatures of all signers into a BIP340
+// schnorr signature of the message that is valid for the x-only form of the
+// final aggregate key.
+func CombineSigs(aggNonce [PubNonceSize]byte, aggKey *AggregateKey,
+	msg [32]byte, partialSigs [][PartialSigSize]byte) ([64]byte, error) {
+
+	var sig [64]byte
+
+	values, err := newSessionValues(aggNonce, aggKey, msg)
+	if err != nil {
+		return sig, err
+	}
+
+	s := new(big.Int)
+	for _, partialSig := range partialSigs {
+		si := new(big.Int).SetBytes(partialSig[:])
+		if si.Cmp(curveN) >= 0 {
+			return sig, ErrInvalidPartialSig
+		}
+
+		s = addMod(s, si)
+	}
+
+	// The accumulated tweaks are added to the signature, so that it is
+	// valid for the tweaked key.
+	s = addMod(s, mulMod(mulMod(values.e, signOf(aggKey.FinalKey)),
+		aggKey.tacc))
+
+	values.rx.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	if !schnorr.Verify(schnorr.XOnly(aggKey.FinalKey), msg[:], sig) {
+		return [64]byte{}, ErrInvalidSignature
+	}
+
+	return sig, nil
+}
+
+// newPubKey returns the public key with the given coordinates.
+func newPubKey(x, y *big.Int) *bronec.PublicKey {
+	return &bronec.PublicKey{
+		Curve: curve,
+		X:     x,
+		Y:     y,
+	}
+}
+
+// parsePoint parses a compressed point.
+func parsePoint(b []byte) (*bronec.PublicKey, error) {
+	if len(b) != PubKeySize || (b[0] != 0x02 && b[0] != 0x03) {
+		return nil, ErrInvalidNonce
+	}
+
+	point, err := bronec.ParsePubKey(b, curve)
+	if err != nil {
+		return nil, ErrInvalidNonce
+	}
+
+	return point, nil
+}
+
+// parsePointExt parses a compressed point, where all zeros encode the point
+// at infinity.
+func parsePointExt(b []byte) (*big.Int, *big.Int, error) {
+	if bytes.Equal(b, make([]byte, PubKeySize)) {
+		return new(big.Int), new(big.Int), nil
+	}
+
+	point, err := parsePoint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return point.X, point.Y, nil
+}
+
+// isInfinity returns true if the coordinates are those of the point at
+// infinity.
+func isInfinity(x, y *big.Int) bool {
+	return x.Sign() == 0 && y.Sign() == 0
+}
+
+// hasEvenY returns true if the y coordinate is even.
+func hasEvenY(y *big.Int) bool {
+	return y.Bit(0) == 0
+}
+
+// negate returns the negation of the point. The point at infinity is its own
+// negation.
+func negate(x, y *big.Int) (*big.Int, *big.Int) {
+	if isInfinity(x, y) {
+		return x, y
+	}
+
+	return x, new(big.Int).Sub(curve.Params().P, y)
+}
+
+// signOf returns one if the y coordinate of the key is even and minus one
+// modulo the curve order otherwise.
+func signOf(key *bronec.PublicKey) *big.Int {
+	if hasEvenY(key.Y) {
+		return big.NewInt(1)
+	}
+
+	return new(big.Int).Sub(curveN, big.NewInt(1))
+}
+
+// isValidScalar returns true if the scalar is in the range [1, n).
+func isValidScalar(k *big.Int) bool {
+	return k.Sign() > 0 && k.Cmp(curveN) < 0
+}
+
+// scalarBytes returns the 32 byte big endian encoding of the scalar.
+func scalarBytes(k *big.Int) []byte {
+	var b [32]byte
+	k.FillBytes(b[:])
+
+	return b[:]
+}
+
+// addMod returns a + b modulo the order of the curve.
+func addMod(a, b *big.Int) *big.Int {
+	r := new(big.Int).Add(a, b)
+	return r.Mod(r, curveN)
+}
+
+// mulMod returns a * b modulo the order of the curve.
+func mulMod(a, b *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return r.Mod(r, curveN)
+}
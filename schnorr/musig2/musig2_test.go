@@ -0,0 +1,218 @@
+package musig2
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/brsuite/broln/schnorr"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAggregateKeys asserts that public keys are aggregated as specified by
+// the key aggregation test vectors of BIP327.
+func TestAggregateKeys(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key1 = "02F9308A019258C31049344F85F89D5229" +
+			"B531C845836F99B08601F113BCE036F9"
+		key2 = "03DFF1D77F2A671C5F36183726DB2341BE" +
+			"58FEAE1DA2DECED843240F7B502BA659"
+		key3 = "023590A94E768F8E1815C2F24B4D80A8E3" +
+			"149316C3518CE7B7AD338368D038CA66"
+	)
+
+	testCases := []struct {
+		keys     []string
+		expected string
+	}{
+		{
+			keys: []string{key1, key2, key3},
+			expected: "90539EEDE565F5D054F32CC0C220126889" +
+				"ED1E5D193BAF15AEF344FE59D4610C",
+		},
+		{
+			keys: []string{key3, key2, key1},
+			expected: "6204DE8B083426DC6EAF9502D27024D53F" +
+				"C826BF7D2012148A0575435DF54B2B",
+		},
+		{
+			keys: []string{key1, key1, key1},
+			expected: "B436E3BAD62B8CD409969A224731C193D0" +
+				"51162D8C5AE8B109306127DA3AA935",
+		},
+		{
+			keys: []string{key1, key1, key2, key2},
+			expected: "69BC22BFA5D106306E48A20679DE1D7389" +
+				"386124D07571D0D872686028C26A3E",
+		},
+	}
+
+	for i, testCase := range testCases {
+		pubKeys := make([]*bronec.PublicKey, len(testCase.keys))
+		for j, key := range testCase.keys {
+			keyBytes, err := hex.DecodeString(key)
+			require.NoError(t, err)
+
+			pubKeys[j], err = bronec.ParsePubKey(
+				keyBytes, bronec.S256(),
+			)
+			require.NoError(t, err)
+		}
+
+		aggKey, err := AggregateKeys(pubKeys)
+		require.NoError(t, err)
+
+		expected, err := hex.DecodeString(testCase.expected)
+		require.NoError(t, err)
+
+		aggKeyX := schnorr.XOnly(aggKey.FinalKey)
+		require.Equal(t, expected, aggKeyX[:], "test case %d", i)
+	}
+}
+
+// TestSigning asserts that the partial signatures of all signers combine into
+// a valid BIP340 signature for the tweaked aggregate key.
+func TestSigning(t *testing.T) {
+	t.Parallel()
+
+	var tweak [32]byte
+	tweak[31] = 7
+
+	testCases := []struct {
+		name       string
+		numSigners int
+		tweak      func(*AggregateKey) error
+	}{
+		{
+			name:       "single signer",
+			numSigners: 1,
+		},
+		{
+			name:       "three signers",
+			numSigners: 3,
+		},
+		{
+			name:       "plain tweak",
+			numSigners: 3,
+			tweak: func(k *AggregateKey) error {
+				return k.ApplyTweak(tweak, false)
+			},
+		},
+		{
+			name:       "x-only tweak",
+			numSigners: 3,
+			tweak: func(k *AggregateKey) error {
+				return k.ApplyTweak(tweak, true)
+			},
+		},
+		{
+			name:       "taproot key spend",
+			numSigners: 2,
+			tweak: func(k *AggregateKey) error {
+				return k.ApplyTaprootTweak(nil)
+			},
+		},
+		{
+			name:       "taproot script root",
+			numSigners: 2,
+			tweak: func(k *AggregateKey) error {
+				return k.ApplyTaprootTweak(tweak[:])
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSigning(t, testCase.numSigners, testCase.tweak)
+		})
+	}
+}
+
+func testSigning(t *testing.T, numSigners int,
+	tweak func(*AggregateKey) error) {
+
+	privKeys := make([]*bronec.PrivateKey, numSigners)
+	pubKeys := make([]*bronec.PublicKey, numSigners)
+	for i := range privKeys {
+		var err error
+		privKeys[i], err = bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	aggKey, err := AggregateKeys(pubKeys)
+	require.NoError(t, err)
+
+	if tweak != nil {
+		require.NoError(t, tweak(aggKey))
+		require.NotEqual(t, aggKey.PreTweakedKey, aggKey.FinalKey)
+	}
+
+	nonces := make([]*Nonces, numSigners)
+	pubNonces := make([][PubNonceSize]byte, numSigners)
+	for i, privKey := range privKeys {
+		nonces[i], err = GenNonces(privKey, aggKey.FinalKey)
+		require.NoError(t, err)
+
+		pubNonces[i] = nonces[i].PubNonce
+	}
+
+	aggNonce, err := AggregateNonces(pubNonces)
+	require.NoError(t, err)
+
+	msg := schnorr.TaggedHash([]byte("test"), []byte("message"))
+
+	partialSigs := make([][PartialSigSize]byte, numSigners)
+	for i, privKey := range privKeys {
+		partialSigs[i], err = Sign(
+			nonces[i].SecNonce, privKey, aggNonce, aggKey, msg,
+		)
+		require.NoError(t, err)
+
+		require.True(t, VerifyPartialSig(
+			partialSigs[i], pubNonces[i], pubKeys[i], aggNonce,
+			aggKey, msg,
+		))
+	}
+
+	sig, err := CombineSigs(aggNonce, aggKey, msg, partialSigs)
+	require.NoError(t, err)
+	require.True(t, schnorr.Verify(
+		schnorr.XOnly(aggKey.FinalKey), msg[:], sig,
+	))
+
+	// A partial signature is not valid for a different signer, and the
+	// signature can't be completed without it.
+	if numSigners > 1 {
+		require.False(t, VerifyPartialSig(
+			partialSigs[0], pubNonces[1], pubKeys[1], aggNonce,
+			aggKey, msg,
+		))
+
+		_, err = CombineSigs(
+			aggNonce, aggKey, msg, partialSigs[1:],
+		)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	}
+
+	// A secret nonce can't be used with the key of another signer.
+	otherKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	_, err = Sign(nonces[0].SecNonce, otherKey, aggNonce, aggKey, msg)
+	require.ErrorIs(t, err, ErrNonceKeyMismatch)
+
+	// Signers that are not part of the aggregate key can't sign.
+	otherNonces, err := GenNonces(otherKey, nil)
+	require.NoError(t, err)
+
+	_, err = Sign(otherNonces.SecNonce, otherKey, aggNonce, aggKey, msg)
+	require.ErrorIs(t, err, ErrUnknownSigner)
+}
@@ -0,0 +1,178 @@
+// Package schnorr implements BIP340 schnorr signatures over the secp256k1
+// curve. The signatures are used to sign BOLT12 invoice requests and invoices,
+// and are the result of MuSig2 signing sessions.
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/brsuite/brond/bronec"
+)
+
+var (
+	// The tags of the hashes that are used to create and verify BIP340
+	// signatures.
+	bip340AuxTag       = []byte("BIP0340/aux")
+	bip340NonceTag     = []byte("BIP0340/nonce")
+	bip340ChallengeTag = []byte("BIP0340/challenge")
+)
+
+// Sign creates a BIP340 schnorr signature for the message with the private
+// key. Fresh auxiliary randomness is used to derive the nonce.
+func Sign(privKey *bronec.PrivateKey, msg []byte) ([64]byte, error) {
+	var auxRand [32]byte
+	if _, err := rand.Read(auxRand[:]); err != nil {
+		return [64]byte{}, err
+	}
+
+	return sign(privKey, msg, auxRand)
+}
+
+// sign creates a BIP340 schnorr signature for the message, using the passed
+// auxiliary randomness to derive the nonce.
+func sign(privKey *bronec.PrivateKey, msg []byte,
+	auxRand [32]byte) ([64]byte, error) {
+
+	var (
+		curve = bronec.S256()
+		n     = curve.Params().N
+		sig   [64]byte
+	)
+
+	d := new(big.Int).Set(privKey.D)
+	if d.Sign() == 0 || d.Cmp(n) >= 0 {
+		return sig, errors.New("invalid private key")
+	}
+
+	// The private key is negated if its public key has an odd y
+	// coordinate, as signatures are verified against the public key with
+	// even y coordinate.
+	pubKey := privKey.PubKey()
+	if pubKey.Y.Bit(0) == 1 {
+		d.Sub(n, d)
+	}
+	pubKeyX := XOnly(pubKey)
+
+	// The nonce is derived from the private key, masked with the
+	// auxiliary randomness, the public key and the message.
+	var dBytes [32]byte
+	d.FillBytes(dBytes[:])
+	auxHash := TaggedHash(bip340AuxTag, auxRand[:])
+	for i := range dBytes {
+		dBytes[i] ^= auxHash[i]
+	}
+
+	nonceHash := TaggedHash(bip340NonceTag, dBytes[:], pubKeyX[:], msg)
+	k := new(big.Int).SetBytes(nonceHash[:])
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return sig, errors.New("invalid nonce")
+	}
+
+	var kBytes [32]byte
+	k.FillBytes(kBytes[:])
+	rx, ry := curve.ScalarBaseMult(kBytes[:])
+	if ry.Bit(0) == 1 {
+		k.Sub(n, k)
+	}
+	rx.FillBytes(sig[:32])
+
+	e := Challenge(sig[:32], pubKeyX[:], msg)
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, n)
+	s.FillBytes(sig[32:])
+
+	// As a safety measure, we verify the signature before returning it.
+	if !Verify(pubKeyX, msg, sig) {
+		return [64]byte{}, errors.New("created invalid signature")
+	}
+
+	return sig, nil
+}
+
+// Verify returns true if the signature is a valid BIP340 schnorr signature of
+// the message for the x-only public key.
+func Verify(pubKeyX [32]byte, msg []byte, sig [64]byte) bool {
+	var (
+		curve  = bronec.S256()
+		params = curve.Params()
+	)
+
+	// The public key is the point with the given x coordinate and an even
+	// y coordinate.
+	pubKey, err := bronec.ParsePubKey(
+		append([]byte{0x02}, pubKeyX[:]...), curve,
+	)
+	if err != nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	if r.Cmp(params.P) >= 0 {
+		return false
+	}
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(params.N) >= 0 {
+		return false
+	}
+
+	// R = s*G - e*P, which is computed as s*G + (n-e)*P.
+	e := Challenge(sig[:32], pubKeyX[:], msg)
+	e.Sub(params.N, e)
+
+	var sBytes, eBytes [32]byte
+	s.FillBytes(sBytes[:])
+	e.FillBytes(eBytes[:])
+
+	sx, sy := curve.ScalarBaseMult(sBytes[:])
+	ex, ey := curve.ScalarMult(pubKey.X, pubKey.Y, eBytes[:])
+	rx, ry := curve.Add(sx, sy, ex, ey)
+
+	// R must not be the point at infinity, must have an even y coordinate
+	// and its x coordinate must match the signature.
+	if rx.Sign() == 0 && ry.Sign() == 0 {
+		return false
+	}
+
+	return ry.Bit(0) == 0 && rx.Cmp(r) == 0
+}
+
+// Challenge computes the BIP340 challenge of a signature with the x
+// coordinate r of its nonce point, reduced modulo the order of the curve.
+func Challenge(r, pubKeyX, msg []byte) *big.Int {
+	hash := TaggedHash(bip340ChallengeTag, r, pubKeyX, msg)
+
+	e := new(big.Int).SetBytes(hash[:])
+	return e.Mod(e, bronec.S256().Params().N)
+}
+
+// TaggedHash computes the BIP340 tagged hash of the message, that is
+// SHA256(SHA256(tag) || SHA256(tag) || msg).
+func TaggedHash(tag []byte, msg ...[]byte) [32]byte {
+	tagHash := sha256.Sum256(tag)
+
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, m := range msg {
+		h.Write(m)
+	}
+
+	var hash [32]byte
+	copy(hash[:], h.Sum(nil))
+
+	return hash
+}
+
+// XOnly returns the x coordinate of the public key, which identifies it for
+// BIP340 signatures.
+func XOnly(pubKey *bronec.PublicKey) [32]byte {
+	var x [32]byte
+	copy(x[:], pubKey.SerializeCompressed()[1:])
+
+	return x
+}
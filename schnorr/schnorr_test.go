@@ -0,0 +1,158 @@
+package schnorr
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSchnorrSignatures asserts that BIP340 signatures are created and
+// verified as specified by the test vectors of BIP340.
+func TestSchnorrSignatures(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		privKey string
+		pubKey  string
+		auxRand string
+		msg     string
+		sig     string
+		valid   bool
+	}{
+		{
+			privKey: "00000000000000000000000000000000" +
+				"00000000000000000000000000000003",
+			pubKey: "F9308A019258C31049344F85F89D5229" +
+				"B531C845836F99B08601F113BCE036F9",
+			auxRand: "00000000000000000000000000000000" +
+				"00000000000000000000000000000000",
+			msg: "00000000000000000000000000000000" +
+				"00000000000000000000000000000000",
+			sig: "E907831F80848D1069A5371B40241036" +
+				"4BDF1C5F8307B0084C55F1CE2DCA8215" +
+				"25F66A4A85EA8B71E482A74F382D2CE5" +
+				"EBEEE8FDB2172F477DF4900D310536C0",
+			valid: true,
+		},
+		{
+			privKey: "B7E151628AED2A6ABF7158809CF4F3C7" +
+				"62E7160F38B4DA56A784D9045190CFEF",
+			pubKey: "DFF1D77F2A671C5F36183726DB2341BE" +
+				"58FEAE1DA2DECED843240F7B502BA659",
+			auxRand: "00000000000000000000000000000000" +
+				"00000000000000000000000000000001",
+			msg: "243F6A8885A308D313198A2E03707344" +
+				"A4093822299F31D0082EFA98EC4E6C89",
+			sig: "6896BD60EEAE296DB48A229FF71DFE07" +
+				"1BDE413E6D43F917DC8DCF8C78DE3341" +
+				"8906D11AC976ABCCB20B091292BFF4EA" +
+				"897EFCB639EA871CFA95F6DE339E4B0A",
+			valid: true,
+		},
+		{
+			privKey: "C90FDAA22168C234C4C6628B80DC1CD1" +
+				"29024E088A67CC74020BBEA63B14E5C9",
+			pubKey: "DD308AFEC5777E13121FA72B9CC1B7CC" +
+				"0139715309B086C960E18FD969774EB8",
+			auxRand: "C87AA53824B4D7AE2EB035A2B5BBBCCC" +
+				"080E76CDC6D1692C4B0B62D798E6D906",
+			msg: "7E2D58D8B3BCDF1ABADEC7829054F90D" +
+				"DA9805AAB56C77333024B9D0A508B75C",
+			sig: "5831AAEED7B44BB74E5EAB94BA9D4294" +
+				"C49BCF2A60728D8B4C200F50DD313C1B" +
+				"AB745879A5AD954A72C45A91C3A51D3C" +
+				"7ADEA98D82F8481E0E1E03674A6F3FB7",
+			valid: true,
+		},
+		{
+			privKey: "0B432B2677937381AEF05BB02A66ECD0" +
+				"12773062CF3FA2549E44F58ED2401710",
+			pubKey: "25D1DFF95105F5253C4022F628A996AD" +
+				"3A0D95FBF21D468A1B33F8C160D8F517",
+			auxRand: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF" +
+				"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			msg: "FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF" +
+				"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF",
+			sig: "7EB0509757E246F19449885651611CB9" +
+				"65ECC1A187DD51B64FDA1EDC9637D5EC" +
+				"97582B9CB13DB3933705B32BA982AF5A" +
+				"F25FD78881EBB32771FC5922EFC66EA3",
+			valid: true,
+		},
+		{
+			pubKey: "D69C3509BB99E412E68B0FE8544E7283" +
+				"7DFA30746D8BE2AA65975F29D22DC7B9",
+			msg: "4DF3C3F68FCC83B27E9D42C90431A724" +
+				"99F17875C81A599B566C9889B9696703",
+			sig: "00000000000000000000003B78CE563F" +
+				"89A0ED9414F5AA28AD0D96D6795F9C63" +
+				"76AFB1548AF603B3EB45C9F8207DEE10" +
+				"60CB71C04E80F593060B07D28308D7F4",
+			valid: true,
+		},
+		{
+			// The public key is not on the curve.
+			pubKey: "EEFDEA4CDB677750A420FEE807EACF21" +
+				"EB9898AE79B9768766E4FAA04A2D4A34",
+			msg: "243F6A8885A308D313198A2E03707344" +
+				"A4093822299F31D0082EFA98EC4E6C89",
+			sig: "6CFF5C3BA86C69EA4B7376F31A9BCB4F" +
+				"74C1976089B2D9963DA2E5543E177769" +
+				"69E89B4C5564D00349106B8497785DD7" +
+				"D1D713A8AE82B32FA79D5F7FC407D39B",
+			valid: false,
+		},
+		{
+			// The y coordinate of R is odd.
+			pubKey: "DFF1D77F2A671C5F36183726DB2341BE" +
+				"58FEAE1DA2DECED843240F7B502BA659",
+			msg: "243F6A8885A308D313198A2E03707344" +
+				"A4093822299F31D0082EFA98EC4E6C89",
+			sig: "FFF97BD5755EEEA420453A14355235D3" +
+				"82F6472F8568A18B2F057A1460297556" +
+				"3CC27944640AC607CD107AE10923D9EF" +
+				"7A73C643E166BE5EBEAFA34B1AC553E2",
+			valid: false,
+		},
+	}
+
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+
+		return b
+	}
+
+	for i, testCase := range testCases {
+		var (
+			pubKey [32]byte
+			sig    [64]byte
+		)
+		copy(pubKey[:], decode(testCase.pubKey))
+		copy(sig[:], decode(testCase.sig))
+		msg := decode(testCase.msg)
+
+		require.Equal(
+			t, testCase.valid, Verify(pubKey, msg, sig),
+			"test case %d", i,
+		)
+
+		if testCase.privKey == "" {
+			continue
+		}
+
+		privKey, derivedPub := bronec.PrivKeyFromBytes(
+			bronec.S256(), decode(testCase.privKey),
+		)
+		require.Equal(t, pubKey, XOnly(derivedPub))
+
+		var auxRand [32]byte
+		copy(auxRand[:], decode(testCase.auxRand))
+
+		created, err := sign(privKey, msg, auxRand)
+		require.NoError(t, err)
+		require.Equal(t, sig, created, "test case %d", i)
+	}
+}
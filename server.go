@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/brsuite/broln/autopilot"
+	"github.com/brsuite/broln/bolt12"
 	"github.com/brsuite/broln/brontide"
 	"github.com/brsuite/broln/cert"
 	"github.com/brsuite/broln/chainreg"
@@ -41,29 +42,37 @@ import (
 	"github.com/brsuite/broln/lncfg"
 	"github.com/brsuite/broln/lnpeer"
 	"github.com/brsuite/broln/lnrpc"
+	"github.com/brsuite/broln/lnrpc/invoicesrpc"
 	"github.com/brsuite/broln/lnrpc/routerrpc"
+	"github.com/brsuite/broln/lntypes"
 	"github.com/brsuite/broln/lnwallet"
 	"github.com/brsuite/broln/lnwallet/chainfee"
 	"github.com/brsuite/broln/lnwallet/rpcwallet"
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/nat"
 	"github.com/brsuite/broln/netann"
+	"github.com/brsuite/broln/offers"
+	"github.com/brsuite/broln/onionmessage"
 	"github.com/brsuite/broln/peer"
 	"github.com/brsuite/broln/peernotifier"
 	"github.com/brsuite/broln/pool"
 	"github.com/brsuite/broln/queue"
 	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/broln/routing/localchans"
+	"github.com/brsuite/broln/routing/prober"
 	"github.com/brsuite/broln/routing/route"
 	"github.com/brsuite/broln/subscribe"
 	"github.com/brsuite/broln/sweep"
 	"github.com/brsuite/broln/ticker"
 	"github.com/brsuite/broln/tor"
+	"github.com/brsuite/broln/trampoline"
 	"github.com/brsuite/broln/walletunlocker"
 	"github.com/brsuite/broln/watchtower/blob"
 	"github.com/brsuite/broln/watchtower/wtclient"
 	"github.com/brsuite/broln/watchtower/wtpolicy"
 	"github.com/brsuite/broln/watchtower/wtserver"
+	"github.com/brsuite/broln/zpay32"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg/chainhash"
 	"github.com/brsuite/brond/connmgr"
@@ -243,6 +252,10 @@ type server struct {
 
 	chanStateDB *channeldb.ChannelStateDB
 
+	// aliasStore keeps track of the SCID aliases of our zero-conf and
+	// option-scid-alias channels, as well as the ones our peers assigned.
+	aliasStore *channeldb.AliasStore
+
 	addrSource chanbackup.AddressSource
 
 	// miscDB is the DB that contains all "other" databases within the main
@@ -255,6 +268,8 @@ type server struct {
 
 	invoices *invoices.InvoiceRegistry
 
+	invoiceHtlcModifier *invoices.HtlcModificationInterceptor
+
 	channelNotifier *channelnotifier.ChannelNotifier
 
 	peerNotifier *peernotifier.PeerNotifier
@@ -324,6 +339,22 @@ type server struct {
 
 	customMessageServer *subscribe.Server
 
+	// onionMessenger sends, receives and forwards onion messages. It is
+	// nil if support for onion messages isn't enabled.
+	onionMessenger *onionmessage.Messenger
+
+	// offers issues our BOLT12 offers and fetches invoices for the offers
+	// that we pay. It is nil if onion messages or route blinding aren't
+	// enabled.
+	offers *offers.Manager
+
+	// trampolineForwarder forwards the trampoline payments that we
+	// receive. It is nil if trampoline routing isn't enabled.
+	trampolineForwarder *trampoline.Forwarder
+
+	// prober sends probe payments to discover the liquidity of channels.
+	prober *prober.Prober
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -536,11 +567,26 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NoAnchors:                cfg.ProtocolOptions.NoAnchorCommitments(),
 		NoWumbo:                  !cfg.ProtocolOptions.Wumbo(),
 		NoScriptEnforcementLease: cfg.ProtocolOptions.NoScriptEnforcementLease(),
+		NoOptionScidAlias:        !cfg.ProtocolOptions.ScidAlias(),
+		NoZeroConf:               !cfg.ProtocolOptions.ZeroConf(),
+		NoRouteBlinding:          !cfg.ProtocolOptions.RouteBlinding(),
+		NoOnionMessages:          !cfg.ProtocolOptions.OnionMessages(),
+		NoTrampolineRouting:      !cfg.ProtocolOptions.TrampolineRouting(),
+		NoDualFund:               !cfg.ProtocolOptions.DualFund(),
+		NoSplice:                 !cfg.ProtocolOptions.Splice(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// The htlc modifier lets a client of the invoices rpc server inspect
+	// and modify exit hop htlcs before the registry processes them.
+	invoiceHtlcModifier := invoices.NewHtlcModificationInterceptor()
+
+	// Settled invoices are appended to the archive before they are deleted
+	// according to the configured retention.
+	invoiceArchive := invoices.NewInvoiceArchive(cfg.Invoices.ArchiveFile)
+
 	registryConfig := invoices.RegistryConfig{
 		FinalCltvRejectDelta:        lncfg.DefaultFinalCltvRejectDelta,
 		HtlcHoldDuration:            invoices.DefaultHtlcHoldDuration,
@@ -550,6 +596,11 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		GcCanceledInvoicesOnStartup: cfg.GcCanceledInvoicesOnStartup,
 		GcCanceledInvoicesOnTheFly:  cfg.GcCanceledInvoicesOnTheFly,
 		KeysendHoldTime:             cfg.KeysendHoldTime,
+		HtlcModifier:                invoiceHtlcModifier,
+		CanceledInvoiceRetention:    cfg.Invoices.CanceledRetention,
+		SettledInvoiceRetention:     cfg.Invoices.SettledRetention,
+		InvoiceArchive:              invoiceArchive,
+		InvoiceGcInterval:           cfg.Invoices.GcInterval,
 	}
 
 	s := &server{
@@ -576,7 +627,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 		// TODO(roasbeef): derive proper onion key based on rotation
 		// schedule
-		sphinx: hop.NewOnionProcessor(sphinxRouter),
+		sphinx: hop.NewOnionProcessor(sphinxRouter, nodeKeyECDH),
 
 		torController: torController,
 
@@ -602,6 +653,26 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		quit:       make(chan struct{}),
 	}
 
+	s.aliasStore, err = channeldb.NewAliasStore(dbs.ChanStateDB)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ProtocolOptions.OnionMessages() {
+		s.onionMessenger = onionmessage.New(&onionmessage.Config{
+			OurPubKey:      nodeKeyECDH.PubKey(),
+			OnionProcessor: s.sphinx,
+			Graph:          s.graphDB,
+			IsPeer: func(node route.Vertex) bool {
+				_, err := s.FindPeerByPubStr(string(node[:]))
+				return err == nil
+			},
+			SendToPeer: s.sendOnionMessage,
+			RateLimit:  onionmessage.DefaultRateLimit,
+			Burst:      onionmessage.DefaultBurst,
+		})
+	}
+
 	s.witnessBeacon = &preimageBeacon{
 		wCache:      dbs.ChanStateDB.NewWitnessCache(),
 		subscribers: make(map[uint64]*preimageSubscriber),
@@ -619,6 +690,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	s.invoices = invoices.NewRegistry(
 		dbs.ChanStateDB, expiryWatcher, &registryConfig,
 	)
+	s.invoiceHtlcModifier = invoiceHtlcModifier
 
 	s.htlcNotifier = htlcswitch.NewHtlcNotifier(time.Now)
 
@@ -656,12 +728,20 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		RejectHTLC:             cfg.RejectHTLC,
 		Clock:                  clock.NewDefaultClock(),
 		HTLCExpiry:             htlcswitch.DefaultHTLCExpiry,
+		FindBaseScid:           s.aliasStore.FindBaseSCID,
 		DustThreshold:          thresholdMSats,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
 	}
-	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(s.htlcSwitch)
+	s.interceptableSwitch = htlcswitch.NewInterceptableSwitch(
+		&htlcswitch.InterceptableSwitchConfig{
+			Switch:             s.htlcSwitch,
+			Notifier:           s.cc.ChainNotifier,
+			RequireInterceptor: cfg.RequireInterceptor,
+			CltvRejectDelta:    cfg.InterceptorCltvRejectDelta,
+		},
+	)
 
 	chanStatusMgrCfg := &netann.ChanStatusConfig{
 		ChanStatusSampleInterval: cfg.ChanStatusSampleInterval,
@@ -670,7 +750,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		OurPubKey:                nodeKeyDesc.PubKey,
 		OurKeyLoc:                nodeKeyDesc.KeyLocator,
 		MessageSigner:            s.nodeSigner,
-		IsChannelActive:          s.htlcSwitch.HasActiveLink,
+		IsChannelActive:          s.isChannelActive,
 		ApplyChannelUpdate:       s.applyChannelUpdate,
 		DB:                       s.chanStateDB,
 		Graph:                    dbs.GraphDB.ChannelGraph(),
@@ -843,18 +923,52 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	// servers, the mission control instance itself can be moved there too.
 	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
 
-	estimatorCfg := routing.ProbabilityEstimatorCfg{
-		AprioriHopProbability: routingConfig.AprioriHopProbability,
-		PenaltyHalfLife:       routingConfig.PenaltyHalfLife,
-		AprioriWeight:         routingConfig.AprioriWeight,
+	// Select the probability estimator that mission control uses for
+	// pathfinding.
+	var estimator routing.Estimator
+	switch routingConfig.ProbabilityEstimatorType {
+	case routing.AprioriEstimatorName:
+		aCfg := routing.AprioriConfig{
+			AprioriHopProbability: routingConfig.
+				AprioriHopProbability,
+			PenaltyHalfLife:  routingConfig.PenaltyHalfLife,
+			AprioriWeight:    routingConfig.AprioriWeight,
+			CapacityFraction: routingConfig.CapacityFraction,
+		}
+
+		estimator, err = routing.NewAprioriEstimator(aCfg)
+		if err != nil {
+			return nil, err
+		}
+
+	case routing.BimodalEstimatorName:
+		bimodalConfig := routingConfig.BimodalConfig
+		bCfg := routing.BimodalConfig{
+			BimodalNodeWeight: bimodalConfig.NodeWeight,
+			BimodalScaleMsat: lnwire.MilliBronees(
+				bimodalConfig.Scale,
+			),
+			BimodalDecayTime: bimodalConfig.DecayTime,
+		}
+
+		estimator, err = routing.NewBimodalEstimator(bCfg)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown estimator type %v",
+			routingConfig.ProbabilityEstimatorType)
 	}
 
 	s.missionControl, err = routing.NewMissionControl(
 		dbs.ChanStateDB, selfNode.PubKeyBytes,
 		&routing.MissionControlConfig{
-			ProbabilityEstimatorCfg: estimatorCfg,
+			Estimator:               estimator,
 			MaxMcHistory:            routingConfig.MaxMcHistory,
 			McFlushInterval:         routingConfig.McFlushInterval,
+			MaxMcPairs:              routingConfig.MaxMcPairs,
+			McPairMaxAge:            routingConfig.McPairMaxAge,
 			MinFailureRelaxInterval: routing.DefaultMinFailureRelaxInterval,
 		},
 	)
@@ -912,11 +1026,106 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PathFindingConfig:   pathFindingConfig,
 		Clock:               clock.NewDefaultClock(),
 		StrictZombiePruning: strictPruning,
+		IsAlias: func(scid lnwire.ShortChannelID) bool {
+			_, err := s.aliasStore.FindBaseSCID(scid)
+			return err == nil
+		},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
 	}
 
+	if cfg.ProtocolOptions.TrampolineRouting() {
+		s.trampolineForwarder = trampoline.NewForwarder(&trampoline.Config{
+			NodeKey: nodeKeyECDH,
+			Policy: trampoline.HopPolicy{
+				FeeBase: lnwire.MilliBronees(
+					cfg.Trampoline.BaseFee,
+				),
+				FeeRate: lnwire.MilliBronees(
+					cfg.Trampoline.FeeRate,
+				),
+				CltvDelta: cfg.Trampoline.CltvDelta,
+			},
+			MppTimeout:       cfg.Trampoline.MppTimeout,
+			BestHeight:       s.htlcSwitch.BestHeight,
+			SendPayment:      s.chanRouter.SendPayment,
+			SubscribePayment: s.controlTower.SubscribePayment,
+			Clock:            clock.NewDefaultClock(),
+		})
+	}
+
+	proberCfg := routingConfig.ProberConfig
+	proberDests := make([]route.Vertex, 0, len(proberCfg.Destinations))
+	for _, dest := range proberCfg.Destinations {
+		vertex, err := route.NewVertexFromStr(dest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe destination "+
+				"%v: %v", dest, err)
+		}
+		proberDests = append(proberDests, vertex)
+	}
+
+	s.prober, err = prober.New(&prober.Config{
+		SendPayment: s.chanRouter.SendPayment,
+		DeletePayment: func(hash lntypes.Hash) error {
+			return s.miscDB.DeletePayment(hash, false)
+		},
+		CentralNodes: func(n int) ([]route.Vertex, error) {
+			return prober.CentralNodes(
+				autopilot.ChannelGraphFromDatabase(s.graphDB),
+				selfNode.PubKeyBytes, n,
+			)
+		},
+		Destinations:    proberDests,
+		NumCentralNodes: proberCfg.NumCentralNodes,
+		Timeout:         proberCfg.Timeout,
+		FeeLimitPPM:     proberCfg.FeeLimitPPM,
+		CltvLimit:       cfg.MaxOutgoingCltvExpiry,
+		FinalCLTVDelta:  uint16(cfg.Brocoin.TimeLockDelta),
+		Clock:           clock.NewDefaultClock(),
+	}, prober.Budget{
+		Interval: proberCfg.Interval,
+		Amount: lnwire.NewMSatFromBroneess(
+			bronutil.Amount(proberCfg.Amt),
+		),
+		MaxInFlight: lnwire.NewMSatFromBroneess(
+			bronutil.Amount(proberCfg.MaxInFlight),
+		),
+	}, proberCfg.Active)
+	if err != nil {
+		return nil, fmt.Errorf("can't create prober: %v", err)
+	}
+
+	// Invoice requests for our offers are received over onion messages,
+	// and the invoices we reply with are paid over blinded paths.
+	if s.onionMessenger != nil && cfg.ProtocolOptions.RouteBlinding() {
+		offerStore, err := channeldb.NewOfferStore(dbs.ChanStateDB)
+		if err != nil {
+			return nil, err
+		}
+
+		s.offers = offers.New(&offers.Config{
+			Messenger:   s.onionMessenger,
+			Store:       offerStore,
+			NodePubKey:  nodeKeyDesc.PubKey,
+			ChainParams: cfg.ActiveNetParams.Params,
+			AddInvoice:  s.addOfferInvoice,
+			SignInvoice: func(digest [32]byte) ([64]byte, error) {
+				nodeKey, err := cc.KeyRing.DerivePrivKey(
+					*nodeKeyDesc,
+				)
+				if err != nil {
+					return [64]byte{}, err
+				}
+
+				return bolt12.SignDigest(nodeKey, digest)
+			},
+			FetchTimeout: offers.DefaultFetchTimeout,
+			Clock:        clock.NewDefaultClock(),
+		})
+	}
+
 	chanSeries := discovery.NewChanSeries(s.graphDB)
 	gossipMessageStore, err := discovery.NewMessageStore(dbs.ChanStateDB)
 	if err != nil {
@@ -954,6 +1163,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		PinnedSyncers:           cfg.Gossip.PinnedSyncers,
 		MaxChannelUpdateBurst:   cfg.Gossip.MaxChannelUpdateBurst,
 		ChannelUpdateInterval:   cfg.Gossip.ChannelUpdateInterval,
+		GetPeerAlias:            s.aliasStore.GetPeerAlias,
 	}, nodeKeyDesc)
 
 	s.localChanMgr = &localchans.Manager{
@@ -1082,8 +1292,12 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		FeeEstimator: cc.FeeEstimator,
 		ChainIO:      cc.ChainIO,
 		MarkLinkInactive: func(chanPoint wire.OutPoint) error {
-			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
-			s.htlcSwitch.RemoveLink(chanID)
+			link, err := s.htlcSwitch.GetLinkByChanPoint(chanPoint)
+			if err != nil {
+				return nil
+			}
+
+			s.htlcSwitch.RemoveLink(link.ChanID())
 			return nil
 		},
 		IsOurAddress: cc.Wallet.IsOurAddress,
@@ -1132,7 +1346,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		},
 		Sweeper:                       s.sweeper,
 		Registry:                      s.invoices,
-		NotifyClosedChannel:           s.channelNotifier.NotifyClosedChannelEvent,
+		NotifyClosedChannel:           s.notifyClosedChannel,
+		NotifySplicedChannel:          s.notifySplicedChannel,
 		NotifyFullyResolvedChannel:    s.channelNotifier.NotifyFullyResolvedChannelEvent,
 		OnionProcessor:                s.sphinx,
 		PaymentsExpirationGracePeriod: cfg.PaymentsExpirationGracePeriod,
@@ -1185,7 +1400,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			}
 
 			for _, channel := range dbChannels {
-				if chanID.IsChanPoint(&channel.FundingOutpoint) {
+				if channel.ChanID() == chanID {
 					return channel, nil
 				}
 			}
@@ -1293,9 +1508,24 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			// the chain arb so it can react to on-chain events.
 			return s.chainArb.WatchNewChannel(channel)
 		},
+		AliasManager: s.aliasStore,
+		DeleteAliasEdge: func(scid lnwire.ShortChannelID) error {
+			err := s.graphDB.DeleteChannelEdges(
+				false, scid.ToUint64(),
+			)
+			if err == channeldb.ErrEdgeNotFound {
+				return nil
+			}
+
+			return err
+		},
 		ReportShortChanID: func(chanPoint wire.OutPoint) error {
-			cid := lnwire.NewChanIDFromOutPoint(&chanPoint)
-			return s.htlcSwitch.UpdateShortChanID(cid)
+			link, err := s.htlcSwitch.GetLinkByChanPoint(chanPoint)
+			if err != nil {
+				return err
+			}
+
+			return s.htlcSwitch.UpdateShortChanID(link.ChanID())
 		},
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit bronutil.Amount) bronutil.Amount {
@@ -1673,6 +1903,30 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.customMessageServer.Stop)
 
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.onionMessenger.Stop)
+		}
+
+		if s.offers != nil {
+			if err := s.offers.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.offers.Stop)
+		}
+
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+			cleanup = cleanup.add(s.trampolineForwarder.Stop)
+		}
+
 		if s.hostAnn != nil {
 			if err := s.hostAnn.Start(); err != nil {
 				startErr = err
@@ -1794,6 +2048,12 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.chanRouter.Stop)
 
+		if err := s.prober.Start(); err != nil {
+			startErr = err
+			return
+		}
+		cleanup = cleanup.add(s.prober.Stop)
+
 		if err := s.invoices.Start(); err != nil {
 			startErr = err
 			return
@@ -1812,6 +2072,12 @@ func (s *server) Start() error {
 		}
 		cleanup = cleanup.add(s.htlcSwitch.Stop)
 
+		if err := s.interceptableSwitch.Start(); err != nil {
+			startErr = err
+			return
+		}
+		cleanup = cleanup.add(s.interceptableSwitch.Stop)
+
 		if err := s.chanStatusMgr.Start(); err != nil {
 			startErr = err
 			return
@@ -2013,6 +2279,10 @@ func (s *server) Stop() error {
 
 		// Shutdown the wallet, funding manager, and the rpc server.
 		s.chanStatusMgr.Stop()
+		if err := s.interceptableSwitch.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop interceptableSwitch: %v",
+				err)
+		}
 		if err := s.htlcSwitch.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcSwitch: %v", err)
 		}
@@ -2025,6 +2295,12 @@ func (s *server) Stop() error {
 		if err := s.chanRouter.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chanRouter: %v", err)
 		}
+
+		// The prober waits for its probes in flight, which are
+		// aborted once the router has stopped.
+		if err := s.prober.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop prober: %v", err)
+		}
 		if err := s.chainArb.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chainArb: %v", err)
 		}
@@ -2049,6 +2325,23 @@ func (s *server) Stop() error {
 		if err := s.peerNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop peerNotifier: %v", err)
 		}
+		if s.offers != nil {
+			if err := s.offers.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop offers: %v", err)
+			}
+		}
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop onionMessenger: "+
+					"%v", err)
+			}
+		}
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop "+
+					"trampolineForwarder: %v", err)
+			}
+		}
 		if err := s.htlcNotifier.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop htlcNotifier: %v", err)
 		}
@@ -3522,6 +3815,13 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
+	if s.onionMessenger != nil {
+		pCfg.HandleOnionMessage = s.onionMessenger.HandleMessage
+	}
+	if s.trampolineForwarder != nil {
+		pCfg.TrampolineHandler = s.trampolineForwarder
+	}
+
 	p := peer.NewBrontide(pCfg)
 
 	// TODO(roasbeef): update IP address for link-node
@@ -3978,6 +4278,12 @@ func (s *server) removePeer(p *peer.Brontide) {
 	copy(pubKey[:], pubSer)
 
 	s.peerNotifier.NotifyPeerOffline(pubKey)
+
+	// Forget the onion message rate limit of the peer, so that we don't
+	// keep state for disconnected peers.
+	if s.onionMessenger != nil {
+		s.onionMessenger.RemovePeer(route.Vertex(pubKey))
+	}
 }
 
 // ConnectToPeer requests that the server connect to a Lightning Network peer
@@ -4275,6 +4581,20 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	ourPubKey := s.identityECDH.PubKey().SerializeCompressed()
 	return func(cid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
 		info, edge1, edge2, err := s.chanRouter.GetChannelByID(cid)
+
+		// Channels that use an alias have their edge stored under
+		// our local alias rather than the SCID the link knows them
+		// by, so fall back to those if needed.
+		if err == channeldb.ErrEdgeNotFound {
+			for _, alias := range s.aliasStore.GetAliases(cid) {
+				info, edge1, edge2, err = s.chanRouter.GetChannelByID(
+					alias,
+				)
+				if err == nil {
+					break
+				}
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -4285,6 +4605,51 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	}
 }
 
+// notifyClosedChannel removes the SCID aliases of the channel with the given
+// funding outpoint, if any, before notifying all subscribers of its closure.
+func (s *server) notifyClosedChannel(chanPoint wire.OutPoint) {
+	summary, err := s.chanStateDB.FetchClosedChannel(&chanPoint)
+	if err != nil {
+		srvrLog.Errorf("Unable to fetch closed channel %v: %v",
+			chanPoint, err)
+	} else {
+		err := s.aliasStore.DeleteAliases(summary.ShortChanID)
+		if err != nil {
+			srvrLog.Errorf("Unable to delete aliases of channel "+
+				"%v: %v", chanPoint, err)
+		}
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+	if err := s.aliasStore.DeletePeerAlias(chanID); err != nil {
+		srvrLog.Errorf("Unable to delete peer alias of channel %v: %v",
+			chanPoint, err)
+	}
+
+	s.channelNotifier.NotifyClosedChannelEvent(chanPoint)
+}
+
+// notifySplicedChannel announces a channel under its new short channel ID once
+// a splice of the channel has been confirmed, and notifies all subscribers
+// that the channel moved to a new funding outpoint.
+func (s *server) notifySplicedChannel(oldChanPoint wire.OutPoint,
+	channel *channeldb.OpenChannel) {
+
+	s.fundingMgr.ProcessSplicedChannel(channel)
+	s.channelNotifier.NotifySplicedChannelEvent(oldChanPoint, channel)
+}
+
+// isChannelActive returns true if the link of the channel with the given
+// funding outpoint is active within the switch and eligible to forward HTLCs.
+func (s *server) isChannelActive(chanPoint wire.OutPoint) bool {
+	link, err := s.htlcSwitch.GetLinkByChanPoint(chanPoint)
+	if err != nil {
+		return false
+	}
+
+	return s.htlcSwitch.HasActiveLink(link.ChanID())
+}
+
 // applyChannelUpdate applies the channel update to the different sub-systems of
 // the server.
 func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
@@ -4326,6 +4691,83 @@ func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
 	return peer.SendMessageLazy(true, msg)
 }
 
+// sendOnionMessage sends an onion message to the peer with the specified
+// pubkey.
+func (s *server) sendOnionMessage(peerPub route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	// Onion messages aren't critical to any channel operation, so they're
+	// sent as low-priority messages.
+	return peer.SendMessageLazy(false, msg)
+}
+
+// addInvoiceConfig returns the config that is used to add invoices to the
+// invoice registry.
+func (s *server) addInvoiceConfig() *invoicesrpc.AddInvoiceConfig {
+	defaultDelta := s.cfg.Brocoin.TimeLockDelta
+	if s.cfg.registeredChains.PrimaryChain() == chainreg.LitecoinChain {
+		defaultDelta = s.cfg.Litecoin.TimeLockDelta
+	}
+
+	return &invoicesrpc.AddInvoiceConfig{
+		AddInvoice:        s.invoices.AddInvoice,
+		IsChannelActive:   s.htlcSwitch.HasActiveLink,
+		ChainParams:       s.cfg.ActiveNetParams.Params,
+		NodeSigner:        s.nodeSigner,
+		DefaultCLTVExpiry: defaultDelta,
+		ChanDB:            s.chanStateDB,
+		Graph:             s.graphDB,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoice)
+		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return s.featureMgr.Get(feature.SetInvoiceAmp)
+		},
+		NodePubKey: s.identityECDH.PubKey(),
+		BestHeight: func() (uint32, error) {
+			_, height, err := s.cc.ChainIO.GetBestBlock()
+			return uint32(height), err
+		},
+		GetAlias: s.aliasStore.GetPeerAlias,
+	}
+}
+
+// addOfferInvoice adds a blinded invoice for an invoice request for one of
+// our offers, returning its payment hash and blinded paths.
+func (s *server) addOfferInvoice(amt lnwire.MilliBronees, memo string,
+	expiry time.Duration) (lntypes.Hash, []*blindedpath.BlindedPayment,
+	error) {
+
+	hash, invoice, err := invoicesrpc.AddInvoice(
+		context.Background(), s.addInvoiceConfig(),
+		&invoicesrpc.AddInvoiceData{
+			Memo:   memo,
+			Value:  amt,
+			Expiry: int64(expiry.Seconds()),
+			Blind:  true,
+		},
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	// The blinded paths are only part of the payment request of the
+	// invoice, so we decode them from it.
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), s.cfg.ActiveNetParams.Params,
+	)
+	if err != nil {
+		return lntypes.Hash{}, nil, err
+	}
+
+	return *hash, payReq.BlindedPaymentPaths, nil
+}
+
 // newSweepPkScriptGen creates closure that generates a new public key script
 // which should be used to sweep any funds into the on-chain wallet.
 // Specifically, the script generated is a version 0, pay-to-witness-pubkey-hash
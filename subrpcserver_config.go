@@ -12,6 +12,7 @@ import (
 	"github.com/brsuite/broln/lncfg"
 	"github.com/brsuite/broln/lnrpc/autopilotrpc"
 	"github.com/brsuite/broln/lnrpc/chainrpc"
+	"github.com/brsuite/broln/lnrpc/devrpc"
 	"github.com/brsuite/broln/lnrpc/invoicesrpc"
 	"github.com/brsuite/broln/lnrpc/routerrpc"
 	"github.com/brsuite/broln/lnrpc/signrpc"
@@ -21,6 +22,7 @@ import (
 	"github.com/brsuite/broln/lnwire"
 	"github.com/brsuite/broln/macaroons"
 	"github.com/brsuite/broln/netann"
+	"github.com/brsuite/broln/offers"
 	"github.com/brsuite/broln/routing"
 	"github.com/brsuite/broln/sweep"
 	"github.com/brsuite/broln/watchtower"
@@ -74,6 +76,10 @@ type subRPCServerConfigs struct {
 	// instance within broln in order to add, remove, list registered client
 	// towers, etc.
 	WatchtowerClientRPC *wtclientrpc.Config `group:"wtclientrpc" namespace:"wtclientrpc"`
+
+	// DevRPC is a sub-RPC server that exposes functionality that is only
+	// meant to be used for testing and development.
+	DevRPC *devrpc.Config `group:"devrpc" namespace:"devrpc"`
 }
 
 // PopulateDependencies attempts to iterate through all the sub-server configs
@@ -87,6 +93,8 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	networkDir string, macService *macaroons.Service,
 	atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
+	htlcModifier invoices.HtlcModifier,
+	offersMgr *offers.Manager,
 	htlcSwitch *htlcswitch.Switch,
 	activeNetParams *chaincfg.Params,
 	chanRouter *routing.ChannelRouter,
@@ -206,6 +214,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("InvoiceRegistry").Set(
 				reflect.ValueOf(invoiceRegistry),
 			)
+			subCfgValue.FieldByName("HtlcModifier").Set(
+				reflect.ValueOf(htlcModifier),
+			)
 			subCfgValue.FieldByName("IsChannelActive").Set(
 				reflect.ValueOf(htlcSwitch.HasActiveLink),
 			)
@@ -234,6 +245,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 			subCfgValue.FieldByName("GenAmpInvoiceFeatures").Set(
 				reflect.ValueOf(genAmpInvoiceFeatures),
 			)
+			subCfgValue.FieldByName("Offers").Set(
+				reflect.ValueOf(offersMgr),
+			)
 
 		// RouterRPC isn't conditionally compiled and doesn't need to be
 		// populated using reflection.
@@ -270,6 +284,13 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 				reflect.ValueOf(rpcLogger),
 			)
 
+		case *devrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("Switch").Set(
+				reflect.ValueOf(htlcSwitch),
+			)
+
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
 				cfg)
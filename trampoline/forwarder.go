@@ -0,0 +1,636 @@
+package trampoline
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/htlcswitch"
+	"github.com/brsuite/broln/invoices"
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/route"
+)
+
+const (
+	// DefaultMppTimeout is the default time that we wait for all parts of
+	// a trampoline payment to arrive before failing them back.
+	DefaultMppTimeout = time.Minute
+
+	// DefaultMaxParts is the maximum number of parts that we split the
+	// outgoing payment of a trampoline forward into.
+	DefaultMaxParts = 16
+
+	// expirySafetyDelta is the number of blocks that we keep between the
+	// expiry of the outgoing htlcs and the expiry of the incoming ones, so
+	// that we have time to claim the incoming htlcs on chain.
+	expirySafetyDelta = 40
+)
+
+var (
+	// errShuttingDown is returned when the forwarder shuts down while
+	// waiting for an outgoing payment to complete.
+	errShuttingDown = errors.New("trampoline forwarder shutting down")
+)
+
+// Config contains the dependencies of the Forwarder.
+type Config struct {
+	// NodeKey is the key of our node, which is used to peel our layer off
+	// the trampoline onions that we receive.
+	NodeKey keychain.SingleKeyECDH
+
+	// Policy is the fee and expiry delta that we charge for forwarding
+	// trampoline payments.
+	Policy HopPolicy
+
+	// MppTimeout is the time that we wait for all parts of a trampoline
+	// payment to arrive before failing them back.
+	MppTimeout time.Duration
+
+	// BestHeight returns the current best block height.
+	BestHeight func() uint32
+
+	// SendPayment sends the passed payment and blocks until it either
+	// succeeded or failed.
+	SendPayment func(*routing.LightningPayment) ([32]byte, *route.Route,
+		error)
+
+	// SubscribePayment subscribes to updates of the outgoing payment with
+	// the given hash. It is used to pick up outgoing payments that were
+	// started before a restart.
+	SubscribePayment func(lntypes.Hash) (*routing.ControlTowerSubscriber,
+		error)
+
+	// Clock is the clock used to time out incomplete htlc sets.
+	Clock clock.Clock
+}
+
+// incomingHtlc is an htlc that is part of an incoming trampoline payment.
+type incomingHtlc struct {
+	// amt is the amount of the htlc.
+	amt lnwire.MilliBronees
+
+	// expiry is the absolute expiry of the htlc.
+	expiry uint32
+
+	// acceptHeight is the block height at which the htlc was accepted.
+	acceptHeight int32
+
+	// hodlChan is the channel that the resolution of the htlc is sent on.
+	// It is nil if the link that the htlc arrived on unsubscribed.
+	hodlChan chan<- interface{}
+}
+
+// setKey identifies the set of htlcs that make up an incoming trampoline
+// payment.
+type setKey struct {
+	payHash     lntypes.Hash
+	paymentAddr [32]byte
+}
+
+// htlcSet is the set of htlcs that make up an incoming trampoline payment.
+type htlcSet struct {
+	// total is the total amount of the set that the sender committed to.
+	total lnwire.MilliBronees
+
+	// onion is the trampoline onion that all htlcs of the set carry.
+	onion []byte
+
+	// htlcs are the htlcs of the set that have arrived so far.
+	htlcs map[channeldb.CircuitKey]*incomingHtlc
+
+	// forwarding is true once the outgoing payment has been started.
+	forwarding bool
+}
+
+// amt returns the sum of the amounts of the htlcs in the set.
+func (s *htlcSet) amt() lnwire.MilliBronees {
+	var amt lnwire.MilliBronees
+	for _, htlc := range s.htlcs {
+		amt += htlc.amt
+	}
+
+	return amt
+}
+
+// minExpiry returns the lowest expiry of the htlcs in the set.
+func (s *htlcSet) minExpiry() uint32 {
+	var expiry uint32
+	for _, htlc := range s.htlcs {
+		if expiry == 0 || htlc.expiry < expiry {
+			expiry = htlc.expiry
+		}
+	}
+
+	return expiry
+}
+
+// Forwarder forwards the trampoline payments that we receive as a trampoline
+// node. It collects the htlcs of an incoming payment, peels its layer off the
+// trampoline onion, pays the next trampoline node or the final recipient and
+// settles or fails the incoming htlcs depending on the outcome.
+type Forwarder struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// sets holds the incoming trampoline payments that haven't been
+	// resolved yet.
+	sets map[setKey]*htlcSet
+	mu   sync.Mutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// A compile time check to ensure Forwarder implements the
+// htlcswitch.TrampolineHandler interface.
+var _ htlcswitch.TrampolineHandler = (*Forwarder)(nil)
+
+// NewForwarder creates a new trampoline forwarder.
+func NewForwarder(cfg *Config) *Forwarder {
+	return &Forwarder{
+		cfg:  cfg,
+		sets: make(map[setKey]*htlcSet),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the forwarder.
+func (f *Forwarder) Start() error {
+	f.started.Do(func() {
+		log.Infof("Trampoline forwarder starting with policy: "+
+			"base_fee=%v, fee_rate=%v, cltv_delta=%v",
+			f.cfg.Policy.FeeBase, f.cfg.Policy.FeeRate,
+			f.cfg.Policy.CltvDelta)
+	})
+
+	return nil
+}
+
+// Stop signals the forwarder for a graceful shutdown. Incoming htlcs that
+// haven't been resolved yet are picked up again after a restart, as they are
+// replayed by their links.
+func (f *Forwarder) Stop() error {
+	f.stopped.Do(func() {
+		log.Info("Trampoline forwarder shutting down")
+
+		close(f.quit)
+		f.wg.Wait()
+	})
+
+	return nil
+}
+
+// NotifyTrampolineHtlc hands an htlc that carries a trampoline onion to the
+// forwarder. Once all htlcs of the payment have arrived, the payment is
+// forwarded, and the htlcs are resolved on their hodlChan once the outgoing
+// payment completes.
+//
+// NOTE: This is part of the htlcswitch.TrampolineHandler interface.
+func (f *Forwarder) NotifyTrampolineHtlc(payHash lntypes.Hash,
+	paidAmount lnwire.MilliBronees, expiry uint32, currentHeight int32,
+	circuitKey channeldb.CircuitKey, hodlChan chan<- interface{},
+	payload htlcswitch.TrampolinePayload) (invoices.HtlcResolution, error) {
+
+	// The sender must provide the total amount of the payment, so that we
+	// know when all htlcs have arrived.
+	mpp := payload.MultiPath()
+	if mpp == nil {
+		log.Debugf("Trampoline htlc %v of payment %v without payment "+
+			"data", circuitKey, payHash)
+
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight,
+			invoices.ResultTrampolineFailed,
+		), nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := setKey{
+		payHash:     payHash,
+		paymentAddr: mpp.PaymentAddr(),
+	}
+
+	set, exists := f.sets[key]
+	if !exists {
+		set = &htlcSet{
+			total: mpp.TotalMsat(),
+			onion: payload.TrampolineOnion(),
+			htlcs: make(map[channeldb.CircuitKey]*incomingHtlc),
+		}
+	}
+
+	// If the htlc is replayed, we only update the channel to deliver its
+	// resolution on.
+	if existing, ok := set.htlcs[circuitKey]; ok {
+		existing.hodlChan = hodlChan
+		return nil, nil
+	}
+
+	// All htlcs of the set must agree on the total amount and carry the
+	// same trampoline onion.
+	switch {
+	case mpp.TotalMsat() != set.total:
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight,
+			invoices.ResultHtlcSetTotalMismatch,
+		), nil
+
+	case !bytes.Equal(payload.TrampolineOnion(), set.onion):
+		return invoices.NewFailResolution(
+			circuitKey, currentHeight,
+			invoices.ResultTrampolineFailed,
+		), nil
+	}
+
+	set.htlcs[circuitKey] = &incomingHtlc{
+		amt:          paidAmount,
+		expiry:       expiry,
+		acceptHeight: currentHeight,
+		hodlChan:     hodlChan,
+	}
+
+	if exists {
+		f.maybeForward(key, set)
+		return nil, nil
+	}
+
+	// This is the first htlc of the set. If we have already started an
+	// outgoing payment for it before a restart, we'll resolve the set
+	// with the outcome of that payment.
+	f.sets[key] = set
+
+	payment, err := f.fetchPayment(payHash)
+	if err != nil {
+		delete(f.sets, key)
+		return nil, err
+	}
+
+	if payment != nil && payment.Status != channeldb.StatusFailed {
+		log.Debugf("Resuming trampoline forward of payment %v", payHash)
+
+		set.forwarding = true
+
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+
+			f.resolveSet(key, set, nil, nil, 0)
+		}()
+
+		return nil, nil
+	}
+
+	f.wg.Add(1)
+	go f.timeoutSet(key, set)
+
+	f.maybeForward(key, set)
+
+	return nil, nil
+}
+
+// HodlUnsubscribeAll unsubscribes from all htlc resolutions that would be
+// sent on the given channel.
+//
+// NOTE: This is part of the htlcswitch.TrampolineHandler interface.
+func (f *Forwarder) HodlUnsubscribeAll(subscriber chan<- interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, set := range f.sets {
+		for _, htlc := range set.htlcs {
+			if htlc.hodlChan == subscriber {
+				htlc.hodlChan = nil
+			}
+		}
+	}
+}
+
+// maybeForward starts the outgoing payment of the set once all of its htlcs
+// have arrived.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (f *Forwarder) maybeForward(key setKey, set *htlcSet) {
+	if set.forwarding || set.amt() < set.total {
+		return
+	}
+
+	set.forwarding = true
+
+	f.wg.Add(1)
+	go f.forward(key, set, set.total, set.minExpiry(), set.onion)
+}
+
+// timeoutSet fails the htlcs of the set back if not all of them arrived
+// within the mpp timeout.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (f *Forwarder) timeoutSet(key setKey, set *htlcSet) {
+	defer f.wg.Done()
+
+	select {
+	case <-f.cfg.Clock.TickAfter(f.cfg.MppTimeout):
+	case <-f.quit:
+		return
+	}
+
+	// Claim the set, unless it has been completed or resolved in the
+	// meantime.
+	f.mu.Lock()
+	if set.forwarding || f.sets[key] != set {
+		f.mu.Unlock()
+		return
+	}
+	set.forwarding = true
+	f.mu.Unlock()
+
+	log.Debugf("Trampoline payment %v timed out waiting for all parts",
+		key.payHash)
+
+	f.resolveSet(
+		key, set, nil, errors.New("mpp timeout"),
+		invoices.ResultMppTimeout,
+	)
+}
+
+// forward peels our layer off the trampoline onion of a complete set, checks
+// that it pays our fee and leaves us enough time, and then forwards the
+// payment to the next node.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (f *Forwarder) forward(key setKey, set *htlcSet,
+	incomingAmt lnwire.MilliBronees, incomingExpiry uint32, onion []byte) {
+
+	defer f.wg.Done()
+
+	payment, outcome, err := f.outgoingPayment(
+		key.payHash, incomingAmt, incomingExpiry, onion,
+	)
+	if err != nil {
+		log.Debugf("Unable to forward trampoline payment %v: %v",
+			key.payHash, err)
+
+		f.resolveSet(key, set, nil, err, outcome)
+		return
+	}
+
+	log.Debugf("Forwarding trampoline payment %v of %v to %v",
+		key.payHash, payment.Amount, payment.Target)
+
+	preimage, _, err := f.cfg.SendPayment(payment)
+
+	// If a payment for this hash is already in flight or succeeded, which
+	// may happen if a set is replayed after we resolved it, we'll wait
+	// for its outcome instead.
+	if errors.Is(err, channeldb.ErrPaymentInFlight) ||
+		errors.Is(err, channeldb.ErrAlreadyPaid) {
+
+		f.resolveSet(key, set, nil, nil, 0)
+		return
+	}
+
+	var p *lntypes.Preimage
+	if err == nil {
+		p = (*lntypes.Preimage)(&preimage)
+	}
+
+	f.resolveSet(key, set, p, err, invoices.ResultTrampolineFailed)
+}
+
+// outgoingPayment constructs the outgoing payment for an incoming trampoline
+// payment. If the incoming payment is invalid, the failure outcome for its
+// htlcs is returned along with the error.
+func (f *Forwarder) outgoingPayment(payHash lntypes.Hash,
+	incomingAmt lnwire.MilliBronees, incomingExpiry uint32,
+	onion []byte) (*routing.LightningPayment,
+	invoices.FailResolutionResult, error) {
+
+	var packet OnionPacket
+	if err := packet.Decode(bytes.NewReader(onion)); err != nil {
+		return nil, invoices.ResultTrampolineFailed, err
+	}
+
+	processed, err := packet.Process(f.cfg.NodeKey, payHash[:])
+	if err != nil {
+		return nil, invoices.ResultTrampolineFailed, err
+	}
+
+	payload, err := DecodePayload(bytes.NewReader(processed.Payload))
+	if err != nil {
+		return nil, invoices.ResultTrampolineFailed, err
+	}
+
+	// The recipient supporting trampoline payments itself, which would
+	// leave us as the final hop of the trampoline onion without payment
+	// data, isn't supported.
+	if processed.NextPacket == nil && payload.PaymentData == nil {
+		return nil, invoices.ResultTrampolineFailed,
+			errors.New("final trampoline hop without payment data")
+	}
+
+	// A single trampoline node has to pay the full amount to the final
+	// recipient.
+	if payload.PaymentData != nil &&
+		payload.PaymentData.TotalMsat() != payload.AmtToForward {
+
+		return nil, invoices.ResultTrampolineFailed,
+			errors.New("partial trampoline payments not supported")
+	}
+
+	fee := f.cfg.Policy.Fee(payload.AmtToForward)
+	if incomingAmt < payload.AmtToForward+fee {
+		return nil, invoices.ResultTrampolineFeeInsufficient,
+			fmt.Errorf("incoming amount %v doesn't cover amount "+
+				"to forward %v plus fee %v", incomingAmt,
+				payload.AmtToForward, fee)
+	}
+
+	var (
+		height    = f.cfg.BestHeight()
+		cltvDelta = uint32(f.cfg.Policy.CltvDelta)
+	)
+	if payload.OutgoingCltv <= height ||
+		incomingExpiry < payload.OutgoingCltv+cltvDelta ||
+		incomingExpiry < height+expirySafetyDelta {
+
+		return nil, invoices.ResultTrampolineExpiryTooSoon,
+			fmt.Errorf("incoming expiry %v doesn't cover outgoing "+
+				"expiry %v plus delta %v at height %v",
+				incomingExpiry, payload.OutgoingCltv,
+				f.cfg.Policy.CltvDelta, height)
+	}
+
+	payment := &routing.LightningPayment{
+		Target:         route.NewVertex(payload.OutgoingNodeID),
+		Amount:         payload.AmtToForward,
+		FeeLimit:       incomingAmt - payload.AmtToForward,
+		CltvLimit:      incomingExpiry - height - expirySafetyDelta,
+		FinalCLTVDelta: uint16(payload.OutgoingCltv - height),
+		MaxParts:       DefaultMaxParts,
+	}
+	if err := payment.SetPaymentHash(payHash); err != nil {
+		return nil, invoices.ResultTrampolineFailed, err
+	}
+
+	// If we're the last trampoline node, we pay the recipient directly
+	// using the payment data of its invoice. Otherwise we hand the next
+	// trampoline node its onion, under a fresh payment address.
+	if payload.PaymentData != nil {
+		paymentAddr := payload.PaymentData.PaymentAddr()
+		payment.PaymentAddr = &paymentAddr
+		payment.DestFeatures = recipientFeatures
+	} else {
+		var paymentAddr [32]byte
+		if _, err := rand.Read(paymentAddr[:]); err != nil {
+			return nil, invoices.ResultTrampolineFailed, err
+		}
+
+		nextOnion, err := processed.NextPacket.Bytes()
+		if err != nil {
+			return nil, invoices.ResultTrampolineFailed, err
+		}
+
+		payment.PaymentAddr = &paymentAddr
+		payment.DestFeatures = trampolineFeatures
+		payment.TrampolineOnion = nextOnion
+	}
+
+	return payment, 0, nil
+}
+
+// resolveSet resolves the htlcs of the set. If the preimage is known, the
+// htlcs are settled, otherwise they're failed with the given outcome. A nil
+// preimage and error signal that the outcome of an existing outgoing payment
+// should be awaited first.
+func (f *Forwarder) resolveSet(key setKey, set *htlcSet,
+	preimage *lntypes.Preimage, err error,
+	outcome invoices.FailResolutionResult) {
+
+	if preimage == nil && err == nil {
+		preimage, err = f.waitForPayment(key.payHash)
+		outcome = invoices.ResultTrampolineFailed
+	}
+
+	// If we're shutting down, the htlcs are left to be replayed.
+	if err == errShuttingDown {
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.sets[key] != set {
+		return
+	}
+	delete(f.sets, key)
+
+	if err != nil {
+		log.Debugf("Failing trampoline payment %v with outcome %v: %v",
+			key.payHash, outcome, err)
+	}
+
+	for circuitKey, htlc := range set.htlcs {
+		var resolution invoices.HtlcResolution
+		if preimage != nil {
+			resolution = invoices.NewSettleResolution(
+				*preimage, circuitKey, htlc.acceptHeight,
+				invoices.ResultSettled,
+			)
+		} else {
+			resolution = invoices.NewFailResolution(
+				circuitKey, htlc.acceptHeight, outcome,
+			)
+		}
+
+		// If the link of the htlc unsubscribed, it'll be replayed
+		// once the link comes back up.
+		if htlc.hodlChan == nil {
+			continue
+		}
+
+		select {
+		case htlc.hodlChan <- resolution:
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+// fetchPayment returns the current state of the outgoing payment with the
+// given hash, or nil if there is none.
+func (f *Forwarder) fetchPayment(payHash lntypes.Hash) (*channeldb.MPPayment,
+	error) {
+
+	sub, err := f.cfg.SubscribePayment(payHash)
+	if err == channeldb.ErrPaymentNotInitiated {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer sub.Close()
+
+	// The current state of the payment is always sent out immediately.
+	select {
+	case item, ok := <-sub.Updates:
+		if !ok {
+			return nil, fmt.Errorf("payment %v subscription "+
+				"closed", payHash)
+		}
+
+		return item.(*channeldb.MPPayment), nil
+
+	case <-f.quit:
+		return nil, errShuttingDown
+	}
+}
+
+// waitForPayment waits for the outgoing payment with the given hash to
+// complete and returns its preimage if it succeeded.
+func (f *Forwarder) waitForPayment(payHash lntypes.Hash) (*lntypes.Preimage,
+	error) {
+
+	sub, err := f.cfg.SubscribePayment(payHash)
+	if err != nil {
+		return nil, err
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case item, ok := <-sub.Updates:
+			if !ok {
+				return nil, fmt.Errorf("payment %v "+
+					"subscription closed", payHash)
+			}
+
+			payment := item.(*channeldb.MPPayment)
+
+			// As soon as a single part settled, we know the
+			// preimage and can settle our incoming htlcs.
+			settle, _ := payment.TerminalInfo()
+			if settle != nil {
+				return &settle.Preimage, nil
+			}
+
+			if payment.Status == channeldb.StatusFailed {
+				return nil, fmt.Errorf("payment %v failed: "+
+					"%v", payHash, payment.FailureReason)
+			}
+
+		case <-f.quit:
+			return nil, errShuttingDown
+		}
+	}
+}
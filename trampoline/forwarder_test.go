@@ -0,0 +1,325 @@
+package trampoline
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/brsuite/broln/channeldb"
+	"github.com/brsuite/broln/clock"
+	"github.com/brsuite/broln/invoices"
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/broln/lntypes"
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTimeout = 5 * time.Second
+
+	testHeight = 100
+)
+
+var testTime = time.Date(2018, time.February, 2, 14, 0, 0, 0, time.UTC)
+
+// mockPayload is a mock implementation of htlcswitch.TrampolinePayload.
+type mockPayload struct {
+	mpp   *record.MPP
+	onion []byte
+}
+
+func (p *mockPayload) MultiPath() *record.MPP {
+	return p.mpp
+}
+
+func (p *mockPayload) TrampolineOnion() []byte {
+	return p.onion
+}
+
+// forwarderTestContext holds a forwarder along with the payments that it
+// sends.
+type forwarderTestContext struct {
+	t *testing.T
+
+	forwarder *Forwarder
+	clock     *clock.TestClock
+	ticks     chan time.Duration
+	payments  chan *routing.LightningPayment
+	preimage  lntypes.Preimage
+}
+
+func newForwarderTestContext(t *testing.T,
+	nodeKey *bronec.PrivateKey) *forwarderTestContext {
+
+	ctx := &forwarderTestContext{
+		t:        t,
+		ticks:    make(chan time.Duration, 1),
+		payments: make(chan *routing.LightningPayment, 1),
+		preimage: lntypes.Preimage{1, 2, 3},
+	}
+	ctx.clock = clock.NewTestClockWithTickSignal(testTime, ctx.ticks)
+
+	ctx.forwarder = NewForwarder(&Config{
+		NodeKey:    &keychain.PrivKeyECDH{PrivKey: nodeKey},
+		Policy:     DefaultHopPolicy,
+		MppTimeout: DefaultMppTimeout,
+		BestHeight: func() uint32 {
+			return testHeight
+		},
+		SendPayment: func(p *routing.LightningPayment) ([32]byte,
+			*route.Route, error) {
+
+			ctx.payments <- p
+			return ctx.preimage, nil, nil
+		},
+		SubscribePayment: func(lntypes.Hash) (
+			*routing.ControlTowerSubscriber, error) {
+
+			return nil, channeldb.ErrPaymentNotInitiated
+		},
+		Clock: ctx.clock,
+	})
+	require.NoError(t, ctx.forwarder.Start())
+	t.Cleanup(func() {
+		require.NoError(t, ctx.forwarder.Stop())
+	})
+
+	return ctx
+}
+
+// notifyHtlc hands an htlc with the given amount to the forwarder and
+// returns the channel that its resolution is delivered on.
+func (c *forwarderTestContext) notifyHtlc(id uint64, payHash lntypes.Hash,
+	amt lnwire.MilliBronees, expiry uint32,
+	payload *mockPayload) chan interface{} {
+
+	hodlChan := make(chan interface{}, 1)
+	resolution, err := c.forwarder.NotifyTrampolineHtlc(
+		payHash, amt, expiry, testHeight,
+		channeldb.CircuitKey{HtlcID: id}, hodlChan, payload,
+	)
+	require.NoError(c.t, err)
+	require.Nil(c.t, resolution)
+
+	return hodlChan
+}
+
+// receiveResolution waits for the resolution of an htlc.
+func (c *forwarderTestContext) receiveResolution(
+	hodlChan chan interface{}) interface{} {
+
+	select {
+	case resolution := <-hodlChan:
+		return resolution
+	case <-time.After(testTimeout):
+		c.t.Fatal("no resolution received")
+	}
+
+	return nil
+}
+
+// newTestKey returns a new private key.
+func newTestKey(t *testing.T) *bronec.PrivateKey {
+	key, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	return key
+}
+
+// preparePayment prepares a trampoline payment to the recipient over the
+// passed trampoline nodes.
+func preparePayment(t *testing.T, recipient *bronec.PrivateKey,
+	nodes ...*bronec.PrivateKey) *routing.LightningPayment {
+
+	paymentAddr := [32]byte{9}
+	payment := &routing.LightningPayment{
+		Target:         route.NewVertex(recipient.PubKey()),
+		Amount:         100_000,
+		FinalCLTVDelta: 40,
+		PaymentAddr:    &paymentAddr,
+	}
+	require.NoError(t, payment.SetPaymentHash(lntypes.Hash{1}))
+
+	vertices := make([]route.Vertex, len(nodes))
+	for i, node := range nodes {
+		vertices[i] = route.NewVertex(node.PubKey())
+	}
+
+	require.NoError(t, PreparePayment(
+		payment, vertices, DefaultHopPolicy, testHeight,
+	))
+
+	return payment
+}
+
+// TestForwarderForward asserts that a trampoline node collects all htlcs of
+// an incoming trampoline payment, forwards the payment to the next
+// trampoline node and settles the htlcs once the payment succeeded.
+func TestForwarderForward(t *testing.T) {
+	t.Parallel()
+
+	var (
+		recipient = newTestKey(t)
+		node1     = newTestKey(t)
+		node2     = newTestKey(t)
+		payment   = preparePayment(t, recipient, node1, node2)
+		payHash   = payment.Identifier()
+	)
+
+	// The sender pays both trampoline nodes on top of the amount and
+	// expiry delta of the recipient.
+	require.Equal(t, route.NewVertex(node1.PubKey()), payment.Target)
+	require.EqualValues(t, 110_205, payment.Amount)
+	require.EqualValues(t, 40+2*DefaultCltvDelta, payment.FinalCLTVDelta)
+
+	ctx := newForwarderTestContext(t, node1)
+
+	var (
+		expiry  = testHeight + uint32(payment.FinalCLTVDelta)
+		payload = &mockPayload{
+			mpp: record.NewMPP(
+				payment.Amount, *payment.PaymentAddr,
+			),
+			onion: payment.TrampolineOnion,
+		}
+	)
+
+	// The payment isn't forwarded until all of its htlcs have arrived.
+	hodlChan1 := ctx.notifyHtlc(0, payHash, 60_000, expiry, payload)
+	select {
+	case <-ctx.payments:
+		t.Fatal("payment forwarded before set is complete")
+	default:
+	}
+
+	hodlChan2 := ctx.notifyHtlc(
+		1, payHash, payment.Amount-60_000, expiry, payload,
+	)
+
+	var outgoing *routing.LightningPayment
+	select {
+	case outgoing = <-ctx.payments:
+	case <-time.After(testTimeout):
+		t.Fatal("payment not forwarded")
+	}
+
+	// The outgoing payment pays the second trampoline node, which may
+	// spend the fee of the first trampoline node on routing fees.
+	require.Equal(t, route.NewVertex(node2.PubKey()), outgoing.Target)
+	require.Equal(t, payHash, outgoing.Identifier())
+	require.EqualValues(t, 105_100, outgoing.Amount)
+	require.EqualValues(t, 5_105, outgoing.FeeLimit)
+	require.EqualValues(t, 40+DefaultCltvDelta, outgoing.FinalCLTVDelta)
+	require.EqualValues(
+		t, expiry-testHeight-expirySafetyDelta, outgoing.CltvLimit,
+	)
+	require.NotEqual(t, payment.PaymentAddr, outgoing.PaymentAddr)
+
+	// The second trampoline node must be able to peel its layer off the
+	// onion and find the payment data of the recipient.
+	var packet OnionPacket
+	require.NoError(t, packet.Decode(
+		bytes.NewReader(outgoing.TrampolineOnion),
+	))
+	processed, err := packet.Process(
+		&keychain.PrivKeyECDH{PrivKey: node2}, payHash[:],
+	)
+	require.NoError(t, err)
+	require.Nil(t, processed.NextPacket)
+
+	finalPayload, err := DecodePayload(bytes.NewReader(processed.Payload))
+	require.NoError(t, err)
+	require.EqualValues(t, 100_000, finalPayload.AmtToForward)
+	require.EqualValues(t, testHeight+40, finalPayload.OutgoingCltv)
+	require.True(t, finalPayload.OutgoingNodeID.IsEqual(
+		recipient.PubKey(),
+	))
+	require.Equal(
+		t, record.NewMPP(100_000, [32]byte{9}),
+		finalPayload.PaymentData,
+	)
+
+	// Both htlcs are settled with the preimage of the outgoing payment.
+	for _, hodlChan := range []chan interface{}{hodlChan1, hodlChan2} {
+		resolution := ctx.receiveResolution(hodlChan)
+		settle, ok := resolution.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, ctx.preimage, settle.Preimage)
+	}
+}
+
+// TestForwarderFeeInsufficient asserts that a trampoline payment which
+// doesn't pay our fee is failed back without being forwarded.
+func TestForwarderFeeInsufficient(t *testing.T) {
+	t.Parallel()
+
+	var (
+		recipient = newTestKey(t)
+		node      = newTestKey(t)
+		payment   = preparePayment(t, recipient, node)
+		payHash   = payment.Identifier()
+		ctx       = newForwarderTestContext(t, node)
+		amt       = payment.Amount - 1
+	)
+
+	hodlChan := ctx.notifyHtlc(
+		0, payHash, amt, testHeight+uint32(payment.FinalCLTVDelta),
+		&mockPayload{
+			mpp:   record.NewMPP(amt, *payment.PaymentAddr),
+			onion: payment.TrampolineOnion,
+		},
+	)
+
+	resolution := ctx.receiveResolution(hodlChan)
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, invoices.ResultTrampolineFeeInsufficient, fail.Outcome)
+
+	select {
+	case <-ctx.payments:
+		t.Fatal("payment forwarded")
+	default:
+	}
+}
+
+// TestForwarderMppTimeout asserts that the htlcs of an incomplete trampoline
+// payment are failed back once the mpp timeout expires.
+func TestForwarderMppTimeout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		recipient = newTestKey(t)
+		node      = newTestKey(t)
+		payment   = preparePayment(t, recipient, node)
+		payHash   = payment.Identifier()
+		ctx       = newForwarderTestContext(t, node)
+	)
+
+	hodlChan := ctx.notifyHtlc(
+		0, payHash, payment.Amount/2,
+		testHeight+uint32(payment.FinalCLTVDelta),
+		&mockPayload{
+			mpp: record.NewMPP(
+				payment.Amount, *payment.PaymentAddr,
+			),
+			onion: payment.TrampolineOnion,
+		},
+	)
+
+	// Wait for the timeout to be scheduled before advancing the clock.
+	select {
+	case <-ctx.ticks:
+	case <-time.After(testTimeout):
+		t.Fatal("mpp timeout not scheduled")
+	}
+	ctx.clock.SetTime(testTime.Add(DefaultMppTimeout))
+
+	resolution := ctx.receiveResolution(hodlChan)
+	fail, ok := resolution.(*invoices.HtlcFailResolution)
+	require.True(t, ok)
+	require.Equal(t, invoices.ResultMppTimeout, fail.Outcome)
+}
@@ -0,0 +1,459 @@
+package trampoline
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+	"golang.org/x/crypto/chacha20"
+)
+
+const (
+	// RoutingInfoSize is the size of the routing info of a trampoline
+	// onion. It is much smaller than that of the outer onion, as it only
+	// has to fit the payloads of a few trampoline hops.
+	RoutingInfoSize = 400
+
+	// HMACSize is the size of the HMAC that authenticates the routing info
+	// of each layer of the onion.
+	HMACSize = 32
+
+	// OnionPacketSize is the size of a serialized trampoline onion packet:
+	// version || ephemeral key || routing info || hmac.
+	OnionPacketSize = 1 + 33 + RoutingInfoSize + HMACSize
+
+	// onionVersion is the only version of the trampoline onion packet that
+	// we understand.
+	onionVersion = 0
+
+	// numStreamBytes is the number of cipher stream bytes that are needed
+	// to process a layer of the onion, which is padded with a full routing
+	// info worth of zeroes before being decrypted.
+	numStreamBytes = 2 * RoutingInfoSize
+)
+
+var (
+	// ErrNoHops is returned when an onion is requested for an empty set
+	// of hops.
+	ErrNoHops = errors.New("trampoline onion must contain at least one " +
+		"hop")
+
+	// ErrRoutingInfoSizeExceeded is returned when the payloads of the
+	// hops don't fit into the routing info of the onion.
+	ErrRoutingInfoSizeExceeded = errors.New("trampoline hop payloads " +
+		"exceed routing info size")
+
+	// ErrInvalidOnionVersion is returned when an onion packet of an
+	// unknown version is decoded.
+	ErrInvalidOnionVersion = errors.New("invalid trampoline onion version")
+
+	// ErrInvalidOnionHMAC is returned when the HMAC of an onion packet
+	// doesn't match its contents.
+	ErrInvalidOnionHMAC = errors.New("invalid trampoline onion hmac")
+
+	// ErrInvalidPayloadSize is returned when the payload of a hop within
+	// an onion packet claims to be larger than the routing info.
+	ErrInvalidPayloadSize = errors.New("invalid trampoline payload size")
+)
+
+// OnionHop is a hop within a trampoline onion along with the payload that is
+// encrypted for it.
+type OnionHop struct {
+	// NodePub is the public key of the trampoline node.
+	NodePub *bronec.PublicKey
+
+	// Payload is the serialized payload for the hop.
+	Payload []byte
+}
+
+// numBytes returns the number of bytes that the hop takes up within the
+// routing info of the onion: length || payload || hmac.
+func (h *OnionHop) numBytes() int {
+	payloadLen := uint64(len(h.Payload))
+
+	return int(tlv.VarIntSize(payloadLen)+payloadLen) + HMACSize
+}
+
+// OnionPacket is a trampoline onion packet. It is constructed like the outer
+// onion of a payment as described in BOLT 04, but has a smaller routing info
+// and is carried in the payload of the final hop of the outer onion.
+type OnionPacket struct {
+	// Version is the version of the packet.
+	Version byte
+
+	// EphemeralKey is the ephemeral public key that the processing node
+	// derives its shared secret with.
+	EphemeralKey *bronec.PublicKey
+
+	// RoutingInfo is the encrypted routing info of the packet.
+	RoutingInfo [RoutingInfoSize]byte
+
+	// HeaderMAC authenticates the routing info of the packet.
+	HeaderMAC [HMACSize]byte
+}
+
+// NewOnionPacket creates a trampoline onion for the passed hops, using the
+// given session key as the initial ephemeral key. The associated data, which
+// is usually the payment hash, is covered by the HMAC of each layer.
+func NewOnionPacket(sessionKey *bronec.PrivateKey, hops []*OnionHop,
+	assocData []byte) (*OnionPacket, error) {
+
+	if len(hops) == 0 {
+		return nil, ErrNoHops
+	}
+
+	var totalSize int
+	for _, hop := range hops {
+		totalSize += hop.numBytes()
+	}
+	if totalSize > RoutingInfoSize {
+		return nil, ErrRoutingInfoSizeExceeded
+	}
+
+	sharedSecrets, err := generateSharedSecrets(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fill the routing info with pseudo-random bytes derived from the
+	// session key, so that the unused space doesn't leak the number of
+	// hops.
+	var (
+		routingInfo [RoutingInfoSize]byte
+		nextHMAC    [HMACSize]byte
+		sessionPriv [32]byte
+	)
+	copy(sessionPriv[:], sessionKey.Serialize())
+	padKey := generateKey("pad", sessionPriv)
+	copy(routingInfo[:], generateCipherStream(padKey, RoutingInfoSize))
+
+	filler := generateFiller(hops, sharedSecrets)
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		rhoKey := generateKey("rho", sharedSecrets[i])
+		muKey := generateKey("mu", sharedSecrets[i])
+
+		// Shift the routing info to the right to make room for the
+		// payload of this hop, followed by the HMAC of the next layer.
+		// The final hop receives an all-zero HMAC.
+		var b bytes.Buffer
+		err := encodeHopPayload(&b, hops[i].Payload, nextHMAC)
+		if err != nil {
+			return nil, err
+		}
+
+		shiftSize := hops[i].numBytes()
+		copy(
+			routingInfo[shiftSize:],
+			routingInfo[:RoutingInfoSize-shiftSize],
+		)
+		copy(routingInfo[:], b.Bytes())
+
+		stream := generateCipherStream(rhoKey, RoutingInfoSize)
+		xor(routingInfo[:], stream)
+
+		// The tail of the innermost layer is overwritten with the
+		// filler, so that the HMACs of all layers remain valid as
+		// each hop shifts its own payload out.
+		if i == len(hops)-1 {
+			copy(routingInfo[RoutingInfoSize-len(filler):], filler)
+		}
+
+		nextHMAC = calcMac(muKey, routingInfo[:], assocData)
+	}
+
+	return &OnionPacket{
+		Version:      onionVersion,
+		EphemeralKey: sessionKey.PubKey(),
+		RoutingInfo:  routingInfo,
+		HeaderMAC:    nextHMAC,
+	}, nil
+}
+
+// Encode serializes the onion packet into the passed io.Writer.
+func (p *OnionPacket) Encode(w io.Writer) error {
+	if _, err := w.Write([]byte{p.Version}); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(p.EphemeralKey.SerializeCompressed()); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(p.RoutingInfo[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(p.HeaderMAC[:])
+
+	return err
+}
+
+// Decode deserializes an onion packet from the passed io.Reader.
+func (p *OnionPacket) Decode(r io.Reader) error {
+	var raw [OnionPacketSize]byte
+	if _, err := io.ReadFull(r, raw[:]); err != nil {
+		return err
+	}
+
+	p.Version = raw[0]
+	if p.Version != onionVersion {
+		return ErrInvalidOnionVersion
+	}
+
+	ephemeralKey, err := bronec.ParsePubKey(raw[1:34], bronec.S256())
+	if err != nil {
+		return err
+	}
+	p.EphemeralKey = ephemeralKey
+
+	copy(p.RoutingInfo[:], raw[34:34+RoutingInfoSize])
+	copy(p.HeaderMAC[:], raw[34+RoutingInfoSize:])
+
+	return nil
+}
+
+// Bytes returns the serialized onion packet.
+func (p *OnionPacket) Bytes() ([]byte, error) {
+	var b bytes.Buffer
+	if err := p.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// ProcessedPacket is the result of peeling a layer off a trampoline onion.
+type ProcessedPacket struct {
+	// Payload is the decrypted payload for the processing node.
+	Payload []byte
+
+	// NextPacket is the onion packet for the next trampoline node. It is
+	// nil if the processing node is the final hop of the onion.
+	NextPacket *OnionPacket
+}
+
+// Process peels a layer off the onion packet using the passed node key,
+// returning the payload for this node along with the packet that should be
+// handed to the next trampoline node, if any.
+func (p *OnionPacket) Process(nodeKey keychain.SingleKeyECDH,
+	assocData []byte) (*ProcessedPacket, error) {
+
+	sharedSecret, err := nodeKey.ECDH(p.EphemeralKey)
+	if err != nil {
+		return nil, err
+	}
+
+	muKey := generateKey("mu", sharedSecret)
+	mac := calcMac(muKey, p.RoutingInfo[:], assocData)
+	if !hmac.Equal(mac[:], p.HeaderMAC[:]) {
+		return nil, ErrInvalidOnionHMAC
+	}
+
+	// Decrypt the routing info, padded with zeroes so that we can shift
+	// out our own payload while keeping the size of the packet constant.
+	rhoKey := generateKey("rho", sharedSecret)
+	var padded [numStreamBytes]byte
+	copy(padded[:], p.RoutingInfo[:])
+	xor(padded[:], generateCipherStream(rhoKey, numStreamBytes))
+
+	r := bytes.NewReader(padded[:])
+	var scratch [8]byte
+	payloadLen, err := tlv.ReadVarInt(r, &scratch)
+	if err != nil {
+		return nil, err
+	}
+	if payloadLen > RoutingInfoSize-HMACSize {
+		return nil, ErrInvalidPayloadSize
+	}
+
+	payload := make([]byte, payloadLen)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, err
+	}
+
+	var nextHMAC [HMACSize]byte
+	if _, err := io.ReadFull(r, nextHMAC[:]); err != nil {
+		return nil, err
+	}
+
+	processed := &ProcessedPacket{
+		Payload: payload,
+	}
+
+	// An all-zero HMAC signals that we're the final hop of the onion.
+	var zeroHMAC [HMACSize]byte
+	if nextHMAC == zeroHMAC {
+		return processed, nil
+	}
+
+	hopSize := int(tlv.VarIntSize(payloadLen)+payloadLen) + HMACSize
+	factor := blindingFactor(p.EphemeralKey, sharedSecret)
+	next := &OnionPacket{
+		Version:      onionVersion,
+		EphemeralKey: scalarMult(p.EphemeralKey, factor),
+		HeaderMAC:    nextHMAC,
+	}
+	copy(next.RoutingInfo[:], padded[hopSize:hopSize+RoutingInfoSize])
+	processed.NextPacket = next
+
+	return processed, nil
+}
+
+// encodeHopPayload writes the payload of a hop as it is placed within the
+// routing info: length || payload || hmac.
+func encodeHopPayload(w io.Writer, payload []byte,
+	nextHMAC [HMACSize]byte) error {
+
+	var scratch [8]byte
+	err := tlv.WriteVarInt(w, uint64(len(payload)), &scratch)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(payload); err != nil {
+		return err
+	}
+
+	_, err = w.Write(nextHMAC[:])
+
+	return err
+}
+
+// generateSharedSecrets derives the shared secret with each of the hops. The
+// ephemeral key for each hop is derived from the one of the previous hop by
+// multiplying it with the blinding factor SHA256(E || ss).
+func generateSharedSecrets(sessionKey *bronec.PrivateKey,
+	hops []*OnionHop) ([][32]byte, error) {
+
+	curve := bronec.S256()
+	sharedSecrets := make([][32]byte, len(hops))
+
+	ephemeralSecret := new(big.Int).Set(sessionKey.D)
+	ephemeralKey := sessionKey.PubKey()
+	for i, hop := range hops {
+		ecdh := &keychain.PrivKeyECDH{
+			PrivKey: scalarToPrivKey(ephemeralSecret),
+		}
+		sharedSecret, err := ecdh.ECDH(hop.NodePub)
+		if err != nil {
+			return nil, err
+		}
+		sharedSecrets[i] = sharedSecret
+
+		factor := blindingFactor(ephemeralKey, sharedSecret)
+		ephemeralSecret.Mul(
+			ephemeralSecret, new(big.Int).SetBytes(factor),
+		)
+		ephemeralSecret.Mod(ephemeralSecret, curve.N)
+		ephemeralKey = scalarMult(ephemeralKey, factor)
+	}
+
+	return sharedSecrets, nil
+}
+
+// generateFiller computes the filler that is placed at the tail of the
+// innermost layer of the onion. It matches the bytes that each hop but the
+// last one shifts in when peeling its layer.
+func generateFiller(hops []*OnionHop, sharedSecrets [][32]byte) []byte {
+	var fillerSize int
+	for _, hop := range hops[:len(hops)-1] {
+		fillerSize += hop.numBytes()
+	}
+	filler := make([]byte, fillerSize)
+
+	fillerStart := RoutingInfoSize
+	for i, hop := range hops[:len(hops)-1] {
+		fillerEnd := RoutingInfoSize + hop.numBytes()
+
+		streamKey := generateKey("rho", sharedSecrets[i])
+		stream := generateCipherStream(streamKey, numStreamBytes)
+		xor(filler, stream[fillerStart:fillerEnd])
+
+		fillerStart -= hop.numBytes()
+	}
+
+	return filler
+}
+
+// generateKey derives a key of the given type from the shared secret as
+// HMAC256(keyType, ss).
+func generateKey(keyType string, sharedSecret [32]byte) [32]byte {
+	mac := hmac.New(sha256.New, []byte(keyType))
+	mac.Write(sharedSecret[:])
+
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+
+	return key
+}
+
+// generateCipherStream generates numBytes of the ChaCha20 stream under the
+// given key and a zero nonce.
+func generateCipherStream(key [32]byte, numBytes int) []byte {
+	var nonce [chacha20.NonceSize]byte
+	cipher, err := chacha20.NewUnauthenticatedCipher(key[:], nonce[:])
+	if err != nil {
+		panic(err)
+	}
+
+	stream := make([]byte, numBytes)
+	cipher.XORKeyStream(stream, stream)
+
+	return stream
+}
+
+// calcMac computes the HMAC of the routing info and associated data under
+// the given key.
+func calcMac(key [32]byte, routingInfo, assocData []byte) [HMACSize]byte {
+	mac := hmac.New(sha256.New, key[:])
+	mac.Write(routingInfo)
+	mac.Write(assocData)
+
+	var h [HMACSize]byte
+	copy(h[:], mac.Sum(nil))
+
+	return h
+}
+
+// xor xors the bytes of b into dst, up to the length of the shorter slice.
+func xor(dst, b []byte) {
+	for i := 0; i < len(dst) && i < len(b); i++ {
+		dst[i] ^= b[i]
+	}
+}
+
+// blindingFactor computes the factor SHA256(E || ss) that the ephemeral key
+// and secret are multiplied with to derive those of the next hop.
+func blindingFactor(ephemeralKey *bronec.PublicKey,
+	sharedSecret [32]byte) []byte {
+
+	h := sha256.New()
+	h.Write(ephemeralKey.SerializeCompressed())
+	h.Write(sharedSecret[:])
+
+	return h.Sum(nil)
+}
+
+// scalarMult multiplies the given public key by the scalar.
+func scalarMult(pub *bronec.PublicKey, scalar []byte) *bronec.PublicKey {
+	x, y := bronec.S256().ScalarMult(pub.X, pub.Y, scalar)
+
+	return &bronec.PublicKey{Curve: bronec.S256(), X: x, Y: y}
+}
+
+// scalarToPrivKey converts the scalar into a private key.
+func scalarToPrivKey(scalar *big.Int) *bronec.PrivateKey {
+	var keyBytes [32]byte
+	scalar.FillBytes(keyBytes[:])
+
+	privKey, _ := bronec.PrivKeyFromBytes(bronec.S256(), keyBytes[:])
+
+	return privKey
+}
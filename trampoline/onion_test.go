@@ -0,0 +1,118 @@
+package trampoline
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/brsuite/broln/keychain"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOnionRoundTrip asserts that each hop of a trampoline onion can peel its
+// own layer off the onion, and that only the final hop is signaled to be the
+// last one.
+func TestOnionRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var (
+		assocData = bytes.Repeat([]byte{1}, 32)
+		nodeKeys  = make([]*bronec.PrivateKey, 3)
+		hops      = make([]*OnionHop, 3)
+	)
+	for i := range hops {
+		key, err := bronec.NewPrivateKey(bronec.S256())
+		require.NoError(t, err)
+
+		nodeKeys[i] = key
+		hops[i] = &OnionHop{
+			NodePub: key.PubKey(),
+			Payload: bytes.Repeat([]byte{byte(i + 1)}, 40+i*10),
+		}
+	}
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	packet, err := NewOnionPacket(sessionKey, hops, assocData)
+	require.NoError(t, err)
+
+	for i, hop := range hops {
+		// Every layer is passed on in its serialized form.
+		b, err := packet.Bytes()
+		require.NoError(t, err)
+		require.Len(t, b, OnionPacketSize)
+
+		packet = &OnionPacket{}
+		require.NoError(t, packet.Decode(bytes.NewReader(b)))
+
+		processed, err := packet.Process(
+			&keychain.PrivKeyECDH{PrivKey: nodeKeys[i]}, assocData,
+		)
+		require.NoError(t, err)
+		require.Equal(t, hop.Payload, processed.Payload)
+
+		if i == len(hops)-1 {
+			require.Nil(t, processed.NextPacket)
+			break
+		}
+
+		require.NotNil(t, processed.NextPacket)
+		packet = processed.NextPacket
+	}
+}
+
+// TestOnionInvalid asserts that onions which don't fit the routing info, or
+// are processed with the wrong key or associated data, are rejected.
+func TestOnionInvalid(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	// A payload that doesn't fit the routing info can't be wrapped.
+	_, err = NewOnionPacket(sessionKey, []*OnionHop{{
+		NodePub: nodeKey.PubKey(),
+		Payload: make([]byte, RoutingInfoSize),
+	}}, nil)
+	require.ErrorIs(t, err, ErrRoutingInfoSizeExceeded)
+
+	_, err = NewOnionPacket(sessionKey, nil, nil)
+	require.ErrorIs(t, err, ErrNoHops)
+
+	assocData := []byte{1, 2, 3}
+	packet, err := NewOnionPacket(sessionKey, []*OnionHop{{
+		NodePub: nodeKey.PubKey(),
+		Payload: []byte{4, 5, 6},
+	}}, assocData)
+	require.NoError(t, err)
+
+	// Processing the onion with different associated data or a different
+	// key must fail the HMAC check.
+	ecdh := &keychain.PrivKeyECDH{PrivKey: nodeKey}
+	_, err = packet.Process(ecdh, []byte{1, 2})
+	require.ErrorIs(t, err, ErrInvalidOnionHMAC)
+
+	_, err = packet.Process(
+		&keychain.PrivKeyECDH{PrivKey: sessionKey}, assocData,
+	)
+	require.ErrorIs(t, err, ErrInvalidOnionHMAC)
+
+	// Tampering with the routing info must also be detected.
+	packet.RoutingInfo[0] ^= 1
+	_, err = packet.Process(ecdh, assocData)
+	require.ErrorIs(t, err, ErrInvalidOnionHMAC)
+
+	// Unknown versions are rejected on decode.
+	packet.RoutingInfo[0] ^= 1
+	packet.Version = 1
+	b, err := packet.Bytes()
+	require.NoError(t, err)
+	require.ErrorIs(
+		t, (&OnionPacket{}).Decode(bytes.NewReader(b)),
+		ErrInvalidOnionVersion,
+	)
+}
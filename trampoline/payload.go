@@ -0,0 +1,120 @@
+package trampoline
+
+import (
+	"errors"
+	"io"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+)
+
+var (
+	// ErrMissingAmt is returned when a trampoline payload doesn't contain
+	// the amount to forward.
+	ErrMissingAmt = errors.New("trampoline payload missing amount")
+
+	// ErrMissingCltv is returned when a trampoline payload doesn't contain
+	// the outgoing cltv value.
+	ErrMissingCltv = errors.New("trampoline payload missing cltv")
+
+	// ErrMissingOutgoingNode is returned when a trampoline payload doesn't
+	// contain the node to forward the payment to.
+	ErrMissingOutgoingNode = errors.New("trampoline payload missing " +
+		"outgoing node id")
+)
+
+// Payload is the payload that a trampoline node finds in its layer of the
+// trampoline onion.
+type Payload struct {
+	// AmtToForward is the amount that the trampoline node should deliver
+	// to the outgoing node.
+	AmtToForward lnwire.MilliBronees
+
+	// OutgoingCltv is the absolute expiry that the htlcs which reach the
+	// outgoing node should carry.
+	OutgoingCltv uint32
+
+	// OutgoingNodeID is the public key of the next trampoline node or of
+	// the final recipient.
+	OutgoingNodeID *bronec.PublicKey
+
+	// PaymentData is set for the last trampoline node of the payment, if
+	// the final recipient doesn't support trampoline payments itself. It
+	// holds the payment address and total amount from the recipient's
+	// invoice, which the trampoline node uses to pay the recipient
+	// directly.
+	PaymentData *record.MPP
+}
+
+// Encode serializes the payload as a TLV stream into the passed io.Writer.
+func (p *Payload) Encode(w io.Writer) error {
+	var (
+		amt    = uint64(p.AmtToForward)
+		nodeID = p.OutgoingNodeID
+	)
+
+	records := []tlv.Record{
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&p.OutgoingCltv),
+		record.NewOutgoingNodeIDRecord(&nodeID),
+	}
+	if p.PaymentData != nil {
+		records = append(records, p.PaymentData.Record())
+	}
+
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return err
+	}
+
+	return stream.Encode(w)
+}
+
+// DecodePayload parses a trampoline payload from the passed io.Reader.
+func DecodePayload(r io.Reader) (*Payload, error) {
+	var (
+		amt         uint64
+		cltv        uint32
+		nodeID      *bronec.PublicKey
+		paymentData = &record.MPP{}
+	)
+
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+		paymentData.Record(),
+		record.NewOutgoingNodeIDRecord(&nodeID),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[record.AmtOnionType]; !ok {
+		return nil, ErrMissingAmt
+	}
+	if _, ok := parsedTypes[record.LockTimeOnionType]; !ok {
+		return nil, ErrMissingCltv
+	}
+	if _, ok := parsedTypes[record.OutgoingNodeIDOnionType]; !ok {
+		return nil, ErrMissingOutgoingNode
+	}
+	if _, ok := parsedTypes[record.MPPOnionType]; !ok {
+		paymentData = nil
+	}
+
+	return &Payload{
+		AmtToForward:   lnwire.MilliBronees(amt),
+		OutgoingCltv:   cltv,
+		OutgoingNodeID: nodeID,
+		PaymentData:    paymentData,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package trampoline
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/tlv"
+	"github.com/brsuite/brond/bronec"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPayloadEncodeDecode asserts that trampoline payloads survive a round
+// trip, and that payloads missing a required field are rejected.
+func TestPayloadEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	key, err := bronec.NewPrivateKey(bronec.S256())
+	require.NoError(t, err)
+
+	payloads := []*Payload{
+		{
+			AmtToForward:   1000,
+			OutgoingCltv:   500,
+			OutgoingNodeID: key.PubKey(),
+		},
+		{
+			AmtToForward:   2000,
+			OutgoingCltv:   600,
+			OutgoingNodeID: key.PubKey(),
+			PaymentData:    record.NewMPP(2000, [32]byte{1}),
+		},
+	}
+
+	for _, payload := range payloads {
+		var b bytes.Buffer
+		require.NoError(t, payload.Encode(&b))
+
+		decoded, err := DecodePayload(&b)
+		require.NoError(t, err)
+		require.Equal(t, payload, decoded)
+	}
+
+	// A payload without an outgoing node can't be forwarded.
+	var (
+		amt  uint64 = 1000
+		cltv uint32 = 500
+		b    bytes.Buffer
+	)
+	stream, err := tlv.NewStream(
+		record.NewAmtToFwdRecord(&amt),
+		record.NewLockTimeRecord(&cltv),
+	)
+	require.NoError(t, err)
+	require.NoError(t, stream.Encode(&b))
+
+	_, err = DecodePayload(&b)
+	require.ErrorIs(t, err, ErrMissingOutgoingNode)
+}
@@ -0,0 +1,231 @@
+package trampoline
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/record"
+	"github.com/brsuite/broln/routing"
+	"github.com/brsuite/broln/routing/route"
+	"github.com/brsuite/brond/bronec"
+)
+
+const (
+	// DefaultFeeBase is the default base fee that a trampoline node
+	// charges for forwarding a payment. As a trampoline node pays for a
+	// full route to the next node, it is considerably higher than the
+	// base fee of a single channel.
+	DefaultFeeBase = lnwire.MilliBronees(5000)
+
+	// DefaultFeeRate is the default proportional fee, in millionths, that
+	// a trampoline node charges for forwarding a payment.
+	DefaultFeeRate = lnwire.MilliBronees(1000)
+
+	// DefaultCltvDelta is the default expiry delta that a trampoline node
+	// requires between its incoming and outgoing htlcs. It has to cover
+	// the expiry deltas of the full route to the next node.
+	DefaultCltvDelta = 288
+
+	// DefaultMaxTrampolineHops is the maximum number of trampoline nodes
+	// that a payment may be routed through.
+	DefaultMaxTrampolineHops = 4
+)
+
+var (
+	// ErrNoTrampolineNodes is returned when a trampoline payment is
+	// requested without any trampoline nodes.
+	ErrNoTrampolineNodes = errors.New("no trampoline nodes specified")
+
+	// ErrTooManyTrampolineNodes is returned when a trampoline payment is
+	// requested over more trampoline nodes than we support.
+	ErrTooManyTrampolineNodes = fmt.Errorf("trampoline payments are "+
+		"limited to %d trampoline nodes", DefaultMaxTrampolineHops)
+
+	// ErrPaymentAddrRequired is returned when a trampoline payment is
+	// requested to a recipient that didn't provide a payment address.
+	ErrPaymentAddrRequired = errors.New("trampoline payments require a " +
+		"payment address")
+
+	// ErrUnsupportedPayment is returned when a trampoline payment is
+	// requested with parameters that can't be conveyed to the last
+	// trampoline node.
+	ErrUnsupportedPayment = errors.New("trampoline payments don't " +
+		"support route hints, blinded paths, custom records, AMP or " +
+		"last hop restrictions")
+)
+
+// HopPolicy describes the fees and expiry delta that a trampoline node
+// charges for forwarding a payment.
+type HopPolicy struct {
+	// FeeBase is the base fee charged by the trampoline node.
+	FeeBase lnwire.MilliBronees
+
+	// FeeRate is the proportional fee, in millionths, charged by the
+	// trampoline node.
+	FeeRate lnwire.MilliBronees
+
+	// CltvDelta is the expiry delta required by the trampoline node.
+	CltvDelta uint16
+}
+
+// DefaultHopPolicy is the policy that senders assume for trampoline nodes
+// that they don't know a policy for.
+var DefaultHopPolicy = HopPolicy{
+	FeeBase:   DefaultFeeBase,
+	FeeRate:   DefaultFeeRate,
+	CltvDelta: DefaultCltvDelta,
+}
+
+// Fee returns the fee that the policy charges for forwarding the given
+// amount.
+func (p *HopPolicy) Fee(amt lnwire.MilliBronees) lnwire.MilliBronees {
+	return p.FeeBase + amt*p.FeeRate/1_000_000
+}
+
+// trampolineFeatures is the feature vector that we assume for trampoline
+// nodes. It signals support for all features needed to receive the outer
+// onion of a trampoline payment.
+var trampolineFeatures = lnwire.NewFeatureVector(
+	lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional,
+		lnwire.PaymentAddrOptional,
+		lnwire.MPPOptional,
+		lnwire.TrampolineRoutingOptional,
+	), lnwire.Features,
+)
+
+// recipientFeatures is the feature vector that the last trampoline node
+// assumes for a recipient that it pays on behalf of the sender. The payment
+// data in its payload requires the recipient to support these features.
+var recipientFeatures = lnwire.NewFeatureVector(
+	lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional,
+		lnwire.PaymentAddrOptional,
+		lnwire.MPPOptional,
+	), lnwire.Features,
+)
+
+// PreparePayment turns the passed payment to its final recipient into a
+// trampoline payment over the given trampoline nodes, which are assumed to
+// charge the given policy. The payment is modified in place: it will target
+// the first trampoline node, carry a trampoline onion that instructs each
+// trampoline node how to forward it, and its amount and final expiry delta
+// are increased to cover the fees and expiry deltas of the trampoline nodes.
+// The last trampoline node pays the recipient using the payment address of
+// its invoice.
+//
+// NOTE: The recipient must be reachable by the last trampoline node without
+// any route hints.
+func PreparePayment(payment *routing.LightningPayment, nodes []route.Vertex,
+	policy HopPolicy, currentHeight uint32) error {
+
+	switch {
+	case len(nodes) == 0:
+		return ErrNoTrampolineNodes
+
+	case len(nodes) > DefaultMaxTrampolineHops:
+		return ErrTooManyTrampolineNodes
+
+	case payment.PaymentAddr == nil:
+		return ErrPaymentAddrRequired
+
+	case len(payment.RouteHints) > 0, len(payment.BlindedPayments) > 0,
+		len(payment.DestCustomRecords) > 0, payment.LastHop != nil:
+
+		return ErrUnsupportedPayment
+	}
+
+	// The payment hash doubles as the associated data of the trampoline
+	// onion. AMP payments don't have a single payment hash, so they
+	// aren't supported.
+	paymentHash := payment.Identifier()
+	if err := payment.SetPaymentHash(paymentHash); err != nil {
+		return ErrUnsupportedPayment
+	}
+
+	recipient, err := bronec.ParsePubKey(payment.Target[:], bronec.S256())
+	if err != nil {
+		return err
+	}
+
+	// Walk backwards from the recipient to compute the amount and expiry
+	// that each trampoline node should forward.
+	var (
+		amt   = payment.Amount
+		cltv  = currentHeight + uint32(payment.FinalCLTVDelta)
+		hops  = make([]*OnionHop, len(nodes))
+		outID = recipient
+	)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		nodePub, err := bronec.ParsePubKey(nodes[i][:], bronec.S256())
+		if err != nil {
+			return err
+		}
+
+		payload := &Payload{
+			AmtToForward:   amt,
+			OutgoingCltv:   cltv,
+			OutgoingNodeID: outID,
+		}
+
+		// The last trampoline node pays the recipient directly, so it
+		// needs the payment data from the recipient's invoice.
+		if i == len(nodes)-1 {
+			payload.PaymentData = record.NewMPP(
+				payment.Amount, *payment.PaymentAddr,
+			)
+		}
+
+		var b bytes.Buffer
+		if err := payload.Encode(&b); err != nil {
+			return err
+		}
+
+		hops[i] = &OnionHop{
+			NodePub: nodePub,
+			Payload: b.Bytes(),
+		}
+
+		amt += policy.Fee(amt)
+		cltv += uint32(policy.CltvDelta)
+		outID = nodePub
+	}
+
+	sessionKey, err := bronec.NewPrivateKey(bronec.S256())
+	if err != nil {
+		return err
+	}
+
+	onion, err := NewOnionPacket(sessionKey, hops, paymentHash[:])
+	if err != nil {
+		return err
+	}
+
+	onionBytes, err := onion.Bytes()
+	if err != nil {
+		return err
+	}
+
+	// The outer payment to the first trampoline node gets a fresh
+	// payment address, so that the recipient's address is only revealed
+	// to the last trampoline node.
+	var paymentAddr [32]byte
+	if _, err := rand.Read(paymentAddr[:]); err != nil {
+		return err
+	}
+
+	finalCltvDelta := uint32(payment.FinalCLTVDelta) +
+		uint32(len(nodes))*uint32(policy.CltvDelta)
+
+	payment.Target = nodes[0]
+	payment.Amount = amt
+	payment.FinalCLTVDelta = uint16(finalCltvDelta)
+	payment.PaymentAddr = &paymentAddr
+	payment.DestFeatures = trampolineFeatures
+	payment.TrampolineOnion = onionBytes
+
+	return nil
+}
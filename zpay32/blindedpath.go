@@ -0,0 +1,196 @@
+package zpay32
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/brsuite/broln/routing/blindedpath"
+	"github.com/brsuite/brond/bronec"
+	"github.com/brsuite/bronutil/bech32"
+)
+
+const (
+	// blindedPayInfoLen is the number of bytes needed to encode the
+	// aggregated relay parameters of a blinded payment path.
+	blindedPayInfoLen = 26
+
+	// maxNumBlindedHops is the maximum number of hops that can be encoded
+	// in a single blinded payment path.
+	maxNumBlindedHops = 255
+)
+
+// encodeBlindedPayment serializes a blinded payment path into the following
+// format: the aggregated fee base (u32), fee rate (u32), cltv delta (u16),
+// htlc minimum (u64) and htlc maximum (u64) of the path, followed by the
+// introduction node (33 bytes), the blinding point (33 bytes), the number of
+// hops (u8) and, for each hop, its blinded node pubkey (33 bytes) along with
+// its length-prefixed (u16) encrypted data.
+func encodeBlindedPayment(w io.Writer,
+	payment *blindedpath.BlindedPayment) error {
+
+	path := payment.BlindedPath
+	if len(path.BlindedHops) == 0 {
+		return blindedpath.ErrNoHops
+	}
+	if len(path.BlindedHops) > maxNumBlindedHops {
+		return fmt.Errorf("blinded path has too many hops: %d",
+			len(path.BlindedHops))
+	}
+
+	var payInfo [blindedPayInfoLen]byte
+	binary.BigEndian.PutUint32(payInfo[:4], payment.BaseFee)
+	binary.BigEndian.PutUint32(payInfo[4:8], payment.ProportionalFeeRate)
+	binary.BigEndian.PutUint16(payInfo[8:10], payment.CltvExpiryDelta)
+	binary.BigEndian.PutUint64(payInfo[10:18], payment.HtlcMinimum)
+	binary.BigEndian.PutUint64(payInfo[18:26], payment.HtlcMaximum)
+	if _, err := w.Write(payInfo[:]); err != nil {
+		return err
+	}
+
+	_, err := w.Write(path.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(path.BlindingPoint.SerializeCompressed()); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{byte(len(path.BlindedHops))}); err != nil {
+		return err
+	}
+
+	for _, hop := range path.BlindedHops {
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		if len(hop.CipherText) > 0xffff {
+			return fmt.Errorf("blinded hop data too large: %d",
+				len(hop.CipherText))
+		}
+
+		var dataLen [2]byte
+		binary.BigEndian.PutUint16(dataLen[:], uint16(len(hop.CipherText)))
+		if _, err := w.Write(dataLen[:]); err != nil {
+			return err
+		}
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeBlindedPayment deserializes a blinded payment path that was encoded
+// using encodeBlindedPayment.
+func decodeBlindedPayment(r io.Reader) (*blindedpath.BlindedPayment, error) {
+	var payInfo [blindedPayInfoLen]byte
+	if _, err := io.ReadFull(r, payInfo[:]); err != nil {
+		return nil, err
+	}
+
+	introductionPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+	blindingPoint, err := readPubKey(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, blindedpath.ErrNoHops
+	}
+
+	hops := make([]*blindedpath.BlindedHop, 0, numHops[0])
+	for i := 0; i < int(numHops[0]); i++ {
+		blindedNodePub, err := readPubKey(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var dataLen [2]byte
+		if _, err := io.ReadFull(r, dataLen[:]); err != nil {
+			return nil, err
+		}
+
+		cipherText := make([]byte, binary.BigEndian.Uint16(dataLen[:]))
+		if _, err := io.ReadFull(r, cipherText); err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, &blindedpath.BlindedHop{
+			BlindedNodePub: blindedNodePub,
+			CipherText:     cipherText,
+		})
+	}
+
+	return &blindedpath.BlindedPayment{
+		BlindedPath: &blindedpath.BlindedPath{
+			IntroductionPoint: introductionPoint,
+			BlindingPoint:     blindingPoint,
+			BlindedHops:       hops,
+		},
+		BaseFee:             binary.BigEndian.Uint32(payInfo[:4]),
+		ProportionalFeeRate: binary.BigEndian.Uint32(payInfo[4:8]),
+		CltvExpiryDelta:     binary.BigEndian.Uint16(payInfo[8:10]),
+		HtlcMinimum:         binary.BigEndian.Uint64(payInfo[10:18]),
+		HtlcMaximum:         binary.BigEndian.Uint64(payInfo[18:26]),
+	}, nil
+}
+
+// readPubKey reads a compressed public key from the passed reader.
+func readPubKey(r io.Reader) (*bronec.PublicKey, error) {
+	var keyBytes [33]byte
+	if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return bronec.ParsePubKey(keyBytes[:], bronec.S256())
+}
+
+// writeBlindedPayment writes a blinded payment path as a tagged field.
+func writeBlindedPayment(bufferBase32 *bytes.Buffer,
+	payment *blindedpath.BlindedPayment) error {
+
+	var b bytes.Buffer
+	if err := encodeBlindedPayment(&b, payment); err != nil {
+		return err
+	}
+
+	paymentBase32, err := bech32.ConvertBits(b.Bytes(), 8, 5, true)
+	if err != nil {
+		return err
+	}
+
+	return writeTaggedField(bufferBase32, fieldTypeB, paymentBase32)
+}
+
+// parseBlindedPayment converts the data (encoded in base32) into a blinded
+// payment path.
+func parseBlindedPayment(data []byte) (*blindedpath.BlindedPayment, error) {
+	base256Data, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bytes.NewReader(base256Data)
+	payment, err := decodeBlindedPayment(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after blinded "+
+			"payment path", r.Len())
+	}
+
+	return payment, nil
+}
@@ -279,6 +279,18 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.RouteHints = append(invoice.RouteHints, routeHint)
+		case fieldTypeB:
+			// A `b` field can be included in an invoice multiple
+			// times, so we won't skip it if we have already seen
+			// one.
+			payment, err := parseBlindedPayment(base32Data)
+			if err != nil {
+				return err
+			}
+
+			invoice.BlindedPaymentPaths = append(
+				invoice.BlindedPaymentPaths, payment,
+			)
 		case fieldType9:
 			if invoice.Features != nil {
 				// We skip the field if we have already seen a
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg"
 	"github.com/brsuite/bronutil"
@@ -73,6 +74,10 @@ const (
 	// probing the recipient.
 	fieldTypeS = 16
 
+	// fieldTypeB contains a blinded payment path that can be used to reach
+	// the receiver without learning its identity.
+	fieldTypeB = 20
+
 	// maxInvoiceLength is the maximum total length an invoice can have.
 	// This is chosen to be the maximum number of bytes that can fit into a
 	// single QR code: https://en.wikipedia.org/wiki/QR_code#Storage
@@ -180,6 +185,14 @@ type Invoice struct {
 	// NOTE: This is optional.
 	RouteHints [][]HopHint
 
+	// BlindedPaymentPaths is a set of blinded paths, along with their
+	// aggregated relay parameters, that can be used to reach the receiver.
+	// Invoices containing blinded paths usually don't include route hints
+	// or the real public key of the receiver.
+	//
+	// NOTE: This is optional.
+	BlindedPaymentPaths []*blindedpath.BlindedPayment
+
 	// Features represents an optional field used to signal optional or
 	// required support for features by the receiver.
 	Features *lnwire.FeatureVector
@@ -256,6 +269,14 @@ func RouteHint(routeHint []HopHint) func(*Invoice) {
 	}
 }
 
+// WithBlindedPaymentPath is a functional option that allows callers of
+// NewInvoice to add a blinded payment path that leads to the destination.
+func WithBlindedPaymentPath(payment *blindedpath.BlindedPayment) func(*Invoice) {
+	return func(i *Invoice) {
+		i.BlindedPaymentPaths = append(i.BlindedPaymentPaths, payment)
+	}
+}
+
 // Features is a functional option that allows callers of NewInvoice to set the
 // desired feature bits that are advertised on the invoice. If this option is
 // not used, an empty feature vector will automatically be populated.
@@ -365,6 +386,14 @@ func validateInvoice(invoice *Invoice) error {
 			len(invoice.Destination.SerializeCompressed()))
 	}
 
+	for _, payment := range invoice.BlindedPaymentPaths {
+		if payment.BlindedPath == nil ||
+			len(payment.BlindedPath.BlindedHops) == 0 {
+
+			return fmt.Errorf("blinded payment path has no hops")
+		}
+	}
+
 	// Ensure that all invoices have feature vectors.
 	if invoice.Features == nil {
 		return fmt.Errorf("missing feature vector")
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/brsuite/broln/lnwire"
+	"github.com/brsuite/broln/routing/blindedpath"
 	"github.com/brsuite/brond/bronec"
 	"github.com/brsuite/brond/chaincfg"
 	"github.com/brsuite/brond/chaincfg/chainhash"
@@ -955,6 +956,73 @@ func TestInvoiceChecksumMalleability(t *testing.T) {
 
 }
 
+// TestBlindedPaymentPaths ensures that invoices containing blinded payment
+// paths can be encoded and decoded, and that an invoice can't contain a
+// blinded path without any hops.
+func TestBlindedPaymentPaths(t *testing.T) {
+	t.Parallel()
+
+	newPayment := func(numHops int) *blindedpath.BlindedPayment {
+		hops := make([]*blindedpath.BlindedHop, numHops)
+		for i := range hops {
+			hops[i] = &blindedpath.BlindedHop{
+				BlindedNodePub: testPubKey,
+				CipherText:     bytes.Repeat([]byte{byte(i)}, 40+i),
+			}
+		}
+
+		return &blindedpath.BlindedPayment{
+			BlindedPath: &blindedpath.BlindedPath{
+				IntroductionPoint: testPubKey,
+				BlindingPoint:     testPubKey,
+				BlindedHops:       hops,
+			},
+			BaseFee:             1000,
+			ProportionalFeeRate: 20,
+			CltvExpiryDelta:     80,
+			HtlcMinimum:         1,
+			HtlcMaximum:         uint64(testMillisat25mBRON),
+		}
+	}
+
+	invoice, err := NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash,
+		time.Unix(1496314658, 0), Amount(testMillisat25mBRON),
+		Description(testCupOfCoffee), PaymentAddr(specPaymentAddr),
+		WithBlindedPaymentPath(newPayment(2)),
+		WithBlindedPaymentPath(newPayment(1)),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+
+	encoded, err := invoice.Encode(testMessageSigner)
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	decoded, err := Decode(encoded, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+
+	// The destination isn't part of the encoded invoice, so it's recovered
+	// from the signature instead.
+	invoice.Destination = testPubKey
+	if err := compareInvoices(invoice, decoded); err != nil {
+		t.Fatalf("decoded invoice mismatch: %v", err)
+	}
+
+	_, err = NewInvoice(
+		&chaincfg.MainNetParams, testPaymentHash,
+		time.Unix(1496314658, 0), Description(testCupOfCoffee),
+		WithBlindedPaymentPath(newPayment(0)),
+	)
+	if err == nil {
+		t.Fatalf("expected invoice with empty blinded path to fail")
+	}
+}
+
 func compareInvoices(expected, actual *Invoice) error {
 	if !reflect.DeepEqual(expected.Net, actual.Net) {
 		return fmt.Errorf("expected net %v, got %v",
@@ -1013,6 +1081,21 @@ func compareInvoices(expected, actual *Invoice) error {
 		}
 	}
 
+	if len(expected.BlindedPaymentPaths) != len(actual.BlindedPaymentPaths) {
+		return fmt.Errorf("expected %d BlindedPaymentPaths, got %d",
+			len(expected.BlindedPaymentPaths),
+			len(actual.BlindedPaymentPaths))
+	}
+
+	for i, payment := range expected.BlindedPaymentPaths {
+		err := compareBlindedPayments(
+			payment, actual.BlindedPaymentPaths[i],
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	if !reflect.DeepEqual(expected.Features, actual.Features) {
 		return fmt.Errorf("expected features %v, got %v",
 			expected.Features, actual.Features)
@@ -1082,3 +1165,55 @@ func compareRouteHints(a, b []HopHint) error {
 
 	return nil
 }
+
+func compareBlindedPayments(a, b *blindedpath.BlindedPayment) error {
+	if a.BaseFee != b.BaseFee ||
+		a.ProportionalFeeRate != b.ProportionalFeeRate ||
+		a.CltvExpiryDelta != b.CltvExpiryDelta ||
+		a.HtlcMinimum != b.HtlcMinimum ||
+		a.HtlcMaximum != b.HtlcMaximum {
+
+		return fmt.Errorf("expected blinded payment info %v, got %v",
+			payInfoString(a), payInfoString(b))
+	}
+
+	pathA, pathB := a.BlindedPath, b.BlindedPath
+	if !comparePubkeys(pathA.IntroductionPoint, pathB.IntroductionPoint) {
+		return fmt.Errorf("expected introduction point %x, got %x",
+			pathA.IntroductionPoint.SerializeCompressed(),
+			pathB.IntroductionPoint.SerializeCompressed())
+	}
+
+	if !comparePubkeys(pathA.BlindingPoint, pathB.BlindingPoint) {
+		return fmt.Errorf("expected blinding point %x, got %x",
+			pathA.BlindingPoint.SerializeCompressed(),
+			pathB.BlindingPoint.SerializeCompressed())
+	}
+
+	if len(pathA.BlindedHops) != len(pathB.BlindedHops) {
+		return fmt.Errorf("expected %d blinded hops, got %d",
+			len(pathA.BlindedHops), len(pathB.BlindedHops))
+	}
+
+	for i, hop := range pathA.BlindedHops {
+		other := pathB.BlindedHops[i]
+		if !comparePubkeys(hop.BlindedNodePub, other.BlindedNodePub) {
+			return fmt.Errorf("expected blinded node pub %x, got %x",
+				hop.BlindedNodePub.SerializeCompressed(),
+				other.BlindedNodePub.SerializeCompressed())
+		}
+
+		if !bytes.Equal(hop.CipherText, other.CipherText) {
+			return fmt.Errorf("expected cipher text %x, got %x",
+				hop.CipherText, other.CipherText)
+		}
+	}
+
+	return nil
+}
+
+func payInfoString(p *blindedpath.BlindedPayment) string {
+	return fmt.Sprintf("base_fee=%d fee_rate=%d cltv_delta=%d "+
+		"htlc_min=%d htlc_max=%d", p.BaseFee, p.ProportionalFeeRate,
+		p.CltvExpiryDelta, p.HtlcMinimum, p.HtlcMaximum)
+}